@@ -17,12 +17,16 @@ import (
 
 	"woragis-jobs-service/internal/config"
 	"woragis-jobs-service/internal/database"
+	"woragis-jobs-service/pkg/aiservice"
 	"woragis-jobs-service/pkg/health"
 	applogger "woragis-jobs-service/pkg/logger"
 	appmetrics "woragis-jobs-service/pkg/metrics"
+	"woragis-jobs-service/pkg/pagination"
 	appsecurity "woragis-jobs-service/pkg/security"
+	"woragis-jobs-service/pkg/storage"
 	apptimeout "woragis-jobs-service/pkg/timeout"
 	apptracing "woragis-jobs-service/pkg/tracing"
+	"woragis-jobs-service/pkg/validation"
 
 	jobsdomain "woragis-jobs-service/internal/domains"
 	authPkg "woragis-jobs-service/pkg/auth"
@@ -72,10 +76,24 @@ func logEnvironmentVariables(logger *slog.Logger, env string) {
 	// Application variables (with defaults)
 	logger.Info("Application Variables:")
 	appVars := map[string]string{
-		"APP_NAME":       os.Getenv("APP_NAME"),
-		"PORT":           os.Getenv("PORT"),
-		"ENV":            env,
-		"APP_PUBLIC_URL": os.Getenv("APP_PUBLIC_URL"),
+		"APP_NAME":                   os.Getenv("APP_NAME"),
+		"PORT":                       os.Getenv("PORT"),
+		"ENV":                        env,
+		"APP_PUBLIC_URL":             os.Getenv("APP_PUBLIC_URL"),
+		"PAGINATION_DEFAULT_LIMIT":   os.Getenv("PAGINATION_DEFAULT_LIMIT"),
+		"PAGINATION_MAX_LIMIT":       os.Getenv("PAGINATION_MAX_LIMIT"),
+		"MAX_TAGS":                   os.Getenv("MAX_TAGS"),
+		"MAX_TAG_LENGTH":             os.Getenv("MAX_TAG_LENGTH"),
+		"RATE_LIMIT_BUDGET":          os.Getenv("RATE_LIMIT_BUDGET"),
+		"RATE_LIMIT_WINDOW":          os.Getenv("RATE_LIMIT_WINDOW"),
+		"RATE_LIMIT_DEFAULT_WEIGHT":  os.Getenv("RATE_LIMIT_DEFAULT_WEIGHT"),
+		"RATE_LIMIT_WEIGHTS":         os.Getenv("RATE_LIMIT_WEIGHTS"),
+		"AUTO_ATTACH_DEFAULT_RESUME": os.Getenv("AUTO_ATTACH_DEFAULT_RESUME"),
+		"DEFAULT_INTEREST_LEVEL":     os.Getenv("DEFAULT_INTEREST_LEVEL"),
+		"APPLICATION_CACHE_ENABLED":  os.Getenv("APPLICATION_CACHE_ENABLED"),
+		"APPLICATION_CACHE_TTL":      os.Getenv("APPLICATION_CACHE_TTL"),
+		"EXPORT_SANITIZE_RULES":      os.Getenv("EXPORT_SANITIZE_RULES"),
+		"EXPORT_PROFANITY_WORDS":     os.Getenv("EXPORT_PROFANITY_WORDS"),
 	}
 	for key, val := range appVars {
 		status := "✓"
@@ -90,10 +108,14 @@ func logEnvironmentVariables(logger *slog.Logger, env string) {
 	// Database connection pool settings (optional)
 	logger.Info("Database Pool Settings (optional):")
 	dbPoolVars := map[string]string{
-		"DATABASE_MAX_OPEN_CONNS":    os.Getenv("DATABASE_MAX_OPEN_CONNS"),
-		"DATABASE_MAX_IDLE_CONNS":    os.Getenv("DATABASE_MAX_IDLE_CONNS"),
-		"DATABASE_MAX_IDLE_TIME":     os.Getenv("DATABASE_MAX_IDLE_TIME"),
-		"DATABASE_CONN_MAX_LIFETIME": os.Getenv("DATABASE_CONN_MAX_LIFETIME"),
+		"DATABASE_MAX_OPEN_CONNS":        os.Getenv("DATABASE_MAX_OPEN_CONNS"),
+		"DATABASE_MAX_IDLE_CONNS":        os.Getenv("DATABASE_MAX_IDLE_CONNS"),
+		"DATABASE_MAX_IDLE_TIME":         os.Getenv("DATABASE_MAX_IDLE_TIME"),
+		"DATABASE_CONN_MAX_LIFETIME":     os.Getenv("DATABASE_CONN_MAX_LIFETIME"),
+		"DATABASE_RETRY_MAX_ATTEMPTS":    os.Getenv("DATABASE_RETRY_MAX_ATTEMPTS"),
+		"DATABASE_RETRY_INITIAL_BACKOFF": os.Getenv("DATABASE_RETRY_INITIAL_BACKOFF"),
+		"DATABASE_RETRY_MAX_BACKOFF":     os.Getenv("DATABASE_RETRY_MAX_BACKOFF"),
+		"DATABASE_SLOW_QUERY_THRESHOLD":  os.Getenv("DATABASE_SLOW_QUERY_THRESHOLD"),
 	}
 	for key, val := range dbPoolVars {
 		status := "○"
@@ -108,8 +130,14 @@ func logEnvironmentVariables(logger *slog.Logger, env string) {
 	// Redis settings (optional)
 	logger.Info("Redis Settings (optional):")
 	redisVars := map[string]string{
-		"REDIS_PASSWORD": os.Getenv("REDIS_PASSWORD"),
-		"REDIS_DB":       os.Getenv("REDIS_DB"),
+		"REDIS_PASSWORD":       os.Getenv("REDIS_PASSWORD"),
+		"REDIS_DB":             os.Getenv("REDIS_DB"),
+		"REDIS_POOL_SIZE":      os.Getenv("REDIS_POOL_SIZE"),
+		"REDIS_MIN_IDLE_CONNS": os.Getenv("REDIS_MIN_IDLE_CONNS"),
+		"REDIS_DIAL_TIMEOUT":   os.Getenv("REDIS_DIAL_TIMEOUT"),
+		"REDIS_READ_TIMEOUT":   os.Getenv("REDIS_READ_TIMEOUT"),
+		"REDIS_WRITE_TIMEOUT":  os.Getenv("REDIS_WRITE_TIMEOUT"),
+		"REDIS_MAX_RETRIES":    os.Getenv("REDIS_MAX_RETRIES"),
 	}
 	for key, val := range redisVars {
 		status := "○"
@@ -151,6 +179,12 @@ func logEnvironmentVariables(logger *slog.Logger, env string) {
 		"RABBITMQ_USER":     os.Getenv("RABBITMQ_USER"),
 		"RABBITMQ_PASSWORD": os.Getenv("RABBITMQ_PASSWORD"),
 		"RABBITMQ_VHOST":    os.Getenv("RABBITMQ_VHOST"),
+		"RESUME_QUEUE_HIGH_PRIORITY_QUEUE":         os.Getenv("RESUME_QUEUE_HIGH_PRIORITY_QUEUE"),
+		"RESUME_QUEUE_HIGH_PRIORITY_ROUTING_KEY":   os.Getenv("RESUME_QUEUE_HIGH_PRIORITY_ROUTING_KEY"),
+		"RESUME_QUEUE_NORMAL_PRIORITY_ROUTING_KEY": os.Getenv("RESUME_QUEUE_NORMAL_PRIORITY_ROUTING_KEY"),
+		"RESUME_QUEUE_MESSAGE_TTL":                 os.Getenv("RESUME_QUEUE_MESSAGE_TTL"),
+		"RESUME_QUEUE_MAX_LENGTH":                  os.Getenv("RESUME_QUEUE_MAX_LENGTH"),
+		"RESUME_QUEUE_OVERFLOW":                    os.Getenv("RESUME_QUEUE_OVERFLOW"),
 	}
 	for key, val := range rabbitVars {
 		status := "○"
@@ -214,9 +248,91 @@ func logEnvironmentVariables(logger *slog.Logger, env string) {
 	// AI Service settings (optional)
 	logger.Info("AI Service Settings (optional):")
 	aiVars := map[string]string{
-		"AI_SERVICE_URL": os.Getenv("AI_SERVICE_URL"),
+		"AI_SERVICE_URL":            os.Getenv("AI_SERVICE_URL"),
+		"AI_SERVICE_API_KEY_HEADER": os.Getenv("AI_SERVICE_API_KEY_HEADER"),
+		"AI_SERVICE_API_KEY":        os.Getenv("AI_SERVICE_API_KEY"),
+		"AI_SERVICE_MAX_RESPONSE_BYTES": os.Getenv("AI_SERVICE_MAX_RESPONSE_BYTES"),
+		"AI_SERVICE_BREAKER_THRESHOLD":  os.Getenv("AI_SERVICE_BREAKER_THRESHOLD"),
+		"AI_SERVICE_BREAKER_COOLDOWN":   os.Getenv("AI_SERVICE_BREAKER_COOLDOWN"),
+		"AI_SERVICE_TIMEOUT":            os.Getenv("AI_SERVICE_TIMEOUT"),
+		"AI_SERVICE_MAX_CONCURRENT":     os.Getenv("AI_SERVICE_MAX_CONCURRENT"),
+		"AI_SERVICE_CONCURRENCY_WAIT":   os.Getenv("AI_SERVICE_CONCURRENCY_WAIT"),
+		"REQUEST_TIMEOUT":               os.Getenv("REQUEST_TIMEOUT"),
+		"REQUEST_TIMEOUT_AI_ROUTES":     os.Getenv("REQUEST_TIMEOUT_AI_ROUTES"),
+		"AI_COVER_LETTER_SANITIZE":      os.Getenv("AI_COVER_LETTER_SANITIZE"),
+		"AI_COVER_LETTER_PREAMBLE_PATTERNS": os.Getenv("AI_COVER_LETTER_PREAMBLE_PATTERNS"),
 	}
 	for key, val := range aiVars {
+		status := "○"
+		display := "<using default>"
+		if val != "" {
+			status = "✓"
+			if key == "AI_SERVICE_API_KEY" {
+				display = maskValue(val)
+			} else {
+				display = val
+			}
+		}
+		logger.Info("  "+status+" "+key, "value", display)
+	}
+	
+	// File storage settings (optional)
+	logger.Info("File Storage Settings (optional):")
+	storageVars := map[string]string{
+		"STORAGE_BACKEND":      os.Getenv("STORAGE_BACKEND"),
+		"STORAGE_LOCAL_PATH":   os.Getenv("STORAGE_LOCAL_PATH"),
+		"S3_ENDPOINT":          os.Getenv("S3_ENDPOINT"),
+		"S3_REGION":            os.Getenv("S3_REGION"),
+		"S3_BUCKET":            os.Getenv("S3_BUCKET"),
+		"S3_ACCESS_KEY_ID":     os.Getenv("S3_ACCESS_KEY_ID"),
+		"S3_SECRET_ACCESS_KEY": os.Getenv("S3_SECRET_ACCESS_KEY"),
+		"S3_USE_PATH_STYLE":    os.Getenv("S3_USE_PATH_STYLE"),
+	}
+	for key, val := range storageVars {
+		status := "○"
+		display := "<using default>"
+		if val != "" {
+			status = "✓"
+			if key == "S3_ACCESS_KEY_ID" || key == "S3_SECRET_ACCESS_KEY" {
+				display = maskValue(val)
+			} else {
+				display = val
+			}
+		}
+		logger.Info("  "+status+" "+key, "value", display)
+	}
+	
+	// Reminder settings (optional)
+	logger.Info("Reminder Settings (optional):")
+	reminderVars := map[string]string{
+		"REMINDERS_ENABLED":             os.Getenv("REMINDERS_ENABLED"),
+		"REMINDER_SCAN_INTERVAL":        os.Getenv("REMINDER_SCAN_INTERVAL"),
+		"REMINDER_DEADLINE_DAYS_BEFORE": os.Getenv("REMINDER_DEADLINE_DAYS_BEFORE"),
+		"REMINDER_WEBHOOK_URL":          os.Getenv("REMINDER_WEBHOOK_URL"),
+		"REMINDER_WEBHOOK_SECRET":       os.Getenv("REMINDER_WEBHOOK_SECRET"),
+	}
+	for key, val := range reminderVars {
+		status := "○"
+		display := "<using default>"
+		if val != "" {
+			status = "✓"
+			if key == "REMINDER_WEBHOOK_SECRET" {
+				display = maskValue(val)
+			} else {
+				display = val
+			}
+		}
+		logger.Info("  "+status+" "+key, "value", display)
+	}
+
+	// Resume job reaper settings (optional)
+	logger.Info("Resume Job Reaper Settings (optional):")
+	reaperVars := map[string]string{
+		"RESUME_JOB_REAPER_ENABLED":     os.Getenv("RESUME_JOB_REAPER_ENABLED"),
+		"RESUME_JOB_REAPER_INTERVAL":    os.Getenv("RESUME_JOB_REAPER_INTERVAL"),
+		"RESUME_JOB_PROCESSING_TIMEOUT": os.Getenv("RESUME_JOB_PROCESSING_TIMEOUT"),
+	}
+	for key, val := range reaperVars {
 		status := "○"
 		display := "<using default>"
 		if val != "" {
@@ -229,7 +345,8 @@ func logEnvironmentVariables(logger *slog.Logger, env string) {
 	// Observability settings (optional)
 	logger.Info("Observability Settings (optional):")
 	obsVars := map[string]string{
-		"JAEGER_ENDPOINT": os.Getenv("JAEGER_ENDPOINT"),
+		"JAEGER_ENDPOINT":        os.Getenv("JAEGER_ENDPOINT"),
+		"HEALTH_CHECK_CACHE_TTL": os.Getenv("HEALTH_CHECK_CACHE_TTL"),
 	}
 	for key, val := range obsVars {
 		status := "○"
@@ -245,6 +362,21 @@ func logEnvironmentVariables(logger *slog.Logger, env string) {
 	logger.Info("")
 }
 
+// newStorageBackend builds the file storage backend selected by cfg
+func newStorageBackend(cfg *config.StorageConfig) (storage.Backend, error) {
+	if cfg.Backend == config.StorageBackendS3 {
+		return storage.NewS3Backend(storage.S3Config{
+			Endpoint:        cfg.S3Endpoint,
+			Region:          cfg.S3Region,
+			Bucket:          cfg.S3Bucket,
+			AccessKeyID:     cfg.S3AccessKeyID,
+			SecretAccessKey: cfg.S3SecretAccessKey,
+			UsePathStyle:    cfg.S3UsePathStyle,
+		})
+	}
+	return storage.NewLocalBackend(cfg.LocalPath)
+}
+
 // validateRequiredEnvVars checks that all required environment variables are set
 func validateRequiredEnvVars(logger *slog.Logger, env string) error {
 	required := []string{
@@ -303,11 +435,15 @@ func main() {
 		os.Exit(1)
 	}
 
+	build := buildInfo()
+	slogLogger.Info("build info", "version", build.Version, "commit", build.Commit, "build_time", build.BuildTime)
+
 	// Initialize OpenTelemetry tracing
 	slogLogger.Info("initializing tracing...")
 	tracingShutdown, err := apptracing.Init(apptracing.Config{
 		ServiceName:    cfg.AppName,
-		ServiceVersion: "1.4.1",
+		ServiceVersion: build.Version,
+		ServiceCommit:  build.Commit,
 		Environment:    env,
 		JaegerEndpoint: os.Getenv("JAEGER_ENDPOINT"), // Defaults to http://jaeger:4318
 	})
@@ -325,11 +461,15 @@ func main() {
 	// Load database and Redis configs
 	slogLogger.Info("loading database and redis configurations...")
 	dbCfg := config.LoadDatabaseConfig()
-	redisCfg := config.LoadRedisConfig()
+	redisCfg, err := config.LoadRedisConfig()
+	if err != nil {
+		slogLogger.Error("invalid redis configuration", "error", err)
+		os.Exit(1)
+	}
 
 	// Initialize database manager
 	slogLogger.Info("initializing database manager...")
-	dbManager, err := database.NewFromConfig(dbCfg, redisCfg)
+	dbManager, err := database.NewFromConfig(dbCfg, redisCfg, slogLogger)
 	if err != nil {
 		slogLogger.Error("failed to initialize database manager", "error", err)
 		os.Exit(1)
@@ -363,16 +503,34 @@ func main() {
 	app.Use(appsecurity.SecurityHeadersMiddleware())
 
 	// CORS middleware (if enabled) - must be early to handle preflight requests
-	corsCfg := config.LoadCORSConfig()
+	corsCfg, err := config.LoadCORSConfig()
+	if err != nil {
+		slogLogger.Error("invalid CORS configuration", "error", err)
+		os.Exit(1)
+	}
 	if corsCfg.Enabled {
-		slogLogger.Info("CORS enabled", "allowed_origins", corsCfg.AllowedOrigins, "allowed_methods", corsCfg.AllowedMethods, "allow_credentials", corsCfg.AllowCredentials)
+		slogLogger.Info("CORS enabled", "allowed_origins", corsCfg.AllowedOrigins, "allowed_methods", corsCfg.AllowedMethods, "allow_credentials", corsCfg.AllowCredentials, "exposed_headers", corsCfg.ExposedHeaders, "max_age", corsCfg.MaxAge)
 		config.SetupCORS(app, corsCfg)
 	} else {
 		slogLogger.Info("CORS disabled")
 	}
 
-	// Request timeout middleware (30 seconds default)
-	app.Use(apptimeout.Middleware(apptimeout.DefaultConfig()))
+	// Request timeout middleware (30 seconds default; AI-backed routes outlast AI_SERVICE_TIMEOUT)
+	requestTimeoutCfg, err := config.LoadRequestTimeoutConfig()
+	if err != nil {
+		slogLogger.Error("invalid request timeout configuration", "error", err)
+		os.Exit(1)
+	}
+	timeoutCfg := apptimeout.DefaultConfig()
+	timeoutCfg.DefaultTimeout = requestTimeoutCfg.Default
+	for _, route := range requestTimeoutCfg.Routes {
+		timeoutCfg.Routes = append(timeoutCfg.Routes, apptimeout.RouteTimeout{
+			Method:  route.Method,
+			Pattern: route.Pattern,
+			Timeout: route.Timeout,
+		})
+	}
+	app.Use(apptimeout.Middleware(timeoutCfg))
 
 	// Add OpenTelemetry tracing middleware (must be first to extract trace context)
 	app.Use(apptracing.Middleware(cfg.AppName))
@@ -383,11 +541,11 @@ func main() {
 	// Add Prometheus metrics middleware
 	app.Use(appmetrics.Middleware())
 
-	// Request size limit (10MB)
-	app.Use(appsecurity.RequestSizeLimitMiddleware(10 * 1024 * 1024))
+	// Request size ceiling (16MB); route groups narrow it with RequestSizeLimitFor
+	app.Use(appsecurity.RequestSizeLimitMiddleware(16 * 1024 * 1024))
 
-	// Input sanitization
-	app.Use(appsecurity.InputSanitizationMiddleware())
+	// Input sanitization (long-form text fields such as jobDescription are exempt)
+	app.Use(appsecurity.InputSanitizationMiddleware(appsecurity.DefaultInputSanitizationConfig()))
 
 	// CSRF protection (for state-changing requests)
 	// Secure cookie should be false in development (HTTP) and true in production (HTTPS)
@@ -398,14 +556,31 @@ func main() {
 	// Rate limiting (100 requests per minute per IP/user)
 	app.Use(appsecurity.RateLimitMiddleware(100, time.Minute))
 
-	// Initialize health checker
+	// Initialize health checker (readiness results are cached briefly so frequent probes don't hammer dependencies)
+	healthCfg, err := config.LoadHealthConfig()
+	if err != nil {
+		slogLogger.Error("invalid health check configuration", "error", err)
+		os.Exit(1)
+	}
 	healthChecker := health.NewHealthChecker(dbManager.GetPostgres(), dbManager.GetRedis(), slogLogger)
+	healthChecker.SetCacheTTL(healthCfg.CacheTTL)
+	healthChecker.SetBuildInfo(build)
+	if rabbitMQ := dbManager.GetRabbitMQ(); rabbitMQ != nil {
+		healthChecker.SetRabbitMQChecker(rabbitMQ)
+	}
 
 	// Health check endpoints (before API routes, no auth required)
 	app.Get("/healthz", healthChecker.Handler())                // Combined health check
 	app.Get("/healthz/live", healthChecker.LivenessHandler())   // Liveness probe (Kubernetes)
 	app.Get("/healthz/ready", healthChecker.ReadinessHandler()) // Readiness probe (Kubernetes)
 
+	// Schema version endpoint, reports applied vs expected version to diagnose rollout skew
+	schemaVersionReader := jobsdomain.NewSchemaVersionReader(dbManager.GetPostgres())
+	app.Get("/healthz/schema", jobsdomain.SchemaVersionHandler(schemaVersionReader, slogLogger))
+
+	// Build version, commit and time (no auth required)
+	app.Get("/version", versionHandler(build))
+
 	// Prometheus metrics endpoint (before API routes, no auth required)
 	app.Get("/metrics", adaptor.HTTPHandler(promhttp.Handler()))
 
@@ -421,12 +596,36 @@ func main() {
 			"message": "CSRF token available in X-CSRF-Token header",
 		})
 	})
+	// Checks a stored CSRF token without rotating it (exempt from CSRF validation)
+	api.Post("/csrf-token/validate", appsecurity.CSRFValidateHandler(csrfCfg))
 
-	// Load AI service URL for cover letter generation
-	aiServiceURL := os.Getenv("AI_SERVICE_URL")
-	if aiServiceURL == "" {
-		aiServiceURL = "http://ai-service:8000"
+	// Load AI service settings for cover letter generation
+	aiServiceCfg, err := config.LoadAIServiceConfig()
+	if err != nil {
+		slogLogger.Error("invalid AI service configuration", "error", err)
+		os.Exit(1)
+	}
+	if aiServiceCfg.URL != "" {
+		// Health probes only hit the AI service /healthz; generation builds its own client in SetupRoutes
+		healthChecker.SetAIServiceChecker(aiservice.NewClientWithOptions(aiServiceCfg.URL, aiservice.ClientOptions{
+			APIKeyHeader: aiServiceCfg.APIKeyHeader,
+			APIKey:       aiServiceCfg.APIKey,
+			Timeout:      aiServiceCfg.Timeout,
+		}))
+	}
+
+	// Load file storage settings for resume files
+	storageCfg, err := config.LoadStorageConfig()
+	if err != nil {
+		slogLogger.Error("invalid file storage configuration", "error", err)
+		os.Exit(1)
 	}
+	fileStorage, err := newStorageBackend(storageCfg)
+	if err != nil {
+		slogLogger.Error("failed to initialize file storage", "backend", storageCfg.Backend, "error", err)
+		os.Exit(1)
+	}
+	slogLogger.Info("file storage initialized", "backend", storageCfg.Backend)
 
 	// Initialize JWT manager for token validation (shared secret with auth service)
 	authCfg, err := config.LoadAuthConfig()
@@ -447,15 +646,80 @@ func main() {
 		}
 	}
 
+	// Page size limits shared by every list endpoint
+	paginationCfg, err := config.LoadPaginationConfig()
+	if err != nil {
+		slogLogger.Error("invalid pagination configuration", "error", err)
+		os.Exit(1)
+	}
+	if err := pagination.SetLimits(pagination.Limits{Default: paginationCfg.DefaultLimit, Max: paginationCfg.MaxLimit}); err != nil {
+		slogLogger.Error("invalid pagination configuration", "error", err)
+		os.Exit(1)
+	}
+
+	// Tag limits shared by applications and resumes
+	tagCfg, err := config.LoadTagConfig()
+	if err != nil {
+		slogLogger.Error("invalid tag configuration", "error", err)
+		os.Exit(1)
+	}
+	if err := validation.SetTagLimits(validation.TagLimits{MaxTags: tagCfg.MaxTags, MaxTagLength: tagCfg.MaxTagLength}); err != nil {
+		slogLogger.Error("invalid tag configuration", "error", err)
+		os.Exit(1)
+	}
+
+	// Weighted per-user budget (AI generations cost more than reads), applied after authentication
+	rateLimitCfg, err := config.LoadRateLimitConfig()
+	if err != nil {
+		slogLogger.Error("invalid rate limit configuration", "error", err)
+		os.Exit(1)
+	}
+
+	// Priority lanes for resume generation jobs on RabbitMQ
+	resumeQueueCfg, err := config.LoadResumeQueueConfig()
+	if err != nil {
+		slogLogger.Error("invalid resume queue configuration", "error", err)
+		os.Exit(1)
+	}
+
+	// Per-user feature flags for gradual rollouts
+	featureFlagCfg, err := config.LoadFeatureFlagConfig()
+	if err != nil {
+		slogLogger.Error("invalid feature flag configuration", "error", err)
+		os.Exit(1)
+	}
+
+	// Offline clients flush queued changes through POST /api/v1/batch
+	batchCfg, err := config.LoadBatchConfig()
+	if err != nil {
+		slogLogger.Error("invalid batch configuration", "error", err)
+		os.Exit(1)
+	}
+
 	// Setup jobs domain routes
 	slogLogger.Info("setting up routes...")
-	jobsdomain.SetupRoutes(api, dbManager, jwtManager, aiServiceURL, slogLogger)
+	jobsdomain.SetupRoutes(api, dbManager, jwtManager, aiServiceCfg, rateLimitCfg, config.LoadJobApplicationConfig(), config.LoadExportConfig(), resumeQueueCfg, featureFlagCfg, batchCfg, fileStorage, slogLogger)
 	slogLogger.Info("routes configured successfully")
 
 	// Setup graceful shutdown
 	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
 	defer stop()
 
+	// Start follow-up/deadline reminders (stops with the shutdown signal)
+	reminderCfg, err := config.LoadReminderConfig()
+	if err != nil {
+		slogLogger.Error("invalid reminder configuration", "error", err)
+		os.Exit(1)
+	}
+	jobsdomain.StartReminderScanner(ctx, dbManager, reminderCfg, slogLogger)
+
+	resumeReaperCfg, err := config.LoadResumeJobReaperConfig()
+	if err != nil {
+		slogLogger.Error("invalid resume job reaper configuration", "error", err)
+		os.Exit(1)
+	}
+	jobsdomain.StartResumeJobReaper(ctx, dbManager, resumeReaperCfg, slogLogger)
+
 	// Start server in a goroutine
 	go func() {
 		addr := fmt.Sprintf(":%s", cfg.Port)
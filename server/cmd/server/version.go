@@ -0,0 +1,43 @@
+package main
+
+import (
+	"runtime/debug"
+
+	"github.com/gofiber/fiber/v2"
+
+	"woragis-jobs-service/pkg/health"
+)
+
+// Build identification, set at build time:
+//
+//	go build -ldflags "-X main.Version=1.5.0 -X main.Commit=$(git rev-parse HEAD) -X main.BuildTime=$(date -u +%Y-%m-%dT%H:%M:%SZ)" ./cmd/server
+var (
+	Version   = "dev"
+	Commit    string
+	BuildTime string
+)
+
+// buildInfo returns the build identification. A commit or build time not set through
+// ldflags falls back to the VCS stamp Go records when building inside a git checkout.
+func buildInfo() health.BuildInfo {
+	build := health.BuildInfo{Version: Version, Commit: Commit, BuildTime: BuildTime}
+	if info, ok := debug.ReadBuildInfo(); ok {
+		build.GoVersion = info.GoVersion
+		for _, setting := range info.Settings {
+			switch {
+			case setting.Key == "vcs.revision" && build.Commit == "":
+				build.Commit = setting.Value
+			case setting.Key == "vcs.time" && build.BuildTime == "":
+				build.BuildTime = setting.Value
+			}
+		}
+	}
+	return build
+}
+
+// versionHandler reports the running build, for correlating metrics and traces with it
+func versionHandler(build health.BuildInfo) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		return c.JSON(build)
+	}
+}
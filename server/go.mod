@@ -7,15 +7,22 @@ require (
 	github.com/gofiber/adaptor/v2 v2.2.1
 	// Fiber
 	github.com/gofiber/fiber/v2 v2.52.9
+	github.com/valyala/fasthttp v1.51.0
 	github.com/golang-jwt/jwt/v5 v5.3.0
 	github.com/google/uuid v1.6.0
 
+	// Postgres driver errors (transient error detection)
+	github.com/jackc/pgx/v5 v5.5.5
+
 	// Godotenv
 	github.com/joho/godotenv v1.5.1
 
 	// Prometheus
 	github.com/prometheus/client_golang v1.19.1
 
+	// RabbitMQ
+	github.com/rabbitmq/amqp091-go v1.10.0
+
 	// Redis
 	github.com/redis/go-redis/v9 v9.13.0
 
@@ -29,6 +36,12 @@ require (
 	go.opentelemetry.io/otel/trace v1.27.0
 	golang.org/x/crypto v0.23.0
 
+	// HTML parsing for job posting selectors
+	golang.org/x/net v0.25.0
+
+	// Coalescing identical in-flight AI generations
+	golang.org/x/sync v0.6.0
+
 	// GORM
 	gorm.io/driver/postgres v1.5.9
 	gorm.io/gorm v1.25.12
@@ -46,7 +59,6 @@ require (
 	github.com/grpc-ecosystem/grpc-gateway/v2 v2.20.0 // indirect
 	github.com/jackc/pgpassfile v1.0.0 // indirect
 	github.com/jackc/pgservicefile v0.0.0-20221227161230-091c0ba34f0a // indirect
-	github.com/jackc/pgx/v5 v5.5.5 // indirect
 	github.com/jackc/puddle/v2 v2.2.1 // indirect
 	github.com/jinzhu/inflection v1.0.0 // indirect
 	github.com/jinzhu/now v1.1.5 // indirect
@@ -59,18 +71,14 @@ require (
 	github.com/prometheus/client_model v0.5.0 // indirect
 	github.com/prometheus/common v0.48.0 // indirect
 	github.com/prometheus/procfs v0.12.0 // indirect
-	github.com/rabbitmq/amqp091-go v1.10.0 // indirect
 	github.com/rivo/uniseg v0.2.0 // indirect
 	github.com/stretchr/objx v0.5.2 // indirect
 	github.com/tinylib/msgp v1.2.5 // indirect
 	github.com/valyala/bytebufferpool v1.0.0 // indirect
-	github.com/valyala/fasthttp v1.51.0 // indirect
 	github.com/valyala/tcplisten v1.0.0 // indirect
 	go.opentelemetry.io/otel/exporters/otlp/otlptrace v1.27.0 // indirect
 	go.opentelemetry.io/otel/metric v1.27.0 // indirect
 	go.opentelemetry.io/proto/otlp v1.2.0 // indirect
-	golang.org/x/net v0.25.0 // indirect
-	golang.org/x/sync v0.6.0 // indirect
 	golang.org/x/sys v0.28.0 // indirect
 	golang.org/x/text v0.15.0 // indirect
 	google.golang.org/genproto/googleapis/api v0.0.0-20240520151616-dc85e6b867a5 // indirect
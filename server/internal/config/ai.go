@@ -0,0 +1,188 @@
+package config
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// AIServiceConfig holds settings for reaching the AI service
+type AIServiceConfig struct {
+	URL          string
+	APIKeyHeader string
+	APIKey       string
+	// MaxResponseBytes caps how much of an AI service response is buffered
+	MaxResponseBytes int64
+	// BreakerThreshold is the number of consecutive failures that opens the circuit breaker
+	BreakerThreshold int
+	// BreakerCooldown is how long the breaker stays open before probing again
+	BreakerCooldown time.Duration
+	// Timeout bounds a single AI service call; AI-backed routes get a longer
+	// request timeout than this (see LoadRequestTimeoutConfig)
+	Timeout time.Duration
+	// MaxConcurrent caps the AI service calls in flight across the process; 0 disables the limit
+	MaxConcurrent int
+	// ConcurrencyWait is how long a call queues for a slot before failing with a 503;
+	// 0 fails immediately when the limit is reached
+	ConcurrencyWait time.Duration
+	// SanitizeCoverLetters strips preambles and code fences from generated cover letters
+	SanitizeCoverLetters bool
+	// CoverLetterPreamblePatterns replaces the built-in preamble regexes when set
+	CoverLetterPreamblePatterns []string
+	// LogCoverLetterPrompts logs cover letter prompts and response lengths at debug level
+	LogCoverLetterPrompts bool
+	// CoverLetterPromptLogMaxChars is how much of each logged prompt is kept
+	CoverLetterPromptLogMaxChars int
+	// LogFullCoverLetterPrompts logs the prompts untruncated; meant for local debugging only
+	LogFullCoverLetterPrompts bool
+	// AgentTemperatures is the default sampling temperature per AI service agent; callers
+	// may still pass their own
+	AgentTemperatures map[string]float64
+}
+
+const (
+	defaultAIServiceURL          = "http://ai-service:8000"
+	defaultAIServiceAPIKeyHeader = "X-API-Key"
+	defaultAIMaxResponseBytes    = 1 << 20  // 1MB
+	maxAIMaxResponseBytes        = 64 << 20 // 64MB
+	defaultAIBreakerThreshold    = 5
+	defaultAIBreakerCooldown     = "30s"
+	defaultAIServiceTimeout      = 90 * time.Second
+	defaultAIMaxConcurrent       = 0
+	defaultAIConcurrencyWait     = "10s"
+	defaultAIPromptLogMaxChars   = 500
+	maxAITemperature             = 2.0
+)
+
+// aiServiceTimeout reads AI_SERVICE_TIMEOUT; the request timeout config needs it too
+func aiServiceTimeout() time.Duration {
+	return getEnvAsDuration("AI_SERVICE_TIMEOUT", defaultAIServiceTimeout.String())
+}
+
+// aiServiceCallBudget is the longest a single AI service call can keep a request waiting:
+// AI_SERVICE_TIMEOUT plus, when AI_SERVICE_MAX_CONCURRENT is set, the time spent queueing
+// for a slot
+func aiServiceCallBudget() time.Duration {
+	budget := aiServiceTimeout()
+	if getEnvAsInt("AI_SERVICE_MAX_CONCURRENT", defaultAIMaxConcurrent) > 0 {
+		if wait := getEnvAsDuration("AI_SERVICE_CONCURRENCY_WAIT", defaultAIConcurrencyWait); wait > 0 {
+			budget += wait
+		}
+	}
+	return budget
+}
+
+// LoadAIServiceConfig reads AI service configuration from the environment
+// and validates that the base URL is well-formed
+func LoadAIServiceConfig() (*AIServiceConfig, error) {
+	cfg := &AIServiceConfig{
+		URL:                          getEnv("AI_SERVICE_URL", defaultAIServiceURL),
+		APIKeyHeader:                 getEnv("AI_SERVICE_API_KEY_HEADER", defaultAIServiceAPIKeyHeader),
+		APIKey:                       getEnv("AI_SERVICE_API_KEY", ""),
+		MaxResponseBytes:             int64(getEnvAsInt("AI_SERVICE_MAX_RESPONSE_BYTES", defaultAIMaxResponseBytes)),
+		BreakerThreshold:             getEnvAsInt("AI_SERVICE_BREAKER_THRESHOLD", defaultAIBreakerThreshold),
+		BreakerCooldown:              getEnvAsDuration("AI_SERVICE_BREAKER_COOLDOWN", defaultAIBreakerCooldown),
+		Timeout:                      aiServiceTimeout(),
+		MaxConcurrent:                getEnvAsInt("AI_SERVICE_MAX_CONCURRENT", defaultAIMaxConcurrent),
+		ConcurrencyWait:              getEnvAsDuration("AI_SERVICE_CONCURRENCY_WAIT", defaultAIConcurrencyWait),
+		SanitizeCoverLetters:         strings.ToLower(getEnv("AI_COVER_LETTER_SANITIZE", "true")) != "false",
+		CoverLetterPreamblePatterns:  parsePatternList(getEnv("AI_COVER_LETTER_PREAMBLE_PATTERNS", "")),
+		LogCoverLetterPrompts:        strings.ToLower(getEnv("AI_COVER_LETTER_LOG_PROMPTS", "false")) == "true",
+		CoverLetterPromptLogMaxChars: getEnvAsInt("AI_COVER_LETTER_PROMPT_LOG_MAX_CHARS", defaultAIPromptLogMaxChars),
+		LogFullCoverLetterPrompts:    strings.ToLower(getEnv("AI_COVER_LETTER_LOG_FULL_PROMPTS", "false")) == "true",
+	}
+
+	temperatures, err := parseAgentTemperatures(getEnv("AI_AGENT_TEMPERATURES", ""))
+	if err != nil {
+		return nil, err
+	}
+	cfg.AgentTemperatures = temperatures
+
+	parsed, err := url.Parse(cfg.URL)
+	if err != nil {
+		return nil, fmt.Errorf("AI_SERVICE_URL is not a valid URL: %w", err)
+	}
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return nil, fmt.Errorf("AI_SERVICE_URL must use http or https, got %q", cfg.URL)
+	}
+	if parsed.Host == "" {
+		return nil, fmt.Errorf("AI_SERVICE_URL must include a host, got %q", cfg.URL)
+	}
+
+	if cfg.MaxResponseBytes <= 0 || cfg.MaxResponseBytes > maxAIMaxResponseBytes {
+		return nil, fmt.Errorf("AI_SERVICE_MAX_RESPONSE_BYTES must be between 1 and %d, got %d", maxAIMaxResponseBytes, cfg.MaxResponseBytes)
+	}
+
+	if cfg.BreakerThreshold <= 0 {
+		return nil, fmt.Errorf("AI_SERVICE_BREAKER_THRESHOLD must be positive, got %d", cfg.BreakerThreshold)
+	}
+	if cfg.BreakerCooldown <= 0 {
+		return nil, fmt.Errorf("AI_SERVICE_BREAKER_COOLDOWN must be positive, got %s", cfg.BreakerCooldown)
+	}
+	if cfg.Timeout <= 0 {
+		return nil, fmt.Errorf("AI_SERVICE_TIMEOUT must be positive, got %s", cfg.Timeout)
+	}
+	if cfg.MaxConcurrent < 0 {
+		return nil, fmt.Errorf("AI_SERVICE_MAX_CONCURRENT must not be negative, got %d", cfg.MaxConcurrent)
+	}
+	if cfg.ConcurrencyWait < 0 {
+		return nil, fmt.Errorf("AI_SERVICE_CONCURRENCY_WAIT must not be negative, got %s", cfg.ConcurrencyWait)
+	}
+
+	if cfg.CoverLetterPromptLogMaxChars <= 0 {
+		return nil, fmt.Errorf("AI_COVER_LETTER_PROMPT_LOG_MAX_CHARS must be positive, got %d", cfg.CoverLetterPromptLogMaxChars)
+	}
+
+	for _, pattern := range cfg.CoverLetterPreamblePatterns {
+		if _, err := regexp.Compile(pattern); err != nil {
+			return nil, fmt.Errorf("AI_COVER_LETTER_PREAMBLE_PATTERNS contains an invalid regex %q: %w", pattern, err)
+		}
+	}
+
+	return cfg, nil
+}
+
+// parseAgentTemperatures parses a comma-separated list of "agent=temperature" entries,
+// e.g. "cover_letter=0.7,resume_bullets=0.3", each temperature between 0 and 2
+func parseAgentTemperatures(raw string) (map[string]float64, error) {
+	temperatures := map[string]float64{}
+	for _, entry := range strings.Split(raw, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		agent, value, ok := strings.Cut(entry, "=")
+		agent = strings.TrimSpace(agent)
+		if !ok || agent == "" {
+			return nil, fmt.Errorf("AI_AGENT_TEMPERATURES entry %q must look like \"agent=0.7\"", entry)
+		}
+		temperature, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil {
+			return nil, fmt.Errorf("AI_AGENT_TEMPERATURES entry %q has an invalid temperature", entry)
+		}
+		if !(temperature >= 0 && temperature <= maxAITemperature) {
+			return nil, fmt.Errorf("AI_AGENT_TEMPERATURES entry %q: temperature must be between 0 and %g", entry, maxAITemperature)
+		}
+		if _, dup := temperatures[agent]; dup {
+			return nil, fmt.Errorf("AI_AGENT_TEMPERATURES lists agent %q more than once", agent)
+		}
+		temperatures[agent] = temperature
+	}
+	return temperatures, nil
+}
+
+// parsePatternList splits a ";"-separated list of regexes, dropping blank entries.
+// Commas are left alone since they are common inside regexes.
+func parsePatternList(raw string) []string {
+	var patterns []string
+	for _, pattern := range strings.Split(raw, ";") {
+		if pattern = strings.TrimSpace(pattern); pattern != "" {
+			patterns = append(patterns, pattern)
+		}
+	}
+	return patterns
+}
@@ -0,0 +1,26 @@
+package config
+
+import "fmt"
+
+// maxBatchOperations bounds BATCH_MAX_OPERATIONS itself; every operation runs
+// sequentially within the one batch request
+const maxBatchOperations = 100
+
+// BatchConfig controls the POST /api/v1/batch endpoint
+type BatchConfig struct {
+	// MaxOperations is the most sub-operations one batch may contain
+	MaxOperations int
+}
+
+// LoadBatchConfig reads batch endpoint settings from the environment
+func LoadBatchConfig() (*BatchConfig, error) {
+	cfg := &BatchConfig{
+		MaxOperations: getEnvAsInt("BATCH_MAX_OPERATIONS", 20),
+	}
+
+	if cfg.MaxOperations < 1 || cfg.MaxOperations > maxBatchOperations {
+		return nil, fmt.Errorf("BATCH_MAX_OPERATIONS must be between 1 and %d, got %d", maxBatchOperations, cfg.MaxOperations)
+	}
+
+	return cfg, nil
+}
@@ -1,42 +1,105 @@
 package config
 
 import (
+	"fmt"
 	"strconv"
 	"strings"
 )
 
+// maxCORSMaxAge bounds CORS_MAX_AGE; browsers cap preflight caching at a day or less anyway
+const maxCORSMaxAge = 86400
+
 // CORSConfig captures cross-origin settings for the HTTP layer
 type CORSConfig struct {
-	Enabled          bool
-	AllowedOrigins   string
-	AllowedMethods   string
-	AllowedHeaders   string
+	Enabled        bool
+	AllowedOrigins string
+	AllowedMethods string
+	AllowedHeaders string
+	// ExposedHeaders are the response headers browser scripts may read, such as the
+	// CSRF token, rate limit and pagination headers
 	ExposedHeaders   string
 	AllowCredentials bool
-	MaxAge           int
+	// MaxAge is how many seconds browsers may cache a preflight response; 0 omits
+	// Access-Control-Max-Age and leaves the browser default of a few seconds
+	MaxAge int
 }
 
 // LoadCORSConfig reads CORS-related environment variables
-func LoadCORSConfig() *CORSConfig {
+func LoadCORSConfig() (*CORSConfig, error) {
 	enabled := strings.ToLower(getEnv("CORS_ENABLED", "true"))
 	allowCredentials := strings.ToLower(getEnv("CORS_ALLOW_CREDENTIALS", "true"))
 
-	maxAge, err := strconv.Atoi(getEnv("CORS_MAX_AGE", "86400"))
-	if err != nil {
-		maxAge = 86400
+	rawMaxAge := getEnv("CORS_MAX_AGE", "86400")
+	maxAge, err := strconv.Atoi(rawMaxAge)
+	if err != nil || maxAge < 0 || maxAge > maxCORSMaxAge {
+		return nil, fmt.Errorf("CORS_MAX_AGE must be a number of seconds between 0 and %d, got %q", maxCORSMaxAge, rawMaxAge)
 	}
 
 	defaultOrigins := "http://localhost:3000,http://127.0.0.1:3000,http://localhost:5173,http://127.0.0.1:5173"
 
-	return &CORSConfig{
+	cfg := &CORSConfig{
 		Enabled:          enabled != "false" && enabled != "0",
 		AllowedOrigins:   sanitizeCSV(getEnv("CORS_ALLOWED_ORIGINS", defaultOrigins)),
 		AllowedMethods:   sanitizeCSV(getEnv("CORS_ALLOWED_METHODS", "GET,POST,PUT,PATCH,DELETE,OPTIONS")),
-		AllowedHeaders:   sanitizeCSV(getEnv("CORS_ALLOWED_HEADERS", "Authorization,Content-Type,X-Requested-With,X-CSRF-Token")),
-		ExposedHeaders:   sanitizeCSV(getEnv("CORS_EXPOSED_HEADERS", "X-CSRF-Token")),
+		AllowedHeaders:   sanitizeCSV(getEnv("CORS_ALLOWED_HEADERS", "Authorization,Content-Type,X-Requested-With,X-CSRF-Token,Accept-Casing,If-None-Match")),
+		ExposedHeaders:   sanitizeCSV(getEnv("CORS_EXPOSED_HEADERS", "X-CSRF-Token,X-Pagination-Limit,X-Pagination-Offset,Link,X-RateLimit-Limit,X-RateLimit-Remaining,X-RateLimit-Cost,Retry-After,ETag")),
 		AllowCredentials: allowCredentials == "true" || allowCredentials == "1" || allowCredentials == "yes",
 		MaxAge:           maxAge,
 	}
+
+	if err := validateHeaderNames("CORS_ALLOWED_HEADERS", cfg.AllowedHeaders); err != nil {
+		return nil, err
+	}
+	if err := validateHeaderNames("CORS_EXPOSED_HEADERS", cfg.ExposedHeaders); err != nil {
+		return nil, err
+	}
+	// With credentials, browsers treat "*" as a literal header name rather than a wildcard
+	if cfg.AllowCredentials && containsCSV(cfg.ExposedHeaders, "*") {
+		return nil, fmt.Errorf("CORS_EXPOSED_HEADERS cannot be \"*\" when CORS_ALLOW_CREDENTIALS is enabled; list the headers instead")
+	}
+
+	return cfg, nil
+}
+
+// validateHeaderNames checks that every entry of a comma-separated list is an HTTP
+// header name (an RFC 9110 token) or the "*" wildcard
+func validateHeaderNames(key, list string) error {
+	if list == "" {
+		return nil
+	}
+	for _, name := range strings.Split(list, ",") {
+		if name == "*" {
+			continue
+		}
+		if !isHTTPToken(name) {
+			return fmt.Errorf("%s entry %q is not a valid header name", key, name)
+		}
+	}
+	return nil
+}
+
+func isHTTPToken(value string) bool {
+	if value == "" {
+		return false
+	}
+	for _, r := range value {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+		case strings.ContainsRune("!#$%&'*+-.^_`|~", r):
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+func containsCSV(list, value string) bool {
+	for _, entry := range strings.Split(list, ",") {
+		if entry == value {
+			return true
+		}
+	}
+	return false
 }
 
 func sanitizeCSV(value string) string {
@@ -11,6 +11,12 @@ type DatabaseConfig struct {
 	MaxIdleConns    int
 	MaxIdleTime     time.Duration
 	ConnMaxLifetime time.Duration
+	// Retry settings for repository calls that fail with a transient connection error
+	RetryMaxAttempts    int
+	RetryInitialBackoff time.Duration
+	RetryMaxBackoff     time.Duration
+	// SlowQueryThreshold logs statements taking at least this long; 0 (the default) disables it
+	SlowQueryThreshold time.Duration
 }
 
 const (
@@ -18,6 +24,12 @@ const (
 	defaultMaxIdleConns    = 25
 	defaultMaxIdleTime     = 15 * time.Minute
 	defaultConnMaxLifetime = 60 * time.Minute
+
+	defaultRetryMaxAttempts    = 3
+	defaultRetryInitialBackoff = 100 * time.Millisecond
+	defaultRetryMaxBackoff     = time.Second
+
+	defaultSlowQueryThreshold = time.Duration(0)
 )
 
 // LoadDatabaseConfig reads database configuration from the environment
@@ -28,5 +40,11 @@ func LoadDatabaseConfig() *DatabaseConfig {
 		MaxIdleConns:    getEnvAsInt("DATABASE_MAX_IDLE_CONNS", defaultMaxIdleConns),
 		MaxIdleTime:     getEnvAsDuration("DATABASE_MAX_IDLE_TIME", defaultMaxIdleTime.String()),
 		ConnMaxLifetime: getEnvAsDuration("DATABASE_CONN_MAX_LIFETIME", defaultConnMaxLifetime.String()),
+
+		RetryMaxAttempts:    getEnvAsInt("DATABASE_RETRY_MAX_ATTEMPTS", defaultRetryMaxAttempts),
+		RetryInitialBackoff: getEnvAsDuration("DATABASE_RETRY_INITIAL_BACKOFF", defaultRetryInitialBackoff.String()),
+		RetryMaxBackoff:     getEnvAsDuration("DATABASE_RETRY_MAX_BACKOFF", defaultRetryMaxBackoff.String()),
+
+		SlowQueryThreshold: getEnvAsDuration("DATABASE_SLOW_QUERY_THRESHOLD", defaultSlowQueryThreshold.String()),
 	}
 }
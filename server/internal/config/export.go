@@ -0,0 +1,31 @@
+package config
+
+import "strings"
+
+// ExportConfig controls the scrubbing applied to account exports requested with ?sanitize=true
+type ExportConfig struct {
+	// SanitizeRules are the pkg/sanitize rule names to apply, in order
+	SanitizeRules []string
+	// ProfanityWords replaces the built-in profanity list when non-empty
+	ProfanityWords []string
+}
+
+// LoadExportConfig reads export settings from the environment. Rule names are checked
+// when the filter is built.
+func LoadExportConfig() *ExportConfig {
+	return &ExportConfig{
+		SanitizeRules:  splitList(getEnv("EXPORT_SANITIZE_RULES", "email,phone,profanity")),
+		ProfanityWords: splitList(getEnv("EXPORT_PROFANITY_WORDS", "")),
+	}
+}
+
+// splitList splits a comma-separated value, dropping blank entries
+func splitList(raw string) []string {
+	var items []string
+	for _, item := range strings.Split(raw, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
@@ -0,0 +1,67 @@
+package config
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// featureFlagName keeps flag names usable as Redis hash fields and log values
+var featureFlagName = regexp.MustCompile(`^[a-z0-9][a-z0-9_.-]*$`)
+
+// FeatureFlagConfig defines the feature flags and how far each is rolled out
+type FeatureFlagConfig struct {
+	// Rollouts maps a flag name to the percentage of users (0-100) it is on for
+	Rollouts map[string]int
+	// RedisOverrides reads per-user overrides from the feature_flags:user:<id> hash
+	RedisOverrides bool
+}
+
+// LoadFeatureFlagConfig reads feature flags from the environment. FEATURE_FLAGS is a
+// comma-separated list of "name=percentage" entries, where "on" and "off" stand for
+// 100 and 0, e.g. "structured_cover_letters=25,ai_resume_recommendations=off".
+func LoadFeatureFlagConfig() (*FeatureFlagConfig, error) {
+	rollouts, err := parseFeatureFlags(getEnv("FEATURE_FLAGS", ""))
+	if err != nil {
+		return nil, err
+	}
+	return &FeatureFlagConfig{
+		Rollouts:       rollouts,
+		RedisOverrides: strings.ToLower(getEnv("FEATURE_FLAGS_REDIS_OVERRIDES", "true")) == "true",
+	}, nil
+}
+
+func parseFeatureFlags(raw string) (map[string]int, error) {
+	rollouts := make(map[string]int)
+	for _, entry := range strings.Split(raw, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		name, value, ok := strings.Cut(entry, "=")
+		name = strings.TrimSpace(name)
+		value = strings.ToLower(strings.TrimSpace(value))
+		if !ok || !featureFlagName.MatchString(name) {
+			return nil, fmt.Errorf("FEATURE_FLAGS entry %q must look like \"flag_name=25\"", entry)
+		}
+		if _, exists := rollouts[name]; exists {
+			return nil, fmt.Errorf("FEATURE_FLAGS lists %q more than once", name)
+		}
+
+		switch value {
+		case "on":
+			rollouts[name] = 100
+		case "off":
+			rollouts[name] = 0
+		default:
+			percentage, err := strconv.Atoi(strings.TrimSuffix(value, "%"))
+			if err != nil || percentage < 0 || percentage > 100 {
+				return nil, fmt.Errorf("FEATURE_FLAGS entry %q must be on, off or a percentage between 0 and 100", entry)
+			}
+			rollouts[name] = percentage
+		}
+	}
+	return rollouts, nil
+}
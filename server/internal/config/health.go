@@ -0,0 +1,26 @@
+package config
+
+import (
+	"fmt"
+	"time"
+)
+
+// HealthConfig controls the /healthz dependency checks
+type HealthConfig struct {
+	// CacheTTL is how long readiness results are reused between probes; 0 disables caching
+	CacheTTL time.Duration
+}
+
+// LoadHealthConfig reads health check settings from the environment
+func LoadHealthConfig() (*HealthConfig, error) {
+	cfg := &HealthConfig{
+		CacheTTL: getEnvAsDuration("HEALTH_CHECK_CACHE_TTL", "2s"),
+	}
+
+	// A long TTL would hide outages from the readiness probe
+	if cfg.CacheTTL < 0 || cfg.CacheTTL > time.Minute {
+		return nil, fmt.Errorf("HEALTH_CHECK_CACHE_TTL must be between 0 and 1m, got %s", cfg.CacheTTL)
+	}
+
+	return cfg, nil
+}
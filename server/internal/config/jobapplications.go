@@ -0,0 +1,29 @@
+package config
+
+import (
+	"strings"
+	"time"
+)
+
+// JobApplicationConfig holds job application behaviors: creation defaults and the read cache
+type JobApplicationConfig struct {
+	// AutoAttachResume attaches the user's best resume (main > featured > most recent)
+	// to new applications created without a resumeId
+	AutoAttachResume bool
+	// DefaultInterestLevel is given to applications created without an interest level
+	DefaultInterestLevel string
+	// CacheEnabled serves single application reads from Redis when Redis is configured
+	CacheEnabled bool
+	// CacheTTL bounds how long a cached application may be served
+	CacheTTL time.Duration
+}
+
+// LoadJobApplicationConfig reads job application settings from the environment
+func LoadJobApplicationConfig() *JobApplicationConfig {
+	return &JobApplicationConfig{
+		AutoAttachResume:     strings.ToLower(getEnv("AUTO_ATTACH_DEFAULT_RESUME", "false")) == "true",
+		DefaultInterestLevel: getEnv("DEFAULT_INTEREST_LEVEL", "medium"),
+		CacheEnabled:         strings.ToLower(getEnv("APPLICATION_CACHE_ENABLED", "true")) == "true",
+		CacheTTL:             getEnvAsDuration("APPLICATION_CACHE_TTL", "30s"),
+	}
+}
@@ -0,0 +1,32 @@
+package config
+
+import "fmt"
+
+// maxPaginationLimit bounds PAGINATION_MAX_LIMIT itself so a misconfiguration
+// cannot reintroduce unbounded pages
+const maxPaginationLimit = 1000
+
+// PaginationConfig controls page sizes on list endpoints
+type PaginationConfig struct {
+	// DefaultLimit is used when a request does not pass ?limit=
+	DefaultLimit int
+	// MaxLimit is the ceiling larger ?limit= values are clamped to
+	MaxLimit int
+}
+
+// LoadPaginationConfig reads pagination settings from the environment
+func LoadPaginationConfig() (*PaginationConfig, error) {
+	cfg := &PaginationConfig{
+		DefaultLimit: getEnvAsInt("PAGINATION_DEFAULT_LIMIT", 50),
+		MaxLimit:     getEnvAsInt("PAGINATION_MAX_LIMIT", 200),
+	}
+
+	if cfg.MaxLimit < 1 || cfg.MaxLimit > maxPaginationLimit {
+		return nil, fmt.Errorf("PAGINATION_MAX_LIMIT must be between 1 and %d, got %d", maxPaginationLimit, cfg.MaxLimit)
+	}
+	if cfg.DefaultLimit < 1 || cfg.DefaultLimit > cfg.MaxLimit {
+		return nil, fmt.Errorf("PAGINATION_DEFAULT_LIMIT must be between 1 and PAGINATION_MAX_LIMIT (%d), got %d", cfg.MaxLimit, cfg.DefaultLimit)
+	}
+
+	return cfg, nil
+}
@@ -0,0 +1,91 @@
+package config
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RateLimitWeight is the cost of one route against the weighted rate limit budget
+type RateLimitWeight struct {
+	Method  string
+	Pattern string
+	Weight  int
+}
+
+// RateLimitConfig holds the weighted per-user rate limit settings
+type RateLimitConfig struct {
+	// Budget is the number of weight units a user may spend per window
+	Budget int
+	// Window is the length of each counting window
+	Window time.Duration
+	// DefaultWeight is charged for routes without an explicit weight
+	DefaultWeight int
+	// Weights are route-specific costs, matched in order
+	Weights []RateLimitWeight
+}
+
+// defaultRateLimitWeights makes AI-backed endpoints count far more than reads:
+// with the default budget a user gets 6 generations or 120 reads per minute
+const defaultRateLimitWeights = "POST /api/v1/job-applications/:id/generate-cover-letter=20," +
+	"POST /api/v1/job-applications/:id/cover-letter/refine=20," +
+	"POST /api/v1/resumes/generate=20," +
+	"POST /api/v1/job-applications/detect-language=5," +
+	"POST /api/v1/job-applications/suggest-tags=5," +
+	"POST /api/v1/job-applications/from-url=5"
+
+// LoadRateLimitConfig reads weighted rate limit settings from the environment.
+// RATE_LIMIT_WEIGHTS is a comma-separated list of "METHOD /path/:param=weight" entries.
+func LoadRateLimitConfig() (*RateLimitConfig, error) {
+	cfg := &RateLimitConfig{
+		Budget:        getEnvAsInt("RATE_LIMIT_BUDGET", 120),
+		Window:        getEnvAsDuration("RATE_LIMIT_WINDOW", "1m"),
+		DefaultWeight: getEnvAsInt("RATE_LIMIT_DEFAULT_WEIGHT", 1),
+	}
+
+	if cfg.Budget < 1 {
+		return nil, fmt.Errorf("RATE_LIMIT_BUDGET must be at least 1, got %d", cfg.Budget)
+	}
+	if cfg.Window < time.Second {
+		return nil, fmt.Errorf("RATE_LIMIT_WINDOW must be at least 1s, got %v", cfg.Window)
+	}
+	if cfg.DefaultWeight < 0 || cfg.DefaultWeight > cfg.Budget {
+		return nil, fmt.Errorf("RATE_LIMIT_DEFAULT_WEIGHT must be between 0 and RATE_LIMIT_BUDGET (%d), got %d", cfg.Budget, cfg.DefaultWeight)
+	}
+
+	weights, err := parseRateLimitWeights(getEnv("RATE_LIMIT_WEIGHTS", defaultRateLimitWeights), cfg.Budget)
+	if err != nil {
+		return nil, err
+	}
+	cfg.Weights = weights
+
+	return cfg, nil
+}
+
+func parseRateLimitWeights(raw string, budget int) ([]RateLimitWeight, error) {
+	var weights []RateLimitWeight
+	for _, entry := range strings.Split(raw, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		route, weightStr, ok := strings.Cut(entry, "=")
+		fields := strings.Fields(route)
+		if !ok || len(fields) != 2 || !strings.HasPrefix(fields[1], "/") {
+			return nil, fmt.Errorf("RATE_LIMIT_WEIGHTS entry %q must look like \"POST /api/v1/path=10\"", entry)
+		}
+		weight, err := strconv.Atoi(strings.TrimSpace(weightStr))
+		if err != nil || weight < 0 || weight > budget {
+			return nil, fmt.Errorf("RATE_LIMIT_WEIGHTS entry %q must have a weight between 0 and RATE_LIMIT_BUDGET (%d)", entry, budget)
+		}
+
+		weights = append(weights, RateLimitWeight{
+			Method:  strings.ToUpper(fields[0]),
+			Pattern: fields[1],
+			Weight:  weight,
+		})
+	}
+	return weights, nil
+}
@@ -1,19 +1,72 @@
 package config
 
+import (
+	"fmt"
+	"time"
+)
+
 // RedisConfig holds Redis configuration
 type RedisConfig struct {
 	URL      string
 	Password string
 	DB       int
+	// PoolSize is the maximum number of socket connections
+	PoolSize int
+	// MinIdleConns keeps this many idle connections open to avoid dialing on bursts
+	MinIdleConns int
+	// DialTimeout bounds establishing a new connection
+	DialTimeout time.Duration
+	// ReadTimeout and WriteTimeout bound a single socket read or write
+	ReadTimeout  time.Duration
+	WriteTimeout time.Duration
+	// MaxRetries is how often a failed command is retried; zero disables retries
+	MaxRetries int
 }
 
-const defaultRedisDB = 0
+// The rate limiter and CSRF store hit Redis on every request, so the pool is sized
+// for that and timeouts are kept short enough to fail fast when Redis stalls.
+const (
+	defaultRedisDB           = 0
+	defaultRedisPoolSize     = 50
+	defaultRedisMinIdleConns = 10
+	defaultRedisDialTimeout  = 5 * time.Second
+	defaultRedisReadTimeout  = time.Second
+	defaultRedisWriteTimeout = time.Second
+	defaultRedisMaxRetries   = 2
+)
+
+// LoadRedisConfig reads Redis configuration from the environment and validates the pool settings
+func LoadRedisConfig() (*RedisConfig, error) {
+	cfg := &RedisConfig{
+		URL:          getEnvRequired("REDIS_URL"),
+		Password:     getEnv("REDIS_PASSWORD", ""),
+		DB:           getEnvAsInt("REDIS_DB", defaultRedisDB),
+		PoolSize:     getEnvAsInt("REDIS_POOL_SIZE", defaultRedisPoolSize),
+		MinIdleConns: getEnvAsInt("REDIS_MIN_IDLE_CONNS", defaultRedisMinIdleConns),
+		DialTimeout:  getEnvAsDuration("REDIS_DIAL_TIMEOUT", defaultRedisDialTimeout.String()),
+		ReadTimeout:  getEnvAsDuration("REDIS_READ_TIMEOUT", defaultRedisReadTimeout.String()),
+		WriteTimeout: getEnvAsDuration("REDIS_WRITE_TIMEOUT", defaultRedisWriteTimeout.String()),
+		MaxRetries:   getEnvAsInt("REDIS_MAX_RETRIES", defaultRedisMaxRetries),
+	}
 
-// LoadRedisConfig reads Redis configuration from the environment
-func LoadRedisConfig() *RedisConfig {
-	return &RedisConfig{
-		URL:      getEnvRequired("REDIS_URL"),
-		Password: getEnv("REDIS_PASSWORD", ""),
-		DB:       getEnvAsInt("REDIS_DB", defaultRedisDB),
+	if cfg.PoolSize <= 0 {
+		return nil, fmt.Errorf("REDIS_POOL_SIZE must be positive, got %d", cfg.PoolSize)
+	}
+	if cfg.MinIdleConns < 0 || cfg.MinIdleConns > cfg.PoolSize {
+		return nil, fmt.Errorf("REDIS_MIN_IDLE_CONNS must be between 0 and REDIS_POOL_SIZE (%d), got %d", cfg.PoolSize, cfg.MinIdleConns)
+	}
+	if cfg.DialTimeout <= 0 {
+		return nil, fmt.Errorf("REDIS_DIAL_TIMEOUT must be positive, got %s", cfg.DialTimeout)
 	}
+	if cfg.ReadTimeout <= 0 {
+		return nil, fmt.Errorf("REDIS_READ_TIMEOUT must be positive, got %s", cfg.ReadTimeout)
+	}
+	if cfg.WriteTimeout <= 0 {
+		return nil, fmt.Errorf("REDIS_WRITE_TIMEOUT must be positive, got %s", cfg.WriteTimeout)
+	}
+	if cfg.MaxRetries < 0 {
+		return nil, fmt.Errorf("REDIS_MAX_RETRIES must not be negative, got %d", cfg.MaxRetries)
+	}
+
+	return cfg, nil
 }
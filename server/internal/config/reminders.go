@@ -0,0 +1,47 @@
+package config
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// ReminderConfig controls the follow-up and deadline reminder scanner
+type ReminderConfig struct {
+	Enabled bool
+	// ScanInterval is how often due reminders are looked up
+	ScanInterval time.Duration
+	// DeadlineDaysBefore is how many days before a deadline deadline.approaching fires
+	DeadlineDaysBefore int
+	// WebhookURL receives reminder events; when empty, reminders are only logged
+	WebhookURL string
+	// WebhookSecret signs webhook bodies (X-Webhook-Signature: sha256=<hex hmac>) when set
+	WebhookSecret string
+}
+
+// LoadReminderConfig reads reminder settings from the environment
+func LoadReminderConfig() (*ReminderConfig, error) {
+	cfg := &ReminderConfig{
+		Enabled:            strings.ToLower(getEnv("REMINDERS_ENABLED", "true")) != "false",
+		ScanInterval:       getEnvAsDuration("REMINDER_SCAN_INTERVAL", "1h"),
+		DeadlineDaysBefore: getEnvAsInt("REMINDER_DEADLINE_DAYS_BEFORE", 3),
+		WebhookURL:         getEnv("REMINDER_WEBHOOK_URL", ""),
+		WebhookSecret:      getEnv("REMINDER_WEBHOOK_SECRET", ""),
+	}
+
+	if cfg.ScanInterval < time.Minute {
+		return nil, fmt.Errorf("REMINDER_SCAN_INTERVAL must be at least 1m, got %s", cfg.ScanInterval)
+	}
+	if cfg.DeadlineDaysBefore < 0 || cfg.DeadlineDaysBefore > 90 {
+		return nil, fmt.Errorf("REMINDER_DEADLINE_DAYS_BEFORE must be between 0 and 90, got %d", cfg.DeadlineDaysBefore)
+	}
+	if cfg.WebhookURL != "" {
+		parsed, err := url.Parse(cfg.WebhookURL)
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			return nil, fmt.Errorf("REMINDER_WEBHOOK_URL must be an http(s) URL, got %q", cfg.WebhookURL)
+		}
+	}
+
+	return cfg, nil
+}
@@ -0,0 +1,66 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ResumeQueueConfig controls how resume generation jobs are routed on RabbitMQ
+type ResumeQueueConfig struct {
+	// HighPriorityQueue is consumed ahead of resumes.queue by the worker
+	HighPriorityQueue string
+	// HighPriorityRoutingKey routes "high" jobs to HighPriorityQueue
+	HighPriorityRoutingKey string
+	// NormalPriorityRoutingKey routes "normal" jobs to resumes.queue; jobs without
+	// a priority keep using resumes.generate
+	NormalPriorityRoutingKey string
+	// MessageTTL dead-letters jobs left unconsumed for this long; zero disables it
+	MessageTTL time.Duration
+	// MaxLength caps each resume queue; zero disables it
+	MaxLength int
+	// Overflow is "drop-head" (dead-letter the oldest job) or "reject-publish-dlx"
+	// (dead-letter the incoming job) once MaxLength is reached
+	Overflow string
+	// MaxPriority turns the resume queues into priority queues with this many levels;
+	// zero disables it
+	MaxPriority int
+}
+
+// LoadResumeQueueConfig reads resume queue routing from the environment
+func LoadResumeQueueConfig() (*ResumeQueueConfig, error) {
+	cfg := &ResumeQueueConfig{
+		HighPriorityQueue:        strings.TrimSpace(getEnv("RESUME_QUEUE_HIGH_PRIORITY_QUEUE", "resumes.queue.high")),
+		HighPriorityRoutingKey:   strings.TrimSpace(getEnv("RESUME_QUEUE_HIGH_PRIORITY_ROUTING_KEY", "resumes.generate.high")),
+		NormalPriorityRoutingKey: strings.TrimSpace(getEnv("RESUME_QUEUE_NORMAL_PRIORITY_ROUTING_KEY", "resumes.generate.normal")),
+		MessageTTL:               getEnvAsDuration("RESUME_QUEUE_MESSAGE_TTL", "24h"),
+		MaxLength:                getEnvAsInt("RESUME_QUEUE_MAX_LENGTH", 10000),
+		Overflow:                 strings.TrimSpace(getEnv("RESUME_QUEUE_OVERFLOW", "drop-head")),
+		MaxPriority:              getEnvAsInt("RESUME_QUEUE_MAX_PRIORITY", 0),
+	}
+
+	if cfg.HighPriorityQueue == "resumes.queue" {
+		return nil, fmt.Errorf("RESUME_QUEUE_HIGH_PRIORITY_QUEUE must differ from resumes.queue")
+	}
+	if cfg.HighPriorityRoutingKey == cfg.NormalPriorityRoutingKey {
+		return nil, fmt.Errorf("RESUME_QUEUE_HIGH_PRIORITY_ROUTING_KEY and RESUME_QUEUE_NORMAL_PRIORITY_ROUTING_KEY must differ")
+	}
+	if cfg.HighPriorityRoutingKey == "resumes.generate" {
+		return nil, fmt.Errorf("RESUME_QUEUE_HIGH_PRIORITY_ROUTING_KEY must differ from resumes.generate")
+	}
+
+	if cfg.MessageTTL < 0 {
+		return nil, fmt.Errorf("RESUME_QUEUE_MESSAGE_TTL must not be negative")
+	}
+	if cfg.MaxLength < 0 {
+		return nil, fmt.Errorf("RESUME_QUEUE_MAX_LENGTH must not be negative")
+	}
+	if cfg.MaxPriority < 0 || cfg.MaxPriority > 255 {
+		return nil, fmt.Errorf("RESUME_QUEUE_MAX_PRIORITY must be between 0 and 255")
+	}
+	if cfg.Overflow != "drop-head" && cfg.Overflow != "reject-publish-dlx" {
+		return nil, fmt.Errorf("RESUME_QUEUE_OVERFLOW must be drop-head or reject-publish-dlx")
+	}
+
+	return cfg, nil
+}
@@ -0,0 +1,35 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ResumeJobReaperConfig controls the reaper that fails resume generation jobs stuck in processing
+type ResumeJobReaperConfig struct {
+	Enabled bool
+	// Interval is how often stuck jobs are looked up
+	Interval time.Duration
+	// ProcessingTimeout is how long a job may stay processing before it is failed
+	// with PROCESSING_TIMEOUT
+	ProcessingTimeout time.Duration
+}
+
+// LoadResumeJobReaperConfig reads resume job reaper settings from the environment
+func LoadResumeJobReaperConfig() (*ResumeJobReaperConfig, error) {
+	cfg := &ResumeJobReaperConfig{
+		Enabled:           strings.ToLower(getEnv("RESUME_JOB_REAPER_ENABLED", "true")) != "false",
+		Interval:          getEnvAsDuration("RESUME_JOB_REAPER_INTERVAL", "1m"),
+		ProcessingTimeout: getEnvAsDuration("RESUME_JOB_PROCESSING_TIMEOUT", "30m"),
+	}
+
+	if cfg.Interval < 10*time.Second {
+		return nil, fmt.Errorf("RESUME_JOB_REAPER_INTERVAL must be at least 10s, got %s", cfg.Interval)
+	}
+	if cfg.ProcessingTimeout < time.Minute {
+		return nil, fmt.Errorf("RESUME_JOB_PROCESSING_TIMEOUT must be at least 1m, got %s", cfg.ProcessingTimeout)
+	}
+
+	return cfg, nil
+}
@@ -0,0 +1,55 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	StorageBackendLocal = "local"
+	StorageBackendS3    = "s3"
+)
+
+// StorageConfig selects and configures the backend that holds resume files
+type StorageConfig struct {
+	Backend   string
+	LocalPath string
+
+	S3Endpoint        string
+	S3Region          string
+	S3Bucket          string
+	S3AccessKeyID     string
+	S3SecretAccessKey string
+	S3UsePathStyle    bool
+}
+
+// LoadStorageConfig reads file storage configuration from the environment
+func LoadStorageConfig() (*StorageConfig, error) {
+	usePathStyle := strings.ToLower(getEnv("S3_USE_PATH_STYLE", "true"))
+
+	cfg := &StorageConfig{
+		Backend:           strings.ToLower(getEnv("STORAGE_BACKEND", StorageBackendLocal)),
+		LocalPath:         getEnv("STORAGE_LOCAL_PATH", "."),
+		S3Endpoint:        getEnv("S3_ENDPOINT", "https://s3.amazonaws.com"),
+		S3Region:          getEnv("S3_REGION", "us-east-1"),
+		S3Bucket:          getEnv("S3_BUCKET", ""),
+		S3AccessKeyID:     getEnv("S3_ACCESS_KEY_ID", ""),
+		S3SecretAccessKey: getEnv("S3_SECRET_ACCESS_KEY", ""),
+		S3UsePathStyle:    usePathStyle == "true" || usePathStyle == "1" || usePathStyle == "yes",
+	}
+
+	switch cfg.Backend {
+	case StorageBackendLocal:
+	case StorageBackendS3:
+		if cfg.S3Bucket == "" {
+			return nil, fmt.Errorf("S3_BUCKET is required when STORAGE_BACKEND=s3")
+		}
+		if cfg.S3AccessKeyID == "" || cfg.S3SecretAccessKey == "" {
+			return nil, fmt.Errorf("S3_ACCESS_KEY_ID and S3_SECRET_ACCESS_KEY are required when STORAGE_BACKEND=s3")
+		}
+	default:
+		return nil, fmt.Errorf("STORAGE_BACKEND must be %q or %q, got %q", StorageBackendLocal, StorageBackendS3, cfg.Backend)
+	}
+
+	return cfg, nil
+}
@@ -0,0 +1,35 @@
+package config
+
+import "fmt"
+
+// maxTagsCeiling and maxTagLengthCeiling bound MAX_TAGS and MAX_TAG_LENGTH so a
+// misconfiguration cannot reintroduce unbounded tag columns
+const (
+	maxTagsCeiling      = 500
+	maxTagLengthCeiling = 200
+)
+
+// TagConfig limits the tags of applications and resumes
+type TagConfig struct {
+	// MaxTags is how many tags one application or resume may carry
+	MaxTags int
+	// MaxTagLength is the longest tag accepted
+	MaxTagLength int
+}
+
+// LoadTagConfig reads tag limits from the environment
+func LoadTagConfig() (*TagConfig, error) {
+	cfg := &TagConfig{
+		MaxTags:      getEnvAsInt("MAX_TAGS", 25),
+		MaxTagLength: getEnvAsInt("MAX_TAG_LENGTH", 50),
+	}
+
+	if cfg.MaxTags < 1 || cfg.MaxTags > maxTagsCeiling {
+		return nil, fmt.Errorf("MAX_TAGS must be between 1 and %d, got %d", maxTagsCeiling, cfg.MaxTags)
+	}
+	if cfg.MaxTagLength < 1 || cfg.MaxTagLength > maxTagLengthCeiling {
+		return nil, fmt.Errorf("MAX_TAG_LENGTH must be between 1 and %d, got %d", maxTagLengthCeiling, cfg.MaxTagLength)
+	}
+
+	return cfg, nil
+}
@@ -0,0 +1,64 @@
+package config
+
+import (
+	"fmt"
+	"time"
+)
+
+// RouteTimeoutSetting is the request timeout of one route
+type RouteTimeoutSetting struct {
+	Method  string
+	Pattern string
+	Timeout time.Duration
+}
+
+// RequestTimeoutConfig holds request timeouts enforced by the timeout middleware
+type RequestTimeoutConfig struct {
+	// Default applies to every route without its own timeout
+	Default time.Duration
+	// AIRoutes applies to routes that wait on the AI service; it must exceed
+	// AI_SERVICE_TIMEOUT, plus AI_SERVICE_CONCURRENCY_WAIT when calls are capped, so a
+	// slow or queued AI call fails with its own error instead of the request being cut
+	// off first
+	AIRoutes time.Duration
+	// Routes are the per-route timeouts derived from AIRoutes
+	Routes []RouteTimeoutSetting
+}
+
+const (
+	defaultRequestTimeout = 30 * time.Second
+	// aiRouteTimeoutMargin leaves room for the work around the AI call, such as saving the result
+	aiRouteTimeoutMargin = 15 * time.Second
+)
+
+// aiBackedRoutes are the routes that call the AI service while the client waits
+var aiBackedRoutes = []RouteTimeoutSetting{
+	{Method: "POST", Pattern: "/api/v1/job-applications/:id/generate-cover-letter"},
+	{Method: "POST", Pattern: "/api/v1/job-applications/:id/cover-letter/refine"},
+	{Method: "POST", Pattern: "/api/v1/job-applications/detect-language"},
+	{Method: "POST", Pattern: "/api/v1/job-applications/suggest-tags"},
+}
+
+// LoadRequestTimeoutConfig reads request timeouts from the environment. AI-backed
+// routes default to the AI call budget (see aiServiceCallBudget) plus a margin.
+func LoadRequestTimeoutConfig() (*RequestTimeoutConfig, error) {
+	aiTimeout := aiServiceCallBudget()
+	cfg := &RequestTimeoutConfig{
+		Default:  getEnvAsDuration("REQUEST_TIMEOUT", defaultRequestTimeout.String()),
+		AIRoutes: getEnvAsDuration("REQUEST_TIMEOUT_AI_ROUTES", (aiTimeout + aiRouteTimeoutMargin).String()),
+	}
+
+	if cfg.Default <= 0 {
+		return nil, fmt.Errorf("REQUEST_TIMEOUT must be positive, got %s", cfg.Default)
+	}
+	if cfg.AIRoutes <= aiTimeout {
+		return nil, fmt.Errorf("REQUEST_TIMEOUT_AI_ROUTES (%s) must be longer than AI_SERVICE_TIMEOUT plus AI_SERVICE_CONCURRENCY_WAIT (%s)", cfg.AIRoutes, aiTimeout)
+	}
+
+	for _, route := range aiBackedRoutes {
+		route.Timeout = cfg.AIRoutes
+		cfg.Routes = append(cfg.Routes, route)
+	}
+
+	return cfg, nil
+}
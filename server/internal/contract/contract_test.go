@@ -160,7 +160,7 @@ func TestJobApplication_Contract(t *testing.T) {
 func TestUpdateJobApplicationRequest_Contract(t *testing.T) {
 	followUpDate := time.Now().Add(7 * 24 * time.Hour)
 	deadline := time.Now().Add(30 * 24 * time.Hour)
-	interestLevel := "high"
+	interestLevel := jobapplications.InterestLevelHigh
 	notes := "Updated notes"
 
 	request := jobapplications.UpdateJobApplicationRequest{
@@ -1,27 +1,43 @@
 package database
 
 import (
+	"log/slog"
+
 	"woragis-jobs-service/internal/config"
 )
 
-// NewFromConfig creates a new database manager from application config
-func NewFromConfig(dbCfg *config.DatabaseConfig, redisCfg *config.RedisConfig) (*Manager, error) {
+// NewFromConfig creates a new database manager from application config. GORM output,
+// including slow query warnings, goes to logger.
+func NewFromConfig(dbCfg *config.DatabaseConfig, redisCfg *config.RedisConfig, logger *slog.Logger) (*Manager, error) {
 	rabbitMQCfg := config.LoadRabbitMQConfig()
 	
 	dbConfig := Config{
 		Postgres: PostgresConfig{
-			DSN:             dbCfg.URL,
-			MaxOpenConns:    dbCfg.MaxOpenConns,
-			MaxIdleConns:    dbCfg.MaxIdleConns,
-			ConnMaxIdleTime: dbCfg.MaxIdleTime,
-			ConnMaxLifetime: dbCfg.ConnMaxLifetime,
+			DSN:                dbCfg.URL,
+			MaxOpenConns:       dbCfg.MaxOpenConns,
+			MaxIdleConns:       dbCfg.MaxIdleConns,
+			ConnMaxIdleTime:    dbCfg.MaxIdleTime,
+			ConnMaxLifetime:    dbCfg.ConnMaxLifetime,
+			SlowQueryThreshold: dbCfg.SlowQueryThreshold,
+			Logger:             logger,
 		},
 		Redis: RedisConfig{
-			URL:      redisCfg.URL,
-			Password: redisCfg.Password,
-			DB:       redisCfg.DB,
+			URL:          redisCfg.URL,
+			Password:     redisCfg.Password,
+			DB:           redisCfg.DB,
+			PoolSize:     redisCfg.PoolSize,
+			MinIdleConns: redisCfg.MinIdleConns,
+			DialTimeout:  redisCfg.DialTimeout,
+			ReadTimeout:  redisCfg.ReadTimeout,
+			WriteTimeout: redisCfg.WriteTimeout,
+			MaxRetries:   redisCfg.MaxRetries,
 		},
 		RabbitMQ: rabbitMQCfg.URL,
+		Retry: RetryPolicy{
+			MaxAttempts:    dbCfg.RetryMaxAttempts,
+			InitialBackoff: dbCfg.RetryInitialBackoff,
+			MaxBackoff:     dbCfg.RetryMaxBackoff,
+		},
 	}
 
 	return NewManager(dbConfig)
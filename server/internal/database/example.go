@@ -10,10 +10,13 @@ import (
 func ExampleUsage() {
 	// Load configuration
 	dbCfg := config.LoadDatabaseConfig()
-	redisCfg := config.LoadRedisConfig()
+	redisCfg, err := config.LoadRedisConfig()
+	if err != nil {
+		log.Fatalf("Invalid Redis configuration: %v", err)
+	}
 
 	// Create database manager
-	dbManager, err := NewFromConfig(dbCfg, redisCfg)
+	dbManager, err := NewFromConfig(dbCfg, redisCfg, nil)
 	if err != nil {
 		log.Fatalf("Failed to initialize database manager: %v", err)
 	}
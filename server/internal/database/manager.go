@@ -13,6 +13,8 @@ type Manager struct {
 	Postgres *gorm.DB
 	Redis    *redis.Client
 	RabbitMQ *RabbitMQConnection
+	// RetryPolicy is applied to repositories decorated for transient connection errors
+	RetryPolicy RetryPolicy
 }
 
 // Config holds configuration for all database connections
@@ -20,11 +22,12 @@ type Config struct {
 	Postgres PostgresConfig
 	Redis    RedisConfig
 	RabbitMQ string // URL
+	Retry    RetryPolicy
 }
 
 // NewManager creates a new database manager with all connections
 func NewManager(config Config) (*Manager, error) {
-	manager := &Manager{}
+	manager := &Manager{RetryPolicy: config.Retry}
 
 	// Initialize PostgreSQL connection
 	postgresDB, err := NewPostgres(config.Postgres)
@@ -126,6 +129,11 @@ func (m *Manager) GetPostgres() *gorm.DB {
 	return m.Postgres
 }
 
+// GetRetryPolicy returns the retry policy for transient database errors
+func (m *Manager) GetRetryPolicy() RetryPolicy {
+	return m.RetryPolicy
+}
+
 // GetRedis returns the Redis client connection
 func (m *Manager) GetRedis() *redis.Client {
 	return m.Redis
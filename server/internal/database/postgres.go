@@ -3,6 +3,7 @@ package database
 import (
 	"fmt"
 	"log"
+	"log/slog"
 	"os"
 	"time"
 
@@ -18,6 +19,10 @@ type PostgresConfig struct {
 	MaxIdleConns    int
 	ConnMaxIdleTime time.Duration
 	ConnMaxLifetime time.Duration
+	// SlowQueryThreshold logs statements taking at least this long at warn level; 0 disables it
+	SlowQueryThreshold time.Duration
+	// Logger receives GORM output; slog.Default() when nil
+	Logger *slog.Logger
 }
 
 // NewPostgres creates a new PostgreSQL database connection
@@ -29,9 +34,9 @@ func NewPostgres(config PostgresConfig) (*gorm.DB, error) {
 	}
 
 	// Set logger level based on environment
-	gormLogger = logger.Default.LogMode(logger.Silent) // Default to silent in production
+	gormLogger = NewQueryLogger(config.Logger, logger.Silent, config.SlowQueryThreshold) // Default to silent in production
 	if os.Getenv("ENV") == "development" {
-		gormLogger = logger.Default.LogMode(logger.Info)
+		gormLogger = gormLogger.LogMode(logger.Info)
 	}
 
 	// Open database connection
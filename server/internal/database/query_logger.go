@@ -0,0 +1,80 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// queryLogger sends GORM output to the application's slog logger. Statements slower than
+// slowThreshold are reported at warn level whatever the log level, so slow queries stay
+// visible while production keeps every other statement quiet. A zero threshold disables it.
+type queryLogger struct {
+	logger        *slog.Logger
+	level         logger.LogLevel
+	slowThreshold time.Duration
+}
+
+// NewQueryLogger returns a GORM logger writing to l. At logger.Info every statement is
+// logged at debug level; at lower levels only slow queries are.
+func NewQueryLogger(l *slog.Logger, level logger.LogLevel, slowThreshold time.Duration) logger.Interface {
+	if l == nil {
+		l = slog.Default()
+	}
+	return &queryLogger{logger: l, level: level, slowThreshold: slowThreshold}
+}
+
+func (q *queryLogger) LogMode(level logger.LogLevel) logger.Interface {
+	copied := *q
+	copied.level = level
+	return &copied
+}
+
+func (q *queryLogger) Info(ctx context.Context, msg string, args ...interface{}) {
+	if q.level >= logger.Info {
+		q.logger.InfoContext(ctx, fmt.Sprintf(msg, args...))
+	}
+}
+
+func (q *queryLogger) Warn(ctx context.Context, msg string, args ...interface{}) {
+	if q.level >= logger.Warn {
+		q.logger.WarnContext(ctx, fmt.Sprintf(msg, args...))
+	}
+}
+
+func (q *queryLogger) Error(ctx context.Context, msg string, args ...interface{}) {
+	if q.level >= logger.Error {
+		q.logger.ErrorContext(ctx, fmt.Sprintf(msg, args...))
+	}
+}
+
+// Trace is called by GORM after every statement.
+func (q *queryLogger) Trace(ctx context.Context, begin time.Time, fc func() (string, int64), err error) {
+	elapsed := time.Since(begin)
+	switch {
+	case q.slowThreshold > 0 && elapsed >= q.slowThreshold:
+		sql, rows := fc()
+		q.logger.WarnContext(ctx, "slow database query",
+			"sql", sql,
+			"duration_ms", elapsed.Milliseconds(),
+			"threshold_ms", q.slowThreshold.Milliseconds(),
+			"rows", rows)
+	case q.level >= logger.Info:
+		sql, rows := fc()
+		attrs := []any{"sql", sql, "duration_ms", elapsed.Milliseconds(), "rows", rows}
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			attrs = append(attrs, "error", err)
+		}
+		q.logger.DebugContext(ctx, "database query", attrs...)
+	}
+}
+
+// ParamsFilter keeps bound values out of the logged SQL, so user data never reaches the logs.
+func (q *queryLogger) ParamsFilter(_ context.Context, sql string, _ ...interface{}) (string, []interface{}) {
+	return sql, nil
+}
@@ -0,0 +1,59 @@
+package database
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm/logger"
+)
+
+func newTestQueryLogger(level logger.LogLevel, threshold time.Duration) (logger.Interface, *bytes.Buffer) {
+	var buf bytes.Buffer
+	l := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	return NewQueryLogger(l, level, threshold), &buf
+}
+
+func traceQuery(l logger.Interface, elapsed time.Duration) {
+	l.Trace(context.Background(), time.Now().Add(-elapsed), func() (string, int64) {
+		return `SELECT * FROM "job_applications" WHERE user_id = $1`, 3
+	}, nil)
+}
+
+func TestQueryLogger_LogsSlowQueries(t *testing.T) {
+	l, buf := newTestQueryLogger(logger.Silent, 200*time.Millisecond)
+
+	traceQuery(l, 50*time.Millisecond)
+	assert.Empty(t, buf.String(), "fast queries stay quiet")
+
+	traceQuery(l, 250*time.Millisecond)
+	out := buf.String()
+	assert.Contains(t, out, "level=WARN")
+	assert.Contains(t, out, "slow database query")
+	assert.Contains(t, out, `WHERE user_id = $1`)
+	assert.Contains(t, out, "threshold_ms=200")
+}
+
+func TestQueryLogger_ZeroThresholdDisabled(t *testing.T) {
+	l, buf := newTestQueryLogger(logger.Silent, 0)
+	traceQuery(l, time.Second)
+	assert.Empty(t, buf.String())
+}
+
+func TestQueryLogger_InfoLevelLogsEveryQueryAtDebug(t *testing.T) {
+	l, buf := newTestQueryLogger(logger.Silent, time.Second)
+	l = l.LogMode(logger.Info)
+
+	traceQuery(l, time.Millisecond)
+	assert.Contains(t, buf.String(), "level=DEBUG")
+	assert.Contains(t, buf.String(), "database query")
+}
+
+func TestQueryLogger_ParamsFilterDropsValues(t *testing.T) {
+	sql, params := (&queryLogger{}).ParamsFilter(context.Background(), "SELECT $1", "secret@example.com")
+	assert.Equal(t, "SELECT $1", sql)
+	assert.Nil(t, params)
+}
@@ -31,6 +31,11 @@ func NewRabbitMQ(url string) (*RabbitMQConnection, error) {
 	}, nil
 }
 
+// IsConnected reports whether the connection is still open, for health checks
+func (r *RabbitMQConnection) IsConnected() bool {
+	return r != nil && r.Connection != nil && !r.Connection.IsClosed()
+}
+
 // Close closes the RabbitMQ connection and channel
 func (r *RabbitMQConnection) Close() error {
 	if r.Channel != nil {
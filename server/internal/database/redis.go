@@ -14,6 +14,14 @@ type RedisConfig struct {
 	URL      string
 	Password string
 	DB       int
+	// Pool and timeout settings; zero values keep the go-redis defaults
+	PoolSize     int
+	MinIdleConns int
+	DialTimeout  time.Duration
+	ReadTimeout  time.Duration
+	WriteTimeout time.Duration
+	// MaxRetries of zero disables retries (go-redis would otherwise retry 3 times)
+	MaxRetries int
 }
 
 // NewRedis creates a new Redis client connection
@@ -36,6 +44,26 @@ func NewRedis(config RedisConfig) (*redis.Client, error) {
 		opt.DB = config.DB
 	}
 
+	if config.PoolSize > 0 {
+		opt.PoolSize = config.PoolSize
+	}
+	if config.MinIdleConns > 0 {
+		opt.MinIdleConns = config.MinIdleConns
+	}
+	if config.DialTimeout > 0 {
+		opt.DialTimeout = config.DialTimeout
+	}
+	if config.ReadTimeout > 0 {
+		opt.ReadTimeout = config.ReadTimeout
+	}
+	if config.WriteTimeout > 0 {
+		opt.WriteTimeout = config.WriteTimeout
+	}
+	opt.MaxRetries = config.MaxRetries
+	if opt.MaxRetries == 0 {
+		opt.MaxRetries = -1
+	}
+
 	// Create Redis client
 	client := redis.NewClient(opt)
 
@@ -0,0 +1,123 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"math/rand"
+	"net"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"woragis-jobs-service/pkg/metrics"
+)
+
+// RetryPolicy bounds how repository calls are retried after transient connection errors,
+// such as the ones seen during a Postgres failover.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one. 1 disables retries.
+	MaxAttempts int
+	// InitialBackoff is the wait before the first retry; it doubles for every further retry.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the wait between two attempts.
+	MaxBackoff time.Duration
+}
+
+// Do runs fn until it succeeds, fails with a non-transient error, ctx is done or the
+// attempts are used up. The last error is returned unchanged, so callers can keep
+// matching gorm.ErrRecordNotFound and friends.
+//
+// A write whose connection dropped after the server committed it can run twice; the
+// repositories rely on client-generated IDs so a duplicate insert fails on the primary
+// key instead of creating a second row.
+func (p RetryPolicy) Do(ctx context.Context, operation string, fn func() error) error {
+	attempts := p.MaxAttempts
+	if attempts < 1 {
+		attempts = 1
+	}
+
+	var err error
+	for attempt := 1; ; attempt++ {
+		err = fn()
+		if err == nil || attempt >= attempts || !IsTransientError(err) {
+			return err
+		}
+
+		timer := time.NewTimer(p.backoff(attempt))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+		metrics.RecordDatabaseRetry(operation)
+	}
+}
+
+// backoff returns the wait before retry number attempt (starting at 1), with up to 20% jitter
+// so instances recovering from the same failover do not reconnect in lockstep.
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	wait := p.InitialBackoff
+	for i := 1; i < attempt && (p.MaxBackoff <= 0 || wait < p.MaxBackoff); i++ {
+		wait *= 2
+	}
+	if p.MaxBackoff > 0 && wait > p.MaxBackoff {
+		wait = p.MaxBackoff
+	}
+	if wait <= 0 {
+		return 0
+	}
+	return wait - time.Duration(rand.Int63n(int64(wait)/5+1))
+}
+
+// Retry runs fn under policy and returns its result, see RetryPolicy.Do.
+func Retry[T any](ctx context.Context, policy RetryPolicy, operation string, fn func() (T, error)) (T, error) {
+	var result T
+	err := policy.Do(ctx, operation, func() error {
+		var err error
+		result, err = fn()
+		return err
+	})
+	return result, err
+}
+
+// transientPgCodes are Postgres error codes raised while a server restarts or fails over.
+var transientPgCodes = map[string]bool{
+	"57P01": true, // admin_shutdown
+	"57P02": true, // crash_shutdown
+	"57P03": true, // cannot_connect_now
+}
+
+// IsTransientError reports whether err is a connection problem worth retrying. Constraint
+// violations, missing records and cancelled contexts are never transient.
+func IsTransientError(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		// Class 08 is "connection exception"; everything else the server reported is final
+		return strings.HasPrefix(pgErr.Code, "08") || transientPgCodes[pgErr.Code]
+	}
+
+	var connectErr *pgconn.ConnectError
+	if errors.As(err, &connectErr) || pgconn.SafeToRetry(err) {
+		return true
+	}
+
+	if errors.Is(err, driver.ErrBadConn) || errors.Is(err, sql.ErrConnDone) ||
+		errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, net.ErrClosed) ||
+		errors.Is(err, syscall.ECONNREFUSED) || errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.EPIPE) {
+		return true
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
@@ -0,0 +1,102 @@
+package account
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"time"
+)
+
+// exportDateLayouts maps the dateFormat query values to Go layouts. "iso" keeps
+// RFC 3339 but in the requested timezone.
+var exportDateLayouts = map[string]string{
+	"iso":      time.RFC3339,
+	"date":     "2006-01-02",
+	"datetime": "2006-01-02 15:04:05", // Parsed as a date by most spreadsheets
+	"us":       "01/02/2006 15:04",
+	"eu":       "02/01/2006 15:04",
+}
+
+// ExportDateOptions selects how timestamps are rendered in an export.
+type ExportDateOptions struct {
+	Location *time.Location
+	Layout   string
+}
+
+// ParseExportDateOptions validates the tz and dateFormat query params. tz must be
+// an IANA zone name such as "America/Sao_Paulo"; both default to UTC and ISO.
+func ParseExportDateOptions(tz, dateFormat string) (ExportDateOptions, error) {
+	options := ExportDateOptions{Location: time.UTC, Layout: time.RFC3339}
+
+	if tz = strings.TrimSpace(tz); tz != "" {
+		// LoadLocation also accepts "Local", which would leak the server's zone
+		if tz == "Local" {
+			return options, NewDomainError(ErrCodeInvalidPayload, ErrInvalidTimezone)
+		}
+		location, err := time.LoadLocation(tz)
+		if err != nil {
+			return options, NewDomainError(ErrCodeInvalidPayload, ErrInvalidTimezone)
+		}
+		options.Location = location
+	}
+
+	if dateFormat = strings.ToLower(strings.TrimSpace(dateFormat)); dateFormat != "" {
+		layout, ok := exportDateLayouts[dateFormat]
+		if !ok {
+			return options, NewDomainError(ErrCodeInvalidPayload, ErrInvalidDateFormat)
+		}
+		options.Layout = layout
+	}
+
+	return options, nil
+}
+
+// IsDefault reports whether timestamps can be emitted as-is (UTC, RFC 3339).
+func (o ExportDateOptions) IsDefault() bool {
+	return o.Location == time.UTC && o.Layout == time.RFC3339
+}
+
+// FormatBundle returns the bundle as generic JSON with every timestamp rendered in
+// the selected timezone and layout. Timestamps are recognized as the RFC 3339
+// strings encoding/json produces for time.Time values.
+func (o ExportDateOptions) FormatBundle(bundle *ExportBundle) (interface{}, error) {
+	raw, err := json.Marshal(bundle)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+	var tree interface{}
+	if err := decoder.Decode(&tree); err != nil {
+		return nil, err
+	}
+
+	return o.formatValue(tree), nil
+}
+
+func (o ExportDateOptions) formatValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, item := range v {
+			v[key] = o.formatValue(item)
+		}
+		return v
+	case []interface{}:
+		for i, item := range v {
+			v[i] = o.formatValue(item)
+		}
+		return v
+	case string:
+		if !strings.Contains(v, "T") {
+			return v
+		}
+		t, err := time.Parse(time.RFC3339Nano, v)
+		if err != nil {
+			return v
+		}
+		return t.In(o.Location).Format(o.Layout)
+	default:
+		return v
+	}
+}
@@ -0,0 +1,58 @@
+package account
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"woragis-jobs-service/internal/domains/jobapplications"
+)
+
+func TestParseExportDateOptions(t *testing.T) {
+	options, err := ParseExportDateOptions("", "")
+	require.NoError(t, err)
+	assert.True(t, options.IsDefault())
+
+	options, err = ParseExportDateOptions("America/Sao_Paulo", "DateTime")
+	require.NoError(t, err)
+	assert.False(t, options.IsDefault())
+	assert.Equal(t, "America/Sao_Paulo", options.Location.String())
+	assert.Equal(t, "2006-01-02 15:04:05", options.Layout)
+
+	for _, tz := range []string{"Local", "Mars/Olympus", "../etc/passwd"} {
+		_, err := ParseExportDateOptions(tz, "")
+		assert.EqualError(t, err, ErrInvalidTimezone, tz)
+	}
+	_, err = ParseExportDateOptions("", "excel")
+	assert.EqualError(t, err, ErrInvalidDateFormat)
+}
+
+func TestExportDateOptions_FormatBundle(t *testing.T) {
+	options, err := ParseExportDateOptions("Asia/Tokyo", "datetime")
+	require.NoError(t, err)
+
+	exportedAt := time.Date(2026, 3, 1, 22, 30, 0, 0, time.UTC)
+	bundle := &ExportBundle{
+		UserID:     uuid.New(),
+		ExportedAt: exportedAt,
+		JobApplications: []jobapplications.JobApplication{{
+			CompanyName: "Acme",
+			Notes:       "Applied on 2026-03-01",
+			CreatedAt:   exportedAt,
+		}},
+	}
+
+	formatted, err := options.FormatBundle(bundle)
+	require.NoError(t, err)
+
+	tree := formatted.(map[string]interface{})
+	assert.Equal(t, "2026-03-02 07:30:00", tree["exportedAt"])
+	assert.Equal(t, bundle.UserID.String(), tree["userId"])
+
+	application := tree["jobApplications"].([]interface{})[0].(map[string]interface{})
+	assert.Equal(t, "2026-03-02 07:30:00", application["createdAt"])
+	assert.Equal(t, "Applied on 2026-03-01", application["notes"])
+}
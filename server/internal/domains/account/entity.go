@@ -0,0 +1,55 @@
+package account
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"woragis-jobs-service/internal/domains/jobapplications"
+	"woragis-jobs-service/internal/domains/jobapplications/contacts"
+	"woragis-jobs-service/internal/domains/jobapplications/interviewstages"
+	"woragis-jobs-service/internal/domains/jobapplications/responses"
+	"woragis-jobs-service/internal/domains/resumes"
+)
+
+// ExportBundle holds everything the service stores for a single user.
+type ExportBundle struct {
+	UserID          uuid.UUID                             `json:"userId"`
+	ExportedAt      time.Time                             `json:"exportedAt"`
+	JobApplications []jobapplications.JobApplication      `json:"jobApplications"`
+	Resumes         []resumes.Resume                      `json:"resumes"`
+	GenerationJobs  []resumes.ResumeGenerationJob         `json:"generationJobs"`
+	Responses       []responses.Response                  `json:"responses"`
+	InterviewStages []interviewstages.InterviewStage      `json:"interviewStages"`
+	Offers          []jobapplications.Offer               `json:"offers"`
+	CoverLetters    []jobapplications.CoverLetterRevision `json:"coverLetterRevisions"`
+	StatusChanges   []jobapplications.StatusChange        `json:"statusChanges"`
+	Expenses        []jobapplications.ApplicationExpense  `json:"expenses"`
+	Templates       []interviewstages.InterviewTemplate   `json:"interviewTemplates"`
+	Contacts        []contacts.Contact                    `json:"contacts"`
+}
+
+// DeletionSummary reports how many records were removed for a user.
+type DeletionSummary struct {
+	JobApplications int64 `json:"jobApplications"`
+	Resumes         int64 `json:"resumes"`
+	GenerationJobs  int64 `json:"generationJobs"`
+	Responses       int64 `json:"responses"`
+	InterviewStages int64 `json:"interviewStages"`
+	Offers          int64 `json:"offers"`
+	CoverLetters    int64 `json:"coverLetterRevisions"`
+	StatusChanges   int64 `json:"statusChanges"`
+	Expenses        int64 `json:"expenses"`
+	Templates       int64 `json:"interviewTemplates"`
+	Contacts        int64 `json:"contacts"`
+	FilesDeleted    int   `json:"filesDeleted"`
+}
+
+// DeletionCleanup lists what remains to clean up outside the database once a user's
+// records are deleted.
+type DeletionCleanup struct {
+	// FilePaths are the deleted resumes' files that no remaining resume references
+	FilePaths []string
+	// ApplicationIDs are the deleted applications, whose cached copies must be dropped
+	ApplicationIDs []uuid.UUID
+}
@@ -0,0 +1,41 @@
+package account
+
+import "errors"
+
+const (
+	ErrCodeInvalidPayload    = 10300
+	ErrCodeRepositoryFailure = 10301
+)
+
+const (
+	ErrEmptyUserID       = "account: user id cannot be empty"
+	ErrUnableToFetch     = "account: unable to fetch data"
+	ErrUnableToDelete    = "account: unable to delete data"
+	ErrInvalidTimezone   = "account: tz must be an IANA timezone such as Europe/Berlin"
+	ErrInvalidDateFormat = "account: dateFormat must be one of iso, date, datetime, us or eu"
+	ErrInvalidSanitize   = "account: sanitize must be true or false"
+)
+
+type DomainError struct {
+	Code    int
+	Message string
+}
+
+func (e *DomainError) Error() string {
+	return e.Message
+}
+
+func NewDomainError(code int, message string) *DomainError {
+	return &DomainError{
+		Code:    code,
+		Message: message,
+	}
+}
+
+func AsDomainError(err error) (*DomainError, bool) {
+	var domainErr *DomainError
+	if errors.As(err, &domainErr) {
+		return domainErr, true
+	}
+	return nil, false
+}
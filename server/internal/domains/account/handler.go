@@ -0,0 +1,112 @@
+package account
+
+import (
+	"log/slog"
+
+	"github.com/gofiber/fiber/v2"
+
+	"woragis-jobs-service/pkg/middleware"
+	"woragis-jobs-service/pkg/response"
+	"woragis-jobs-service/pkg/sanitize"
+)
+
+// Handler exposes account data endpoints.
+type Handler interface {
+	ExportAccount(c *fiber.Ctx) error
+	DeleteAccount(c *fiber.Ctx) error
+}
+
+type handler struct {
+	service      Service
+	exportFilter *sanitize.Filter // Applied to exports requested with ?sanitize=true
+	logger       *slog.Logger
+}
+
+// NewHandler constructs an account handler that sanitizes exports with the default rules.
+func NewHandler(service Service, logger *slog.Logger) Handler {
+	return NewHandlerWithExportFilter(service, sanitize.NewDefaultFilter(), logger)
+}
+
+// NewHandlerWithExportFilter constructs an account handler that sanitizes exports with filter.
+func NewHandlerWithExportFilter(service Service, exportFilter *sanitize.Filter, logger *slog.Logger) Handler {
+	return &handler{
+		service:      service,
+		exportFilter: exportFilter,
+		logger:       logger,
+	}
+}
+
+func (h *handler) ExportAccount(c *fiber.Ctx) error {
+	userID, err := middleware.GetUserIDFromFiberContext(c)
+	if err != nil {
+		return response.Error(c, fiber.StatusUnauthorized, 401, fiber.Map{
+			"message": "authentication required",
+		})
+	}
+
+	dateOptions, err := ParseExportDateOptions(c.Query("tz"), c.Query("dateFormat"))
+	if err != nil {
+		return h.handleError(c, err)
+	}
+	sanitized, err := ParseExportSanitize(c.Query("sanitize"))
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	bundle, err := h.service.ExportUserData(c.Context(), userID)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+	if sanitized {
+		SanitizeBundle(bundle, h.exportFilter)
+	}
+
+	c.Set(fiber.HeaderContentDisposition, `attachment; filename="account-export.json"`)
+	if dateOptions.IsDefault() {
+		return response.Success(c, fiber.StatusOK, bundle)
+	}
+
+	formatted, err := dateOptions.FormatBundle(bundle)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+	return response.Success(c, fiber.StatusOK, formatted)
+}
+
+func (h *handler) DeleteAccount(c *fiber.Ctx) error {
+	userID, err := middleware.GetUserIDFromFiberContext(c)
+	if err != nil {
+		return response.Error(c, fiber.StatusUnauthorized, 401, fiber.Map{
+			"message": "authentication required",
+		})
+	}
+
+	summary, err := h.service.DeleteUserData(c.Context(), userID)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return response.Success(c, fiber.StatusOK, fiber.Map{
+		"message": "account data deleted successfully",
+		"deleted": summary,
+	})
+}
+
+func (h *handler) handleError(c *fiber.Ctx, err error) error {
+	if domainErr, ok := AsDomainError(err); ok {
+		statusCode := fiber.StatusInternalServerError
+		switch domainErr.Code {
+		case ErrCodeInvalidPayload:
+			statusCode = fiber.StatusBadRequest
+		}
+
+		return response.Error(c, statusCode, domainErr.Code, fiber.Map{
+			"message": domainErr.Message,
+		})
+	}
+
+	h.logger.ErrorContext(c.UserContext(), "unhandled error", slog.Any("error", err))
+	return response.Error(c, fiber.StatusInternalServerError, 500, fiber.Map{
+		"message": "internal server error",
+	})
+}
@@ -0,0 +1,224 @@
+package account
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"woragis-jobs-service/internal/domains/jobapplications"
+	"woragis-jobs-service/internal/domains/jobapplications/contacts"
+	"woragis-jobs-service/internal/domains/jobapplications/interviewstages"
+	"woragis-jobs-service/internal/domains/jobapplications/responses"
+	"woragis-jobs-service/internal/domains/resumes"
+)
+
+// Repository defines persistence operations spanning all user-owned data.
+type Repository interface {
+	ExportUserData(ctx context.Context, userID uuid.UUID) (*ExportBundle, error)
+	DeleteUserData(ctx context.Context, userID uuid.UUID) (*DeletionSummary, *DeletionCleanup, error)
+}
+
+type gormRepository struct {
+	db *gorm.DB
+}
+
+// NewGormRepository returns a GORM-backed repository.
+func NewGormRepository(db *gorm.DB) Repository {
+	return &gormRepository{db: db}
+}
+
+// applicationIDsQuery selects the ids of every application owned by the user.
+func applicationIDsQuery(db *gorm.DB, userID uuid.UUID) *gorm.DB {
+	return db.Model(&jobapplications.JobApplication{}).Select("id").Where("user_id = ?", userID)
+}
+
+func (r *gormRepository) ExportUserData(ctx context.Context, userID uuid.UUID) (*ExportBundle, error) {
+	db := r.db.WithContext(ctx)
+	bundle := &ExportBundle{
+		UserID:          userID,
+		JobApplications: []jobapplications.JobApplication{},
+		Resumes:         []resumes.Resume{},
+		GenerationJobs:  []resumes.ResumeGenerationJob{},
+		Responses:       []responses.Response{},
+		InterviewStages: []interviewstages.InterviewStage{},
+		Offers:          []jobapplications.Offer{},
+		CoverLetters:    []jobapplications.CoverLetterRevision{},
+		StatusChanges:   []jobapplications.StatusChange{},
+		Expenses:        []jobapplications.ApplicationExpense{},
+		Templates:       []interviewstages.InterviewTemplate{},
+		Contacts:        []contacts.Contact{},
+	}
+
+	if err := db.Where("user_id = ?", userID).Order("created_at ASC").Find(&bundle.JobApplications).Error; err != nil {
+		return nil, NewDomainError(ErrCodeRepositoryFailure, ErrUnableToFetch)
+	}
+	if err := db.Where("user_id = ?", userID).Order("created_at ASC").Find(&bundle.Resumes).Error; err != nil {
+		return nil, NewDomainError(ErrCodeRepositoryFailure, ErrUnableToFetch)
+	}
+	if err := db.Where("user_id = ?", userID).Order("created_at ASC").Find(&bundle.GenerationJobs).Error; err != nil {
+		return nil, NewDomainError(ErrCodeRepositoryFailure, ErrUnableToFetch)
+	}
+	if err := db.Where("job_application_id IN (?)", applicationIDsQuery(db, userID)).
+		Order("response_date ASC").Find(&bundle.Responses).Error; err != nil {
+		return nil, NewDomainError(ErrCodeRepositoryFailure, ErrUnableToFetch)
+	}
+	if err := db.Where("job_application_id IN (?)", applicationIDsQuery(db, userID)).
+		Order("created_at ASC").Find(&bundle.InterviewStages).Error; err != nil {
+		return nil, NewDomainError(ErrCodeRepositoryFailure, ErrUnableToFetch)
+	}
+	if err := db.Where("job_application_id IN (?)", applicationIDsQuery(db, userID)).
+		Order("created_at ASC").Find(&bundle.Contacts).Error; err != nil {
+		return nil, NewDomainError(ErrCodeRepositoryFailure, ErrUnableToFetch)
+	}
+	if err := db.Where("user_id = ?", userID).Order("created_at ASC").Find(&bundle.Offers).Error; err != nil {
+		return nil, NewDomainError(ErrCodeRepositoryFailure, ErrUnableToFetch)
+	}
+	if err := db.Where("user_id = ?", userID).Order("job_application_id ASC, revision ASC").Find(&bundle.CoverLetters).Error; err != nil {
+		return nil, NewDomainError(ErrCodeRepositoryFailure, ErrUnableToFetch)
+	}
+	if err := db.Where("user_id = ?", userID).Order("job_application_id ASC, created_at ASC").Find(&bundle.StatusChanges).Error; err != nil {
+		return nil, NewDomainError(ErrCodeRepositoryFailure, ErrUnableToFetch)
+	}
+	if err := db.Where("user_id = ?", userID).Order("job_application_id ASC, incurred_at ASC").Find(&bundle.Expenses).Error; err != nil {
+		return nil, NewDomainError(ErrCodeRepositoryFailure, ErrUnableToFetch)
+	}
+	if err := db.Where("user_id = ?", userID).Order("created_at ASC").Find(&bundle.Templates).Error; err != nil {
+		return nil, NewDomainError(ErrCodeRepositoryFailure, ErrUnableToFetch)
+	}
+
+	return bundle, nil
+}
+
+// DeleteUserData removes every record owned by the user in a single transaction.
+// It returns the file paths of the deleted resumes that no remaining resume
+// references, so the caller can remove them from storage, and the ids of the
+// deleted applications.
+func (r *gormRepository) DeleteUserData(ctx context.Context, userID uuid.UUID) (*DeletionSummary, *DeletionCleanup, error) {
+	summary := &DeletionSummary{}
+	var filePaths []string
+	applicationIDs := []uuid.UUID{}
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Lock the user's resumes so a concurrent deduplicated upload can't
+		// start sharing one of these files while we decide which to remove.
+		var locked []resumes.Resume
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("user_id = ?", userID).Find(&locked).Error; err != nil {
+			return err
+		}
+		seen := make(map[string]bool, len(locked))
+		for _, resume := range locked {
+			if resume.FilePath != "" && !seen[resume.FilePath] {
+				seen[resume.FilePath] = true
+				filePaths = append(filePaths, resume.FilePath)
+			}
+		}
+
+		if err := applicationIDsQuery(tx, userID).Pluck("id", &applicationIDs).Error; err != nil {
+			return err
+		}
+
+		// Children first so no row is left pointing at a deleted application.
+		result := tx.Where("job_application_id IN (?)", applicationIDsQuery(tx, userID)).Delete(&responses.Response{})
+		if result.Error != nil {
+			return result.Error
+		}
+		summary.Responses = result.RowsAffected
+
+		result = tx.Where("job_application_id IN (?)", applicationIDsQuery(tx, userID)).Delete(&interviewstages.InterviewStage{})
+		if result.Error != nil {
+			return result.Error
+		}
+		summary.InterviewStages = result.RowsAffected
+
+		result = tx.Where("job_application_id IN (?)", applicationIDsQuery(tx, userID)).Delete(&contacts.Contact{})
+		if result.Error != nil {
+			return result.Error
+		}
+		summary.Contacts = result.RowsAffected
+
+		result = tx.Where("user_id = ?", userID).Delete(&jobapplications.Offer{})
+		if result.Error != nil {
+			return result.Error
+		}
+		summary.Offers = result.RowsAffected
+
+		result = tx.Where("user_id = ?", userID).Delete(&jobapplications.CoverLetterRevision{})
+		if result.Error != nil {
+			return result.Error
+		}
+		summary.CoverLetters = result.RowsAffected
+
+		result = tx.Where("user_id = ?", userID).Delete(&jobapplications.StatusChange{})
+		if result.Error != nil {
+			return result.Error
+		}
+		summary.StatusChanges = result.RowsAffected
+
+		result = tx.Where("user_id = ?", userID).Delete(&jobapplications.ApplicationExpense{})
+		if result.Error != nil {
+			return result.Error
+		}
+		summary.Expenses = result.RowsAffected
+
+		result = tx.Where("user_id = ?", userID).Delete(&jobapplications.JobApplication{})
+		if result.Error != nil {
+			return result.Error
+		}
+		summary.JobApplications = result.RowsAffected
+
+		result = tx.Where("user_id = ?", userID).Delete(&resumes.ResumeGenerationJob{})
+		if result.Error != nil {
+			return result.Error
+		}
+		summary.GenerationJobs = result.RowsAffected
+
+		result = tx.Where("user_id = ?", userID).Delete(&resumes.Resume{})
+		if result.Error != nil {
+			return result.Error
+		}
+		summary.Resumes = result.RowsAffected
+
+		if len(filePaths) > 0 {
+			var shared []string
+			if err := tx.Model(&resumes.Resume{}).Where("file_path IN ?", filePaths).
+				Distinct("file_path").Pluck("file_path", &shared).Error; err != nil {
+				return err
+			}
+			filePaths = withoutPaths(filePaths, shared)
+		}
+
+		result = tx.Where("user_id = ?", userID).Delete(&interviewstages.InterviewTemplate{})
+		if result.Error != nil {
+			return result.Error
+		}
+		summary.Templates = result.RowsAffected
+
+		return nil
+	})
+	if err != nil {
+		return nil, nil, NewDomainError(ErrCodeRepositoryFailure, ErrUnableToDelete)
+	}
+
+	return summary, &DeletionCleanup{FilePaths: filePaths, ApplicationIDs: applicationIDs}, nil
+}
+
+// withoutPaths returns the entries of paths that are not in exclude.
+func withoutPaths(paths, exclude []string) []string {
+	if len(exclude) == 0 {
+		return paths
+	}
+	excluded := make(map[string]bool, len(exclude))
+	for _, path := range exclude {
+		excluded[path] = true
+	}
+	kept := paths[:0]
+	for _, path := range paths {
+		if !excluded[path] {
+			kept = append(kept, path)
+		}
+	}
+	return kept
+}
@@ -0,0 +1,71 @@
+package account
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// dryRunPool lets a dry-run session open and commit transactions without a database.
+type dryRunPool struct {
+	gorm.ConnPool
+}
+
+func (p *dryRunPool) BeginTx(context.Context, *sql.TxOptions) (gorm.ConnPool, error) {
+	return p, nil
+}
+
+func (*dryRunPool) Commit() error   { return nil }
+func (*dryRunPool) Rollback() error { return nil }
+
+// newDryRunRepository returns a repository that records the SQL it would run.
+func newDryRunRepository(t *testing.T) (*gormRepository, *[]string) {
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: &dryRunPool{}}), &gorm.Config{DryRun: true, DisableAutomaticPing: true})
+	require.NoError(t, err)
+
+	var statements []string
+	record := func(tx *gorm.DB) {
+		statements = append(statements, tx.Statement.SQL.String())
+	}
+	require.NoError(t, db.Callback().Query().After("gorm:query").Register("test:record", record))
+	require.NoError(t, db.Callback().Delete().After("gorm:delete").Register("test:record", record))
+	return &gormRepository{db: db}, &statements
+}
+
+// indexOf returns the position of the first statement with the given prefix, or -1.
+func indexOf(statements []string, prefix string) int {
+	for i, statement := range statements {
+		if strings.HasPrefix(statement, prefix) {
+			return i
+		}
+	}
+	return -1
+}
+
+func TestExportUserData_IncludesExpenses(t *testing.T) {
+	repo, statements := newDryRunRepository(t)
+
+	bundle, err := repo.ExportUserData(context.Background(), uuid.New())
+	require.NoError(t, err)
+	assert.NotNil(t, bundle.Expenses, "exported as [] when the user has none")
+	assert.GreaterOrEqual(t, indexOf(*statements, `SELECT * FROM "job_application_expenses" WHERE user_id = $1`), 0)
+}
+
+func TestDeleteUserData_DeletesExpensesBeforeApplications(t *testing.T) {
+	repo, statements := newDryRunRepository(t)
+
+	_, _, err := repo.DeleteUserData(context.Background(), uuid.New())
+	require.NoError(t, err)
+
+	expenses := indexOf(*statements, `DELETE FROM "job_application_expenses" WHERE user_id = $1`)
+	applications := indexOf(*statements, `DELETE FROM "job_applications" WHERE user_id = $1`)
+	require.GreaterOrEqual(t, expenses, 0)
+	assert.Less(t, expenses, applications)
+}
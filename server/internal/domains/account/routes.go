@@ -0,0 +1,10 @@
+package account
+
+import "github.com/gofiber/fiber/v2"
+
+// SetupRoutes registers account data endpoints.
+// Both routes act only on the authenticated user's data.
+func SetupRoutes(api fiber.Router, handler Handler) {
+	api.Get("/export", handler.ExportAccount)
+	api.Delete("/", handler.DeleteAccount)
+}
@@ -0,0 +1,65 @@
+package account
+
+import (
+	"strconv"
+	"strings"
+
+	"woragis-jobs-service/pkg/sanitize"
+)
+
+// ParseExportSanitize validates the sanitize query param; blank means false.
+func ParseExportSanitize(raw string) (bool, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return false, nil
+	}
+	enabled, err := strconv.ParseBool(raw)
+	if err != nil {
+		return false, NewDomainError(ErrCodeInvalidPayload, ErrInvalidSanitize)
+	}
+	return enabled, nil
+}
+
+// SanitizeBundle scrubs the free-text fields of an exported bundle with filter. The
+// bundle must be a copy loaded for this export; stored records are never changed.
+func SanitizeBundle(bundle *ExportBundle, filter *sanitize.Filter) {
+	for i := range bundle.JobApplications {
+		application := &bundle.JobApplications[i]
+		application.Notes = filter.Apply(application.Notes)
+		application.CoverLetter = filter.Apply(application.CoverLetter)
+		application.RejectionReason = filter.Apply(application.RejectionReason)
+		application.ErrorMessage = filter.Apply(application.ErrorMessage)
+	}
+	for i := range bundle.CoverLetters {
+		revision := &bundle.CoverLetters[i]
+		revision.Content = filter.Apply(revision.Content)
+		revision.OriginalContent = filter.Apply(revision.OriginalContent)
+		revision.Feedback = filter.Apply(revision.Feedback)
+	}
+	for i := range bundle.StatusChanges {
+		bundle.StatusChanges[i].Reason = filter.Apply(bundle.StatusChanges[i].Reason)
+	}
+	for i := range bundle.Expenses {
+		bundle.Expenses[i].Description = filter.Apply(bundle.Expenses[i].Description)
+	}
+	for i := range bundle.Offers {
+		offer := &bundle.Offers[i]
+		offer.Notes = filter.Apply(offer.Notes)
+		offer.EquityDetails = filter.Apply(offer.EquityDetails)
+	}
+	for i := range bundle.Responses {
+		resp := &bundle.Responses[i]
+		resp.Message = filter.Apply(resp.Message)
+		resp.ContactEmail = filter.Apply(resp.ContactEmail)
+		resp.ContactPhone = filter.Apply(resp.ContactPhone)
+	}
+	for i := range bundle.InterviewStages {
+		stage := &bundle.InterviewStages[i]
+		stage.Notes = filter.Apply(stage.Notes)
+		stage.Feedback = filter.Apply(stage.Feedback)
+		stage.InterviewerEmail = filter.Apply(stage.InterviewerEmail)
+	}
+	for i := range bundle.Contacts {
+		bundle.Contacts[i].Email = filter.Apply(bundle.Contacts[i].Email)
+	}
+}
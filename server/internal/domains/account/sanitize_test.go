@@ -0,0 +1,48 @@
+package account
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"woragis-jobs-service/internal/domains/jobapplications"
+	"woragis-jobs-service/internal/domains/jobapplications/contacts"
+	"woragis-jobs-service/pkg/sanitize"
+)
+
+func TestParseExportSanitize(t *testing.T) {
+	enabled, err := ParseExportSanitize("")
+	require.NoError(t, err)
+	assert.False(t, enabled)
+
+	enabled, err = ParseExportSanitize("true")
+	require.NoError(t, err)
+	assert.True(t, enabled)
+
+	_, err = ParseExportSanitize("maybe")
+	assert.EqualError(t, err, ErrInvalidSanitize)
+}
+
+func TestSanitizeBundle(t *testing.T) {
+	bundle := &ExportBundle{
+		JobApplications: []jobapplications.JobApplication{{
+			CompanyName: "Acme",
+			Notes:       "Recruiter: jane@acme.example, +1 415 555 0100",
+			CoverLetter: "Dear Acme, damn I want this job.",
+		}},
+		CoverLetters: []jobapplications.CoverLetterRevision{{Content: "Reach me at me@example.com"}},
+		Expenses:     []jobapplications.ApplicationExpense{{Description: "Train ticket, receipt sent to me@example.com"}},
+		Contacts:     []contacts.Contact{{Name: "Jane", Email: "jane@acme.example"}},
+	}
+
+	SanitizeBundle(bundle, sanitize.NewDefaultFilter())
+
+	assert.Equal(t, "Acme", bundle.JobApplications[0].CompanyName)
+	assert.Equal(t, "Recruiter: [email], [phone]", bundle.JobApplications[0].Notes)
+	assert.Equal(t, "Dear Acme, d*** I want this job.", bundle.JobApplications[0].CoverLetter)
+	assert.Equal(t, "Reach me at [email]", bundle.CoverLetters[0].Content)
+	assert.Equal(t, "Train ticket, receipt sent to [email]", bundle.Expenses[0].Description)
+	assert.Equal(t, "Jane", bundle.Contacts[0].Name)
+	assert.Equal(t, "[email]", bundle.Contacts[0].Email)
+}
@@ -0,0 +1,96 @@
+package account
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"woragis-jobs-service/pkg/storage"
+)
+
+// Service orchestrates account-level data export and deletion.
+type Service interface {
+	ExportUserData(ctx context.Context, userID uuid.UUID) (*ExportBundle, error)
+	DeleteUserData(ctx context.Context, userID uuid.UUID) (*DeletionSummary, error)
+}
+
+// ApplicationCache drops cached copies of job applications. Account deletion removes
+// applications directly in the database, so it invalidates their cached copies itself.
+type ApplicationCache interface {
+	Invalidate(ctx context.Context, applicationIDs ...uuid.UUID)
+}
+
+type service struct {
+	repo             Repository
+	fileStorage      storage.Backend  // Optional: removes resume files after deletion
+	applicationCache ApplicationCache // Optional: drops deleted applications from the read cache
+	logger           *slog.Logger
+}
+
+// NewService constructs a Service.
+func NewService(repo Repository, fileStorage storage.Backend, logger *slog.Logger) Service {
+	return NewServiceWithApplicationCache(repo, fileStorage, nil, logger)
+}
+
+// NewServiceWithApplicationCache constructs a Service that also drops the deleted
+// applications from the application read cache.
+func NewServiceWithApplicationCache(repo Repository, fileStorage storage.Backend, applicationCache ApplicationCache, logger *slog.Logger) Service {
+	return &service{
+		repo:             repo,
+		fileStorage:      fileStorage,
+		applicationCache: applicationCache,
+		logger:           logger,
+	}
+}
+
+func (s *service) ExportUserData(ctx context.Context, userID uuid.UUID) (*ExportBundle, error) {
+	if userID == uuid.Nil {
+		return nil, NewDomainError(ErrCodeInvalidPayload, ErrEmptyUserID)
+	}
+
+	bundle, err := s.repo.ExportUserData(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	bundle.ExportedAt = time.Now().UTC()
+
+	return bundle, nil
+}
+
+func (s *service) DeleteUserData(ctx context.Context, userID uuid.UUID) (*DeletionSummary, error) {
+	if userID == uuid.Nil {
+		return nil, NewDomainError(ErrCodeInvalidPayload, ErrEmptyUserID)
+	}
+
+	summary, cleanup, err := s.repo.DeleteUserData(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.applicationCache != nil {
+		s.applicationCache.Invalidate(ctx, cleanup.ApplicationIDs...)
+	}
+
+	if s.fileStorage != nil {
+		for _, filePath := range cleanup.FilePaths {
+			// The records are already gone; don't fail the request over leftover files
+			if err := s.fileStorage.Delete(ctx, filePath); err != nil {
+				s.logger.ErrorContext(ctx, "failed to delete resume file after account deletion", "user_id", userID.String(), "key", filePath, "error", err)
+				continue
+			}
+			summary.FilesDeleted++
+		}
+	}
+
+	s.logger.InfoContext(ctx, "account data deleted",
+		"user_id", userID.String(),
+		"job_applications", summary.JobApplications,
+		"resumes", summary.Resumes,
+		"generation_jobs", summary.GenerationJobs,
+		"files_deleted", summary.FilesDeleted,
+	)
+
+	return summary, nil
+}
@@ -0,0 +1,45 @@
+package account
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeDeleteRepo reports a fixed set of deleted applications.
+type fakeDeleteRepo struct {
+	Repository
+	applicationIDs []uuid.UUID
+}
+
+func (r *fakeDeleteRepo) DeleteUserData(context.Context, uuid.UUID) (*DeletionSummary, *DeletionCleanup, error) {
+	summary := &DeletionSummary{JobApplications: int64(len(r.applicationIDs))}
+	return summary, &DeletionCleanup{ApplicationIDs: r.applicationIDs}, nil
+}
+
+// fakeApplicationCache records the applications it was asked to drop.
+type fakeApplicationCache struct {
+	invalidated []uuid.UUID
+}
+
+func (c *fakeApplicationCache) Invalidate(_ context.Context, applicationIDs ...uuid.UUID) {
+	c.invalidated = append(c.invalidated, applicationIDs...)
+}
+
+func TestService_DeleteUserData_InvalidatesApplicationCache(t *testing.T) {
+	repo := &fakeDeleteRepo{applicationIDs: []uuid.UUID{uuid.New(), uuid.New()}}
+	cache := &fakeApplicationCache{}
+	svc := NewServiceWithApplicationCache(repo, nil, cache, slog.Default())
+
+	summary, err := svc.DeleteUserData(context.Background(), uuid.New())
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), summary.JobApplications)
+	assert.Equal(t, repo.applicationIDs, cache.invalidated)
+
+	_, err = NewService(repo, nil, slog.Default()).DeleteUserData(context.Background(), uuid.New())
+	assert.NoError(t, err, "the cache is optional")
+}
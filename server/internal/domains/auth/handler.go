@@ -0,0 +1,110 @@
+package auth
+
+import (
+	"log/slog"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	authPkg "woragis-jobs-service/pkg/auth"
+	"woragis-jobs-service/pkg/response"
+)
+
+// Handler exposes token introspection endpoints.
+type Handler interface {
+	GetCurrentClaims(c *fiber.Ctx) error
+}
+
+type handler struct {
+	jwtManager *authPkg.JWTManager
+	logger     *slog.Logger
+}
+
+// NewHandler constructs an auth handler.
+func NewHandler(jwtManager *authPkg.JWTManager, logger *slog.Logger) Handler {
+	return &handler{
+		jwtManager: jwtManager,
+		logger:     logger,
+	}
+}
+
+// GetCurrentClaims decodes the bearer token of the current request and returns
+// its claims together with the remaining validity. Revoked tokens yield 401.
+func (h *handler) GetCurrentClaims(c *fiber.Ctx) error {
+	if h.jwtManager == nil {
+		return response.Error(c, fiber.StatusServiceUnavailable, 503, fiber.Map{
+			"message": "token validation is not configured",
+		})
+	}
+
+	token, err := authPkg.ExtractTokenFromHeader(c.Get(fiber.HeaderAuthorization))
+	if err != nil {
+		return unauthorized(c, "authentication required")
+	}
+
+	// Validate also consults the token blacklist, so logged-out tokens fail here
+	claims, err := h.jwtManager.Validate(token)
+	if err != nil {
+		if err == authPkg.ErrTokenExpired {
+			return unauthorized(c, "token has expired")
+		}
+		return unauthorized(c, "invalid token")
+	}
+
+	revoked, err := h.jwtManager.IsUserTokenRevoked(claims.UserID)
+	if err != nil {
+		h.logger.WarnContext(c.UserContext(), "failed to check user token revocation", slog.String("userId", claims.UserID.String()), slog.Any("error", err))
+	} else if revoked {
+		return unauthorized(c, "token has been revoked")
+	}
+
+	return response.Success(c, fiber.StatusOK, newClaimsView(claims, time.Now()))
+}
+
+type claimsView struct {
+	UserID           string     `json:"userId"`
+	Email            string     `json:"email"`
+	Role             string     `json:"role,omitempty"`
+	Name             string     `json:"name,omitempty"`
+	Issuer           string     `json:"issuer,omitempty"`
+	Subject          string     `json:"subject,omitempty"`
+	IssuedAt         *time.Time `json:"issuedAt,omitempty"`
+	NotBefore        *time.Time `json:"notBefore,omitempty"`
+	ExpiresAt        *time.Time `json:"expiresAt,omitempty"`
+	ExpiresInSeconds *int64     `json:"expiresInSeconds,omitempty"`
+}
+
+func newClaimsView(claims *authPkg.Claims, now time.Time) claimsView {
+	view := claimsView{
+		UserID:  claims.UserID.String(),
+		Email:   claims.Email,
+		Role:    claims.Role,
+		Name:    claims.Name,
+		Issuer:  claims.Issuer,
+		Subject: claims.Subject,
+	}
+	if claims.IssuedAt != nil {
+		issuedAt := claims.IssuedAt.Time
+		view.IssuedAt = &issuedAt
+	}
+	if claims.NotBefore != nil {
+		notBefore := claims.NotBefore.Time
+		view.NotBefore = &notBefore
+	}
+	if claims.ExpiresAt != nil {
+		expiresAt := claims.ExpiresAt.Time
+		remaining := int64(expiresAt.Sub(now).Seconds())
+		if remaining < 0 {
+			remaining = 0
+		}
+		view.ExpiresAt = &expiresAt
+		view.ExpiresInSeconds = &remaining
+	}
+	return view
+}
+
+func unauthorized(c *fiber.Ctx, message string) error {
+	return response.Error(c, fiber.StatusUnauthorized, 401, fiber.Map{
+		"message": message,
+	})
+}
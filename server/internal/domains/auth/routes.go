@@ -0,0 +1,8 @@
+package auth
+
+import "github.com/gofiber/fiber/v2"
+
+// SetupRoutes registers token introspection endpoints.
+func SetupRoutes(router fiber.Router, handler Handler) {
+	router.Get("/me", handler.GetCurrentClaims)
+}
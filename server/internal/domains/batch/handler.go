@@ -0,0 +1,179 @@
+package batch
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"path"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/valyala/fasthttp"
+
+	"woragis-jobs-service/pkg/response"
+)
+
+// allowedMethods are the HTTP methods an operation may use.
+var allowedMethods = map[string]bool{
+	fiber.MethodGet:    true,
+	fiber.MethodPost:   true,
+	fiber.MethodPut:    true,
+	fiber.MethodPatch:  true,
+	fiber.MethodDelete: true,
+}
+
+// Operation is one request inside a batch. Path is relative to the API root, e.g.
+// "/job-applications/<id>/status", and may carry a query string.
+type Operation struct {
+	Method string          `json:"method"`
+	Path   string          `json:"path"`
+	Body   json.RawMessage `json:"body,omitempty"`
+}
+
+// Result is the outcome of one operation, in the order the operations were sent.
+// Body is the operation's JSON response; other response types are left out.
+type Result struct {
+	Method  string          `json:"method"`
+	Path    string          `json:"path"`
+	Status  int             `json:"status,omitempty"`
+	Body    json.RawMessage `json:"body,omitempty"`
+	Skipped bool            `json:"skipped,omitempty"` // Not run: an earlier operation failed authentication or the batch timed out
+}
+
+type batchPayload struct {
+	Operations []Operation `json:"operations"`
+}
+
+// Handler exposes the batch endpoint.
+type Handler interface {
+	Execute(c *fiber.Ctx) error
+}
+
+type handler struct {
+	maxOperations int
+	logger        *slog.Logger
+}
+
+// NewHandler constructs a batch handler accepting at most maxOperations operations per batch.
+func NewHandler(maxOperations int, logger *slog.Logger) Handler {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &handler{
+		maxOperations: maxOperations,
+		logger:        logger,
+	}
+}
+
+// Execute runs the operations one after another. Each operation is dispatched through
+// the application with the batch request's headers, so it is authenticated, rate limited
+// and validated exactly like a direct call to its endpoint. Operations that fail do not
+// stop the batch, except for a 401: the credentials are shared, so the remaining
+// operations are skipped.
+func (h *handler) Execute(c *fiber.Ctx) error {
+	var payload batchPayload
+	if err := c.BodyParser(&payload); err != nil {
+		return response.Error(c, fiber.StatusBadRequest, 400, fiber.Map{
+			"message": "invalid request payload",
+		})
+	}
+	if err := validateOperations(payload.Operations, h.maxOperations); err != nil {
+		return response.Error(c, fiber.StatusBadRequest, 400, fiber.Map{
+			"message": err.Error(),
+		})
+	}
+
+	// Operation paths are relative to the router the batch endpoint is mounted on
+	apiPrefix := strings.TrimSuffix(path.Dir(c.Route().Path), "/")
+	// Server().Handler serves requests without rebuilding the route tree, unlike App().Handler()
+	dispatch := c.App().Server().Handler
+
+	results := make([]Result, len(payload.Operations))
+	stopped := false
+	for i, operation := range payload.Operations {
+		results[i] = Result{Method: operation.Method, Path: operation.Path}
+		if stopped || c.UserContext().Err() != nil {
+			results[i].Skipped = true
+			stopped = true
+			continue
+		}
+
+		results[i].Status, results[i].Body = run(c, dispatch, apiPrefix+operation.Path, operation)
+		if results[i].Status == fiber.StatusUnauthorized {
+			h.logger.InfoContext(c.UserContext(), "batch stopped after an authentication failure",
+				"operation", i, "method", operation.Method, "path", operation.Path)
+			stopped = true
+		}
+	}
+
+	return response.Success(c, fiber.StatusOK, fiber.Map{
+		"results": results,
+	})
+}
+
+// run serves one operation and returns its status and JSON body.
+func run(c *fiber.Ctx, dispatch fasthttp.RequestHandler, uri string, operation Operation) (int, json.RawMessage) {
+	var req fasthttp.Request
+	c.Request().Header.CopyTo(&req.Header)
+	req.Header.SetMethod(operation.Method)
+	req.SetRequestURI(uri)
+	req.Header.Del(fiber.HeaderContentType)
+	if body := bytes.TrimSpace(operation.Body); len(body) > 0 && !bytes.Equal(body, []byte("null")) {
+		req.Header.SetContentType(fiber.MIMEApplicationJSON)
+		req.SetBody(body)
+	} else {
+		req.SetBody(nil)
+	}
+	req.Header.SetContentLength(len(req.Body()))
+
+	var sub fasthttp.RequestCtx
+	sub.Init(&req, c.Context().RemoteAddr(), nil)
+	dispatch(&sub)
+
+	body := sub.Response.Body()
+	if !strings.HasPrefix(string(sub.Response.Header.ContentType()), fiber.MIMEApplicationJSON) || !json.Valid(body) {
+		return sub.Response.StatusCode(), nil
+	}
+	return sub.Response.StatusCode(), append(json.RawMessage(nil), body...)
+}
+
+// validateOperations checks the shape of every operation before any of them runs.
+// What an operation's body must contain is left to its endpoint.
+func validateOperations(operations []Operation, maxOperations int) error {
+	if len(operations) == 0 {
+		return fmt.Errorf("operations: at least one operation is required")
+	}
+	if len(operations) > maxOperations {
+		return fmt.Errorf("operations: at most %d operations per batch, got %d", maxOperations, len(operations))
+	}
+
+	for i := range operations {
+		operation := &operations[i]
+		operation.Method = strings.ToUpper(strings.TrimSpace(operation.Method))
+		if !allowedMethods[operation.Method] {
+			return fmt.Errorf("operations[%d].method: must be GET, POST, PUT, PATCH or DELETE", i)
+		}
+
+		operationPath, _, _ := strings.Cut(operation.Path, "?")
+		if !strings.HasPrefix(operationPath, "/") || path.Clean(operationPath) != operationPath || strings.Contains(operationPath, "#") {
+			return fmt.Errorf("operations[%d].path: must be an API path such as /job-applications", i)
+		}
+		if isReservedPath(operationPath) {
+			return fmt.Errorf("operations[%d].path: %s cannot be called from a batch", i, operationPath)
+		}
+	}
+	return nil
+}
+
+// isReservedPath reports whether the path is a batch itself or an internal worker callback.
+// Routing ignores case, so the comparison does too.
+func isReservedPath(operationPath string) bool {
+	operationPath = strings.ToLower(operationPath)
+	for _, reserved := range []string{"/batch", "/internal"} {
+		if operationPath == reserved || strings.HasPrefix(operationPath, reserved+"/") {
+			return true
+		}
+	}
+	return false
+}
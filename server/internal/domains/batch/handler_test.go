@@ -0,0 +1,121 @@
+package batch
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newBatchApp mounts a batch endpoint next to a small API guarded by a bearer token.
+func newBatchApp() *fiber.App {
+	app := fiber.New()
+	api := app.Group("/api/v1")
+	api.Use(func(c *fiber.Ctx) error {
+		if c.Get(fiber.HeaderAuthorization) != "Bearer good" {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"message": "authentication required"})
+		}
+		return c.Next()
+	})
+	api.Post("/items", func(c *fiber.Ctx) error {
+		var payload struct {
+			Name string `json:"name"`
+		}
+		if err := c.BodyParser(&payload); err != nil || payload.Name == "" {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"message": "name: required"})
+		}
+		return c.Status(fiber.StatusCreated).JSON(fiber.Map{"name": payload.Name})
+	})
+	api.Get("/items", func(c *fiber.Ctx) error {
+		return c.JSON(fiber.Map{"query": c.Query("q")})
+	})
+	api.Get("/items/export", func(c *fiber.Ctx) error {
+		return c.SendString("plain text")
+	})
+	api.Delete("/session", func(c *fiber.Ctx) error {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"message": "token revoked"})
+	})
+	SetupRoutes(api, NewHandler(5, nil), 5)
+	return app
+}
+
+func postBatch(t *testing.T, app *fiber.App, body string) (int, []Result) {
+	t.Helper()
+	req := httptest.NewRequest("POST", "/api/v1/batch", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer good")
+	resp, err := app.Test(req)
+	require.NoError(t, err)
+
+	var decoded struct {
+		Data struct {
+			Results []Result `json:"results"`
+		} `json:"data"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&decoded))
+	return resp.StatusCode, decoded.Data.Results
+}
+
+func TestExecute_RunsOperationsInOrder(t *testing.T) {
+	status, results := postBatch(t, newBatchApp(), `{"operations": [
+		{"method": "post", "path": "/items", "body": {"name": "first"}},
+		{"method": "POST", "path": "/items", "body": {}},
+		{"method": "GET", "path": "/items?q=go"},
+		{"method": "GET", "path": "/items/export"},
+		{"method": "GET", "path": "/missing"}
+	]}`)
+	require.Equal(t, fiber.StatusOK, status)
+	require.Len(t, results, 5)
+
+	assert.Equal(t, fiber.StatusCreated, results[0].Status)
+	assert.Equal(t, "POST", results[0].Method)
+	assert.JSONEq(t, `{"name":"first"}`, string(results[0].Body))
+	assert.Equal(t, fiber.StatusBadRequest, results[1].Status, "operations are validated by their endpoint")
+	assert.JSONEq(t, `{"query":"go"}`, string(results[2].Body))
+	assert.Equal(t, fiber.StatusOK, results[3].Status)
+	assert.Nil(t, results[3].Body, "non-JSON bodies are left out")
+	assert.Equal(t, fiber.StatusNotFound, results[4].Status)
+}
+
+func TestExecute_StopsAfterAuthenticationFailure(t *testing.T) {
+	_, results := postBatch(t, newBatchApp(), `{"operations": [
+		{"method": "POST", "path": "/items", "body": {"name": "kept"}},
+		{"method": "DELETE", "path": "/session"},
+		{"method": "POST", "path": "/items", "body": {"name": "skipped"}}
+	]}`)
+	require.Len(t, results, 3)
+	assert.Equal(t, fiber.StatusCreated, results[0].Status)
+	assert.Equal(t, fiber.StatusUnauthorized, results[1].Status)
+	assert.True(t, results[2].Skipped)
+	assert.Zero(t, results[2].Status)
+}
+
+func TestExecute_RejectsInvalidBatches(t *testing.T) {
+	app := newBatchApp()
+	tooMany := `{"operations": [` + strings.Repeat(`{"method": "GET", "path": "/items"},`, 5) + `{"method": "GET", "path": "/items"}]}`
+	for name, body := range map[string]string{
+		"empty":          `{"operations": []}`,
+		"too many":       tooMany,
+		"bad method":     `{"operations": [{"method": "TRACE", "path": "/items"}]}`,
+		"relative":       `{"operations": [{"method": "GET", "path": "items"}]}`,
+		"traversal":      `{"operations": [{"method": "GET", "path": "/../metrics"}]}`,
+		"nested":         `{"operations": [{"method": "POST", "path": "/batch"}]}`,
+		"internal":       `{"operations": [{"method": "POST", "path": "/internal/resumes/complete"}]}`,
+		"nested upper":   `{"operations": [{"method": "POST", "path": "/BATCH"}]}`,
+		"internal mixed": `{"operations": [{"method": "POST", "path": "/Internal/resumes/complete"}]}`,
+		"not an object":  `[]`,
+	} {
+		status, _ := postBatch(t, app, body)
+		assert.Equal(t, fiber.StatusBadRequest, status, name)
+	}
+
+	req := httptest.NewRequest("POST", "/api/v1/batch", strings.NewReader(`{"operations": [{"method": "GET", "path": "/items"}]}`))
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := app.Test(req)
+	require.NoError(t, err)
+	assert.Equal(t, fiber.StatusUnauthorized, resp.StatusCode, "the batch itself requires authentication")
+}
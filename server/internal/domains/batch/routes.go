@@ -0,0 +1,13 @@
+package batch
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	"woragis-jobs-service/pkg/security"
+)
+
+// SetupRoutes registers the batch endpoint on the API router; operation paths are
+// relative to that router. The body limit leaves room for one JSON body per operation.
+func SetupRoutes(router fiber.Router, handler Handler, maxOperations int) {
+	router.Post("/batch", security.RequestSizeLimitFor(int64(maxOperations)*security.DefaultJSONBodyLimit), handler.Execute)
+}
@@ -0,0 +1,44 @@
+package jobs
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"woragis-jobs-service/internal/domains/jobapplications"
+	"woragis-jobs-service/internal/domains/resumes"
+)
+
+// bestResumeProvider exposes resumes.Service.GetBestResume to the job applications
+// domain, which cannot import resumes directly.
+type bestResumeProvider struct {
+	service resumes.Service
+}
+
+func newBestResumeProvider(service resumes.Service) jobapplications.DefaultResumeProvider {
+	return &bestResumeProvider{service: service}
+}
+
+// GetBestResume returns nil without an error when the user has no resumes.
+func (p *bestResumeProvider) GetBestResume(ctx context.Context, userID uuid.UUID) (*jobapplications.Resume, error) {
+	resume, err := p.service.GetBestResume(ctx, userID)
+	if err != nil {
+		if domainErr, ok := resumes.AsDomainError(err); ok && domainErr.Code == resumes.ErrCodeNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &jobapplications.Resume{
+		ID:         resume.ID,
+		UserID:     resume.UserID,
+		Title:      resume.Title,
+		IsMain:     resume.IsMain,
+		IsFeatured: resume.IsFeatured,
+		FilePath:   resume.FilePath,
+		FileName:   resume.FileName,
+		FileSize:   resume.FileSize,
+		Tags:       resume.Tags,
+		CreatedAt:  resume.CreatedAt,
+		UpdatedAt:  resume.UpdatedAt,
+	}, nil
+}
@@ -0,0 +1,69 @@
+package jobapplications
+
+import (
+	"context"
+	"strings"
+)
+
+// Application methods an application can be submitted with.
+const (
+	ApplicationMethodAuto     = "auto"
+	ApplicationMethodManual   = "manual"
+	ApplicationMethodAssisted = "assisted"
+)
+
+// WebsiteApplicationMethods describes which application methods a job website supports.
+type WebsiteApplicationMethods struct {
+	Default   string   // Applied when an application is created without a method; may be empty
+	Supported []string // Empty means every method is supported
+}
+
+// Supports reports whether applications on the website may use method.
+func (m *WebsiteApplicationMethods) Supports(method string) bool {
+	if len(m.Supported) == 0 {
+		return true
+	}
+	for _, supported := range m.Supported {
+		if supported == method {
+			return true
+		}
+	}
+	return false
+}
+
+// WebsiteMethodsProvider looks up the application methods of a job website by
+// name. It returns nil without an error for websites it does not know.
+type WebsiteMethodsProvider interface {
+	GetWebsiteApplicationMethods(ctx context.Context, website string) (*WebsiteApplicationMethods, error)
+}
+
+// resolveApplicationMethod applies the website's default when method is blank and
+// rejects methods the website does not support. Without a provider, or when the
+// website is unknown or cannot be looked up, method is returned unchanged.
+func (s *service) resolveApplicationMethod(ctx context.Context, website, method string) (string, error) {
+	method = strings.ToLower(strings.TrimSpace(method))
+	if s.websiteMethods == nil || website == "" {
+		return method, nil
+	}
+
+	methods, err := s.websiteMethods.GetWebsiteApplicationMethods(ctx, website)
+	if err != nil {
+		if s.logger != nil {
+			s.logger.WarnContext(ctx, "failed to look up website application methods",
+				"website", website,
+				"error", err)
+		}
+		return method, nil
+	}
+	if methods == nil {
+		return method, nil
+	}
+
+	if method == "" {
+		return methods.Default, nil
+	}
+	if !methods.Supports(method) {
+		return "", NewDomainError(ErrCodeInvalidPayload, ErrApplicationMethodNotSupported)
+	}
+	return method, nil
+}
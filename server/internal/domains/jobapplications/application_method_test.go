@@ -0,0 +1,101 @@
+package jobapplications
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeWebsiteMethods struct {
+	websites map[string]*WebsiteApplicationMethods
+	err      error
+}
+
+func (p *fakeWebsiteMethods) GetWebsiteApplicationMethods(_ context.Context, website string) (*WebsiteApplicationMethods, error) {
+	return p.websites[website], p.err
+}
+
+// fakeCreateRepo stores created applications in memory.
+type fakeCreateRepo struct {
+	Repository
+	applications map[uuid.UUID]*JobApplication
+}
+
+func (r *fakeCreateRepo) CreateJobApplication(_ context.Context, application *JobApplication) error {
+	if r.applications == nil {
+		r.applications = make(map[uuid.UUID]*JobApplication)
+	}
+	r.applications[application.ID] = application
+	return nil
+}
+
+func (r *fakeCreateRepo) UpdateJobApplication(_ context.Context, application *JobApplication) error {
+	r.applications[application.ID] = application
+	return nil
+}
+
+func (r *fakeCreateRepo) GetJobApplication(_ context.Context, applicationID uuid.UUID) (*JobApplication, error) {
+	application, ok := r.applications[applicationID]
+	if !ok {
+		return nil, NewDomainError(ErrCodeNotFound, ErrApplicationNotFound)
+	}
+	return application, nil
+}
+
+func newMethodsTestService(provider WebsiteMethodsProvider) (Service, *fakeCreateRepo) {
+	repo := &fakeCreateRepo{}
+	return NewServiceWithWebsiteMethods(repo, nil, nil, provider, nil), repo
+}
+
+func manualOnlyWebsites() *fakeWebsiteMethods {
+	return &fakeWebsiteMethods{websites: map[string]*WebsiteApplicationMethods{
+		"glassdoor": {Default: ApplicationMethodManual, Supported: []string{ApplicationMethodManual}},
+		"linkedin":  {Default: ApplicationMethodAuto},
+	}}
+}
+
+func TestRequestJobApplication_AppliesWebsiteDefaultMethod(t *testing.T) {
+	svc, _ := newMethodsTestService(manualOnlyWebsites())
+
+	application, err := svc.RequestJobApplication(context.Background(), uuid.New(), "Acme", "", "Engineer", "https://example.com/1", "glassdoor", "")
+	require.NoError(t, err)
+	assert.Equal(t, ApplicationMethodManual, application.ApplicationMethod)
+
+	application, err = svc.RequestJobApplication(context.Background(), uuid.New(), "Acme", "", "Engineer", "https://example.com/2", "linkedin", "Assisted")
+	require.NoError(t, err)
+	assert.Equal(t, ApplicationMethodAssisted, application.ApplicationMethod, "a website without a supported list accepts every method")
+}
+
+func TestRequestJobApplication_RejectsUnsupportedMethod(t *testing.T) {
+	svc, repo := newMethodsTestService(manualOnlyWebsites())
+
+	_, err := svc.RequestJobApplication(context.Background(), uuid.New(), "Acme", "", "Engineer", "https://example.com/1", "glassdoor", ApplicationMethodAuto)
+	assert.EqualError(t, err, ErrApplicationMethodNotSupported)
+	assert.Empty(t, repo.applications)
+}
+
+func TestRequestJobApplication_UnknownOrUnavailableWebsiteKeepsMethod(t *testing.T) {
+	svc, _ := newMethodsTestService(manualOnlyWebsites())
+	application, err := svc.RequestJobApplication(context.Background(), uuid.New(), "Acme", "", "Engineer", "https://example.com/1", "indeed", ApplicationMethodAuto)
+	require.NoError(t, err)
+	assert.Equal(t, ApplicationMethodAuto, application.ApplicationMethod)
+
+	svc, _ = newMethodsTestService(&fakeWebsiteMethods{err: errors.New("database down")})
+	application, err = svc.RequestJobApplication(context.Background(), uuid.New(), "Acme", "", "Engineer", "https://example.com/2", "glassdoor", ApplicationMethodAuto)
+	require.NoError(t, err, "a failed lookup never blocks creation")
+	assert.Equal(t, ApplicationMethodAuto, application.ApplicationMethod)
+}
+
+func TestUpdateJobApplication_RejectsUnsupportedMethod(t *testing.T) {
+	svc, _ := newMethodsTestService(manualOnlyWebsites())
+	application, err := svc.RequestJobApplication(context.Background(), uuid.New(), "Acme", "", "Engineer", "https://example.com/1", "glassdoor", "")
+	require.NoError(t, err)
+
+	auto := ApplicationMethodAuto
+	_, err = svc.UpdateJobApplication(context.Background(), application.ID, UpdateJobApplicationRequest{ApplicationMethod: &auto})
+	assert.EqualError(t, err, ErrApplicationMethodNotSupported)
+}
@@ -0,0 +1,70 @@
+package jobapplications
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeBatchGetRepo returns the stored applications owned by the user, in storage order.
+type fakeBatchGetRepo struct {
+	Repository
+	applications []JobApplication
+	requested    []uuid.UUID
+}
+
+func (r *fakeBatchGetRepo) GetJobApplicationsByIDs(_ context.Context, userID uuid.UUID, applicationIDs []uuid.UUID) ([]JobApplication, error) {
+	r.requested = applicationIDs
+	wanted := make(map[uuid.UUID]bool, len(applicationIDs))
+	for _, id := range applicationIDs {
+		wanted[id] = true
+	}
+	var result []JobApplication
+	for _, application := range r.applications {
+		if application.UserID == userID && wanted[application.ID] {
+			result = append(result, application)
+		}
+	}
+	return result, nil
+}
+
+func TestValidateBatchGetPayload_Caps(t *testing.T) {
+	_, err := ValidateBatchGetPayload(&batchGetPayload{})
+	assert.ErrorContains(t, err, "at least one")
+
+	ids := make([]string, maxBatchGetApplications)
+	for i := range ids {
+		ids[i] = uuid.NewString()
+	}
+	parsed, err := ValidateBatchGetPayload(&batchGetPayload{IDs: ids})
+	require.NoError(t, err)
+	assert.Len(t, parsed, maxBatchGetApplications)
+
+	_, err = ValidateBatchGetPayload(&batchGetPayload{IDs: append(ids, uuid.NewString())})
+	assert.ErrorContains(t, err, "too many")
+}
+
+func TestValidateBatchGetPayload_RejectsInvalidID(t *testing.T) {
+	_, err := ValidateBatchGetPayload(&batchGetPayload{IDs: []string{uuid.NewString(), "not-a-uuid"}})
+	assert.ErrorContains(t, err, "ids[1]")
+}
+
+func TestBatchGetJobApplications_OrdersAndOmits(t *testing.T) {
+	userID := uuid.New()
+	first := JobApplication{ID: uuid.New(), UserID: userID}
+	second := JobApplication{ID: uuid.New(), UserID: userID}
+	foreign := JobApplication{ID: uuid.New(), UserID: uuid.New()}
+	repo := &fakeBatchGetRepo{applications: []JobApplication{first, second, foreign}}
+	svc := NewService(repo, nil, nil)
+
+	missing := uuid.New()
+	result, err := svc.BatchGetJobApplications(context.Background(), userID,
+		[]uuid.UUID{second.ID, missing, foreign.ID, first.ID, second.ID})
+	require.NoError(t, err)
+
+	assert.Equal(t, []JobApplication{second, first}, result, "request order, unknown and foreign ids omitted")
+	assert.Equal(t, []uuid.UUID{second.ID, missing, foreign.ID, first.ID}, repo.requested, "duplicates are dropped before querying")
+}
@@ -0,0 +1,112 @@
+package jobapplications
+
+import (
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"woragis-jobs-service/pkg/middleware"
+	"woragis-jobs-service/pkg/response"
+)
+
+// defaultBoardLimitPerStatus is how many applications each board column holds when
+// ?limitPerStatus= is omitted. Larger values are clamped to the pagination maximum.
+const defaultBoardLimitPerStatus = 20
+
+// BoardStatuses are the board's columns, in display order.
+var BoardStatuses = []ApplicationStatus{
+	ApplicationStatusPending,
+	ApplicationStatusProcessing,
+	ApplicationStatusApplied,
+	ApplicationStatusContacted,
+	ApplicationStatusReopened,
+	ApplicationStatusAccepted,
+	ApplicationStatusRejected,
+	ApplicationStatusFailed,
+}
+
+// BoardColumn is the first page of applications with one status.
+type BoardColumn struct {
+	Applications []JobApplication `json:"applications"`
+	// HasMore is true when the column holds more applications than were returned;
+	// the rest are listed with GET /job-applications?status=
+	HasMore bool `json:"hasMore"`
+}
+
+// Board groups a user's applications by status, as on a kanban board.
+type Board struct {
+	Statuses       []ApplicationStatus               `json:"statuses"` // Column order
+	Columns        map[ApplicationStatus]BoardColumn `json:"columns"`
+	LimitPerStatus int                               `json:"limitPerStatus"`
+}
+
+// NewBoard returns a board with an empty column per status.
+func NewBoard(limitPerStatus int) *Board {
+	board := &Board{
+		Statuses:       BoardStatuses,
+		Columns:        make(map[ApplicationStatus]BoardColumn, len(BoardStatuses)),
+		LimitPerStatus: limitPerStatus,
+	}
+	for _, status := range BoardStatuses {
+		board.Columns[status] = BoardColumn{Applications: []JobApplication{}}
+	}
+	return board
+}
+
+// SetColumn fills the status column from a listing fetched with a limit of
+// LimitPerStatus+1, so the extra application only signals that more exist.
+func (b *Board) SetColumn(status ApplicationStatus, applications []JobApplication) {
+	column := BoardColumn{Applications: applications}
+	if len(applications) > b.LimitPerStatus {
+		column.Applications = applications[:b.LimitPerStatus]
+		column.HasMore = true
+	}
+	if column.Applications == nil {
+		column.Applications = []JobApplication{}
+	}
+	b.Columns[status] = column
+}
+
+// GetBoard returns the user's applications grouped by status in one response. The
+// website and search filters apply to every column, and snoozed applications are
+// hidden unless includeSnoozed=true, as in the list endpoint.
+func (h *handler) GetBoard(c *fiber.Ctx) error {
+	userID, err := middleware.GetUserIDFromFiberContext(c)
+	if err != nil {
+		return response.Error(c, fiber.StatusUnauthorized, 401, fiber.Map{
+			"message": "authentication required",
+		})
+	}
+
+	website := c.Query("website")
+	search := strings.TrimSpace(c.Query("search"))
+	includeSnoozed := c.Query("includeSnoozed")
+
+	limitPerStatus, err := ValidateBoardQueryParams(website, search, c.Query("limitPerStatus"), includeSnoozed)
+	if err != nil {
+		return response.Error(c, fiber.StatusBadRequest, ErrCodeInvalidPayload, fiber.Map{
+			"message": err.Error(),
+		})
+	}
+
+	filters := JobApplicationFilters{UserID: &userID}
+	if website != "" {
+		normalizedWebsite := strings.ToLower(strings.TrimSpace(website))
+		filters.Website = &normalizedWebsite
+	}
+	if search != "" {
+		filters.Search = &search
+	}
+	if includeSnoozed != "true" {
+		now := time.Now().UTC()
+		filters.SnoozedAt = &now
+	}
+
+	board, err := h.service.GetBoard(c.Context(), filters, limitPerStatus)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return response.Success(c, fiber.StatusOK, board)
+}
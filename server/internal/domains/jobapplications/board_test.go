@@ -0,0 +1,90 @@
+package jobapplications
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"woragis-jobs-service/pkg/pagination"
+)
+
+// fakeBoardRepo lists stored applications by status and records the filters it was given.
+type fakeBoardRepo struct {
+	Repository
+	applications []JobApplication
+	filters      []JobApplicationFilters
+}
+
+func (r *fakeBoardRepo) ListJobApplications(_ context.Context, filters JobApplicationFilters) ([]JobApplication, error) {
+	r.filters = append(r.filters, filters)
+	var result []JobApplication
+	for _, application := range r.applications {
+		if application.Status == *filters.Status && len(result) < filters.Limit {
+			result = append(result, application)
+		}
+	}
+	return result, nil
+}
+
+func TestGetBoard_GroupsByStatus(t *testing.T) {
+	repo := &fakeBoardRepo{applications: []JobApplication{
+		{ID: uuid.New(), Status: ApplicationStatusApplied},
+		{ID: uuid.New(), Status: ApplicationStatusApplied},
+		{ID: uuid.New(), Status: ApplicationStatusApplied},
+		{ID: uuid.New(), Status: ApplicationStatusRejected},
+	}}
+	svc := NewService(repo, nil, nil)
+
+	search := "acme"
+	board, err := svc.GetBoard(context.Background(), JobApplicationFilters{Search: &search, Limit: 5, Offset: 10}, 2)
+	require.NoError(t, err)
+
+	require.Len(t, board.Columns, len(BoardStatuses))
+	assert.Len(t, board.Columns[ApplicationStatusApplied].Applications, 2)
+	assert.True(t, board.Columns[ApplicationStatusApplied].HasMore)
+	assert.Len(t, board.Columns[ApplicationStatusRejected].Applications, 1)
+	assert.False(t, board.Columns[ApplicationStatusRejected].HasMore)
+	assert.NotNil(t, board.Columns[ApplicationStatusPending].Applications, "empty columns serialize as []")
+
+	require.Len(t, repo.filters, len(BoardStatuses))
+	for i, filters := range repo.filters {
+		assert.Equal(t, BoardStatuses[i], *filters.Status)
+		assert.Equal(t, &search, filters.Search, "filters apply to every column")
+		assert.Equal(t, 3, filters.Limit)
+		assert.Zero(t, filters.Offset)
+	}
+}
+
+func TestValidateBoardQueryParams(t *testing.T) {
+	limit, err := ValidateBoardQueryParams("", "", "", "")
+	require.NoError(t, err)
+	assert.Equal(t, defaultBoardLimitPerStatus, limit)
+
+	limit, err = ValidateBoardQueryParams("linkedin", "go", "100000", "true")
+	require.NoError(t, err)
+	assert.Equal(t, pagination.CurrentLimits().Max, limit, "clamped to the pagination maximum")
+
+	_, err = ValidateBoardQueryParams("", "", "0", "")
+	assert.ErrorContains(t, err, "limitPerStatus")
+	_, err = ValidateBoardQueryParams("", "", "", "maybe")
+	assert.ErrorContains(t, err, "includeSnoozed")
+}
+
+func TestGetBoard_InvalidLimitPerStatus(t *testing.T) {
+	app := fiber.New()
+	app.Use(func(c *fiber.Ctx) error {
+		c.Locals("userID", uuid.New())
+		return c.Next()
+	})
+	h := NewHandler(nil, nil)
+	app.Get("/job-applications/board", h.GetBoard)
+
+	resp, err := app.Test(httptest.NewRequest("GET", "/job-applications/board?limitPerStatus=abc", nil))
+	require.NoError(t, err)
+	assert.Equal(t, fiber.StatusBadRequest, resp.StatusCode)
+}
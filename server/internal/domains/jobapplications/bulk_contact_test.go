@@ -0,0 +1,110 @@
+package jobapplications
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"woragis-jobs-service/internal/domains/jobapplications/responses"
+)
+
+func TestRecordContact_RespectsStatus(t *testing.T) {
+	respondedAt := time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)
+
+	for _, status := range []ApplicationStatus{ApplicationStatusApplied, ApplicationStatusReopened} {
+		application := &JobApplication{Status: status}
+		require.NoError(t, application.RecordContact(respondedAt), status)
+		assert.Equal(t, ApplicationStatusContacted, application.Status)
+		require.NotNil(t, application.ResponseReceivedAt)
+		assert.Equal(t, respondedAt, *application.ResponseReceivedAt)
+	}
+
+	for _, status := range []ApplicationStatus{ApplicationStatusPending, ApplicationStatusProcessing, ApplicationStatusContacted, ApplicationStatusRejected} {
+		application := &JobApplication{Status: status}
+		domainErr, ok := AsDomainError(application.RecordContact(respondedAt))
+		require.True(t, ok, status)
+		assert.Equal(t, ErrCodeInvalidTransition, domainErr.Code)
+		assert.Equal(t, status, application.Status)
+	}
+}
+
+func TestRecordContact_KeepsFirstResponseDate(t *testing.T) {
+	first := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+	application := &JobApplication{Status: ApplicationStatusReopened, ResponseReceivedAt: &first}
+	require.NoError(t, application.RecordContact(first.AddDate(0, 1, 0)))
+	assert.Equal(t, first, *application.ResponseReceivedAt)
+}
+
+func TestValidateBulkContactPayload(t *testing.T) {
+	id := uuid.New()
+	ids, contact, err := ValidateBulkContactPayload(&bulkContactPayload{
+		ApplicationIDs: []string{id.String(), id.String()},
+		Message:        "  Thanks, let's talk next week  ",
+		ResponseDate:   "2026-03-02T10:00:00Z",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{id}, ids)
+	assert.Equal(t, "Thanks, let's talk next week", contact.Message)
+	assert.Equal(t, time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC), contact.ResponseDate)
+
+	tooMany := make([]string, maxBulkContactApplications+1)
+	for i := range tooMany {
+		tooMany[i] = uuid.NewString()
+	}
+	for name, payload := range map[string]bulkContactPayload{
+		"no ids":        {Message: "hi"},
+		"too many ids":  {ApplicationIDs: tooMany, Message: "hi"},
+		"bad id":        {ApplicationIDs: []string{"nope"}, Message: "hi"},
+		"empty message": {ApplicationIDs: []string{id.String()}, Message: "   "},
+		"long channel":  {ApplicationIDs: []string{id.String()}, Message: "hi", ResponseChannel: strings.Repeat("x", 51)},
+		"bad date":      {ApplicationIDs: []string{id.String()}, Message: "hi", ResponseDate: "yesterday"},
+		"future date":   {ApplicationIDs: []string{id.String()}, Message: "hi", ResponseDate: time.Now().Add(time.Hour).Format(time.RFC3339)},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, _, err := ValidateBulkContactPayload(&payload)
+			assert.Error(t, err)
+		})
+	}
+}
+
+// fakeContactRepo records the arguments of a bulk contact.
+type fakeContactRepo struct {
+	Repository
+	applicationIDs []uuid.UUID
+	response       responses.Response
+}
+
+func (r *fakeContactRepo) FilterOwnedIDs(_ context.Context, _ uuid.UUID, applicationIDs []uuid.UUID) ([]uuid.UUID, error) {
+	return applicationIDs, nil
+}
+
+func (r *fakeContactRepo) ContactApplications(_ context.Context, _ uuid.UUID, applicationIDs []uuid.UUID, response responses.Response) (*BulkContactResult, error) {
+	r.applicationIDs = applicationIDs
+	r.response = response
+	return &BulkContactResult{Requested: len(applicationIDs), Contacted: applicationIDs, Skipped: []uuid.UUID{}}, nil
+}
+
+func TestServiceContactApplications_RecordsInterviewResponse(t *testing.T) {
+	repo := &fakeContactRepo{}
+	svc := NewService(repo, nil, nil)
+	id := uuid.New()
+	respondedAt := time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)
+
+	result, err := svc.ContactApplications(context.Background(), uuid.New(), []uuid.UUID{id, id}, BulkContact{
+		Message:         "Let's schedule a call",
+		ResponseChannel: "email",
+		ResponseDate:    respondedAt,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{id}, result.Contacted)
+	assert.Equal(t, []uuid.UUID{id}, repo.applicationIDs)
+	assert.Equal(t, responses.ResponseTypeInterview, repo.response.ResponseType)
+	assert.Equal(t, respondedAt, repo.response.ResponseDate)
+	assert.Equal(t, "Let's schedule a call", repo.response.Message)
+	assert.Equal(t, "email", repo.response.ResponseChannel)
+}
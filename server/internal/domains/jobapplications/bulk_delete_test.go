@@ -0,0 +1,102 @@
+package jobapplications
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeBulkDeleteRepo records the filter it was called with and reports deleted as removed.
+type fakeBulkDeleteRepo struct {
+	Repository
+	calls   int
+	filter  BulkDeleteFilter
+	deleted []uuid.UUID
+}
+
+func (r *fakeBulkDeleteRepo) DeleteJobApplicationsByFilter(_ context.Context, _ uuid.UUID, filter BulkDeleteFilter) ([]uuid.UUID, error) {
+	r.calls++
+	r.filter = filter
+	return r.deleted, nil
+}
+
+type fakeChatsRepo struct {
+	unlinked []uuid.UUID
+}
+
+func (r *fakeChatsRepo) UnlinkFromJobApplication(_ context.Context, jobApplicationID uuid.UUID) error {
+	r.unlinked = append(r.unlinked, jobApplicationID)
+	return nil
+}
+
+func TestValidateBulkDeleteParams(t *testing.T) {
+	filter, err := ValidateBulkDeleteParams(" Rejected ", " LinkedIn ", "2024-03-01", "2024-02-01T10:00:00-03:00")
+	require.NoError(t, err)
+	require.NotNil(t, filter.Status)
+	assert.Equal(t, ApplicationStatus("rejected"), *filter.Status)
+	require.NotNil(t, filter.Website)
+	assert.Equal(t, "linkedin", *filter.Website)
+	require.NotNil(t, filter.AppliedBefore)
+	assert.Equal(t, time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC), *filter.AppliedBefore)
+	require.NotNil(t, filter.CreatedBefore)
+	assert.Equal(t, time.Date(2024, time.February, 1, 13, 0, 0, 0, time.UTC), *filter.CreatedBefore)
+
+	filter, err = ValidateBulkDeleteParams("", "", "", "2024-01-01")
+	require.NoError(t, err)
+	assert.Nil(t, filter.Status)
+	assert.Nil(t, filter.Website)
+	assert.Nil(t, filter.AppliedBefore)
+	assert.NotNil(t, filter.CreatedBefore)
+}
+
+func TestValidateBulkDeleteParams_RequiresAFilter(t *testing.T) {
+	_, err := ValidateBulkDeleteParams("", "", "", "")
+	assert.ErrorContains(t, err, "at least one filter is required")
+}
+
+func TestValidateBulkDeleteParams_RejectsInvalidValues(t *testing.T) {
+	_, err := ValidateBulkDeleteParams("maybe", "", "", "")
+	assert.ErrorContains(t, err, "status")
+
+	_, err = ValidateBulkDeleteParams("", "", "01/03/2024", "")
+	assert.ErrorContains(t, err, "appliedBefore")
+
+	_, err = ValidateBulkDeleteParams("", "", "", "yesterday")
+	assert.ErrorContains(t, err, "createdBefore")
+}
+
+func TestBulkDeleteFilter_IsEmpty(t *testing.T) {
+	assert.True(t, BulkDeleteFilter{}.IsEmpty())
+
+	website := "linkedin"
+	assert.False(t, BulkDeleteFilter{Website: &website}.IsEmpty())
+}
+
+func TestDeleteJobApplicationsByFilter_RejectsEmptyFilter(t *testing.T) {
+	repo := &fakeBulkDeleteRepo{}
+	svc := NewService(repo, nil, nil)
+
+	_, err := svc.DeleteJobApplicationsByFilter(context.Background(), uuid.New(), BulkDeleteFilter{})
+
+	assert.EqualError(t, err, ErrEmptyBulkDeleteFilter)
+	assert.Zero(t, repo.calls, "an empty filter must never reach the repository")
+}
+
+func TestDeleteJobApplicationsByFilter_UnlinksConversations(t *testing.T) {
+	deleted := []uuid.UUID{uuid.New(), uuid.New()}
+	repo := &fakeBulkDeleteRepo{deleted: deleted}
+	chats := &fakeChatsRepo{}
+	svc := NewServiceWithChats(repo, nil, chats, nil)
+
+	status := ApplicationStatus("rejected")
+	result, err := svc.DeleteJobApplicationsByFilter(context.Background(), uuid.New(), BulkDeleteFilter{Status: &status})
+
+	require.NoError(t, err)
+	assert.Equal(t, deleted, result)
+	assert.Equal(t, &status, repo.filter.Status)
+	assert.Equal(t, deleted, chats.unlinked)
+}
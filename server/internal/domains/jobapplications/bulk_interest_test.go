@@ -0,0 +1,112 @@
+package jobapplications
+
+import (
+	"context"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateBulkInterestPayload(t *testing.T) {
+	id := uuid.New()
+	ids, level, err := ValidateBulkInterestPayload(&bulkInterestPayload{
+		ApplicationIDs: []string{id.String()},
+		InterestLevel:  " Low ",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{id}, ids)
+	assert.Equal(t, InterestLevelLow, level)
+
+	tooMany := make([]string, maxBulkInterestApplications+1)
+	for i := range tooMany {
+		tooMany[i] = uuid.NewString()
+	}
+	for name, payload := range map[string]bulkInterestPayload{
+		"no ids":        {InterestLevel: "low"},
+		"too many ids":  {ApplicationIDs: tooMany, InterestLevel: "low"},
+		"bad id":        {ApplicationIDs: []string{"nope"}, InterestLevel: "low"},
+		"missing level": {ApplicationIDs: []string{id.String()}},
+		"unknown level": {ApplicationIDs: []string{id.String()}, InterestLevel: "urgent"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, _, err := ValidateBulkInterestPayload(&payload)
+			assert.Error(t, err)
+		})
+	}
+}
+
+// fakeInterestRepo records the arguments of a bulk interest update. IDs in foreign are
+// treated as belonging to another user.
+type fakeInterestRepo struct {
+	Repository
+	foreign        map[uuid.UUID]bool
+	applicationIDs []uuid.UUID
+	level          InterestLevel
+}
+
+func (r *fakeInterestRepo) FilterOwnedIDs(_ context.Context, _ uuid.UUID, applicationIDs []uuid.UUID) ([]uuid.UUID, error) {
+	owned := []uuid.UUID{}
+	for _, id := range applicationIDs {
+		if !r.foreign[id] {
+			owned = append(owned, id)
+		}
+	}
+	return owned, nil
+}
+
+func (r *fakeInterestRepo) SetInterestLevel(_ context.Context, _ uuid.UUID, applicationIDs []uuid.UUID, level InterestLevel) (*BulkInterestResult, error) {
+	r.applicationIDs = applicationIDs
+	r.level = level
+	return &BulkInterestResult{Requested: len(applicationIDs), Updated: len(applicationIDs), Skipped: []uuid.UUID{}}, nil
+}
+
+func TestBulkSetInterestLevel(t *testing.T) {
+	repo := &fakeInterestRepo{}
+	app := fiber.New()
+	app.Use(func(c *fiber.Ctx) error {
+		c.Locals("userID", uuid.New())
+		return c.Next()
+	})
+	app.Post("/job-applications/bulk-interest", NewHandler(NewService(repo, nil, nil), nil).BulkSetInterestLevel)
+
+	post := func(body string) int {
+		req := httptest.NewRequest("POST", "/job-applications/bulk-interest", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		return resp.StatusCode
+	}
+
+	id := uuid.New()
+	assert.Equal(t, fiber.StatusOK, post(`{"applicationIds":["`+id.String()+`","`+id.String()+`"],"interestLevel":"low"}`))
+	assert.Equal(t, []uuid.UUID{id}, repo.applicationIDs, "duplicate ids are updated once")
+	assert.Equal(t, InterestLevelLow, repo.level)
+
+	assert.Equal(t, fiber.StatusUnprocessableEntity, post(`{"applicationIds":["`+id.String()+`"],"interestLevel":"urgent"}`))
+	assert.Equal(t, fiber.StatusBadRequest, post(`{"applicationIds":[],"interestLevel":"low"}`))
+}
+
+func TestServiceSetInterestLevel_SkipsForeignApplications(t *testing.T) {
+	owned, foreign := uuid.New(), uuid.New()
+	repo := &fakeInterestRepo{foreign: map[uuid.UUID]bool{foreign: true}}
+	svc := NewService(repo, nil, nil)
+
+	result, err := svc.SetInterestLevel(context.Background(), uuid.New(), []uuid.UUID{foreign, owned, foreign}, InterestLevelHigh)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{owned}, repo.applicationIDs, "only owned ids reach the repository")
+	assert.Equal(t, 2, result.Requested)
+	assert.Equal(t, 1, result.Updated)
+	assert.Equal(t, []uuid.UUID{foreign}, result.Skipped)
+
+	repo.applicationIDs = nil
+	result, err = svc.SetInterestLevel(context.Background(), uuid.New(), []uuid.UUID{foreign}, InterestLevelHigh)
+	require.NoError(t, err)
+	assert.Nil(t, repo.applicationIDs, "nothing to update when no id is owned")
+	assert.Equal(t, 1, result.Requested)
+	assert.Equal(t, []uuid.UUID{foreign}, result.Skipped)
+}
@@ -0,0 +1,55 @@
+package jobapplications
+
+import (
+	"context"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeByCompanyRepository records the company name the service looks up.
+type fakeByCompanyRepository struct {
+	Repository
+	companyName string
+}
+
+func (r *fakeByCompanyRepository) ListJobApplicationsByCompany(_ context.Context, _ uuid.UUID, companyName string, _, _ int) ([]JobApplication, error) {
+	r.companyName = companyName
+	return []JobApplication{{CompanyName: "Acme"}}, nil
+}
+
+func TestListJobApplicationsByCompany_TrimsName(t *testing.T) {
+	repo := &fakeByCompanyRepository{}
+	svc := NewService(repo, nil, nil)
+
+	applications, err := svc.ListJobApplicationsByCompany(context.Background(), uuid.New(), "  acme ", 50, 0)
+	require.NoError(t, err)
+	assert.Len(t, applications, 1)
+	assert.Equal(t, "acme", repo.companyName)
+}
+
+func TestListJobApplicationsByCompany_Handler(t *testing.T) {
+	repo := &fakeByCompanyRepository{}
+	app := fiber.New()
+	app.Use(func(c *fiber.Ctx) error {
+		c.Locals("userID", uuid.New())
+		return c.Next()
+	})
+	app.Get("/job-applications/by-company", NewHandler(NewService(repo, nil, nil), nil).ListJobApplicationsByCompany)
+
+	resp, err := app.Test(httptest.NewRequest("GET", "/job-applications/by-company?name="+url.QueryEscape("ACME Corp"), nil))
+	require.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	assert.Equal(t, "ACME Corp", repo.companyName)
+
+	for _, query := range []string{"", "?name=", "?name=%20%20"} {
+		resp, err := app.Test(httptest.NewRequest("GET", "/job-applications/by-company"+query, nil))
+		require.NoError(t, err)
+		assert.Equal(t, fiber.StatusBadRequest, resp.StatusCode, query)
+	}
+}
@@ -0,0 +1,234 @@
+package jobapplications
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+
+	"woragis-jobs-service/internal/domains/jobapplications/responses"
+)
+
+// DefaultApplicationCacheTTL bounds how long a cached application may be served. It is
+// kept short because writes that bypass this repository are only seen once it expires.
+const DefaultApplicationCacheTTL = 30 * time.Second
+
+// cachedApplication is the Redis value for one application.
+type cachedApplication struct {
+	Version     int            `json:"version"`
+	Application JobApplication `json:"application"`
+}
+
+// setIfNotOlder stores ARGV[2] under KEYS[1] with a TTL of ARGV[3] milliseconds unless the
+// cached entry has a higher version than ARGV[1], so a read that raced with an update
+// cannot put the older application back.
+var setIfNotOlder = redis.NewScript(`
+local current = redis.call("GET", KEYS[1])
+if current then
+	local ok, decoded = pcall(cjson.decode, current)
+	if ok and tonumber(decoded.version) > tonumber(ARGV[1]) then
+		return 0
+	end
+end
+redis.call("SET", KEYS[1], ARGV[2], "PX", ARGV[3])
+return 1
+`)
+
+// cachingRepository serves GetJobApplication from Redis, falling back to the wrapped
+// repository and populating the cache on a miss. Saves that bump the version write the
+// new application through; every other write to an application drops its entry.
+// Redis failures never fail a call: reads fall back to the wrapped repository.
+type cachingRepository struct {
+	Repository
+	client *redis.Client
+	ttl    time.Duration
+	logger *slog.Logger
+}
+
+// NewCachingRepository wraps repo with a Redis cache of single application reads.
+// A nil client returns repo unchanged, and a non-positive ttl uses DefaultApplicationCacheTTL.
+func NewCachingRepository(repo Repository, client *redis.Client, ttl time.Duration, logger *slog.Logger) Repository {
+	if client == nil {
+		return repo
+	}
+	if ttl <= 0 {
+		ttl = DefaultApplicationCacheTTL
+	}
+	return &cachingRepository{Repository: repo, client: client, ttl: ttl, logger: logger}
+}
+
+// applicationCacheKey is the Redis key of a cached application.
+func applicationCacheKey(applicationID uuid.UUID) string {
+	return "app:" + applicationID.String()
+}
+
+func (r *cachingRepository) GetJobApplication(ctx context.Context, applicationID uuid.UUID) (*JobApplication, error) {
+	if application, ok := r.load(ctx, applicationID); ok {
+		return application, nil
+	}
+
+	application, err := r.Repository.GetJobApplication(ctx, applicationID)
+	if err != nil {
+		return nil, err
+	}
+	r.store(ctx, application)
+	return application, nil
+}
+
+func (r *cachingRepository) UpdateJobApplication(ctx context.Context, application *JobApplication) error {
+	if err := r.Repository.UpdateJobApplication(ctx, application); err != nil {
+		return err
+	}
+	r.store(ctx, application)
+	return nil
+}
+
+func (r *cachingRepository) SaveStatusChange(ctx context.Context, application *JobApplication, change *StatusChange) error {
+	if err := r.Repository.SaveStatusChange(ctx, application, change); err != nil {
+		return err
+	}
+	r.store(ctx, application)
+	return nil
+}
+
+func (r *cachingRepository) SaveCoverLetterRevision(ctx context.Context, application *JobApplication, revision *CoverLetterRevision) error {
+	err := r.Repository.SaveCoverLetterRevision(ctx, application, revision)
+	r.invalidate(ctx, application.ID)
+	return err
+}
+
+func (r *cachingRepository) DeleteJobApplication(ctx context.Context, applicationID uuid.UUID) error {
+	err := r.Repository.DeleteJobApplication(ctx, applicationID)
+	r.invalidate(ctx, applicationID)
+	return err
+}
+
+func (r *cachingRepository) DeleteJobApplicationsByFilter(ctx context.Context, userID uuid.UUID, filter BulkDeleteFilter) ([]uuid.UUID, error) {
+	deleted, err := r.Repository.DeleteJobApplicationsByFilter(ctx, userID, filter)
+	r.invalidate(ctx, deleted...)
+	return deleted, err
+}
+
+func (r *cachingRepository) ReassignWebsite(ctx context.Context, userID uuid.UUID, from, to string) ([]uuid.UUID, error) {
+	moved, err := r.Repository.ReassignWebsite(ctx, userID, from, to)
+	r.invalidate(ctx, moved...)
+	return moved, err
+}
+
+func (r *cachingRepository) AddTagToApplications(ctx context.Context, userID uuid.UUID, applicationIDs []uuid.UUID, tag string) (*BulkTagResult, error) {
+	result, err := r.Repository.AddTagToApplications(ctx, userID, applicationIDs, tag)
+	r.invalidate(ctx, applicationIDs...)
+	return result, err
+}
+
+func (r *cachingRepository) RemoveTagFromApplications(ctx context.Context, userID uuid.UUID, applicationIDs []uuid.UUID, tag string) (*BulkTagResult, error) {
+	result, err := r.Repository.RemoveTagFromApplications(ctx, userID, applicationIDs, tag)
+	r.invalidate(ctx, applicationIDs...)
+	return result, err
+}
+
+func (r *cachingRepository) SetInterestLevel(ctx context.Context, userID uuid.UUID, applicationIDs []uuid.UUID, level InterestLevel) (*BulkInterestResult, error) {
+	result, err := r.Repository.SetInterestLevel(ctx, userID, applicationIDs, level)
+	r.invalidate(ctx, applicationIDs...)
+	return result, err
+}
+
+func (r *cachingRepository) ContactApplications(ctx context.Context, userID uuid.UUID, applicationIDs []uuid.UUID, response responses.Response) (*BulkContactResult, error) {
+	result, err := r.Repository.ContactApplications(ctx, userID, applicationIDs, response)
+	r.invalidate(ctx, applicationIDs...)
+	return result, err
+}
+
+func (r *cachingRepository) ClaimReminder(ctx context.Context, applicationID uuid.UUID, remindedBefore, remindedAt time.Time) (bool, error) {
+	claimed, err := r.Repository.ClaimReminder(ctx, applicationID, remindedBefore, remindedAt)
+	if claimed {
+		r.invalidate(ctx, applicationID)
+	}
+	return claimed, err
+}
+
+func (r *cachingRepository) ReleaseReminder(ctx context.Context, applicationID uuid.UUID, remindedAt time.Time, previous *time.Time) error {
+	err := r.Repository.ReleaseReminder(ctx, applicationID, remindedAt, previous)
+	r.invalidate(ctx, applicationID)
+	return err
+}
+
+// load returns the cached application, reporting false on a miss or any Redis error.
+func (r *cachingRepository) load(ctx context.Context, applicationID uuid.UUID) (*JobApplication, bool) {
+	raw, err := r.client.Get(ctx, applicationCacheKey(applicationID)).Bytes()
+	if err != nil {
+		if !errors.Is(err, redis.Nil) {
+			r.warn(ctx, "application cache read failed", applicationID, err)
+		}
+		return nil, false
+	}
+
+	var entry cachedApplication
+	if err := json.Unmarshal(raw, &entry); err != nil {
+		r.warn(ctx, "discarding unreadable cached application", applicationID, err)
+		r.invalidate(ctx, applicationID)
+		return nil, false
+	}
+	return &entry.Application, true
+}
+
+// store caches application unless a newer version is already cached.
+func (r *cachingRepository) store(ctx context.Context, application *JobApplication) {
+	raw, err := json.Marshal(cachedApplication{Version: application.Version, Application: *application})
+	if err != nil {
+		r.warn(ctx, "application cache encode failed", application.ID, err)
+		return
+	}
+	key := applicationCacheKey(application.ID)
+	if err := setIfNotOlder.Run(ctx, r.client, []string{key}, application.Version, raw, r.ttl.Milliseconds()).Err(); err != nil {
+		r.warn(ctx, "application cache write failed", application.ID, err)
+		// A failed write-through must not leave the previous version behind
+		r.invalidate(ctx, application.ID)
+	}
+}
+
+// invalidate drops the cached applications. Entries that cannot be dropped expire with the TTL.
+func (r *cachingRepository) invalidate(ctx context.Context, applicationIDs ...uuid.UUID) {
+	invalidateApplications(ctx, r.client, r.logger, applicationIDs)
+}
+
+// ApplicationCacheInvalidator drops cached applications after writes that bypass the
+// caching repository, such as account deletion.
+type ApplicationCacheInvalidator struct {
+	client *redis.Client
+	logger *slog.Logger
+}
+
+// NewApplicationCacheInvalidator returns an invalidator for the cache kept by
+// NewCachingRepository on the same client.
+func NewApplicationCacheInvalidator(client *redis.Client, logger *slog.Logger) *ApplicationCacheInvalidator {
+	return &ApplicationCacheInvalidator{client: client, logger: logger}
+}
+
+// Invalidate drops the cached applications. Entries that cannot be dropped expire with the TTL.
+func (i *ApplicationCacheInvalidator) Invalidate(ctx context.Context, applicationIDs ...uuid.UUID) {
+	invalidateApplications(ctx, i.client, i.logger, applicationIDs)
+}
+
+func invalidateApplications(ctx context.Context, client *redis.Client, logger *slog.Logger, applicationIDs []uuid.UUID) {
+	if len(applicationIDs) == 0 {
+		return
+	}
+	keys := make([]string, len(applicationIDs))
+	for i, applicationID := range applicationIDs {
+		keys[i] = applicationCacheKey(applicationID)
+	}
+	if err := client.Del(ctx, keys...).Err(); err != nil && logger != nil {
+		logger.WarnContext(ctx, "application cache invalidation failed", "applications", len(keys), "error", err)
+	}
+}
+
+func (r *cachingRepository) warn(ctx context.Context, msg string, applicationID uuid.UUID, err error) {
+	if r.logger != nil {
+		r.logger.WarnContext(ctx, msg, "application_id", applicationID.String(), "error", err)
+	}
+}
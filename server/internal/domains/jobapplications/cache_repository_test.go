@@ -0,0 +1,115 @@
+package jobapplications
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeCacheRepo serves one stored application and counts reads that reach it.
+type fakeCacheRepo struct {
+	Repository
+	application JobApplication
+	reads       int
+}
+
+func (r *fakeCacheRepo) GetJobApplication(_ context.Context, applicationID uuid.UUID) (*JobApplication, error) {
+	r.reads++
+	if applicationID != r.application.ID {
+		return nil, NewDomainError(ErrCodeNotFound, ErrApplicationNotFound)
+	}
+	application := r.application
+	return &application, nil
+}
+
+func (r *fakeCacheRepo) UpdateJobApplication(_ context.Context, application *JobApplication) error {
+	application.Version++
+	r.application = *application
+	return nil
+}
+
+func (r *fakeCacheRepo) DeleteJobApplication(_ context.Context, _ uuid.UUID) error {
+	r.application = JobApplication{}
+	return nil
+}
+
+func TestNewCachingRepository_WithoutRedis(t *testing.T) {
+	repo := &fakeCacheRepo{}
+	assert.Same(t, repo, NewCachingRepository(repo, nil, time.Minute, nil))
+}
+
+func TestApplicationCacheKey(t *testing.T) {
+	id := uuid.MustParse("3f1c2a4e-8b7d-4c2e-9a1f-0d5e6b7c8a90")
+	assert.Equal(t, "app:3f1c2a4e-8b7d-4c2e-9a1f-0d5e6b7c8a90", applicationCacheKey(id))
+}
+
+func TestCachingRepository(t *testing.T) {
+	client := redis.NewClient(&redis.Options{Addr: "localhost:6379", DB: 1})
+	ctx := context.Background()
+	if err := client.Ping(ctx).Err(); err != nil {
+		t.Skip("Redis not available, skipping application cache test")
+	}
+
+	application := JobApplication{ID: uuid.New(), UserID: uuid.New(), CompanyName: "Acme", Version: 1}
+	t.Cleanup(func() { client.Del(ctx, applicationCacheKey(application.ID)) })
+	inner := &fakeCacheRepo{application: application}
+	repo := NewCachingRepository(inner, client, time.Minute, nil)
+
+	// A miss reads through and populates, the next read is served from Redis
+	got, err := repo.GetJobApplication(ctx, application.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "Acme", got.CompanyName)
+	_, err = repo.GetJobApplication(ctx, application.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 1, inner.reads)
+
+	// Updates write the new version through
+	got.CompanyName = "Acme Corp"
+	require.NoError(t, repo.UpdateJobApplication(ctx, got))
+	got, err = repo.GetJobApplication(ctx, application.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "Acme Corp", got.CompanyName)
+	assert.Equal(t, 2, got.Version)
+	assert.Equal(t, 1, inner.reads)
+
+	// An older version read before the update cannot replace the cached one
+	stale := application
+	repo.(*cachingRepository).store(ctx, &stale)
+	got, err = repo.GetJobApplication(ctx, application.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 2, got.Version)
+
+	// Deletes drop the entry
+	require.NoError(t, repo.DeleteJobApplication(ctx, application.ID))
+	_, err = repo.GetJobApplication(ctx, application.ID)
+	assert.Error(t, err)
+	assert.Equal(t, 2, inner.reads)
+}
+
+func TestApplicationCacheInvalidator(t *testing.T) {
+	client := redis.NewClient(&redis.Options{Addr: "localhost:6379", DB: 1})
+	ctx := context.Background()
+	if err := client.Ping(ctx).Err(); err != nil {
+		t.Skip("Redis not available, skipping application cache test")
+	}
+
+	application := JobApplication{ID: uuid.New(), UserID: uuid.New(), CompanyName: "Acme", Version: 1}
+	t.Cleanup(func() { client.Del(ctx, applicationCacheKey(application.ID)) })
+	inner := &fakeCacheRepo{application: application}
+	repo := NewCachingRepository(inner, client, time.Minute, nil)
+	_, err := repo.GetJobApplication(ctx, application.ID)
+	require.NoError(t, err)
+
+	// A write that bypassed the repository is seen once the entry is invalidated
+	inner.application.CompanyName = "Acme Corp"
+	NewApplicationCacheInvalidator(client, nil).Invalidate(ctx, application.ID)
+	got, err := repo.GetJobApplication(ctx, application.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "Acme Corp", got.CompanyName)
+	assert.Equal(t, 2, inner.reads)
+}
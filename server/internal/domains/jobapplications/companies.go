@@ -0,0 +1,67 @@
+package jobapplications
+
+import (
+	"context"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"woragis-jobs-service/pkg/middleware"
+	"woragis-jobs-service/pkg/pagination"
+	"woragis-jobs-service/pkg/response"
+)
+
+// CompanySummary is one company the user has applied to. Company names are grouped
+// ignoring case; Company and LatestStatus come from the newest application.
+type CompanySummary struct {
+	Company      string            `json:"company"`
+	Count        int64             `json:"count"`
+	LatestStatus ApplicationStatus `json:"latestStatus"`
+}
+
+// ListCompanies returns the companies the user has applied to, most applications first,
+// along with the number of companies ignoring the limit and offset.
+func (s *service) ListCompanies(ctx context.Context, userID uuid.UUID, limit, offset int) ([]CompanySummary, int64, error) {
+	companies, err := s.repo.ListCompanies(ctx, userID, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	total, err := s.repo.CountCompanies(ctx, userID)
+	if err != nil {
+		return nil, 0, err
+	}
+	return companies, total, nil
+}
+
+// ListCompanies lists the companies the user has applied to with their application
+// count and latest status, most applications first.
+func (h *handler) ListCompanies(c *fiber.Ctx) error {
+	userID, err := middleware.GetUserIDFromFiberContext(c)
+	if err != nil {
+		return response.Error(c, fiber.StatusUnauthorized, 401, fiber.Map{
+			"message": "authentication required",
+		})
+	}
+
+	page, err := pagination.FromQuery(c)
+	if err != nil {
+		return response.Error(c, fiber.StatusBadRequest, ErrCodeInvalidPayload, fiber.Map{
+			"message": err.Error(),
+		})
+	}
+
+	companies, total, err := h.service.ListCompanies(c.Context(), userID, page.Limit, page.Offset)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	page.SetHeaders(c)
+	page.SetLinkHeader(c, len(companies), total)
+	return response.Success(c, fiber.StatusOK, fiber.Map{
+		"companies": companies,
+		"count":     len(companies),
+		"total":     total,
+		"limit":     page.Limit,
+		"offset":    page.Offset,
+	})
+}
@@ -0,0 +1,68 @@
+package jobapplications
+
+import (
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeCompaniesRepo returns a fixed page of companies and records the page requested.
+type fakeCompaniesRepo struct {
+	Repository
+	companies     []CompanySummary
+	total         int64
+	limit, offset int
+}
+
+func (r *fakeCompaniesRepo) ListCompanies(_ context.Context, _ uuid.UUID, limit, offset int) ([]CompanySummary, error) {
+	r.limit, r.offset = limit, offset
+	return r.companies, nil
+}
+
+func (r *fakeCompaniesRepo) CountCompanies(context.Context, uuid.UUID) (int64, error) {
+	return r.total, nil
+}
+
+func TestListCompanies_Handler(t *testing.T) {
+	repo := &fakeCompaniesRepo{
+		companies: []CompanySummary{
+			{Company: "Acme", Count: 3, LatestStatus: ApplicationStatusContacted},
+			{Company: "Globex", Count: 1, LatestStatus: ApplicationStatusApplied},
+		},
+		total: 5,
+	}
+	app := fiber.New()
+	app.Use(func(c *fiber.Ctx) error {
+		c.Locals("userID", uuid.New())
+		return c.Next()
+	})
+	app.Get("/job-applications/companies", NewHandler(NewService(repo, nil, nil), nil).ListCompanies)
+
+	resp, err := app.Test(httptest.NewRequest("GET", "/job-applications/companies?limit=2&offset=0", nil))
+	require.NoError(t, err)
+	require.Equal(t, fiber.StatusOK, resp.StatusCode)
+	assert.Equal(t, 2, repo.limit)
+	assert.Contains(t, resp.Header.Get("Link"), `rel="next"`)
+
+	var decoded struct {
+		Data struct {
+			Companies []CompanySummary `json:"companies"`
+			Count     int              `json:"count"`
+			Total     int64            `json:"total"`
+		} `json:"data"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&decoded))
+	assert.Equal(t, repo.companies, decoded.Data.Companies)
+	assert.Equal(t, 2, decoded.Data.Count)
+	assert.Equal(t, int64(5), decoded.Data.Total)
+
+	resp, err = app.Test(httptest.NewRequest("GET", "/job-applications/companies?limit=-1", nil))
+	require.NoError(t, err)
+	assert.Equal(t, fiber.StatusBadRequest, resp.StatusCode)
+}
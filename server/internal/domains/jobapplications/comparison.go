@@ -0,0 +1,167 @@
+package jobapplications
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ApplicationComparison lines up several applications for a side-by-side table.
+type ApplicationComparison struct {
+	Applications []ComparedApplication `json:"applications"`
+	Salary       SalaryComparison      `json:"salary"`
+	Offers       OfferComparison       `json:"offers"`
+	Count        int                   `json:"count"`
+}
+
+// ComparedApplication holds the fields shown in a comparison table, in the same
+// shape for every application so missing values line up as nulls.
+type ComparedApplication struct {
+	ID                 uuid.UUID         `json:"id"`
+	CompanyName        string            `json:"companyName"`
+	JobTitle           string            `json:"jobTitle"`
+	Location           string            `json:"location"`
+	Website            string            `json:"website"`
+	Status             ApplicationStatus `json:"status"`
+	InterestLevel      *string           `json:"interestLevel"`
+	Salary             *NormalizedSalary `json:"salary"`
+	Offer              *ComparedOffer    `json:"offer"`
+	AppliedAt          *time.Time        `json:"appliedAt"`
+	DaysSinceApplied   *int              `json:"daysSinceApplied"`
+	ResponseReceivedAt *time.Time        `json:"responseReceivedAt"`
+	InterviewCount     int               `json:"interviewCount"`
+	NextInterviewDate  *time.Time        `json:"nextInterviewDate"`
+	Deadline           *time.Time        `json:"deadline"`
+	Source             *string           `json:"source"`
+	Tags               []string          `json:"tags"`
+}
+
+// NormalizedSalary is a salary range with both bounds filled in and a midpoint to rank by.
+type NormalizedSalary struct {
+	Min      int    `json:"min"`
+	Max      int    `json:"max"`
+	Midpoint int    `json:"midpoint"`
+	Currency string `json:"currency"`
+}
+
+// SalaryComparison summarizes whether the salaries can be ranked against each other.
+type SalaryComparison struct {
+	// Comparable is true when at least two applications have a salary and all share one currency.
+	Comparable bool `json:"comparable"`
+	// Currency is the shared currency when Comparable is true.
+	Currency string `json:"currency,omitempty"`
+	// HighestMidpointID is the application with the highest salary midpoint when Comparable is true.
+	HighestMidpointID *uuid.UUID `json:"highestMidpointId,omitempty"`
+}
+
+// NewApplicationComparison builds a comparison of applications in the given order,
+// attaching each application's offer from offers when one was recorded.
+func NewApplicationComparison(applications []JobApplication, offers map[uuid.UUID]Offer, now time.Time) *ApplicationComparison {
+	comparison := &ApplicationComparison{
+		Applications: make([]ComparedApplication, 0, len(applications)),
+		Count:        len(applications),
+	}
+
+	for i := range applications {
+		application := &applications[i]
+		_, daysSinceApplied := applicationAge(application, now)
+		tags := []string(application.Tags)
+		if tags == nil {
+			tags = []string{}
+		}
+		var offer *Offer
+		if found, ok := offers[application.ID]; ok {
+			offer = &found
+		}
+
+		comparison.Applications = append(comparison.Applications, ComparedApplication{
+			ID:                 application.ID,
+			CompanyName:        application.CompanyName,
+			JobTitle:           application.JobTitle,
+			Location:           application.Location,
+			Website:            application.Website,
+			Status:             application.Status,
+			InterestLevel:      optionalString(string(application.InterestLevel)),
+			Salary:             normalizeSalary(application.SalaryMin, application.SalaryMax, application.SalaryCurrency),
+			Offer:              newComparedOffer(offer),
+			AppliedAt:          application.AppliedAt,
+			DaysSinceApplied:   daysSinceApplied,
+			ResponseReceivedAt: application.ResponseReceivedAt,
+			InterviewCount:     application.InterviewCount,
+			NextInterviewDate:  application.NextInterviewDate,
+			Deadline:           application.Deadline,
+			Source:             optionalString(application.Source),
+			Tags:               tags,
+		})
+	}
+
+	comparison.Salary = compareSalaries(comparison.Applications)
+	comparison.Offers = compareOffers(comparison.Applications)
+	return comparison
+}
+
+// normalizeSalary fills a missing bound from the other one, orders the bounds and
+// upper-cases the currency. It returns nil when no salary is known.
+func normalizeSalary(salaryMin, salaryMax *int, currency string) *NormalizedSalary {
+	if salaryMin == nil && salaryMax == nil {
+		return nil
+	}
+
+	low, high := salaryMin, salaryMax
+	if low == nil {
+		low = high
+	}
+	if high == nil {
+		high = low
+	}
+	normalized := &NormalizedSalary{
+		Min:      *low,
+		Max:      *high,
+		Currency: strings.ToUpper(strings.TrimSpace(currency)),
+	}
+	if normalized.Min > normalized.Max {
+		normalized.Min, normalized.Max = normalized.Max, normalized.Min
+	}
+	normalized.Midpoint = normalized.Min + (normalized.Max-normalized.Min)/2
+	return normalized
+}
+
+func compareSalaries(applications []ComparedApplication) SalaryComparison {
+	var (
+		currency string
+		withPay  int
+		best     *ComparedApplication
+	)
+	for i := range applications {
+		salary := applications[i].Salary
+		if salary == nil {
+			continue
+		}
+		if withPay > 0 && salary.Currency != currency {
+			return SalaryComparison{}
+		}
+		currency = salary.Currency
+		withPay++
+		if best == nil || salary.Midpoint > best.Salary.Midpoint {
+			best = &applications[i]
+		}
+	}
+
+	if withPay < 2 {
+		return SalaryComparison{}
+	}
+	bestID := best.ID
+	return SalaryComparison{
+		Comparable:        true,
+		Currency:          currency,
+		HighestMidpointID: &bestID,
+	}
+}
+
+func optionalString(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
@@ -0,0 +1,61 @@
+package jobapplications
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func intPtr(v int) *int { return &v }
+
+func TestNormalizeSalary(t *testing.T) {
+	tests := []struct {
+		name     string
+		min, max *int
+		currency string
+		want     *NormalizedSalary
+	}{
+		{name: "no salary", want: nil},
+		{name: "full range", min: intPtr(100), max: intPtr(200), currency: "usd",
+			want: &NormalizedSalary{Min: 100, Max: 200, Midpoint: 150, Currency: "USD"}},
+		{name: "only minimum", min: intPtr(90), currency: " eur ",
+			want: &NormalizedSalary{Min: 90, Max: 90, Midpoint: 90, Currency: "EUR"}},
+		{name: "only maximum", max: intPtr(120), currency: "BRL",
+			want: &NormalizedSalary{Min: 120, Max: 120, Midpoint: 120, Currency: "BRL"}},
+		{name: "swapped bounds", min: intPtr(300), max: intPtr(100), currency: "usd",
+			want: &NormalizedSalary{Min: 100, Max: 300, Midpoint: 200, Currency: "USD"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, normalizeSalary(tt.min, tt.max, tt.currency))
+		})
+	}
+}
+
+func TestNewApplicationComparison_Salary(t *testing.T) {
+	now := time.Now()
+	low := JobApplication{ID: uuid.New(), SalaryMin: intPtr(100), SalaryMax: intPtr(140), SalaryCurrency: "usd"}
+	high := JobApplication{ID: uuid.New(), SalaryMin: intPtr(130), SalaryCurrency: "USD"}
+	unknown := JobApplication{ID: uuid.New()}
+
+	comparison := NewApplicationComparison([]JobApplication{low, unknown, high}, nil, now)
+	require.Equal(t, 3, comparison.Count)
+	assert.Nil(t, comparison.Applications[1].Salary)
+	assert.Equal(t, []string{}, comparison.Applications[1].Tags)
+	assert.True(t, comparison.Salary.Comparable)
+	assert.Equal(t, "USD", comparison.Salary.Currency)
+	require.NotNil(t, comparison.Salary.HighestMidpointID)
+	assert.Equal(t, high.ID, *comparison.Salary.HighestMidpointID)
+
+	mixed := JobApplication{ID: uuid.New(), SalaryMin: intPtr(500), SalaryCurrency: "EUR"}
+	comparison = NewApplicationComparison([]JobApplication{low, high, mixed}, nil, now)
+	assert.False(t, comparison.Salary.Comparable, "different currencies can't be ranked")
+	assert.Nil(t, comparison.Salary.HighestMidpointID)
+
+	comparison = NewApplicationComparison([]JobApplication{low, unknown}, nil, now)
+	assert.False(t, comparison.Salary.Comparable, "ranking needs at least two salaries")
+}
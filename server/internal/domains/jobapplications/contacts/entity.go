@@ -0,0 +1,77 @@
+package contacts
+
+import (
+	"net/mail"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Contact represents a recruiter, hiring manager or other person involved in a job application.
+type Contact struct {
+	ID               uuid.UUID `gorm:"column:id;type:uuid;primaryKey" json:"id"`
+	JobApplicationID uuid.UUID `gorm:"column:job_application_id;type:uuid;index;not null" json:"jobApplicationId"`
+	Name             string    `gorm:"column:name;size:255;not null" json:"name"`
+	Role             string    `gorm:"column:role;size:100" json:"role,omitempty"` // e.g., "recruiter", "hiring-manager", "engineer"
+	Email            string    `gorm:"column:email;size:255;index" json:"email,omitempty"`
+	LinkedInURL      string    `gorm:"column:linkedin_url;type:text" json:"linkedinUrl,omitempty"`
+	CreatedAt        time.Time `gorm:"column:created_at" json:"createdAt"`
+	UpdatedAt        time.Time `gorm:"column:updated_at" json:"updatedAt"`
+}
+
+// TableName specifies the table name for Contact.
+func (Contact) TableName() string {
+	return "job_application_contacts"
+}
+
+// NewContact creates a new contact entity.
+func NewContact(jobApplicationID uuid.UUID, name string) (*Contact, error) {
+	contact := &Contact{
+		ID:               uuid.New(),
+		JobApplicationID: jobApplicationID,
+		Name:             strings.TrimSpace(name),
+		CreatedAt:        time.Now().UTC(),
+		UpdatedAt:        time.Now().UTC(),
+	}
+
+	return contact, contact.Validate()
+}
+
+// Validate ensures contact invariants hold.
+func (c *Contact) Validate() error {
+	if c.ID == uuid.Nil {
+		return NewDomainError(ErrCodeInvalidPayload, ErrEmptyContactID)
+	}
+	if c.JobApplicationID == uuid.Nil {
+		return NewDomainError(ErrCodeInvalidPayload, ErrEmptyJobApplicationID)
+	}
+	if c.Name == "" || len(c.Name) > 255 {
+		return NewDomainError(ErrCodeInvalidPayload, ErrInvalidContactName)
+	}
+	if len(c.Role) > 100 {
+		return NewDomainError(ErrCodeInvalidPayload, ErrInvalidContactRole)
+	}
+	if c.Email != "" {
+		if address, err := mail.ParseAddress(c.Email); err != nil || address.Address != c.Email {
+			return NewDomainError(ErrCodeInvalidPayload, ErrInvalidContactEmail)
+		}
+	}
+	if c.LinkedInURL != "" {
+		parsed, err := url.Parse(c.LinkedInURL)
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			return NewDomainError(ErrCodeInvalidPayload, ErrInvalidContactLinkedIn)
+		}
+	}
+	return nil
+}
+
+// UpdateDetails replaces the contact's descriptive fields.
+func (c *Contact) UpdateDetails(name, role, email, linkedInURL string) {
+	c.Name = strings.TrimSpace(name)
+	c.Role = strings.TrimSpace(role)
+	c.Email = strings.ToLower(strings.TrimSpace(email))
+	c.LinkedInURL = strings.TrimSpace(linkedInURL)
+	c.UpdatedAt = time.Now().UTC()
+}
@@ -0,0 +1,83 @@
+package contacts
+
+import (
+	"errors"
+
+	"woragis-jobs-service/pkg/response"
+)
+
+const (
+	ErrCodeInvalidPayload    = 10400
+	ErrCodeRepositoryFailure = 10401
+	ErrCodeNotFound          = 10402
+)
+
+const (
+	ErrEmptyContactID         = "contacts: contact id cannot be empty"
+	ErrEmptyJobApplicationID  = "contacts: job application id cannot be empty"
+	ErrInvalidContactName     = "contacts: name must be 1-255 characters"
+	ErrInvalidContactRole     = "contacts: role must be at most 100 characters"
+	ErrInvalidContactEmail    = "contacts: email is not a valid address"
+	ErrInvalidContactLinkedIn = "contacts: linkedinUrl must be an http(s) URL"
+	ErrContactNotFound        = "contacts: contact not found"
+	ErrUnableToPersist        = "contacts: unable to persist data"
+	ErrUnableToFetch          = "contacts: unable to fetch data"
+	ErrUnableToUpdate         = "contacts: unable to update data"
+)
+
+type DomainError struct {
+	Code    int
+	Message string
+	// cause is the underlying database error, kept so retries can tell transient failures apart
+	cause error
+}
+
+func (e *DomainError) Error() string {
+	return e.Message
+}
+
+// Unwrap returns the underlying error, if any.
+func (e *DomainError) Unwrap() error {
+	return e.cause
+}
+
+// ErrorKind classifies the error for response.FromDomainError.
+func (e *DomainError) ErrorKind() response.ErrorKind {
+	switch e.Code {
+	case ErrCodeInvalidPayload:
+		return response.KindValidation
+	case ErrCodeNotFound:
+		return response.KindNotFound
+	default:
+		return response.KindInternal
+	}
+}
+
+// ErrorCode returns the numeric code sent in the error envelope.
+func (e *DomainError) ErrorCode() int {
+	return e.Code
+}
+
+func NewDomainError(code int, message string) *DomainError {
+	return &DomainError{
+		Code:    code,
+		Message: message,
+	}
+}
+
+// newRepositoryError reports a failed database operation, keeping err as the cause.
+func newRepositoryError(message string, err error) *DomainError {
+	return &DomainError{
+		Code:    ErrCodeRepositoryFailure,
+		Message: message,
+		cause:   err,
+	}
+}
+
+func AsDomainError(err error) (*DomainError, bool) {
+	var domainErr *DomainError
+	if errors.As(err, &domainErr) {
+		return domainErr, true
+	}
+	return nil, false
+}
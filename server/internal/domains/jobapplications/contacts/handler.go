@@ -0,0 +1,163 @@
+package contacts
+
+import (
+	"log/slog"
+
+	"github.com/gofiber/fiber/v2"
+
+	"woragis-jobs-service/pkg/middleware"
+	"woragis-jobs-service/pkg/response"
+)
+
+// Handler exposes contact endpoints.
+type Handler interface {
+	CreateContact(c *fiber.Ctx) error
+	GetContact(c *fiber.Ctx) error
+	ListContacts(c *fiber.Ctx) error
+	UpdateContact(c *fiber.Ctx) error
+	DeleteContact(c *fiber.Ctx) error
+}
+
+type handler struct {
+	service Service
+	logger  *slog.Logger
+}
+
+// NewHandler constructs a contact handler.
+func NewHandler(service Service, logger *slog.Logger) Handler {
+	return &handler{
+		service: service,
+		logger:  logger,
+	}
+}
+
+type createContactPayload struct {
+	Name        string `json:"name"`
+	Role        string `json:"role,omitempty"`
+	Email       string `json:"email,omitempty"`
+	LinkedInURL string `json:"linkedinUrl,omitempty"`
+}
+
+type updateContactPayload struct {
+	Name        *string `json:"name,omitempty"`
+	Role        *string `json:"role,omitempty"`
+	Email       *string `json:"email,omitempty"`
+	LinkedInURL *string `json:"linkedinUrl,omitempty"`
+}
+
+func (h *handler) CreateContact(c *fiber.Ctx) error {
+	applicationID, err := middleware.UUIDParam(c, "applicationId")
+	if err != nil {
+		return middleware.InvalidUUIDParam(c, "applicationId")
+	}
+
+	var payload createContactPayload
+	if err := c.BodyParser(&payload); err != nil {
+		return response.Error(c, fiber.StatusBadRequest, ErrCodeInvalidPayload, fiber.Map{
+			"message": "invalid request payload",
+		})
+	}
+
+	contact, err := h.service.CreateContact(c.Context(), applicationID, ContactDetails{
+		Name:        payload.Name,
+		Role:        payload.Role,
+		Email:       payload.Email,
+		LinkedInURL: payload.LinkedInURL,
+	})
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return response.Success(c, fiber.StatusCreated, contact)
+}
+
+func (h *handler) GetContact(c *fiber.Ctx) error {
+	applicationID, err := middleware.UUIDParam(c, "applicationId")
+	if err != nil {
+		return middleware.InvalidUUIDParam(c, "applicationId")
+	}
+	contactID, err := middleware.UUIDParam(c, "id")
+	if err != nil {
+		return middleware.InvalidUUIDParam(c, "id")
+	}
+
+	contact, err := h.service.GetContact(c.Context(), applicationID, contactID)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return response.Success(c, fiber.StatusOK, contact)
+}
+
+func (h *handler) ListContacts(c *fiber.Ctx) error {
+	applicationID, err := middleware.UUIDParam(c, "applicationId")
+	if err != nil {
+		return middleware.InvalidUUIDParam(c, "applicationId")
+	}
+
+	contacts, err := h.service.ListContacts(c.Context(), applicationID)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return response.Success(c, fiber.StatusOK, fiber.Map{
+		"contacts": contacts,
+		"count":    len(contacts),
+	})
+}
+
+func (h *handler) UpdateContact(c *fiber.Ctx) error {
+	applicationID, err := middleware.UUIDParam(c, "applicationId")
+	if err != nil {
+		return middleware.InvalidUUIDParam(c, "applicationId")
+	}
+	contactID, err := middleware.UUIDParam(c, "id")
+	if err != nil {
+		return middleware.InvalidUUIDParam(c, "id")
+	}
+
+	var payload updateContactPayload
+	if err := c.BodyParser(&payload); err != nil {
+		return response.Error(c, fiber.StatusBadRequest, ErrCodeInvalidPayload, fiber.Map{
+			"message": "invalid request payload",
+		})
+	}
+
+	contact, err := h.service.UpdateContact(c.Context(), applicationID, contactID, UpdateContactRequest{
+		Name:        payload.Name,
+		Role:        payload.Role,
+		Email:       payload.Email,
+		LinkedInURL: payload.LinkedInURL,
+	})
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return response.Success(c, fiber.StatusOK, contact)
+}
+
+func (h *handler) DeleteContact(c *fiber.Ctx) error {
+	applicationID, err := middleware.UUIDParam(c, "applicationId")
+	if err != nil {
+		return middleware.InvalidUUIDParam(c, "applicationId")
+	}
+	contactID, err := middleware.UUIDParam(c, "id")
+	if err != nil {
+		return middleware.InvalidUUIDParam(c, "id")
+	}
+
+	if err := h.service.DeleteContact(c.Context(), applicationID, contactID); err != nil {
+		return h.handleError(c, err)
+	}
+
+	return response.Success(c, fiber.StatusOK, fiber.Map{
+		"message": "contact deleted successfully",
+	})
+}
+
+func (h *handler) handleError(c *fiber.Ctx, err error) error {
+	if _, ok := AsDomainError(err); !ok {
+		h.logger.ErrorContext(c.UserContext(), "unhandled error", slog.Any("error", err))
+	}
+	return response.FromDomainError(c, err)
+}
@@ -0,0 +1,97 @@
+package contacts
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Repository defines persistence operations for contacts.
+type Repository interface {
+	CreateContact(ctx context.Context, contact *Contact) error
+	UpdateContact(ctx context.Context, contact *Contact) error
+	GetContact(ctx context.Context, applicationID, contactID uuid.UUID) (*Contact, error)
+	ListContacts(ctx context.Context, applicationID uuid.UUID) ([]Contact, error)
+	DeleteContact(ctx context.Context, applicationID, contactID uuid.UUID) error
+	FindContactByEmail(ctx context.Context, applicationID uuid.UUID, email string) (*Contact, error)
+}
+
+type gormRepository struct {
+	db *gorm.DB
+}
+
+// NewGormRepository returns a GORM-backed repository.
+func NewGormRepository(db *gorm.DB) Repository {
+	return &gormRepository{db: db}
+}
+
+func (r *gormRepository) CreateContact(ctx context.Context, contact *Contact) error {
+	if err := contact.Validate(); err != nil {
+		return err
+	}
+	if err := r.db.WithContext(ctx).Create(contact).Error; err != nil {
+		return newRepositoryError(ErrUnableToPersist, err)
+	}
+	return nil
+}
+
+func (r *gormRepository) UpdateContact(ctx context.Context, contact *Contact) error {
+	if err := contact.Validate(); err != nil {
+		return err
+	}
+	if err := r.db.WithContext(ctx).Save(contact).Error; err != nil {
+		return newRepositoryError(ErrUnableToUpdate, err)
+	}
+	return nil
+}
+
+func (r *gormRepository) GetContact(ctx context.Context, applicationID, contactID uuid.UUID) (*Contact, error) {
+	var contact Contact
+	if err := r.db.WithContext(ctx).Where("id = ? AND job_application_id = ?", contactID, applicationID).First(&contact).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, NewDomainError(ErrCodeNotFound, ErrContactNotFound)
+		}
+		return nil, newRepositoryError(ErrUnableToFetch, err)
+	}
+	return &contact, nil
+}
+
+func (r *gormRepository) ListContacts(ctx context.Context, applicationID uuid.UUID) ([]Contact, error) {
+	var contacts []Contact
+	if err := r.db.WithContext(ctx).Where("job_application_id = ?", applicationID).Order("created_at ASC").Find(&contacts).Error; err != nil {
+		return nil, newRepositoryError(ErrUnableToFetch, err)
+	}
+	return contacts, nil
+}
+
+func (r *gormRepository) DeleteContact(ctx context.Context, applicationID, contactID uuid.UUID) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("id = ? AND job_application_id = ?", contactID, applicationID).Delete(&Contact{})
+		if result.Error != nil {
+			return newRepositoryError(ErrUnableToUpdate, result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return NewDomainError(ErrCodeNotFound, ErrContactNotFound)
+		}
+		// Responses keep their history but no longer point at the removed contact.
+		if err := tx.Table("job_application_responses").Where("contact_id = ?", contactID).
+			Update("contact_id", nil).Error; err != nil {
+			return newRepositoryError(ErrUnableToUpdate, err)
+		}
+		return nil
+	})
+	return err
+}
+
+func (r *gormRepository) FindContactByEmail(ctx context.Context, applicationID uuid.UUID, email string) (*Contact, error) {
+	var contact Contact
+	if err := r.db.WithContext(ctx).Where("job_application_id = ? AND email = ?", applicationID, email).Order("created_at ASC").First(&contact).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, NewDomainError(ErrCodeNotFound, ErrContactNotFound)
+		}
+		return nil, newRepositoryError(ErrUnableToFetch, err)
+	}
+	return &contact, nil
+}
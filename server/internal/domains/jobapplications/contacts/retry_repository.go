@@ -0,0 +1,57 @@
+package contacts
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"woragis-jobs-service/internal/database"
+)
+
+// retryingRepository retries calls to the wrapped repository after transient connection
+// errors, so a brief Postgres failover does not fail the request. Services are unaware of it.
+type retryingRepository struct {
+	next   Repository
+	policy database.RetryPolicy
+}
+
+// NewRetryingRepository wraps repo so every call is retried according to policy.
+func NewRetryingRepository(repo Repository, policy database.RetryPolicy) Repository {
+	return &retryingRepository{next: repo, policy: policy}
+}
+
+func (r *retryingRepository) CreateContact(ctx context.Context, contact *Contact) error {
+	return r.policy.Do(ctx, "CreateContact", func() error {
+		return r.next.CreateContact(ctx, contact)
+	})
+}
+
+func (r *retryingRepository) UpdateContact(ctx context.Context, contact *Contact) error {
+	return r.policy.Do(ctx, "UpdateContact", func() error {
+		return r.next.UpdateContact(ctx, contact)
+	})
+}
+
+func (r *retryingRepository) GetContact(ctx context.Context, applicationID, contactID uuid.UUID) (*Contact, error) {
+	return database.Retry(ctx, r.policy, "GetContact", func() (*Contact, error) {
+		return r.next.GetContact(ctx, applicationID, contactID)
+	})
+}
+
+func (r *retryingRepository) ListContacts(ctx context.Context, applicationID uuid.UUID) ([]Contact, error) {
+	return database.Retry(ctx, r.policy, "ListContacts", func() ([]Contact, error) {
+		return r.next.ListContacts(ctx, applicationID)
+	})
+}
+
+func (r *retryingRepository) DeleteContact(ctx context.Context, applicationID, contactID uuid.UUID) error {
+	return r.policy.Do(ctx, "DeleteContact", func() error {
+		return r.next.DeleteContact(ctx, applicationID, contactID)
+	})
+}
+
+func (r *retryingRepository) FindContactByEmail(ctx context.Context, applicationID uuid.UUID, email string) (*Contact, error) {
+	return database.Retry(ctx, r.policy, "FindContactByEmail", func() (*Contact, error) {
+		return r.next.FindContactByEmail(ctx, applicationID, email)
+	})
+}
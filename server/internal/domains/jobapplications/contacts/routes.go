@@ -0,0 +1,19 @@
+package contacts
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	"woragis-jobs-service/pkg/middleware"
+)
+
+// SetupRoutes registers contact endpoints.
+// The routes are nested under /job-applications/:applicationId/contacts
+// so applicationId is available in the route params.
+func SetupRoutes(api fiber.Router, handler Handler) {
+	id := middleware.ValidateUUIDParam("id")
+	api.Post("/", handler.CreateContact)
+	api.Get("/", handler.ListContacts)
+	api.Get("/:id", id, handler.GetContact)
+	api.Patch("/:id", id, handler.UpdateContact)
+	api.Delete("/:id", id, handler.DeleteContact)
+}
@@ -0,0 +1,130 @@
+package contacts
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// Service orchestrates contact workflows.
+type Service interface {
+	CreateContact(ctx context.Context, applicationID uuid.UUID, details ContactDetails) (*Contact, error)
+	GetContact(ctx context.Context, applicationID, contactID uuid.UUID) (*Contact, error)
+	ListContacts(ctx context.Context, applicationID uuid.UUID) ([]Contact, error)
+	UpdateContact(ctx context.Context, applicationID, contactID uuid.UUID, updates UpdateContactRequest) (*Contact, error)
+	DeleteContact(ctx context.Context, applicationID, contactID uuid.UUID) error
+	ContactBelongsToApplication(ctx context.Context, applicationID, contactID uuid.UUID) (bool, error)
+	FindContactIDByEmail(ctx context.Context, applicationID uuid.UUID, email string) (*uuid.UUID, error)
+}
+
+// ContactDetails holds the descriptive fields of a contact.
+type ContactDetails struct {
+	Name        string
+	Role        string
+	Email       string
+	LinkedInURL string
+}
+
+// UpdateContactRequest represents fields that can be updated on a contact.
+type UpdateContactRequest struct {
+	Name        *string
+	Role        *string
+	Email       *string
+	LinkedInURL *string
+}
+
+type service struct {
+	repo   Repository
+	logger *slog.Logger
+}
+
+// NewService constructs a Service.
+func NewService(repo Repository, logger *slog.Logger) Service {
+	return &service{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+func (s *service) CreateContact(ctx context.Context, applicationID uuid.UUID, details ContactDetails) (*Contact, error) {
+	contact, err := NewContact(applicationID, details.Name)
+	if err != nil {
+		return nil, err
+	}
+	contact.UpdateDetails(details.Name, details.Role, details.Email, details.LinkedInURL)
+
+	if err := s.repo.CreateContact(ctx, contact); err != nil {
+		return nil, err
+	}
+
+	return contact, nil
+}
+
+func (s *service) GetContact(ctx context.Context, applicationID, contactID uuid.UUID) (*Contact, error) {
+	return s.repo.GetContact(ctx, applicationID, contactID)
+}
+
+func (s *service) ListContacts(ctx context.Context, applicationID uuid.UUID) ([]Contact, error) {
+	return s.repo.ListContacts(ctx, applicationID)
+}
+
+func (s *service) UpdateContact(ctx context.Context, applicationID, contactID uuid.UUID, updates UpdateContactRequest) (*Contact, error) {
+	contact, err := s.repo.GetContact(ctx, applicationID, contactID)
+	if err != nil {
+		return nil, err
+	}
+
+	name, role, email, linkedInURL := contact.Name, contact.Role, contact.Email, contact.LinkedInURL
+	if updates.Name != nil {
+		name = *updates.Name
+	}
+	if updates.Role != nil {
+		role = *updates.Role
+	}
+	if updates.Email != nil {
+		email = *updates.Email
+	}
+	if updates.LinkedInURL != nil {
+		linkedInURL = *updates.LinkedInURL
+	}
+	contact.UpdateDetails(name, role, email, linkedInURL)
+
+	if err := s.repo.UpdateContact(ctx, contact); err != nil {
+		return nil, err
+	}
+
+	return contact, nil
+}
+
+func (s *service) DeleteContact(ctx context.Context, applicationID, contactID uuid.UUID) error {
+	return s.repo.DeleteContact(ctx, applicationID, contactID)
+}
+
+// ContactBelongsToApplication reports whether contactID is one of the application's contacts.
+func (s *service) ContactBelongsToApplication(ctx context.Context, applicationID, contactID uuid.UUID) (bool, error) {
+	if _, err := s.repo.GetContact(ctx, applicationID, contactID); err != nil {
+		if domainErr, ok := AsDomainError(err); ok && domainErr.Code == ErrCodeNotFound {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// FindContactIDByEmail returns the ID of the application's contact with this email, or nil if there is none.
+func (s *service) FindContactIDByEmail(ctx context.Context, applicationID uuid.UUID, email string) (*uuid.UUID, error) {
+	email = strings.ToLower(strings.TrimSpace(email))
+	if email == "" {
+		return nil, nil
+	}
+	contact, err := s.repo.FindContactByEmail(ctx, applicationID, email)
+	if err != nil {
+		if domainErr, ok := AsDomainError(err); ok && domainErr.Code == ErrCodeNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &contact.ID, nil
+}
@@ -2,11 +2,14 @@ package jobapplications
 
 import (
 	"context"
+	"errors"
 	"log/slog"
+	"strings"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/google/uuid"
 
+	"woragis-jobs-service/pkg/aiservice"
 	"woragis-jobs-service/pkg/middleware"
 	"woragis-jobs-service/pkg/response"
 )
@@ -19,14 +22,19 @@ type MessageService interface {
 
 type generateCoverLetterPayload struct {
 	MessageID *string `json:"messageId,omitempty"` // Optional: message ID from chat to use as additional context
+	Agent     string  `json:"agent,omitempty"`     // Optional: AI agent from the allowlist, defaults to cover_letter
+}
+
+type refineCoverLetterPayload struct {
+	PreviousCoverLetter string `json:"previousCoverLetter,omitempty"` // Defaults to the application's current cover letter
+	Feedback            string `json:"feedback"`
+	Agent               string `json:"agent,omitempty"`
 }
 
 func (h *handler) GenerateCoverLetter(c *fiber.Ctx) error {
-	applicationID, err := uuid.Parse(c.Params("id"))
+	applicationID, err := middleware.UUIDParam(c, "id")
 	if err != nil {
-		return response.Error(c, fiber.StatusBadRequest, ErrCodeInvalidPayload, fiber.Map{
-			"message": "invalid application id",
-		})
+		return middleware.InvalidUUIDParam(c, "id")
 	}
 
 	// Get user ID from context
@@ -56,6 +64,11 @@ func (h *handler) GenerateCoverLetter(c *fiber.Ctx) error {
 		// Payload is optional, so we ignore parsing errors
 		payload = generateCoverLetterPayload{}
 	}
+	if err := ValidateGenerateCoverLetterPayload(&payload); err != nil {
+		return response.Error(c, fiber.StatusBadRequest, ErrCodeInvalidPayload, fiber.Map{
+			"message": err.Error(),
+		})
+	}
 
 	// Get additional context from message if provided
 	additionalContext := ""
@@ -70,14 +83,177 @@ func (h *handler) GenerateCoverLetter(c *fiber.Ctx) error {
 		}
 	}
 
-	// Check if cover letter generator is available
-	if h.coverLetterGenerator == nil {
+	// Generate the cover letter and store it as the application's next revision
+	updatedApplication, _, err := h.generateCoverLetterRevision(c.Context(), userID, application, additionalContext, payload.Agent, "")
+	var saveErr *coverLetterSaveError
+	if errors.As(err, &saveErr) {
+		h.logger.ErrorContext(c.UserContext(), "failed to update cover letter", slog.Any("error", err))
+		return response.Error(c, fiber.StatusInternalServerError, 500, fiber.Map{
+			"message": "failed to update cover letter",
+		})
+	}
+	if err != nil {
+		return h.coverLetterGenerationError(c, err)
+	}
+
+	return response.Success(c, fiber.StatusOK, updatedApplication)
+}
+
+// RefineCoverLetter revises a cover letter with the user's feedback, e.g. "make it
+// shorter and mention my Kubernetes experience", and stores the result as a new revision.
+func (h *handler) RefineCoverLetter(c *fiber.Ctx) error {
+	applicationID, err := middleware.UUIDParam(c, "id")
+	if err != nil {
+		return middleware.InvalidUUIDParam(c, "id")
+	}
+
+	userID, err := middleware.GetUserIDFromFiberContext(c)
+	if err != nil {
+		return response.Error(c, fiber.StatusUnauthorized, 401, fiber.Map{
+			"message": "authentication required",
+		})
+	}
+
+	var payload refineCoverLetterPayload
+	if err := c.BodyParser(&payload); err != nil {
+		return response.Error(c, fiber.StatusBadRequest, ErrCodeInvalidPayload, fiber.Map{
+			"message": "invalid request payload",
+		})
+	}
+	if err := ValidateRefineCoverLetterPayload(&payload); err != nil {
+		return response.Error(c, fiber.StatusBadRequest, ErrCodeInvalidPayload, fiber.Map{
+			"message": err.Error(),
+		})
+	}
+
+	application, err := h.service.GetJobApplication(c.Context(), applicationID)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+	if application.UserID != userID {
+		return response.Error(c, fiber.StatusForbidden, 403, fiber.Map{
+			"message": "access denied",
+		})
+	}
+
+	previous := payload.PreviousCoverLetter
+	if previous == "" {
+		previous = strings.TrimSpace(application.CoverLetter)
+	}
+	if previous == "" {
+		return response.Error(c, fiber.StatusBadRequest, ErrCodeInvalidPayload, fiber.Map{
+			"message": "previousCoverLetter is required when the application has no cover letter yet",
+		})
+	}
+
+	_, revision, err := h.generateCoverLetterRevision(c.Context(), userID, application, buildRefinementContext(previous, payload.Feedback), payload.Agent, payload.Feedback)
+	var saveErr *coverLetterSaveError
+	if errors.As(err, &saveErr) {
+		h.logger.ErrorContext(c.UserContext(), "failed to save cover letter revision", slog.Any("error", err))
+		return h.handleError(c, saveErr.err)
+	}
+	if err != nil {
+		return h.coverLetterGenerationError(c, err)
+	}
+
+	return response.Success(c, fiber.StatusCreated, revision)
+}
+
+// ListCoverLetterRevisions returns the application's cover letter revisions, newest first.
+func (h *handler) ListCoverLetterRevisions(c *fiber.Ctx) error {
+	userID, err := middleware.GetUserIDFromFiberContext(c)
+	if err != nil {
+		return response.Error(c, fiber.StatusUnauthorized, 401, fiber.Map{
+			"message": "authentication required",
+		})
+	}
+
+	applicationID, err := middleware.UUIDParam(c, "id")
+	if err != nil {
+		return middleware.InvalidUUIDParam(c, "id")
+	}
+
+	revisions, err := h.service.ListCoverLetterRevisions(c.Context(), userID, applicationID)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return response.Success(c, fiber.StatusOK, revisions)
+}
+
+// GetCoverLetterPrompt returns the system prompt and user input a cover letter generation
+// for the application would send to the AI service, so users can see why letters come
+// out the way they do. Nothing is generated or stored.
+func (h *handler) GetCoverLetterPrompt(c *fiber.Ctx) error {
+	applicationID, err := middleware.UUIDParam(c, "id")
+	if err != nil {
+		return middleware.InvalidUUIDParam(c, "id")
+	}
+
+	userID, err := middleware.GetUserIDFromFiberContext(c)
+	if err != nil {
+		return response.Error(c, fiber.StatusUnauthorized, 401, fiber.Map{
+			"message": "authentication required",
+		})
+	}
+
+	payload := generateCoverLetterPayload{Agent: c.Query("agent")}
+	if err := ValidateGenerateCoverLetterPayload(&payload); err != nil {
+		return response.Error(c, fiber.StatusBadRequest, ErrCodeInvalidPayload, fiber.Map{
+			"message": err.Error(),
+		})
+	}
+
+	builder, ok := h.coverLetterGenerator.(CoverLetterPromptBuilder)
+	if !ok {
 		return response.Error(c, fiber.StatusNotImplemented, 501, fiber.Map{
-			"message": "cover letter generation not available",
+			"message": errCoverLetterGeneratorMissing.Error(),
+		})
+	}
+
+	application, err := h.service.GetJobApplication(c.Context(), applicationID)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+	if application.UserID != userID {
+		return response.Error(c, fiber.StatusForbidden, 403, fiber.Map{
+			"message": "access denied",
 		})
 	}
 
-	// Build user profile for cover letter generation
+	profile, jobInfo := coverLetterInputs(application)
+	return response.Success(c, fiber.StatusOK, builder.BuildCoverLetterPrompt(profile, jobInfo, "", payload.Agent))
+}
+
+// errCoverLetterGeneratorMissing is returned by writeCoverLetter when no generator is configured.
+var errCoverLetterGeneratorMissing = errors.New("cover letter generation not available")
+
+// writeCoverLetter asks the generator for a cover letter for the application, waiting for
+// one of the owner's generation slots first.
+func (h *handler) writeCoverLetter(ctx context.Context, application *JobApplication, additionalContext, agent string) (*GeneratedCoverLetter, error) {
+	// Check if cover letter generator is available
+	if h.coverLetterGenerator == nil {
+		return nil, errCoverLetterGeneratorMissing
+	}
+
+	release, err := coverLetterSlots.Acquire(ctx, application.UserID)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	profile, jobInfo := coverLetterInputs(application)
+	return h.coverLetterGenerator.GenerateCoverLetterWithContext(
+		ctx,
+		profile,
+		jobInfo,
+		additionalContext,
+		agent,
+	)
+}
+
+// coverLetterInputs builds the profile and job information a cover letter is written from.
+func coverLetterInputs(application *JobApplication) (UserProfile, JobInfo) {
 	profile := UserProfile{
 		Projects:          []ProjectInfo{},
 		Posts:             []PostInfo{},
@@ -100,31 +276,23 @@ func (h *handler) GenerateCoverLetter(c *fiber.Ctx) error {
 		Requirements:   []string{}, // Could parse from job description in the future
 	}
 
-	// Generate cover letter
-	coverLetter, err := h.coverLetterGenerator.GenerateCoverLetterWithContext(
-		c.Context(),
-		profile,
-		jobInfo,
-		additionalContext,
-	)
-	if err != nil {
-		h.logger.Error("failed to generate cover letter", slog.Any("error", err))
-		return response.Error(c, fiber.StatusInternalServerError, 500, fiber.Map{
-			"message": "failed to generate cover letter",
-		})
-	}
+	return profile, jobInfo
+}
 
-	// Update job application with generated cover letter
-	updates := UpdateJobApplicationRequest{
-		CoverLetter: &coverLetter,
+// coverLetterGenerationError maps a writeCoverLetter failure to a response.
+func (h *handler) coverLetterGenerationError(c *fiber.Ctx, err error) error {
+	if errors.Is(err, errCoverLetterGeneratorMissing) {
+		return response.Error(c, fiber.StatusNotImplemented, 501, fiber.Map{
+			"message": err.Error(),
+		})
 	}
-	updatedApplication, err := h.service.UpdateJobApplication(c.Context(), applicationID, updates)
-	if err != nil {
-		h.logger.Error("failed to update cover letter", slog.Any("error", err))
-		return response.Error(c, fiber.StatusInternalServerError, 500, fiber.Map{
-			"message": "failed to update cover letter",
+	h.logger.ErrorContext(c.UserContext(), "failed to generate cover letter", slog.Any("error", err))
+	if aiservice.IsUnavailable(err) {
+		return response.Error(c, fiber.StatusServiceUnavailable, ErrCodeAIServiceFailure, fiber.Map{
+			"message": ErrAIServiceUnavailable,
 		})
 	}
-
-	return response.Success(c, fiber.StatusOK, updatedApplication)
+	return response.Error(c, fiber.StatusInternalServerError, 500, fiber.Map{
+		"message": "failed to generate cover letter",
+	})
 }
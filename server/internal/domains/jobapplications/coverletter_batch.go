@@ -0,0 +1,203 @@
+package jobapplications
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"woragis-jobs-service/pkg/aiservice"
+	"woragis-jobs-service/pkg/middleware"
+	"woragis-jobs-service/pkg/response"
+	"woragis-jobs-service/pkg/security"
+)
+
+// maxConcurrentCoverLettersPerUser caps how many cover letters are generated at once for
+// one user, across single and batch requests, so a batch cannot monopolize the AI service.
+const maxConcurrentCoverLettersPerUser = 3
+
+// coverLetterSlots limits concurrent cover letter generation per user within this process.
+var coverLetterSlots = newUserSemaphore(maxConcurrentCoverLettersPerUser)
+
+// Outcomes of one application in a batch cover letter generation.
+const (
+	CoverLetterBatchGenerated = "generated"
+	CoverLetterBatchFailed    = "failed"
+)
+
+// CoverLetterBatchResult is the outcome of one application in a batch generation.
+type CoverLetterBatchResult struct {
+	Status   string               `json:"status"`
+	Revision *CoverLetterRevision `json:"revision,omitempty"`
+	Error    string               `json:"error,omitempty"`
+}
+
+type coverLetterBatchPayload struct {
+	ApplicationIDs []string `json:"applicationIds"`
+	Agent          string   `json:"agent,omitempty"` // Defaults to cover_letter, used for every letter
+}
+
+// GenerateCoverLetterBatch writes a tailored cover letter for each listed application and
+// stores it as the application's next revision. Every letter is charged against the
+// caller's rate limit budget like a single generate-cover-letter request, and letters
+// that fail are reported per application without aborting the rest.
+func (h *handler) GenerateCoverLetterBatch(c *fiber.Ctx) error {
+	userID, err := middleware.GetUserIDFromFiberContext(c)
+	if err != nil {
+		return response.Error(c, fiber.StatusUnauthorized, 401, fiber.Map{
+			"message": "authentication required",
+		})
+	}
+
+	var payload coverLetterBatchPayload
+	if err := c.BodyParser(&payload); err != nil {
+		return response.Error(c, fiber.StatusBadRequest, ErrCodeInvalidPayload, fiber.Map{
+			"message": "invalid request payload",
+		})
+	}
+
+	applicationIDs, err := ValidateCoverLetterBatchPayload(&payload)
+	if err != nil {
+		return response.Error(c, fiber.StatusBadRequest, ErrCodeInvalidPayload, fiber.Map{
+			"message": err.Error(),
+		})
+	}
+
+	if h.coverLetterGenerator == nil {
+		return h.coverLetterGenerationError(c, errCoverLetterGeneratorMissing)
+	}
+
+	applications, err := h.service.BatchGetJobApplications(c.Context(), userID, applicationIDs)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+	owned := make(map[uuid.UUID]*JobApplication, len(applications))
+	for i := range applications {
+		owned[applications[i].ID] = &applications[i]
+	}
+
+	ctx := c.Context()
+	charge := security.RateLimitChargerFrom(c)
+	// Letters cost what POST /job-applications/:id/generate-cover-letter costs
+	applicationsPath := strings.TrimSuffix(c.Path(), "cover-letters/batch")
+
+	results := make(map[string]CoverLetterBatchResult, len(applicationIDs))
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for _, applicationID := range applicationIDs {
+		application, ok := owned[applicationID]
+		if !ok {
+			mu.Lock()
+			results[applicationID.String()] = CoverLetterBatchResult{Status: CoverLetterBatchFailed, Error: ErrApplicationNotFound}
+			mu.Unlock()
+			continue
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			result := h.generateBatchCoverLetter(ctx, userID, application, payload.Agent, func() bool {
+				return charge(ctx, fiber.MethodPost, applicationsPath+application.ID.String()+"/generate-cover-letter")
+			})
+			mu.Lock()
+			results[application.ID.String()] = result
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	generated := 0
+	for _, result := range results {
+		if result.Status == CoverLetterBatchGenerated {
+			generated++
+		}
+	}
+
+	return response.Success(c, fiber.StatusOK, fiber.Map{
+		"results":   results,
+		"generated": generated,
+		"failed":    len(results) - generated,
+	})
+}
+
+// generateBatchCoverLetter charges, writes and stores one letter of a batch.
+func (h *handler) generateBatchCoverLetter(ctx context.Context, userID uuid.UUID, application *JobApplication, agent string, charge func() bool) CoverLetterBatchResult {
+	if !charge() {
+		return CoverLetterBatchResult{Status: CoverLetterBatchFailed, Error: "rate limit exceeded"}
+	}
+
+	letter, err := h.writeCoverLetter(ctx, application, "", agent)
+	if err != nil {
+		if h.logger != nil {
+			h.logger.WarnContext(ctx, "failed to generate batch cover letter", "application_id", application.ID.String(), "error", err)
+		}
+		if aiservice.IsUnavailable(err) {
+			return CoverLetterBatchResult{Status: CoverLetterBatchFailed, Error: ErrAIServiceUnavailable}
+		}
+		return CoverLetterBatchResult{Status: CoverLetterBatchFailed, Error: "failed to generate cover letter"}
+	}
+
+	_, revision, err := h.service.SaveCoverLetterRevision(ctx, userID, application.ID, *letter, "")
+	if err != nil {
+		if h.logger != nil {
+			h.logger.WarnContext(ctx, "failed to save batch cover letter", "application_id", application.ID.String(), "error", err)
+		}
+		return CoverLetterBatchResult{Status: CoverLetterBatchFailed, Error: "failed to save cover letter"}
+	}
+	return CoverLetterBatchResult{Status: CoverLetterBatchGenerated, Revision: revision}
+}
+
+// userSemaphore hands out at most limit concurrent slots per user. Entries are dropped
+// once nobody holds or waits for a user's slots.
+type userSemaphore struct {
+	mu    sync.Mutex
+	limit int
+	users map[uuid.UUID]*userSlots
+}
+
+type userSlots struct {
+	sem  chan struct{}
+	refs int // holders and waiters
+}
+
+func newUserSemaphore(limit int) *userSemaphore {
+	return &userSemaphore{limit: limit, users: make(map[uuid.UUID]*userSlots)}
+}
+
+// Acquire waits for one of the user's slots and returns the func that frees it, or the
+// context's error if it ends first.
+func (s *userSemaphore) Acquire(ctx context.Context, userID uuid.UUID) (func(), error) {
+	s.mu.Lock()
+	slots, ok := s.users[userID]
+	if !ok {
+		slots = &userSlots{sem: make(chan struct{}, s.limit)}
+		s.users[userID] = slots
+	}
+	slots.refs++
+	s.mu.Unlock()
+
+	select {
+	case slots.sem <- struct{}{}:
+		var once sync.Once
+		return func() {
+			once.Do(func() {
+				<-slots.sem
+				s.leave(userID, slots)
+			})
+		}, nil
+	case <-ctx.Done():
+		s.leave(userID, slots)
+		return nil, ctx.Err()
+	}
+}
+
+func (s *userSemaphore) leave(userID uuid.UUID, slots *userSlots) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	slots.refs--
+	if slots.refs == 0 {
+		delete(s.users, userID)
+	}
+}
@@ -0,0 +1,150 @@
+package jobapplications
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeBatchCoverLetterService serves the user's applications and records saved letters.
+type fakeBatchCoverLetterService struct {
+	Service
+	applications []JobApplication
+	mu           sync.Mutex
+	saved        map[uuid.UUID]string
+}
+
+func (s *fakeBatchCoverLetterService) BatchGetJobApplications(_ context.Context, userID uuid.UUID, applicationIDs []uuid.UUID) ([]JobApplication, error) {
+	var result []JobApplication
+	for _, application := range s.applications {
+		if application.UserID == userID {
+			result = append(result, application)
+		}
+	}
+	return result, nil
+}
+
+func (s *fakeBatchCoverLetterService) SaveCoverLetterRevision(_ context.Context, _, applicationID uuid.UUID, letter GeneratedCoverLetter, _ string) (*JobApplication, *CoverLetterRevision, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.saved[applicationID] = letter.Content
+	return nil, &CoverLetterRevision{JobApplicationID: applicationID, Revision: 1, Content: letter.Content}, nil
+}
+
+// companyCoverLetterGenerator writes a letter naming the company, failing for one company.
+type companyCoverLetterGenerator struct {
+	failFor string
+}
+
+func (g *companyCoverLetterGenerator) GenerateCoverLetterWithContext(_ context.Context, _ UserProfile, job JobInfo, _ string, _ string) (*GeneratedCoverLetter, error) {
+	if job.CompanyName == g.failFor {
+		return nil, errors.New("model timeout")
+	}
+	return &GeneratedCoverLetter{Content: "Dear " + job.CompanyName}, nil
+}
+
+func TestGenerateCoverLetterBatch_ReportsFailuresPerApplication(t *testing.T) {
+	userID := uuid.New()
+	acme := JobApplication{ID: uuid.New(), UserID: userID, CompanyName: "Acme"}
+	globex := JobApplication{ID: uuid.New(), UserID: userID, CompanyName: "Globex"}
+	foreign := JobApplication{ID: uuid.New(), UserID: uuid.New(), CompanyName: "Initech"}
+	svc := &fakeBatchCoverLetterService{applications: []JobApplication{acme, globex, foreign}, saved: map[uuid.UUID]string{}}
+
+	app := fiber.New()
+	app.Use(func(c *fiber.Ctx) error {
+		c.Locals("userID", userID)
+		return c.Next()
+	})
+	h := NewHandlerWithDependencies(svc, nil, nil, &companyCoverLetterGenerator{failFor: "Globex"}, nil)
+	app.Post("/job-applications/cover-letters/batch", h.GenerateCoverLetterBatch)
+
+	body := `{"applicationIds":["` + acme.ID.String() + `","` + globex.ID.String() + `","` + foreign.ID.String() + `"]}`
+	req := httptest.NewRequest("POST", "/job-applications/cover-letters/batch", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := app.Test(req)
+	require.NoError(t, err)
+	require.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+	var decoded struct {
+		Data struct {
+			Results   map[string]CoverLetterBatchResult `json:"results"`
+			Generated int                               `json:"generated"`
+			Failed    int                               `json:"failed"`
+		} `json:"data"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&decoded))
+
+	results := decoded.Data.Results
+	require.Len(t, results, 3)
+	assert.Equal(t, CoverLetterBatchGenerated, results[acme.ID.String()].Status)
+	assert.Equal(t, "Dear Acme", results[acme.ID.String()].Revision.Content)
+	assert.Equal(t, CoverLetterBatchResult{Status: CoverLetterBatchFailed, Error: "failed to generate cover letter"}, results[globex.ID.String()])
+	assert.Equal(t, CoverLetterBatchResult{Status: CoverLetterBatchFailed, Error: ErrApplicationNotFound}, results[foreign.ID.String()])
+	assert.Equal(t, 1, decoded.Data.Generated)
+	assert.Equal(t, 2, decoded.Data.Failed)
+	assert.Equal(t, map[uuid.UUID]string{acme.ID: "Dear Acme"}, svc.saved)
+}
+
+func TestGenerateBatchCoverLetter_RateLimited(t *testing.T) {
+	h := &handler{coverLetterGenerator: &companyCoverLetterGenerator{}}
+	result := h.generateBatchCoverLetter(context.Background(), uuid.New(), &JobApplication{ID: uuid.New()}, DefaultCoverLetterAgent, func() bool { return false })
+	assert.Equal(t, CoverLetterBatchResult{Status: CoverLetterBatchFailed, Error: "rate limit exceeded"}, result)
+}
+
+func TestValidateCoverLetterBatchPayload(t *testing.T) {
+	id := uuid.NewString()
+	payload := &coverLetterBatchPayload{ApplicationIDs: []string{id, id}}
+	ids, err := ValidateCoverLetterBatchPayload(payload)
+	require.NoError(t, err)
+	assert.Len(t, ids, 1, "duplicates are generated once")
+	assert.Equal(t, DefaultCoverLetterAgent, payload.Agent)
+
+	_, err = ValidateCoverLetterBatchPayload(&coverLetterBatchPayload{})
+	assert.ErrorContains(t, err, "at least one")
+
+	tooMany := make([]string, maxCoverLetterBatchSize+1)
+	for i := range tooMany {
+		tooMany[i] = uuid.NewString()
+	}
+	_, err = ValidateCoverLetterBatchPayload(&coverLetterBatchPayload{ApplicationIDs: tooMany})
+	assert.ErrorContains(t, err, "too many")
+
+	_, err = ValidateCoverLetterBatchPayload(&coverLetterBatchPayload{ApplicationIDs: []string{id}, Agent: "pirate"})
+	assert.ErrorContains(t, err, "agent")
+}
+
+func TestUserSemaphore(t *testing.T) {
+	sem := newUserSemaphore(1)
+	userID := uuid.New()
+
+	release, err := sem.Acquire(context.Background(), userID)
+	require.NoError(t, err)
+
+	// Other users are not affected
+	releaseOther, err := sem.Acquire(context.Background(), uuid.New())
+	require.NoError(t, err)
+	releaseOther()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err = sem.Acquire(ctx, userID)
+	assert.ErrorIs(t, err, context.DeadlineExceeded, "the user's only slot is taken")
+
+	release()
+	release() // releasing twice is harmless
+	again, err := sem.Acquire(context.Background(), userID)
+	require.NoError(t, err)
+	again()
+
+	assert.Empty(t, sem.users, "idle users are forgotten")
+}
@@ -0,0 +1,79 @@
+package jobapplications
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	"github.com/google/uuid"
+	"golang.org/x/sync/singleflight"
+)
+
+// coverLetterFlights coalesces identical cover letter generations within this process,
+// so a double-clicked "generate" makes one AI call and stores one revision.
+var coverLetterFlights singleflight.Group
+
+// coverLetterFlight is the shared outcome of a coalesced generation.
+type coverLetterFlight struct {
+	application *JobApplication
+	revision    *CoverLetterRevision
+}
+
+// coverLetterSaveError marks a failure to store a generated letter, as opposed to a
+// failure to generate it.
+type coverLetterSaveError struct {
+	err error
+}
+
+func (e *coverLetterSaveError) Error() string { return e.err.Error() }
+
+func (e *coverLetterSaveError) Unwrap() error { return e.err }
+
+// coverLetterFlightKey hashes everything a generation depends on: the owner, the
+// application and the posting fields the prompt is built from, the agent, the additional
+// context and the feedback stored with the revision.
+func coverLetterFlightKey(userID uuid.UUID, application *JobApplication, additionalContext, agent, feedback string) string {
+	_, job := coverLetterInputs(application)
+	parts := []string{
+		userID.String(),
+		application.ID.String(),
+		job.CompanyName,
+		job.JobTitle,
+		job.JobDescription,
+		job.Location,
+		agent,
+		additionalContext,
+		feedback,
+	}
+	sum := sha256.Sum256([]byte(strings.Join(parts, "\x00")))
+	return hex.EncodeToString(sum[:])
+}
+
+// generateCoverLetterRevision writes a cover letter for the application and stores it as
+// its next revision. Concurrent calls with identical inputs share one generation and one
+// revision. The shared call runs detached from the caller's cancellation so one client
+// going away does not fail the others. Save failures are returned as *coverLetterSaveError.
+func (h *handler) generateCoverLetterRevision(ctx context.Context, userID uuid.UUID, application *JobApplication, additionalContext, agent, feedback string) (*JobApplication, *CoverLetterRevision, error) {
+	key := coverLetterFlightKey(userID, application, additionalContext, agent, feedback)
+	result, err, shared := coverLetterFlights.Do(key, func() (interface{}, error) {
+		flightCtx := context.WithoutCancel(ctx)
+		letter, err := h.writeCoverLetter(flightCtx, application, additionalContext, agent)
+		if err != nil {
+			return nil, err
+		}
+		updated, revision, err := h.service.SaveCoverLetterRevision(flightCtx, userID, application.ID, *letter, feedback)
+		if err != nil {
+			return nil, &coverLetterSaveError{err: err}
+		}
+		return &coverLetterFlight{application: updated, revision: revision}, nil
+	})
+	if shared && h.logger != nil {
+		h.logger.DebugContext(ctx, "coalesced identical cover letter generation", "application_id", application.ID.String())
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	flight := result.(*coverLetterFlight)
+	return flight.application, flight.revision, nil
+}
@@ -0,0 +1,90 @@
+package jobapplications
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// blockingCoverLetterGenerator counts calls and holds each one until release is closed.
+type blockingCoverLetterGenerator struct {
+	calls   atomic.Int32
+	started chan struct{}
+	release chan struct{}
+}
+
+func (g *blockingCoverLetterGenerator) GenerateCoverLetterWithContext(_ context.Context, _ UserProfile, _ JobInfo, _ string, _ string) (*GeneratedCoverLetter, error) {
+	if g.calls.Add(1) == 1 {
+		close(g.started)
+	}
+	<-g.release
+	return &GeneratedCoverLetter{Content: "Dear Acme, ..."}, nil
+}
+
+// countingRevisionService counts stored revisions.
+type countingRevisionService struct {
+	Service
+	saves atomic.Int32
+}
+
+func (s *countingRevisionService) SaveCoverLetterRevision(_ context.Context, _, _ uuid.UUID, letter GeneratedCoverLetter, _ string) (*JobApplication, *CoverLetterRevision, error) {
+	s.saves.Add(1)
+	return &JobApplication{CoverLetter: letter.Content}, &CoverLetterRevision{Content: letter.Content}, nil
+}
+
+func TestGenerateCoverLetterRevision_CoalescesIdenticalRequests(t *testing.T) {
+	userID := uuid.New()
+	application := &JobApplication{ID: uuid.New(), UserID: userID, CompanyName: "Acme", JobTitle: "Engineer"}
+	svc := &countingRevisionService{}
+	generator := &blockingCoverLetterGenerator{started: make(chan struct{}), release: make(chan struct{})}
+	h := NewHandlerWithDependencies(svc, nil, nil, generator, nil).(*handler)
+
+	const requests = 3
+	revisions := make([]*CoverLetterRevision, requests)
+	var wg sync.WaitGroup
+	generate := func(i int) {
+		defer wg.Done()
+		_, revision, err := h.generateCoverLetterRevision(context.Background(), userID, application, "", DefaultCoverLetterAgent, "")
+		assert.NoError(t, err)
+		revisions[i] = revision
+	}
+
+	wg.Add(requests)
+	go generate(0)
+	<-generator.started
+	for i := 1; i < requests; i++ {
+		go generate(i)
+	}
+	// Give the duplicates time to join the in-flight generation before it finishes.
+	time.Sleep(50 * time.Millisecond)
+	close(generator.release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), generator.calls.Load())
+	assert.Equal(t, int32(1), svc.saves.Load())
+	for _, revision := range revisions {
+		require.NotNil(t, revision)
+		assert.Same(t, revisions[0], revision)
+	}
+}
+
+func TestCoverLetterFlightKey(t *testing.T) {
+	userID := uuid.New()
+	application := &JobApplication{ID: uuid.New(), UserID: userID, CompanyName: "Acme", JobTitle: "Engineer"}
+	key := coverLetterFlightKey(userID, application, "", DefaultCoverLetterAgent, "")
+
+	assert.Equal(t, key, coverLetterFlightKey(userID, application, "", DefaultCoverLetterAgent, ""))
+	assert.NotEqual(t, key, coverLetterFlightKey(uuid.New(), application, "", DefaultCoverLetterAgent, ""))
+	assert.NotEqual(t, key, coverLetterFlightKey(userID, application, "", "cover_letter_technical", ""))
+	assert.NotEqual(t, key, coverLetterFlightKey(userID, application, "", DefaultCoverLetterAgent, "shorter"))
+
+	edited := *application
+	edited.JobTitle = "Senior Engineer"
+	assert.NotEqual(t, key, coverLetterFlightKey(userID, &edited, "", DefaultCoverLetterAgent, ""))
+}
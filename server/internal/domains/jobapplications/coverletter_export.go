@@ -0,0 +1,161 @@
+package jobapplications
+
+import (
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"woragis-jobs-service/pkg/middleware"
+	"woragis-jobs-service/pkg/pdf"
+	"woragis-jobs-service/pkg/response"
+)
+
+// Cover letter export formats, chosen with ?format= or the Accept header
+const (
+	CoverLetterFormatJSON = "json"
+	CoverLetterFormatText = "text"
+	CoverLetterFormatPDF  = "pdf"
+)
+
+// coverLetterMediaTypes maps the Accept header offers to export formats, in order of
+// preference when the client accepts several equally.
+var coverLetterMediaTypes = []struct {
+	mediaType string
+	format    string
+}{
+	{fiber.MIMEApplicationJSON, CoverLetterFormatJSON},
+	{fiber.MIMETextPlain, CoverLetterFormatText},
+	{"application/pdf", CoverLetterFormatPDF},
+}
+
+// GetCoverLetter returns the application's current cover letter as JSON (the default),
+// plain text, or a PDF download headed with the user's name and today's date.
+func (h *handler) GetCoverLetter(c *fiber.Ctx) error {
+	userID, err := middleware.GetUserIDFromFiberContext(c)
+	if err != nil {
+		return response.Error(c, fiber.StatusUnauthorized, 401, fiber.Map{
+			"message": "authentication required",
+		})
+	}
+
+	applicationID, err := middleware.UUIDParam(c, "id")
+	if err != nil {
+		return middleware.InvalidUUIDParam(c, "id")
+	}
+
+	format, ok := negotiateCoverLetterFormat(c)
+	if !ok {
+		return response.Error(c, fiber.StatusBadRequest, ErrCodeInvalidPayload, fiber.Map{
+			"message": "format must be one of json, text, pdf",
+		})
+	}
+
+	application, err := h.service.GetCoverLetter(c.Context(), userID, applicationID)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	switch format {
+	case CoverLetterFormatText:
+		c.Set(fiber.HeaderContentType, fiber.MIMETextPlainCharsetUTF8)
+		return c.SendString(application.CoverLetter)
+	case CoverLetterFormatPDF:
+		// The name is optional: tokens issued before it was added to the claims lack it
+		userName, _ := middleware.GetUserNameFromFiberContext(c)
+		c.Set(fiber.HeaderContentType, "application/pdf")
+		c.Set(fiber.HeaderContentDisposition, `attachment; filename="`+coverLetterFileName(application)+`"`)
+		return c.Send(renderCoverLetterPDF(application, userName, time.Now().UTC()))
+	default:
+		return response.Success(c, fiber.StatusOK, fiber.Map{
+			"applicationId": application.ID,
+			"companyName":   application.CompanyName,
+			"jobTitle":      application.JobTitle,
+			"coverLetter":   application.CoverLetter,
+		})
+	}
+}
+
+// negotiateCoverLetterFormat picks the export format from ?format=, falling back to the
+// Accept header and then JSON. It reports false for an unknown ?format= value.
+func negotiateCoverLetterFormat(c *fiber.Ctx) (string, bool) {
+	c.Vary(fiber.HeaderAccept)
+
+	if format := strings.ToLower(strings.TrimSpace(c.Query("format"))); format != "" {
+		switch format {
+		case CoverLetterFormatJSON, CoverLetterFormatText, CoverLetterFormatPDF:
+			return format, true
+		}
+		return "", false
+	}
+
+	offers := make([]string, len(coverLetterMediaTypes))
+	for i, media := range coverLetterMediaTypes {
+		offers[i] = media.mediaType
+	}
+	accepted := c.Accepts(offers...)
+	for _, media := range coverLetterMediaTypes {
+		if media.mediaType == accepted {
+			return media.format, true
+		}
+	}
+	return CoverLetterFormatJSON, true
+}
+
+// renderCoverLetterPDF lays the letter out under a header with the user's name (when
+// known) and the date.
+func renderCoverLetterPDF(application *JobApplication, userName string, now time.Time) []byte {
+	header := now.Format("January 2, 2006")
+	if userName = strings.TrimSpace(userName); userName != "" {
+		header = userName + "\n" + header
+	}
+
+	paragraphs := []string{header}
+	for _, paragraph := range strings.Split(strings.ReplaceAll(application.CoverLetter, "\r\n", "\n"), "\n\n") {
+		if paragraph = strings.TrimSpace(paragraph); paragraph != "" {
+			paragraphs = append(paragraphs, paragraph)
+		}
+	}
+
+	return pdf.Document{
+		Title:      "Cover letter - " + application.JobTitle + " at " + application.CompanyName,
+		Author:     userName,
+		Paragraphs: paragraphs,
+		CreatedAt:  now,
+	}.Render()
+}
+
+// coverLetterFileName builds an ASCII download name such as
+// "cover-letter-acme-platform-engineer.pdf".
+func coverLetterFileName(application *JobApplication) string {
+	name := "cover-letter"
+	for _, part := range []string{application.CompanyName, application.JobTitle} {
+		if slug := fileNameSlug(part); slug != "" {
+			name += "-" + slug
+		}
+	}
+	return name + ".pdf"
+}
+
+// fileNameSlug lowercases s and keeps ASCII letters and digits, collapsing everything
+// else into single hyphens.
+func fileNameSlug(s string) string {
+	var b strings.Builder
+	pendingHyphen := false
+	for _, r := range strings.ToLower(s) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			if pendingHyphen && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			pendingHyphen = false
+			b.WriteRune(r)
+			continue
+		}
+		pendingHyphen = true
+	}
+	slug := b.String()
+	if len(slug) > 60 {
+		slug = strings.TrimRight(slug[:60], "-")
+	}
+	return slug
+}
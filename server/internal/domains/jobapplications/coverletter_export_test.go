@@ -0,0 +1,110 @@
+package jobapplications
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newCoverLetterExportApp(application *JobApplication, userID uuid.UUID, userName string) *fiber.App {
+	app := fiber.New()
+	app.Use(func(c *fiber.Ctx) error {
+		c.Locals("userID", userID)
+		if userName != "" {
+			c.Locals("userName", userName)
+		}
+		return c.Next()
+	})
+	svc := NewService(&fakeDuplicateRepo{application: application}, nil, nil)
+	app.Get("/job-applications/:id/cover-letter", NewHandler(svc, nil).GetCoverLetter)
+	return app
+}
+
+func TestGetCoverLetter_Formats(t *testing.T) {
+	userID := uuid.New()
+	application := &JobApplication{
+		ID: uuid.New(), UserID: userID, CompanyName: "Acme, Inc.", JobTitle: "Platform Engineer",
+		CoverLetter: "Dear Acme team,\n\nI would love to help run your platform.",
+	}
+	app := newCoverLetterExportApp(application, userID, "Ada Lovelace")
+	path := "/job-applications/" + application.ID.String() + "/cover-letter"
+
+	resp, err := app.Test(httptest.NewRequest("GET", path, nil))
+	require.NoError(t, err)
+	require.Equal(t, fiber.StatusOK, resp.StatusCode)
+	assert.Contains(t, resp.Header.Get("Vary"), "Accept")
+	var decoded struct {
+		Data struct {
+			CoverLetter string `json:"coverLetter"`
+		} `json:"data"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&decoded))
+	assert.Equal(t, application.CoverLetter, decoded.Data.CoverLetter, "JSON is the default")
+
+	req := httptest.NewRequest("GET", path, nil)
+	req.Header.Set("Accept", "text/plain")
+	resp, err = app.Test(req)
+	require.NoError(t, err)
+	require.Equal(t, fiber.StatusOK, resp.StatusCode)
+	assert.Equal(t, fiber.MIMETextPlainCharsetUTF8, resp.Header.Get("Content-Type"))
+	body, _ := io.ReadAll(resp.Body)
+	assert.Equal(t, application.CoverLetter, string(body))
+
+	pdfRequests := map[string]func() *http.Request{
+		"accept header": func() *http.Request {
+			r := httptest.NewRequest("GET", path, nil)
+			r.Header.Set("Accept", "application/pdf")
+			return r
+		},
+		"format query": func() *http.Request {
+			r := httptest.NewRequest("GET", path+"?format=pdf", nil)
+			r.Header.Set("Accept", "application/json")
+			return r
+		},
+	}
+	for name, build := range pdfRequests {
+		resp, err = app.Test(build())
+		require.NoError(t, err, name)
+		require.Equal(t, fiber.StatusOK, resp.StatusCode, name)
+		assert.Equal(t, "application/pdf", resp.Header.Get("Content-Type"), name)
+		assert.Equal(t, `attachment; filename="cover-letter-acme-inc-platform-engineer.pdf"`, resp.Header.Get("Content-Disposition"), name)
+		body, _ = io.ReadAll(resp.Body)
+		assert.Contains(t, string(body), "%PDF-", name)
+		assert.Contains(t, string(body), "(Ada Lovelace) Tj", name)
+		assert.Contains(t, string(body), "("+time.Now().UTC().Format("January 2, 2006")+") Tj", name)
+		assert.Contains(t, string(body), "(I would love to help run your platform.) Tj", name)
+	}
+}
+
+func TestGetCoverLetter_Errors(t *testing.T) {
+	userID := uuid.New()
+	application := &JobApplication{ID: uuid.New(), UserID: userID, CompanyName: "Acme", CoverLetter: "Dear Acme"}
+	path := "/job-applications/" + application.ID.String() + "/cover-letter"
+
+	resp, err := newCoverLetterExportApp(application, userID, "").Test(httptest.NewRequest("GET", path+"?format=docx", nil))
+	require.NoError(t, err)
+	assert.Equal(t, fiber.StatusBadRequest, resp.StatusCode)
+
+	resp, err = newCoverLetterExportApp(application, uuid.New(), "").Test(httptest.NewRequest("GET", path, nil))
+	require.NoError(t, err)
+	assert.Equal(t, fiber.StatusNotFound, resp.StatusCode, "another user's application")
+
+	empty := &JobApplication{ID: application.ID, UserID: userID, CompanyName: "Acme"}
+	resp, err = newCoverLetterExportApp(empty, userID, "").Test(httptest.NewRequest("GET", path+"?format=pdf", nil))
+	require.NoError(t, err)
+	assert.Equal(t, fiber.StatusNotFound, resp.StatusCode, "no cover letter generated yet")
+}
+
+func TestCoverLetterFileName(t *testing.T) {
+	assert.Equal(t, "cover-letter-acme-inc-senior-go-engineer-remote.pdf",
+		coverLetterFileName(&JobApplication{CompanyName: "  Acme, Inc. ", JobTitle: "Senior Go Engineer (Remote)"}))
+	assert.Equal(t, "cover-letter.pdf", coverLetterFileName(&JobApplication{CompanyName: "日本"}))
+}
@@ -9,63 +9,198 @@ import (
 	"woragis-jobs-service/pkg/aiservice"
 )
 
+// DefaultCoverLetterAgent is the AI service agent used when a request does not pick one
+const DefaultCoverLetterAgent = "cover_letter"
+
+// defaultCoverLetterTemperature balances creativity for professional writing; it applies
+// to agents without a configured default temperature
+const defaultCoverLetterTemperature = 0.7
+
+// coverLetterAgents is the allowlist of AI service agents a request may pick
+var coverLetterAgents = map[string]bool{
+	DefaultCoverLetterAgent:  true,
+	"cover_letter_technical": true, // Emphasizes hands-on engineering work
+	"cover_letter_executive": true, // Emphasizes leadership and business impact
+}
+
+// IsValidCoverLetterAgent reports whether agent is on the cover letter allowlist
+func IsValidCoverLetterAgent(agent string) bool {
+	return coverLetterAgents[agent]
+}
+
+// CoverLetterPrompt is the request a cover letter generation sends to the AI service
+type CoverLetterPrompt struct {
+	Agent        string  `json:"agent"`
+	SystemPrompt string  `json:"systemPrompt"`
+	UserInput    string  `json:"userInput"`
+	Temperature  float64 `json:"temperature"`
+	MaxTokens    int     `json:"maxTokens"`
+}
+
+// CoverLetterPromptBuilder is implemented by generators that can show the prompt they
+// would send for a cover letter
+type CoverLetterPromptBuilder interface {
+	BuildCoverLetterPrompt(profile UserProfile, job JobInfo, additionalContext, agent string) CoverLetterPrompt
+}
+
+// defaultPromptLogMaxChars is how much of each prompt is logged when no limit is set
+const defaultPromptLogMaxChars = 500
+
+// PromptLogging controls debug logging of the prompts sent to the AI service. Prompts
+// carry the whole job description and candidate profile, so logging is opt-in, cut
+// short unless Full is set, and never above debug level
+type PromptLogging struct {
+	Enabled  bool // Log the system prompt, user input and response length
+	MaxChars int  // Characters kept of each prompt; 0 uses defaultPromptLogMaxChars
+	Full     bool // Debug mode: log the prompts untruncated
+}
+
+// clip shortens a prompt to the configured length, marking where it was cut
+func (p PromptLogging) clip(prompt string) string {
+	if p.Full {
+		return prompt
+	}
+	maxChars := p.MaxChars
+	if maxChars <= 0 {
+		maxChars = defaultPromptLogMaxChars
+	}
+	if clipped := truncateRunes(prompt, maxChars); clipped != prompt {
+		return clipped + "…"
+	}
+	return prompt
+}
+
+// CoverLetterGeneratorOptions configures an AI service cover letter generator
+type CoverLetterGeneratorOptions struct {
+	// Sanitizer cleans the AI output; nil returns it verbatim
+	Sanitizer     *CoverLetterSanitizer
+	PromptLogging PromptLogging
+}
+
 // AIServiceCoverLetterGenerator implements CoverLetterGenerator using the AI service
 type AIServiceCoverLetterGenerator struct {
-	client *aiservice.Client
-	logger *slog.Logger
+	client        *aiservice.Client
+	sanitizer     *CoverLetterSanitizer
+	promptLogging PromptLogging
+	logger        *slog.Logger
 }
 
 // NewAIServiceCoverLetterGenerator creates a new AI service cover letter generator
+// that strips the default preamble patterns
 func NewAIServiceCoverLetterGenerator(client *aiservice.Client, logger *slog.Logger) CoverLetterGenerator {
+	sanitizer, _ := NewCoverLetterSanitizer(nil)
+	return NewAIServiceCoverLetterGeneratorWithSanitizer(client, sanitizer, logger)
+}
+
+// NewAIServiceCoverLetterGeneratorWithSanitizer creates a cover letter generator that
+// cleans AI output with sanitizer; a nil sanitizer returns the output verbatim
+func NewAIServiceCoverLetterGeneratorWithSanitizer(client *aiservice.Client, sanitizer *CoverLetterSanitizer, logger *slog.Logger) CoverLetterGenerator {
+	return NewAIServiceCoverLetterGeneratorWithOptions(client, CoverLetterGeneratorOptions{Sanitizer: sanitizer}, logger)
+}
+
+// NewAIServiceCoverLetterGeneratorWithOptions creates a cover letter generator with the
+// given sanitizer and prompt logging
+func NewAIServiceCoverLetterGeneratorWithOptions(client *aiservice.Client, opts CoverLetterGeneratorOptions, logger *slog.Logger) CoverLetterGenerator {
 	return &AIServiceCoverLetterGenerator{
-		client: client,
-		logger: logger,
+		client:        client,
+		sanitizer:     opts.Sanitizer,
+		promptLogging: opts.PromptLogging,
+		logger:        logger,
 	}
 }
 
-// GenerateCoverLetterWithContext generates a cover letter using the AI service
+// GenerateCoverLetterWithContext generates a cover letter using the AI service.
+// The raw output is kept in Original when sanitizing changed it.
 func (g *AIServiceCoverLetterGenerator) GenerateCoverLetterWithContext(
 	ctx context.Context,
 	profile UserProfile,
 	job JobInfo,
 	additionalContext string,
-) (string, error) {
-	// Build the system prompt for cover letter generation
-	systemPrompt := g.buildSystemPrompt()
-
-	// Build the user input with job and profile information
-	userInput := g.buildUserInput(profile, job, additionalContext)
+	agent string,
+) (*GeneratedCoverLetter, error) {
+	prompt := g.BuildCoverLetterPrompt(profile, job, additionalContext, agent)
 
-	// Call the AI service using the cover_letter agent
+	// Call the AI service using the requested cover letter agent
 	req := aiservice.ChatRequest{
-		Agent:  "cover_letter", // Using specialized cover_letter agent
-		Input:  userInput,
-		System: &systemPrompt,
-		// Use default temperature for professional writing (balanced creativity)
-		Temperature: func() *float64 { t := 0.7; return &t }(),
-		MaxTokens: func() *int { t := 2000; return &t }(), // Cover letters should be concise
+		Agent:       prompt.Agent,
+		Input:       prompt.UserInput,
+		System:      &prompt.SystemPrompt,
+		Temperature: &prompt.Temperature,
+		MaxTokens:   &prompt.MaxTokens,
 	}
 
-	g.logger.Info("generating cover letter",
+	g.logger.InfoContext(ctx, "generating cover letter",
 		"company", job.CompanyName,
 		"jobTitle", job.JobTitle,
+		"agent", prompt.Agent,
 	)
+	g.logPrompt(ctx, prompt)
 
 	resp, err := g.client.Chat(ctx, req)
 	if err != nil {
-		g.logger.Error("failed to generate cover letter via AI service", "error", err)
-		return "", fmt.Errorf("AI service error: %w", err)
+		g.logger.ErrorContext(ctx, "failed to generate cover letter via AI service", "error", err)
+		return nil, fmt.Errorf("AI service error: %w", err)
+	}
+	if g.promptLogging.Enabled {
+		g.logger.DebugContext(ctx, "cover letter AI response",
+			"agent", prompt.Agent,
+			"responseLength", len(resp.Output),
+		)
 	}
 
 	if resp.Output == "" {
-		return "", fmt.Errorf("AI service returned empty response")
+		return nil, fmt.Errorf("AI service returned empty response")
+	}
+
+	letter := &GeneratedCoverLetter{Content: resp.Output}
+	if g.sanitizer != nil {
+		if cleaned := g.sanitizer.Sanitize(resp.Output); cleaned != strings.TrimSpace(resp.Output) {
+			letter.Content = cleaned
+			letter.Original = resp.Output
+		}
 	}
 
-	g.logger.Info("cover letter generated successfully",
-		"length", len(resp.Output),
+	g.logger.InfoContext(ctx, "cover letter generated successfully",
+		"length", len(letter.Content),
+		"sanitized", letter.Original != "",
 	)
 
-	return resp.Output, nil
+	return letter, nil
+}
+
+// logPrompt logs the prompt at debug level when prompt logging is enabled
+func (g *AIServiceCoverLetterGenerator) logPrompt(ctx context.Context, prompt CoverLetterPrompt) {
+	if !g.promptLogging.Enabled {
+		return
+	}
+	g.logger.DebugContext(ctx, "cover letter AI prompt",
+		"agent", prompt.Agent,
+		"systemPrompt", g.promptLogging.clip(prompt.SystemPrompt),
+		"systemPromptLength", len(prompt.SystemPrompt),
+		"userInput", g.promptLogging.clip(prompt.UserInput),
+		"userInputLength", len(prompt.UserInput),
+		"full", g.promptLogging.Full,
+	)
+}
+
+// BuildCoverLetterPrompt assembles exactly what GenerateCoverLetterWithContext sends to
+// the AI service, without calling it
+func (g *AIServiceCoverLetterGenerator) BuildCoverLetterPrompt(profile UserProfile, job JobInfo, additionalContext, agent string) CoverLetterPrompt {
+	if agent == "" {
+		agent = DefaultCoverLetterAgent
+	}
+	temperature, ok := g.client.AgentTemperature(agent)
+	if !ok {
+		temperature = defaultCoverLetterTemperature
+	}
+	return CoverLetterPrompt{
+		Agent:        agent,
+		SystemPrompt: g.buildSystemPrompt(),
+		UserInput:    g.buildUserInput(profile, job, additionalContext),
+		Temperature:  temperature,
+		// Cover letters should be concise
+		MaxTokens: 2000,
+	}
 }
 
 // buildSystemPrompt creates the system prompt for cover letter generation
@@ -132,6 +267,14 @@ func (g *AIServiceCoverLetterGenerator) buildUserInput(
 		parts = append(parts, fmt.Sprintf("Technical Writings: %d items", len(profile.TechnicalWritings)))
 	}
 
+	// A refinement carries the previous letter and the requested changes
+	if isRefinementContext(additionalContext) {
+		parts = append(parts, "\n"+additionalContext)
+		parts = append(parts, "\n## Instructions")
+		parts = append(parts, "Revise the previous cover letter above rather than writing a new one. Apply the requested changes and keep everything else that still fits the job.")
+		return strings.Join(parts, "\n")
+	}
+
 	// Additional context (e.g., from chat messages)
 	if additionalContext != "" {
 		parts = append(parts, "\n## Additional Context")
@@ -0,0 +1,136 @@
+package jobapplications
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"woragis-jobs-service/pkg/aiservice"
+)
+
+func newPromptTestApp(svc Service, generator CoverLetterGenerator, userID uuid.UUID) *fiber.App {
+	app := fiber.New()
+	app.Use(func(c *fiber.Ctx) error {
+		c.Locals("userID", userID)
+		return c.Next()
+	})
+	h := NewHandlerWithDependencies(svc, nil, nil, generator, nil)
+	app.Get("/job-applications/:id/cover-letter/prompt", h.GetCoverLetterPrompt)
+	return app
+}
+
+func TestGetCoverLetterPrompt(t *testing.T) {
+	userID := uuid.New()
+	application := &JobApplication{ID: uuid.New(), UserID: userID, CompanyName: "Acme", JobTitle: "Platform Engineer", JobDescription: "Run Kubernetes at scale."}
+	generator := &AIServiceCoverLetterGenerator{}
+	app := newPromptTestApp(&fakeRefineService{application: application}, generator, userID)
+
+	resp, err := app.Test(httptest.NewRequest("GET", "/job-applications/"+application.ID.String()+"/cover-letter/prompt?agent=cover_letter_technical", nil))
+	require.NoError(t, err)
+	require.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+	var decoded struct {
+		Data CoverLetterPrompt `json:"data"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&decoded))
+	assert.Equal(t, "cover_letter_technical", decoded.Data.Agent)
+	assert.Equal(t, generator.buildSystemPrompt(), decoded.Data.SystemPrompt)
+	assert.Contains(t, decoded.Data.UserInput, "Company: Acme")
+	assert.Contains(t, decoded.Data.UserInput, "Run Kubernetes at scale.")
+	assert.Equal(t, 2000, decoded.Data.MaxTokens)
+}
+
+func TestGetCoverLetterPrompt_Errors(t *testing.T) {
+	userID := uuid.New()
+	application := &JobApplication{ID: uuid.New(), UserID: uuid.New(), CompanyName: "Acme"}
+	path := "/job-applications/" + application.ID.String() + "/cover-letter/prompt"
+
+	app := newPromptTestApp(&fakeRefineService{application: application}, &AIServiceCoverLetterGenerator{}, userID)
+	resp, err := app.Test(httptest.NewRequest("GET", path, nil))
+	require.NoError(t, err)
+	assert.Equal(t, fiber.StatusForbidden, resp.StatusCode, "another user's application")
+
+	resp, err = app.Test(httptest.NewRequest("GET", path+"?agent=poet", nil))
+	require.NoError(t, err)
+	assert.Equal(t, fiber.StatusBadRequest, resp.StatusCode)
+
+	app = newPromptTestApp(&fakeRefineService{application: application}, &fakeCoverLetterGenerator{}, userID)
+	resp, err = app.Test(httptest.NewRequest("GET", path, nil))
+	require.NoError(t, err)
+	assert.Equal(t, fiber.StatusNotImplemented, resp.StatusCode, "generator cannot show its prompt")
+}
+
+func TestBuildCoverLetterPrompt_AgentTemperature(t *testing.T) {
+	client := aiservice.NewClientWithOptions("http://ai.invalid", aiservice.ClientOptions{
+		AgentTemperatures: map[string]float64{"cover_letter_technical": 0.4},
+	})
+	generator := &AIServiceCoverLetterGenerator{client: client}
+
+	assert.Equal(t, 0.4, generator.BuildCoverLetterPrompt(UserProfile{}, JobInfo{}, "", "cover_letter_technical").Temperature)
+	assert.Equal(t, defaultCoverLetterTemperature, generator.BuildCoverLetterPrompt(UserProfile{}, JobInfo{}, "", "").Temperature)
+	assert.Equal(t, defaultCoverLetterTemperature, (&AIServiceCoverLetterGenerator{}).BuildCoverLetterPrompt(UserProfile{}, JobInfo{}, "", "").Temperature)
+}
+
+func TestGenerateCoverLetter_PromptLogging(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		json.NewEncoder(w).Encode(aiservice.ChatResponse{Output: "Dear Acme team"})
+	}))
+	defer server.Close()
+	client := aiservice.NewClientWithOptions(server.URL, aiservice.ClientOptions{})
+	job := JobInfo{CompanyName: "Acme", JobTitle: "Engineer", JobDescription: strings.Repeat("x", 2000)}
+
+	generate := func(logging PromptLogging, level slog.Level) []map[string]any {
+		var buf bytes.Buffer
+		logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: level}))
+		generator := NewAIServiceCoverLetterGeneratorWithOptions(client, CoverLetterGeneratorOptions{PromptLogging: logging}, logger)
+		_, err := generator.GenerateCoverLetterWithContext(context.Background(), UserProfile{}, job, "", "")
+		require.NoError(t, err)
+
+		var records []map[string]any
+		for _, line := range bytes.Split(bytes.TrimSpace(buf.Bytes()), []byte("\n")) {
+			var record map[string]any
+			require.NoError(t, json.Unmarshal(line, &record))
+			records = append(records, record)
+		}
+		return records
+	}
+	find := func(records []map[string]any, msg string) map[string]any {
+		for _, record := range records {
+			if record["msg"] == msg {
+				return record
+			}
+		}
+		return nil
+	}
+
+	records := generate(PromptLogging{}, slog.LevelDebug)
+	assert.Nil(t, find(records, "cover letter AI prompt"), "off by default")
+
+	records = generate(PromptLogging{Enabled: true, MaxChars: 100}, slog.LevelDebug)
+	prompt := find(records, "cover letter AI prompt")
+	require.NotNil(t, prompt)
+	assert.Equal(t, "DEBUG", prompt["level"])
+	assert.Len(t, []rune(prompt["userInput"].(string)), 101, "cut to MaxChars plus an ellipsis")
+	assert.Greater(t, prompt["userInputLength"], float64(2000))
+	response := find(records, "cover letter AI response")
+	require.NotNil(t, response)
+	assert.Equal(t, float64(len("Dear Acme team")), response["responseLength"])
+
+	records = generate(PromptLogging{Enabled: true, MaxChars: 100, Full: true}, slog.LevelDebug)
+	assert.Contains(t, find(records, "cover letter AI prompt")["userInput"], job.JobDescription)
+
+	records = generate(PromptLogging{Enabled: true, Full: true}, slog.LevelInfo)
+	for _, record := range records {
+		assert.NotContains(t, record, "userInput", "prompts are never logged at info level")
+	}
+}
@@ -0,0 +1,75 @@
+package jobapplications
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxCoverLetterFeedbackLength bounds the feedback sent along with a refinement request.
+const MaxCoverLetterFeedbackLength = 2000
+
+// coverLetterRevisionHeader opens the additional context of a refinement, which tells the
+// generator to revise the previous draft instead of writing a new letter.
+const coverLetterRevisionHeader = "## Previous Cover Letter"
+
+// CoverLetterRevision is one saved version of an application's cover letter. Revisions
+// are numbered from 1 per application; the latest one is also kept on the application.
+type CoverLetterRevision struct {
+	ID               uuid.UUID `gorm:"column:id;type:uuid;primaryKey" json:"id"`
+	JobApplicationID uuid.UUID `gorm:"column:job_application_id;type:uuid;uniqueIndex:idx_cover_letter_revision;not null" json:"jobApplicationId"`
+	UserID           uuid.UUID `gorm:"column:user_id;type:uuid;index;not null" json:"userId"`
+	Revision         int       `gorm:"column:revision;uniqueIndex:idx_cover_letter_revision;not null" json:"revision"`
+	Content          string    `gorm:"column:content;type:text;not null" json:"content"`
+	OriginalContent  string    `gorm:"column:original_content;type:text" json:"originalContent,omitempty"` // Raw AI output when sanitizing changed it
+	Feedback         string    `gorm:"column:feedback;type:text" json:"feedback,omitempty"`                // Blank for a fresh generation
+	CreatedAt        time.Time `gorm:"column:created_at" json:"createdAt"`
+}
+
+// TableName specifies the table name for CoverLetterRevision.
+func (CoverLetterRevision) TableName() string {
+	return "job_application_cover_letter_revisions"
+}
+
+// NewCoverLetterRevision creates an unnumbered revision for the application; the
+// repository assigns the revision number when saving it.
+func NewCoverLetterRevision(application *JobApplication, letter GeneratedCoverLetter, feedback string) (*CoverLetterRevision, error) {
+	revision := &CoverLetterRevision{
+		ID:               uuid.New(),
+		JobApplicationID: application.ID,
+		UserID:           application.UserID,
+		Content:          strings.TrimSpace(letter.Content),
+		OriginalContent:  letter.Original,
+		Feedback:         strings.TrimSpace(feedback),
+		CreatedAt:        time.Now().UTC(),
+	}
+	return revision, revision.Validate()
+}
+
+// Validate ensures revision invariants hold.
+func (r *CoverLetterRevision) Validate() error {
+	if r.JobApplicationID == uuid.Nil {
+		return NewDomainError(ErrCodeInvalidPayload, ErrEmptyApplicationID)
+	}
+	if r.Content == "" {
+		return NewDomainError(ErrCodeInvalidPayload, ErrEmptyCoverLetter)
+	}
+	return nil
+}
+
+// buildRefinementContext folds the previous letter and the user's feedback into the
+// additional context passed to the cover letter generator.
+func buildRefinementContext(previous, feedback string) string {
+	return strings.Join([]string{
+		coverLetterRevisionHeader,
+		strings.TrimSpace(previous),
+		"\n## Requested Changes",
+		strings.TrimSpace(feedback),
+	}, "\n")
+}
+
+// isRefinementContext reports whether additionalContext came from buildRefinementContext.
+func isRefinementContext(additionalContext string) bool {
+	return strings.HasPrefix(additionalContext, coverLetterRevisionHeader)
+}
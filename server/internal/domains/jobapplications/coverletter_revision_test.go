@@ -0,0 +1,113 @@
+package jobapplications
+
+import (
+	"context"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeRefineService serves one application and records saved revisions.
+type fakeRefineService struct {
+	Service
+	application *JobApplication
+	saved       *CoverLetterRevision
+}
+
+func (s *fakeRefineService) GetJobApplication(_ context.Context, _ uuid.UUID) (*JobApplication, error) {
+	return s.application, nil
+}
+
+func (s *fakeRefineService) SaveCoverLetterRevision(_ context.Context, _, _ uuid.UUID, letter GeneratedCoverLetter, feedback string) (*JobApplication, *CoverLetterRevision, error) {
+	revision, err := NewCoverLetterRevision(s.application, letter, feedback)
+	if err != nil {
+		return nil, nil, err
+	}
+	revision.Revision = 2
+	s.saved = revision
+	return s.application, revision, nil
+}
+
+// fakeCoverLetterGenerator records the additional context it was given.
+type fakeCoverLetterGenerator struct {
+	additionalContext string
+}
+
+func (g *fakeCoverLetterGenerator) GenerateCoverLetterWithContext(_ context.Context, _ UserProfile, _ JobInfo, additionalContext string, _ string) (*GeneratedCoverLetter, error) {
+	g.additionalContext = additionalContext
+	return &GeneratedCoverLetter{Content: "Shorter letter mentioning Kubernetes."}, nil
+}
+
+func newRefineTestApp(svc Service, generator CoverLetterGenerator, userID uuid.UUID) *fiber.App {
+	app := fiber.New()
+	app.Use(func(c *fiber.Ctx) error {
+		c.Locals("userID", userID)
+		return c.Next()
+	})
+	h := NewHandlerWithDependencies(svc, nil, nil, generator, nil)
+	app.Post("/job-applications/:id/cover-letter/refine", h.RefineCoverLetter)
+	return app
+}
+
+func TestRefineCoverLetter_RevisesCurrentLetter(t *testing.T) {
+	userID := uuid.New()
+	application := &JobApplication{ID: uuid.New(), UserID: userID, CompanyName: "Acme", CoverLetter: "Dear Acme, long letter."}
+	svc := &fakeRefineService{application: application}
+	generator := &fakeCoverLetterGenerator{}
+	app := newRefineTestApp(svc, generator, userID)
+
+	req := httptest.NewRequest("POST", "/job-applications/"+application.ID.String()+"/cover-letter/refine",
+		strings.NewReader(`{"feedback":"make it shorter and mention my Kubernetes experience"}`))
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := app.Test(req)
+	require.NoError(t, err)
+	assert.Equal(t, fiber.StatusCreated, resp.StatusCode)
+
+	assert.True(t, isRefinementContext(generator.additionalContext))
+	assert.Contains(t, generator.additionalContext, "Dear Acme, long letter.")
+	assert.Contains(t, generator.additionalContext, "mention my Kubernetes experience")
+
+	require.NotNil(t, svc.saved)
+	assert.Equal(t, "Shorter letter mentioning Kubernetes.", svc.saved.Content)
+	assert.Equal(t, "make it shorter and mention my Kubernetes experience", svc.saved.Feedback)
+}
+
+func TestRefineCoverLetter_RequiresAPreviousLetter(t *testing.T) {
+	userID := uuid.New()
+	application := &JobApplication{ID: uuid.New(), UserID: userID}
+	svc := &fakeRefineService{application: application}
+	app := newRefineTestApp(svc, &fakeCoverLetterGenerator{}, userID)
+
+	req := httptest.NewRequest("POST", "/job-applications/"+application.ID.String()+"/cover-letter/refine",
+		strings.NewReader(`{"feedback":"shorter"}`))
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := app.Test(req)
+	require.NoError(t, err)
+	assert.Equal(t, fiber.StatusBadRequest, resp.StatusCode)
+	assert.Nil(t, svc.saved)
+}
+
+func TestValidateRefineCoverLetterPayload(t *testing.T) {
+	payload := &refineCoverLetterPayload{Feedback: "  shorter  "}
+	require.NoError(t, ValidateRefineCoverLetterPayload(payload))
+	assert.Equal(t, "shorter", payload.Feedback)
+	assert.Equal(t, DefaultCoverLetterAgent, payload.Agent)
+
+	assert.Error(t, ValidateRefineCoverLetterPayload(&refineCoverLetterPayload{}))
+	assert.Error(t, ValidateRefineCoverLetterPayload(&refineCoverLetterPayload{Feedback: strings.Repeat("x", MaxCoverLetterFeedbackLength+1)}))
+	assert.Error(t, ValidateRefineCoverLetterPayload(&refineCoverLetterPayload{Feedback: "shorter", Agent: "poet"}))
+}
+
+func TestBuildUserInput_RefinementAsksForRevision(t *testing.T) {
+	g := &AIServiceCoverLetterGenerator{}
+	input := g.buildUserInput(UserProfile{}, JobInfo{CompanyName: "Acme"}, buildRefinementContext("Old letter", "shorter"))
+
+	assert.Contains(t, input, "Old letter")
+	assert.Contains(t, input, "Revise the previous cover letter")
+	assert.NotContains(t, input, "## Additional Context")
+}
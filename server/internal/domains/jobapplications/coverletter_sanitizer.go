@@ -0,0 +1,74 @@
+package jobapplications
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// DefaultCoverLetterPreamblePatterns match chatty openers the AI service sometimes puts
+// before the letter itself, such as "Here is your cover letter:".
+var DefaultCoverLetterPreamblePatterns = []string{
+	`(?i)^(?:sure|certainly|of course|absolutely)\b[^\n]*[:!][ \t]*\n`,
+	`(?i)^here(?:'s| is) (?:a |an |the |your )?[^\n]{0,80}cover letter[^\n]*:[ \t]*\n`,
+}
+
+// codeFencePattern matches a letter wrapped in a markdown code fence, with an optional language tag.
+var codeFencePattern = regexp.MustCompile("(?s)^```[A-Za-z0-9_-]*[ \t]*\n(.*?)\n?```$")
+
+// maxSanitizePasses bounds how often preambles and fences are peeled off the same letter.
+const maxSanitizePasses = 4
+
+// CoverLetterSanitizer cleans up generated cover letters. Preamble patterns are only
+// removed at the very start of the letter, so the body is never rewritten.
+type CoverLetterSanitizer struct {
+	preambles []*regexp.Regexp
+}
+
+// NewCoverLetterSanitizer compiles patterns; when none are given the defaults are used.
+func NewCoverLetterSanitizer(patterns []string) (*CoverLetterSanitizer, error) {
+	if len(patterns) == 0 {
+		patterns = DefaultCoverLetterPreamblePatterns
+	}
+	preambles := make([]*regexp.Regexp, 0, len(patterns))
+	for _, pattern := range patterns {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cover letter preamble pattern %q: %w", pattern, err)
+		}
+		preambles = append(preambles, re)
+	}
+	return &CoverLetterSanitizer{preambles: preambles}, nil
+}
+
+// Sanitize strips known preambles and a surrounding code fence from letter. If nothing
+// would be left, the trimmed letter is returned unchanged.
+func (s *CoverLetterSanitizer) Sanitize(letter string) string {
+	cleaned := strings.TrimSpace(letter)
+	for pass := 0; pass < maxSanitizePasses; pass++ {
+		next := cleaned
+		for _, re := range s.preambles {
+			if loc := re.FindStringIndex(next); loc != nil && loc[0] == 0 {
+				next = strings.TrimSpace(next[loc[1]:])
+			}
+		}
+		if match := codeFencePattern.FindStringSubmatch(next); match != nil {
+			next = strings.TrimSpace(match[1])
+		}
+		if next == cleaned {
+			break
+		}
+		cleaned = next
+	}
+	if cleaned == "" {
+		return strings.TrimSpace(letter)
+	}
+	return cleaned
+}
+
+// GeneratedCoverLetter is a cover letter as returned by a CoverLetterGenerator. Original
+// keeps the raw AI output when sanitizing changed it, and is blank otherwise.
+type GeneratedCoverLetter struct {
+	Content  string
+	Original string
+}
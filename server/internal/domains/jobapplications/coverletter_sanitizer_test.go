@@ -0,0 +1,57 @@
+package jobapplications
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCoverLetterSanitizer_Sanitize(t *testing.T) {
+	sanitizer, err := NewCoverLetterSanitizer(nil)
+	require.NoError(t, err)
+
+	letter := "Dear Hiring Manager,\n\nI am excited to apply.\n\nBest regards,\nAda"
+
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{name: "clean letter", input: letter, want: letter},
+		{name: "preamble", input: "Here is your cover letter:\n\n" + letter, want: letter},
+		{name: "chatty preamble", input: "Sure! Here's a tailored cover letter for the role:\n" + letter, want: letter},
+		{name: "code fence", input: "```markdown\n" + letter + "\n```", want: letter},
+		{name: "preamble then fence", input: "Certainly!\n\nHere is the cover letter:\n```\n" + letter + "\n```\n", want: letter},
+		{name: "body mentions cover letter", input: letter + "\nHere is my cover letter: thanks.", want: letter + "\nHere is my cover letter: thanks."},
+		{name: "nothing left", input: "Here is your cover letter:\n", want: "Here is your cover letter:"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, sanitizer.Sanitize(tt.input))
+		})
+	}
+}
+
+func TestCoverLetterSanitizer_CustomPatterns(t *testing.T) {
+	sanitizer, err := NewCoverLetterSanitizer([]string{`(?i)^draft:\s*`})
+	require.NoError(t, err)
+	assert.Equal(t, "Dear Acme,", sanitizer.Sanitize("DRAFT: Dear Acme,"))
+	assert.Equal(t, "Here is your cover letter:\nDear Acme,", sanitizer.Sanitize("Here is your cover letter:\nDear Acme,"),
+		"custom patterns replace the defaults")
+
+	_, err = NewCoverLetterSanitizer([]string{"("})
+	assert.Error(t, err)
+}
+
+func TestNewCoverLetterRevision_KeepsOriginalOutput(t *testing.T) {
+	application := &JobApplication{ID: uuid.New(), UserID: uuid.New()}
+	raw := "Here is your cover letter:\nDear Acme,"
+
+	revision, err := NewCoverLetterRevision(application, GeneratedCoverLetter{Content: "Dear Acme,", Original: raw}, "")
+	require.NoError(t, err)
+	assert.Equal(t, "Dear Acme,", revision.Content)
+	assert.Equal(t, raw, revision.OriginalContent)
+}
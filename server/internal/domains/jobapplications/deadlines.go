@@ -0,0 +1,88 @@
+package jobapplications
+
+import (
+	"context"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"woragis-jobs-service/pkg/middleware"
+	"woragis-jobs-service/pkg/pagination"
+	"woragis-jobs-service/pkg/response"
+)
+
+// UpcomingDeadline is an application with a future deadline and the UTC calendar days
+// left until it: 0 when it is due later today, 1 tomorrow, and so on.
+type UpcomingDeadline struct {
+	JobApplication
+	DaysRemaining int `json:"daysRemaining"`
+}
+
+// daysUntilDeadline counts UTC calendar days from now's day to the deadline's day.
+func daysUntilDeadline(deadline, now time.Time) int {
+	today := utcDayStart(now)
+	return int(utcDayStart(deadline).Sub(today).Hours() / 24)
+}
+
+func utcDayStart(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// ListUpcomingDeadlines returns the user's applications whose deadline is still ahead,
+// soonest first. activeOnly leaves out rejected, accepted and failed applications.
+func (s *service) ListUpcomingDeadlines(ctx context.Context, userID uuid.UUID, activeOnly bool, limit, offset int) ([]UpcomingDeadline, error) {
+	now := time.Now().UTC()
+	applications, err := s.repo.ListUpcomingDeadlines(ctx, userID, now, activeOnly, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	deadlines := make([]UpcomingDeadline, len(applications))
+	for i, application := range applications {
+		deadlines[i] = UpcomingDeadline{
+			JobApplication: application,
+			DaysRemaining:  daysUntilDeadline(*application.Deadline, now),
+		}
+	}
+	return deadlines, nil
+}
+
+// ListUpcomingDeadlines lists applications with a future deadline, most urgent first,
+// each with its daysRemaining. ?active=true keeps only open applications.
+func (h *handler) ListUpcomingDeadlines(c *fiber.Ctx) error {
+	userID, err := middleware.GetUserIDFromFiberContext(c)
+	if err != nil {
+		return response.Error(c, fiber.StatusUnauthorized, 401, fiber.Map{
+			"message": "authentication required",
+		})
+	}
+
+	active := c.Query("active")
+	if active != "" && active != "true" && active != "false" {
+		return response.Error(c, fiber.StatusBadRequest, ErrCodeInvalidPayload, fiber.Map{
+			"message": "active: must be true or false",
+		})
+	}
+	page, err := pagination.FromQuery(c)
+	if err != nil {
+		return response.Error(c, fiber.StatusBadRequest, ErrCodeInvalidPayload, fiber.Map{
+			"message": err.Error(),
+		})
+	}
+
+	deadlines, err := h.service.ListUpcomingDeadlines(c.Context(), userID, active == "true", page.Limit, page.Offset)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	page.SetHeaders(c)
+	page.SetLinkHeader(c, len(deadlines), pagination.UnknownTotal)
+	return response.Success(c, fiber.StatusOK, fiber.Map{
+		"applications": deadlines,
+		"count":        len(deadlines),
+		"limit":        page.Limit,
+		"offset":       page.Offset,
+	})
+}
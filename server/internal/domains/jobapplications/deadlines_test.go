@@ -0,0 +1,79 @@
+package jobapplications
+
+import (
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeDeadlinesRepository serves fixed applications and records the query.
+type fakeDeadlinesRepository struct {
+	Repository
+	applications []JobApplication
+	after        time.Time
+	activeOnly   bool
+}
+
+func (r *fakeDeadlinesRepository) ListUpcomingDeadlines(_ context.Context, _ uuid.UUID, after time.Time, activeOnly bool, _, _ int) ([]JobApplication, error) {
+	r.after, r.activeOnly = after, activeOnly
+	return r.applications, nil
+}
+
+func TestDaysUntilDeadline(t *testing.T) {
+	now := time.Date(2026, 3, 2, 22, 0, 0, 0, time.UTC)
+	assert.Equal(t, 0, daysUntilDeadline(time.Date(2026, 3, 2, 23, 30, 0, 0, time.UTC), now), "later today")
+	assert.Equal(t, 1, daysUntilDeadline(time.Date(2026, 3, 3, 1, 0, 0, 0, time.UTC), now), "tomorrow, even if under 24h away")
+	assert.Equal(t, 30, daysUntilDeadline(time.Date(2026, 4, 1, 12, 0, 0, 0, time.UTC), now))
+}
+
+func TestListUpcomingDeadlines_Handler(t *testing.T) {
+	soon := time.Now().UTC().Add(time.Hour)
+	later := time.Now().UTC().AddDate(0, 0, 10)
+	repo := &fakeDeadlinesRepository{applications: []JobApplication{
+		{ID: uuid.New(), CompanyName: "Acme", Deadline: &soon},
+		{ID: uuid.New(), CompanyName: "Globex", Deadline: &later},
+	}}
+	app := fiber.New()
+	app.Use(func(c *fiber.Ctx) error {
+		c.Locals("userID", uuid.New())
+		return c.Next()
+	})
+	app.Get("/job-applications/deadlines", NewHandler(NewService(repo, nil, nil), nil).ListUpcomingDeadlines)
+
+	before := time.Now().UTC()
+	resp, err := app.Test(httptest.NewRequest("GET", "/job-applications/deadlines?active=true", nil))
+	require.NoError(t, err)
+	require.Equal(t, fiber.StatusOK, resp.StatusCode)
+	assert.True(t, repo.activeOnly)
+	assert.False(t, repo.after.Before(before), "only deadlines after now are listed")
+
+	var decoded struct {
+		Data struct {
+			Applications []struct {
+				CompanyName   string `json:"companyName"`
+				DaysRemaining int    `json:"daysRemaining"`
+			} `json:"applications"`
+			Count int `json:"count"`
+		} `json:"data"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&decoded))
+	require.Equal(t, 2, decoded.Data.Count)
+	assert.Equal(t, "Acme", decoded.Data.Applications[0].CompanyName)
+	assert.Equal(t, daysUntilDeadline(soon, time.Now()), decoded.Data.Applications[0].DaysRemaining)
+	assert.Equal(t, 10, decoded.Data.Applications[1].DaysRemaining)
+
+	_, err = app.Test(httptest.NewRequest("GET", "/job-applications/deadlines", nil))
+	require.NoError(t, err)
+	assert.False(t, repo.activeOnly, "all statuses by default")
+
+	resp, err = app.Test(httptest.NewRequest("GET", "/job-applications/deadlines?active=yes", nil))
+	require.NoError(t, err)
+	assert.Equal(t, fiber.StatusBadRequest, resp.StatusCode)
+}
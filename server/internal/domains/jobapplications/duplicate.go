@@ -0,0 +1,72 @@
+package jobapplications
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Duplicate returns a new pending application for a similar role at the same company.
+// The posting, tags, notes, resume link, salary and preferences are copied; the status,
+// its dates and the cover letter are not, and neither are the interview stages,
+// responses or status history stored alongside the original.
+func (j *JobApplication) Duplicate() (*JobApplication, error) {
+	duplicate, err := NewJobApplication(j.UserID, j.CompanyName, j.Location, j.JobTitle, j.JobURL, j.Website)
+	if err != nil {
+		return nil, err
+	}
+
+	if j.ResumeID != nil {
+		resumeID := *j.ResumeID
+		duplicate.ResumeID = &resumeID
+	}
+	duplicate.SalaryMin = copyIntPtr(j.SalaryMin)
+	duplicate.SalaryMax = copyIntPtr(j.SalaryMax)
+	duplicate.SalaryCurrency = j.SalaryCurrency
+	duplicate.JobDescription = j.JobDescription
+	duplicate.InterestLevel = j.InterestLevel
+	duplicate.Notes = j.Notes
+	if j.Tags != nil {
+		duplicate.Tags = append(JSONArray{}, j.Tags...)
+	}
+	duplicate.Source = j.Source
+	duplicate.ApplicationMethod = j.ApplicationMethod
+	duplicate.Language = j.Language
+	return duplicate, nil
+}
+
+func copyIntPtr(v *int) *int {
+	if v == nil {
+		return nil
+	}
+	copied := *v
+	return &copied
+}
+
+// DuplicateJobApplication saves a copy of the user's application as a new pending
+// application to edit. Like an ingested capture, it is not queued for submission.
+func (s *service) DuplicateJobApplication(ctx context.Context, userID, applicationID uuid.UUID) (*JobApplication, error) {
+	original, err := s.getOwnedApplication(ctx, userID, applicationID)
+	if err != nil {
+		return nil, err
+	}
+
+	duplicate, err := original.Duplicate()
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repo.CreateJobApplication(ctx, duplicate); err != nil {
+		return nil, err
+	}
+
+	s.recalculateResumeMetricsAfterUpdate(ctx, nil, duplicate.ResumeID)
+
+	if s.logger != nil {
+		s.logger.InfoContext(ctx, "job application duplicated",
+			"user_id", userID.String(),
+			"job_application_id", duplicate.ID.String(),
+			"source_application_id", original.ID.String())
+	}
+
+	return duplicate, nil
+}
@@ -0,0 +1,71 @@
+package jobapplications
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeDuplicateRepo serves one application and records the created copy.
+type fakeDuplicateRepo struct {
+	Repository
+	application *JobApplication
+	created     *JobApplication
+}
+
+func (r *fakeDuplicateRepo) GetJobApplication(_ context.Context, _ uuid.UUID) (*JobApplication, error) {
+	return r.application, nil
+}
+
+func (r *fakeDuplicateRepo) CreateJobApplication(_ context.Context, application *JobApplication) error {
+	r.created = application
+	return nil
+}
+
+func TestService_DuplicateJobApplication(t *testing.T) {
+	userID := uuid.New()
+	resumeID := uuid.New()
+	appliedAt := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	original := &JobApplication{
+		ID: uuid.New(), UserID: userID, CompanyName: "Acme", JobTitle: "Backend Engineer",
+		JobURL: "https://acme.test/jobs/1", Website: "linkedin", Status: ApplicationStatusContacted,
+		ResumeID: &resumeID, Tags: JSONArray{"go", "remote"}, Notes: "Referred by Sam",
+		SalaryMin: intPtr(100000), SalaryCurrency: "EUR", InterestLevel: InterestLevelHigh,
+		AppliedAt: &appliedAt, ResponseReceivedAt: &appliedAt, FollowUpDate: &appliedAt,
+		CoverLetter: "Dear Acme", InterviewCount: 2, Version: 4,
+	}
+	repo := &fakeDuplicateRepo{application: original}
+
+	duplicate, err := NewService(repo, nil, nil).DuplicateJobApplication(context.Background(), userID, original.ID)
+	require.NoError(t, err)
+	require.Same(t, duplicate, repo.created)
+
+	assert.NotEqual(t, original.ID, duplicate.ID)
+	assert.Equal(t, ApplicationStatusPending, duplicate.Status)
+	assert.Equal(t, "Acme", duplicate.CompanyName)
+	assert.Equal(t, &resumeID, duplicate.ResumeID)
+	assert.Equal(t, JSONArray{"go", "remote"}, duplicate.Tags)
+	assert.Equal(t, "Referred by Sam", duplicate.Notes)
+	assert.Equal(t, 100000, *duplicate.SalaryMin)
+	assert.Equal(t, InterestLevelHigh, duplicate.InterestLevel)
+	assert.Nil(t, duplicate.AppliedAt)
+	assert.Nil(t, duplicate.ResponseReceivedAt)
+	assert.Nil(t, duplicate.FollowUpDate)
+	assert.Empty(t, duplicate.CoverLetter)
+	assert.Zero(t, duplicate.InterviewCount)
+	assert.Equal(t, 1, duplicate.Version)
+
+	duplicate.Tags[0] = "python"
+	assert.Equal(t, "go", original.Tags[0], "the copy does not share the original's tags")
+}
+
+func TestService_DuplicateJobApplication_HidesOtherUsersApplications(t *testing.T) {
+	repo := &fakeDuplicateRepo{application: &JobApplication{ID: uuid.New(), UserID: uuid.New()}}
+	_, err := NewService(repo, nil, nil).DuplicateJobApplication(context.Background(), uuid.New(), repo.application.ID)
+	assert.EqualError(t, err, ErrApplicationNotFound)
+	assert.Nil(t, repo.created)
+}
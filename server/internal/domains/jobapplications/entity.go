@@ -3,10 +3,13 @@ package jobapplications
 import (
 	"database/sql/driver"
 	"encoding/json"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/google/uuid"
+
+	"woragis-jobs-service/pkg/validation"
 )
 
 // ApplicationStatus represents the status of a job application.
@@ -20,11 +23,38 @@ const (
 	ApplicationStatusRejected   ApplicationStatus = "rejected"
 	ApplicationStatusAccepted   ApplicationStatus = "accepted"
 	ApplicationStatusFailed     ApplicationStatus = "failed"
+	ApplicationStatusReopened   ApplicationStatus = "reopened"
 )
 
-// JSONArray is a custom type for storing JSON arrays in PostgreSQL.
+// terminalStatuses end an application; they get no reminders and only they can be reopened.
+var terminalStatuses = []ApplicationStatus{ApplicationStatusRejected, ApplicationStatusAccepted, ApplicationStatusFailed}
+
+// IsTerminal reports whether the status ends the application.
+func (s ApplicationStatus) IsTerminal() bool {
+	return slices.Contains(terminalStatuses, s)
+}
+
+// contactableStatuses were sent and are still open, so a recruiter reply can move them to contacted.
+var contactableStatuses = []ApplicationStatus{ApplicationStatusApplied, ApplicationStatusReopened}
+
+// CanBeContacted reports whether an application in this status may move to contacted.
+func (s ApplicationStatus) CanBeContacted() bool {
+	return slices.Contains(contactableStatuses, s)
+}
+
+// JSONArray is a custom type for storing JSON arrays in PostgreSQL. It is never
+// null in JSON: nil and empty arrays both marshal to [], and NULL columns scan
+// to an empty array.
 type JSONArray []string
 
+// MarshalJSON implements the json.Marshaler interface.
+func (j JSONArray) MarshalJSON() ([]byte, error) {
+	if j == nil {
+		return []byte("[]"), nil
+	}
+	return json.Marshal([]string(j))
+}
+
 // Value implements the driver.Valuer interface.
 func (j JSONArray) Value() (driver.Value, error) {
 	if j == nil {
@@ -35,15 +65,21 @@ func (j JSONArray) Value() (driver.Value, error) {
 
 // Scan implements the sql.Scanner interface.
 func (j *JSONArray) Scan(value interface{}) error {
+	*j = JSONArray{}
 	if value == nil {
-		*j = nil
 		return nil
 	}
 	bytes, ok := value.([]byte)
 	if !ok {
-		return json.Unmarshal([]byte(value.(string)), j)
+		bytes = []byte(value.(string))
+	}
+	if err := json.Unmarshal(bytes, j); err != nil {
+		return err
+	}
+	if *j == nil { // A JSON null stored in the column
+		*j = JSONArray{}
 	}
-	return json.Unmarshal(bytes, j)
+	return nil
 }
 
 // JobApplication represents a job application record.
@@ -74,10 +110,18 @@ type JobApplication struct {
 	Deadline            *time.Time       `gorm:"column:deadline" json:"deadline,omitempty"`
 	
 	// Interest and notes
-	InterestLevel       string           `gorm:"column:interest_level;size:50" json:"interestLevel,omitempty"` // e.g., "low", "medium", "high", "very-high"
+	InterestLevel       InterestLevel    `gorm:"column:interest_level;size:50" json:"interestLevel,omitempty"` // low, medium or high
 	Notes               string           `gorm:"column:notes;type:text" json:"notes,omitempty"`
-	Tags                JSONArray        `gorm:"column:tags;type:jsonb" json:"tags,omitempty"` // e.g., ["remote", "startup", "dream-job"]
+	Tags                JSONArray        `gorm:"column:tags;type:jsonb;index:idx_job_applications_tags,type:gin" json:"tags"` // e.g., ["remote", "startup", "dream-job"]
 	FollowUpDate        *time.Time       `gorm:"column:follow_up_date" json:"followUpDate,omitempty"`
+	LastRemindedAt      *time.Time       `gorm:"column:last_reminded_at" json:"lastRemindedAt,omitempty"` // last follow-up/deadline reminder sent
+	SnoozedUntil        *time.Time       `gorm:"column:snoozed_until;index" json:"snoozedUntil,omitempty"` // hidden from the default list until then
+	PinOrder            *int             `gorm:"column:pin_order" json:"pinOrder,omitempty"` // listed first, ascending; nil when not pinned
+	NotificationsMuted  bool             `gorm:"column:notifications_muted;not null;default:false" json:"notificationsMuted"` // no reminders while true
+	
+	// Next action, suggested on every status change and editable
+	NextAction          string           `gorm:"column:next_action;size:255" json:"nextAction,omitempty"` // e.g. "follow up"
+	NextActionDue       *time.Time       `gorm:"column:next_action_due;index" json:"nextActionDue,omitempty"`
 	
 	// Response tracking
 	ResponseReceivedAt  *time.Time       `gorm:"column:response_received_at" json:"responseReceivedAt,omitempty"`
@@ -94,6 +138,8 @@ type JobApplication struct {
 	// Language
 	Language            string           `gorm:"column:language;size:2" json:"language,omitempty"` // ISO 639-1 language code (e.g., "en", "pt", "es")
 	
+	// Version is incremented by the repository on every save
+	Version             int              `gorm:"column:version;not null;default:1" json:"version"`
 	CreatedAt           time.Time        `gorm:"column:created_at" json:"createdAt"`
 	UpdatedAt           time.Time        `gorm:"column:updated_at" json:"updatedAt"`
 }
@@ -109,17 +155,20 @@ func NewJobApplication(userID uuid.UUID, companyName, location, jobTitle, jobURL
 	website = strings.ToLower(strings.TrimSpace(website))
 	
 	app := &JobApplication{
-		ID:          uuid.New(),
-		UserID:      userID,
-		CompanyName: companyName,
-		Location:    location,
-		JobTitle:    jobTitle,
-		JobURL:      jobURL,
-		Website:     website,
-		Status:      ApplicationStatusPending,
-		CreatedAt:   time.Now().UTC(),
-		UpdatedAt:   time.Now().UTC(),
+		ID:            uuid.New(),
+		UserID:        userID,
+		CompanyName:   companyName,
+		Location:      location,
+		JobTitle:      jobTitle,
+		JobURL:        jobURL,
+		Website:       website,
+		Status:        ApplicationStatusPending,
+		InterestLevel: DefaultInterestLevel(),
+		Version:       1,
+		CreatedAt:     time.Now().UTC(),
+		UpdatedAt:     time.Now().UTC(),
 	}
+	app.suggestNextAction(app.CreatedAt)
 
 	return app, app.Validate()
 }
@@ -144,19 +193,30 @@ func (j *JobApplication) Validate() error {
 	if j.Website == "" {
 		return NewDomainError(ErrCodeInvalidPayload, ErrEmptyWebsite)
 	}
-	if !isValidStatus(j.Status) {
+	if !j.Status.IsValid() {
 		return NewDomainError(ErrCodeInvalidStatus, ErrUnsupportedStatus)
 	}
 	return nil
 }
 
-func isValidStatus(status ApplicationStatus) bool {
-	switch status {
-	case ApplicationStatusPending, ApplicationStatusProcessing, ApplicationStatusApplied,
-		ApplicationStatusContacted, ApplicationStatusRejected, ApplicationStatusAccepted, ApplicationStatusFailed:
-		return true
+// ApplicationStatuses lists the accepted statuses in lifecycle order.
+var ApplicationStatuses = []ApplicationStatus{
+	ApplicationStatusPending, ApplicationStatusProcessing, ApplicationStatusApplied, ApplicationStatusContacted,
+	ApplicationStatusRejected, ApplicationStatusAccepted, ApplicationStatusFailed, ApplicationStatusReopened,
+}
+
+// IsValid reports whether the status is one of ApplicationStatuses.
+func (s ApplicationStatus) IsValid() bool {
+	return slices.Contains(ApplicationStatuses, s)
+}
+
+// ParseApplicationStatus normalizes raw and checks it is one of ApplicationStatuses.
+func ParseApplicationStatus(raw string) (ApplicationStatus, error) {
+	status := ApplicationStatus(strings.ToLower(strings.TrimSpace(raw)))
+	if !status.IsValid() {
+		return "", NewDomainError(ErrCodeInvalidStatus, ErrUnsupportedStatus)
 	}
-	return false
+	return status, nil
 }
 
 // MarkApplied updates the application status to applied and sets the applied timestamp.
@@ -166,6 +226,7 @@ func (j *JobApplication) MarkApplied(coverLetter string) {
 	j.AppliedAt = &now
 	j.CoverLetter = coverLetter
 	j.UpdatedAt = now
+	j.suggestNextAction(now)
 }
 
 // MarkContacted updates the application status to contacted.
@@ -173,6 +234,23 @@ func (j *JobApplication) MarkContacted() {
 	j.Status = ApplicationStatusContacted
 	j.LinkedInContact = true
 	j.UpdatedAt = time.Now().UTC()
+	j.suggestNextAction(j.UpdatedAt)
+}
+
+// RecordContact moves an applied or reopened application to contacted after a recruiter
+// replied at respondedAt. The first reply also sets ResponseReceivedAt.
+func (j *JobApplication) RecordContact(respondedAt time.Time) error {
+	if !j.Status.CanBeContacted() {
+		return NewDomainError(ErrCodeInvalidTransition, ErrContactNotAllowed)
+	}
+	j.Status = ApplicationStatusContacted
+	if j.ResponseReceivedAt == nil {
+		respondedAt = respondedAt.UTC()
+		j.ResponseReceivedAt = &respondedAt
+	}
+	j.UpdatedAt = time.Now().UTC()
+	j.suggestNextAction(j.UpdatedAt)
+	return nil
 }
 
 // MarkFailed updates the application status to failed with an error message.
@@ -180,24 +258,143 @@ func (j *JobApplication) MarkFailed(errorMessage string) {
 	j.Status = ApplicationStatusFailed
 	j.ErrorMessage = errorMessage
 	j.UpdatedAt = time.Now().UTC()
+	j.suggestNextAction(j.UpdatedAt)
 }
 
 // MarkProcessing updates the application status to processing.
 func (j *JobApplication) MarkProcessing() {
 	j.Status = ApplicationStatusProcessing
 	j.UpdatedAt = time.Now().UTC()
+	j.suggestNextAction(j.UpdatedAt)
+}
+
+// Reopen moves a terminal application back to active and clears the fields
+// that only describe how it ended.
+func (j *JobApplication) Reopen() error {
+	if !j.Status.IsTerminal() {
+		return NewDomainError(ErrCodeInvalidTransition, ErrReopenNotTerminal)
+	}
+	j.Status = ApplicationStatusReopened
+	j.RejectionReason = ""
+	j.ErrorMessage = ""
+	j.UpdatedAt = time.Now().UTC()
+	j.suggestNextAction(j.UpdatedAt)
+	return nil
+}
+
+// Snooze hides the application from the default list until the given time.
+func (j *JobApplication) Snooze(until time.Time) error {
+	now := time.Now().UTC()
+	if !until.After(now) {
+		return NewDomainError(ErrCodeInvalidPayload, ErrSnoozeNotInFuture)
+	}
+	until = until.UTC()
+	j.SnoozedUntil = &until
+	j.UpdatedAt = now
+	return nil
 }
 
-// UpdateStatus updates the application status.
+// Unsnooze makes the application visible again right away.
+func (j *JobApplication) Unsnooze() {
+	j.SnoozedUntil = nil
+	j.UpdatedAt = time.Now().UTC()
+}
+
+// IsSnoozed reports whether the application is still snoozed at now.
+func (j *JobApplication) IsSnoozed(now time.Time) bool {
+	return j.SnoozedUntil != nil && j.SnoozedUntil.After(now)
+}
+
+// Pin places the application at position order among the user's pinned applications.
+func (j *JobApplication) Pin(order int) error {
+	if order < 1 || order > MaxPinnedApplications {
+		return NewDomainError(ErrCodeInvalidPayload, ErrInvalidPinOrder)
+	}
+	j.PinOrder = &order
+	j.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+// Unpin returns the application to the normal list order.
+func (j *JobApplication) Unpin() {
+	j.PinOrder = nil
+	j.UpdatedAt = time.Now().UTC()
+}
+
+// IsPinned reports whether the application is pinned.
+func (j *JobApplication) IsPinned() bool {
+	return j.PinOrder != nil
+}
+
+// MuteNotifications stops reminders for the application without archiving it.
+func (j *JobApplication) MuteNotifications() {
+	j.NotificationsMuted = true
+	j.UpdatedAt = time.Now().UTC()
+}
+
+// UnmuteNotifications resumes reminders for the application.
+func (j *JobApplication) UnmuteNotifications() {
+	j.NotificationsMuted = false
+	j.UpdatedAt = time.Now().UTC()
+}
+
+// UpdateStatus updates the application status. A changed status replaces the next
+// action with the new status's suggestion.
 func (j *JobApplication) UpdateStatus(status ApplicationStatus) error {
-	if !isValidStatus(status) {
+	if !status.IsValid() {
 		return NewDomainError(ErrCodeInvalidStatus, ErrUnsupportedStatus)
 	}
+	changed := j.Status != status
 	j.Status = status
 	j.UpdatedAt = time.Now().UTC()
+	if changed {
+		j.suggestNextAction(j.UpdatedAt)
+	}
 	return nil
 }
 
+// AddTag appends tag if it is not already present. Returns false when the application
+// already has the tag or is at the tag limit; check HasTagCapacity to tell the two apart.
+func (j *JobApplication) AddTag(tag string) bool {
+	if !j.HasTagCapacity() || j.HasTag(tag) {
+		return false
+	}
+	j.Tags = append(j.Tags, tag)
+	j.UpdatedAt = time.Now().UTC()
+	return true
+}
+
+// HasTag reports whether the application carries tag, ignoring case.
+func (j *JobApplication) HasTag(tag string) bool {
+	for _, existing := range j.Tags {
+		if strings.EqualFold(existing, tag) {
+			return true
+		}
+	}
+	return false
+}
+
+// HasTagCapacity reports whether another tag fits under the configured maximum.
+func (j *JobApplication) HasTagCapacity() bool {
+	return len(j.Tags) < validation.CurrentTagLimits().MaxTags
+}
+
+// RemoveTag drops every occurrence of tag. Returns true when the tags changed.
+func (j *JobApplication) RemoveTag(tag string) bool {
+	kept := make(JSONArray, 0, len(j.Tags))
+	for _, existing := range j.Tags {
+		if !strings.EqualFold(existing, tag) {
+			kept = append(kept, existing)
+		}
+	}
+	if len(kept) == len(j.Tags) {
+		return false
+	}
+	j.Tags = kept
+	j.UpdatedAt = time.Now().UTC()
+	return true
+}
+
 // JobApplicationJob represents a job in the Redis queue.
 type JobApplicationJob struct {
 	ID        string    `json:"id"`
@@ -3,6 +3,8 @@ package jobapplications
 import (
 	"errors"
 	"strings"
+
+	"woragis-jobs-service/pkg/response"
 )
 
 const (
@@ -19,6 +21,8 @@ const (
 	ErrCodeDatabaseUniqueViolation = 10010
 	ErrCodeDatabaseForeignKeyViolation = 10011
 	ErrCodeDatabaseConnection   = 10012
+	ErrCodePinLimitReached      = 10013
+	ErrCodeInvalidTransition    = 10014
 )
 
 const (
@@ -30,7 +34,34 @@ const (
 	ErrEmptyJobURL                   = "jobapplications: job url cannot be empty"
 	ErrEmptyWebsite                  = "jobapplications: website cannot be empty"
 	ErrApplicationNotFound           = "jobapplications: application not found"
-	ErrUnsupportedStatus             = "jobapplications: unsupported status"
+	ErrComparedApplicationNotFound   = "jobapplications: one or more applications to compare were not found"
+	ErrEmptyLanguageDetectionText    = "jobapplications: text for language detection cannot be empty"
+	ErrLanguageNotDetected           = "jobapplications: unable to detect the language of the text"
+	ErrEmptyTagSuggestionText        = "jobapplications: job description for tag suggestions cannot be empty"
+	ErrUnsupportedStatus             = "jobapplications: status must be one of pending, processing, applied, contacted, rejected, accepted, failed, reopened"
+	ErrUnsupportedTimeSeriesMetric   = "jobapplications: unsupported time series metric"
+	ErrEmptyBulkDeleteFilter         = "jobapplications: bulk delete requires at least one filter"
+	ErrReassignSameWebsite           = "jobapplications: applications are already on that website"
+	ErrSnoozeNotInFuture             = "jobapplications: snooze date must be in the future"
+	ErrInvalidPinOrder               = "jobapplications: pin order must be between 1 and 10"
+	ErrInvalidInterestLevel          = "jobapplications: interestLevel must be one of low, medium, high"
+	ErrNegativeSalary                = "jobapplications: salaryMin and salaryMax cannot be negative"
+	ErrSalaryRangeInverted           = "jobapplications: salaryMin cannot be greater than salaryMax"
+	ErrUnknownSalaryCurrency         = "jobapplications: salaryCurrency must be an ISO 4217 currency code"
+	ErrPinLimitReached               = "jobapplications: at most 10 applications can be pinned"
+	ErrReopenNotTerminal             = "jobapplications: only rejected, accepted or failed applications can be reopened"
+	ErrContactNotAllowed             = "jobapplications: only applied or reopened applications can be marked contacted"
+	ErrStatusChanged                 = "jobapplications: application status changed concurrently, reload and retry"
+	ErrApplicationMethodNotSupported = "jobapplications: application method is not supported by this website"
+	ErrEmptyCoverLetter              = "jobapplications: cover letter cannot be empty"
+	ErrCoverLetterNotGenerated       = "jobapplications: no cover letter generated for this application"
+	ErrOfferNotFound                 = "jobapplications: no offer recorded for this application"
+	ErrInvalidOfferBaseSalary        = "jobapplications: offer baseSalary must be greater than zero"
+	ErrInvalidOfferCurrency          = "jobapplications: offer currency must be a 3-letter code"
+	ErrInvalidOfferAmount            = "jobapplications: offer bonus and equityValue cannot be negative"
+	ErrInvalidExpenseDescription     = "jobapplications: expense description is required and at most 255 characters"
+	ErrInvalidExpenseAmount          = "jobapplications: expense amountCents must be greater than zero"
+	ErrInvalidExpenseCurrency        = "jobapplications: expense currency must be a 3-letter code"
 	ErrUnableToPersist               = "jobapplications: unable to persist data"
 	ErrUnableToFetch                 = "jobapplications: unable to fetch data"
 	ErrUnableToUpdate                = "jobapplications: unable to update data"
@@ -48,12 +79,42 @@ const (
 type DomainError struct {
 	Code    int
 	Message string
+	// cause is the underlying database error, kept so retries can tell transient failures apart
+	cause error
 }
 
 func (e *DomainError) Error() string {
 	return e.Message
 }
 
+// Unwrap returns the underlying error, if any.
+func (e *DomainError) Unwrap() error {
+	return e.cause
+}
+
+// ErrorKind classifies the error for response.FromDomainError.
+func (e *DomainError) ErrorKind() response.ErrorKind {
+	switch e.Code {
+	case ErrCodeInvalidPayload, ErrCodeInvalidStatus, ErrCodeDatabaseConstraint, ErrCodeDatabaseValueTooLong, ErrCodeDatabaseForeignKeyViolation:
+		return response.KindValidation
+	case ErrCodeNotFound:
+		return response.KindNotFound
+	case ErrCodeDatabaseUniqueViolation, ErrCodePinLimitReached, ErrCodeInvalidTransition:
+		return response.KindConflict
+	case ErrCodeAccessDenied:
+		return response.KindForbidden
+	case ErrCodeJobQueueFailure, ErrCodeAIServiceFailure, ErrCodePlaywrightFailure, ErrCodeDatabaseConnection:
+		return response.KindUnavailable
+	default:
+		return response.KindInternal
+	}
+}
+
+// ErrorCode returns the numeric code sent in the error envelope.
+func (e *DomainError) ErrorCode() int {
+	return e.Code
+}
+
 func NewDomainError(code int, message string) *DomainError {
 	return &DomainError{
 		Code:    code,
@@ -61,6 +122,15 @@ func NewDomainError(code int, message string) *DomainError {
 	}
 }
 
+// newRepositoryError reports a failed database operation, keeping err as the cause.
+func newRepositoryError(message string, err error) *DomainError {
+	return &DomainError{
+		Code:    ErrCodeRepositoryFailure,
+		Message: message,
+		cause:   err,
+	}
+}
+
 func AsDomainError(err error) (*DomainError, bool) {
 	var domainErr *DomainError
 	if errors.As(err, &domainErr) {
@@ -70,7 +140,8 @@ func AsDomainError(err error) (*DomainError, bool) {
 }
 
 // handleDatabaseError converts database errors to domain errors.
-// It checks for PostgreSQL error codes (SQLSTATE) in the error message.
+// It checks for PostgreSQL error codes (SQLSTATE) in the error message and keeps
+// the original error as the cause, so transient failures can still be retried.
 func handleDatabaseError(err error) error {
 	if err == nil {
 		return nil
@@ -86,32 +157,32 @@ func handleDatabaseError(err error) error {
 	// Check for PostgreSQL SQLSTATE codes
 	// SQLSTATE 22001: string data right truncated / value too long
 	if strings.Contains(errStr, "SQLSTATE 22001") || strings.Contains(errStr, "value too long") {
-		return NewDomainError(ErrCodeDatabaseValueTooLong, ErrValueTooLong)
+		return &DomainError{Code: ErrCodeDatabaseValueTooLong, Message: ErrValueTooLong, cause: err}
 	}
 
 	// SQLSTATE 23505: unique violation
 	if strings.Contains(errStr, "SQLSTATE 23505") || strings.Contains(errStr, "duplicate key") || strings.Contains(errStr, "unique constraint") {
-		return NewDomainError(ErrCodeDatabaseUniqueViolation, ErrDatabaseUniqueViolation)
+		return &DomainError{Code: ErrCodeDatabaseUniqueViolation, Message: ErrDatabaseUniqueViolation, cause: err}
 	}
 
 	// SQLSTATE 23503: foreign key violation
 	if strings.Contains(errStr, "SQLSTATE 23503") || strings.Contains(errStr, "foreign key constraint") {
-		return NewDomainError(ErrCodeDatabaseForeignKeyViolation, ErrDatabaseForeignKeyViolation)
+		return &DomainError{Code: ErrCodeDatabaseForeignKeyViolation, Message: ErrDatabaseForeignKeyViolation, cause: err}
 	}
 
 	// SQLSTATE 23514: check constraint violation
 	// SQLSTATE 23502: not null violation
 	// SQLSTATE 23XXX: other constraint violations
 	if strings.Contains(errStr, "SQLSTATE 23") || strings.Contains(errStr, "constraint") {
-		return NewDomainError(ErrCodeDatabaseConstraint, ErrDatabaseConstraintViolation)
+		return &DomainError{Code: ErrCodeDatabaseConstraint, Message: ErrDatabaseConstraintViolation, cause: err}
 	}
 
 	// Connection errors
 	if strings.Contains(errStr, "connection") || strings.Contains(errStr, "dial") || strings.Contains(errStr, "network") {
-		return NewDomainError(ErrCodeDatabaseConnection, ErrDatabaseConnectionFailure)
+		return &DomainError{Code: ErrCodeDatabaseConnection, Message: ErrDatabaseConnectionFailure, cause: err}
 	}
 
 	// For any other database error, return a generic repository failure
-	return NewDomainError(ErrCodeRepositoryFailure, ErrUnableToPersist)
+	return newRepositoryError(ErrUnableToPersist, err)
 }
 
@@ -0,0 +1,43 @@
+package jobapplications
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// applicationETag derives a strong validator for the single-application response
+// from the row's updated_at and version. The response also carries day-relative
+// ages and, when loaded, the linked resume, so today's UTC date and the resume's
+// updated_at are part of the hash as well.
+func applicationETag(application *JobApplication, today time.Time, resumeUpdatedAt *time.Time) string {
+	var b strings.Builder
+	b.WriteString(application.ID.String())
+	b.WriteByte('|')
+	b.WriteString(strconv.FormatInt(application.UpdatedAt.UnixNano(), 10))
+	b.WriteByte('|')
+	b.WriteString(strconv.Itoa(application.Version))
+	b.WriteByte('|')
+	b.WriteString(today.UTC().Format(timeSeriesDateLayout))
+	if resumeUpdatedAt != nil {
+		b.WriteByte('|')
+		b.WriteString(strconv.FormatInt(resumeUpdatedAt.UnixNano(), 10))
+	}
+
+	sum := sha256.Sum256([]byte(b.String()))
+	return `"` + hex.EncodeToString(sum[:16]) + `"`
+}
+
+// etagMatches reports whether an If-None-Match header matches etag. Weak
+// validators are compared weakly, as RFC 9110 requires for If-None-Match.
+func etagMatches(ifNoneMatch, etag string) bool {
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == etag {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,84 @@
+package jobapplications
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeGetService struct {
+	Service
+	application *JobApplication
+}
+
+func (s *fakeGetService) GetJobApplication(_ context.Context, _ uuid.UUID) (*JobApplication, error) {
+	copied := *s.application
+	return &copied, nil
+}
+
+func TestApplicationETag(t *testing.T) {
+	today := time.Date(2024, time.March, 2, 10, 0, 0, 0, time.UTC)
+	application := &JobApplication{ID: uuid.New(), Version: 3, UpdatedAt: today.Add(-time.Hour)}
+
+	etag := applicationETag(application, today, nil)
+	assert.Regexp(t, `^"[0-9a-f]{32}"$`, etag)
+	assert.Equal(t, etag, applicationETag(application, today.Add(time.Hour), nil), "stable within the same day")
+
+	bumped := *application
+	bumped.Version++
+	assert.NotEqual(t, etag, applicationETag(&bumped, today, nil))
+
+	touched := *application
+	touched.UpdatedAt = touched.UpdatedAt.Add(time.Millisecond)
+	assert.NotEqual(t, etag, applicationETag(&touched, today, nil))
+
+	assert.NotEqual(t, etag, applicationETag(application, today.AddDate(0, 0, 1), nil), "ages in the response change daily")
+
+	resumeUpdatedAt := today
+	assert.NotEqual(t, etag, applicationETag(application, today, &resumeUpdatedAt))
+}
+
+func TestEtagMatches(t *testing.T) {
+	etag := `"abc"`
+	assert.True(t, etagMatches(`"abc"`, etag))
+	assert.True(t, etagMatches(`W/"abc"`, etag))
+	assert.True(t, etagMatches(`"xyz", "abc"`, etag))
+	assert.True(t, etagMatches(`*`, etag))
+	assert.False(t, etagMatches(``, etag))
+	assert.False(t, etagMatches(`"abcd"`, etag))
+}
+
+func TestGetJobApplication_ConditionalGet(t *testing.T) {
+	application := &JobApplication{ID: uuid.New(), UserID: uuid.New(), Version: 2, UpdatedAt: time.Now().UTC()}
+	svc := &fakeGetService{application: application}
+	app := fiber.New()
+	app.Get("/job-applications/:id", NewHandler(svc, nil).GetJobApplication)
+	path := "/job-applications/" + application.ID.String()
+
+	resp, err := app.Test(httptest.NewRequest("GET", path, nil))
+	require.NoError(t, err)
+	require.Equal(t, fiber.StatusOK, resp.StatusCode)
+	etag := resp.Header.Get(fiber.HeaderETag)
+	require.NotEmpty(t, etag)
+
+	req := httptest.NewRequest("GET", path, nil)
+	req.Header.Set(fiber.HeaderIfNoneMatch, etag)
+	resp, err = app.Test(req)
+	require.NoError(t, err)
+	assert.Equal(t, fiber.StatusNotModified, resp.StatusCode)
+	assert.Equal(t, etag, resp.Header.Get(fiber.HeaderETag))
+
+	application.Version++
+	req = httptest.NewRequest("GET", path, nil)
+	req.Header.Set(fiber.HeaderIfNoneMatch, etag)
+	resp, err = app.Test(req)
+	require.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	assert.NotEqual(t, etag, resp.Header.Get(fiber.HeaderETag))
+}
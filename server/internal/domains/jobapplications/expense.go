@@ -0,0 +1,241 @@
+package jobapplications
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"woragis-jobs-service/pkg/middleware"
+	"woragis-jobs-service/pkg/response"
+)
+
+// MaxExpenseDescriptionLength bounds the description of an expense.
+const MaxExpenseDescriptionLength = 255
+
+// ApplicationExpense is a cost incurred for a job application, such as a certification
+// exam or travel to an onsite interview. Amounts are in the currency's minor unit
+// (e.g. cents) so totals add up exactly.
+type ApplicationExpense struct {
+	ID               uuid.UUID `gorm:"column:id;type:uuid;primaryKey" json:"id"`
+	JobApplicationID uuid.UUID `gorm:"column:job_application_id;type:uuid;index;not null" json:"jobApplicationId"`
+	UserID           uuid.UUID `gorm:"column:user_id;type:uuid;index;not null" json:"userId"`
+	Description      string    `gorm:"column:description;size:255;not null" json:"description"`
+	AmountCents      int64     `gorm:"column:amount_cents;not null" json:"amountCents"`
+	Currency         string    `gorm:"column:currency;size:3;not null" json:"currency"`
+	IncurredAt       time.Time `gorm:"column:incurred_at;not null" json:"incurredAt"`
+	CreatedAt        time.Time `gorm:"column:created_at" json:"createdAt"`
+}
+
+// TableName specifies the table name for ApplicationExpense.
+func (ApplicationExpense) TableName() string {
+	return "job_application_expenses"
+}
+
+// ExpenseDetails holds the user-supplied fields of an expense.
+type ExpenseDetails struct {
+	Description string
+	AmountCents int64
+	Currency    string
+	IncurredAt  *time.Time // Defaults to now
+}
+
+// NewApplicationExpense creates an expense for the given application.
+func NewApplicationExpense(application *JobApplication, details ExpenseDetails) (*ApplicationExpense, error) {
+	now := time.Now().UTC()
+	expense := &ApplicationExpense{
+		ID:               uuid.New(),
+		JobApplicationID: application.ID,
+		UserID:           application.UserID,
+		Description:      strings.TrimSpace(details.Description),
+		AmountCents:      details.AmountCents,
+		Currency:         strings.ToUpper(strings.TrimSpace(details.Currency)),
+		IncurredAt:       now,
+		CreatedAt:        now,
+	}
+	if details.IncurredAt != nil {
+		expense.IncurredAt = details.IncurredAt.UTC()
+	}
+
+	return expense, expense.Validate()
+}
+
+// Validate ensures expense invariants hold.
+func (e *ApplicationExpense) Validate() error {
+	if e.JobApplicationID == uuid.Nil {
+		return NewDomainError(ErrCodeInvalidPayload, ErrEmptyApplicationID)
+	}
+	if e.Description == "" || len(e.Description) > MaxExpenseDescriptionLength {
+		return NewDomainError(ErrCodeInvalidPayload, ErrInvalidExpenseDescription)
+	}
+	if e.AmountCents <= 0 {
+		return NewDomainError(ErrCodeInvalidPayload, ErrInvalidExpenseAmount)
+	}
+	if len(e.Currency) != 3 {
+		return NewDomainError(ErrCodeInvalidPayload, ErrInvalidExpenseCurrency)
+	}
+	return nil
+}
+
+// ExpenseStats totals a user's expenses. Amounts are never mixed across currencies,
+// so totals are reported per currency.
+type ExpenseStats struct {
+	Count        int                    `json:"count"`
+	Applications int                    `json:"applications"` // Applications with at least one expense
+	ByCurrency   []CurrencyExpenseStats `json:"byCurrency"`
+}
+
+// CurrencyExpenseStats totals the expenses paid in one currency.
+type CurrencyExpenseStats struct {
+	Currency     string `json:"currency"`
+	Count        int    `json:"count"`
+	TotalCents   int64  `json:"totalCents"`
+	LargestCents int64  `json:"largestCents"`
+}
+
+// NewExpenseStats totals expenses per currency, ordered by currency code.
+func NewExpenseStats(expenses []ApplicationExpense) *ExpenseStats {
+	applications := make(map[uuid.UUID]struct{})
+	byCurrency := make(map[string]*CurrencyExpenseStats)
+	for i := range expenses {
+		expense := &expenses[i]
+		applications[expense.JobApplicationID] = struct{}{}
+		totals, ok := byCurrency[expense.Currency]
+		if !ok {
+			totals = &CurrencyExpenseStats{Currency: expense.Currency}
+			byCurrency[expense.Currency] = totals
+		}
+		totals.Count++
+		totals.TotalCents += expense.AmountCents
+		totals.LargestCents = max(totals.LargestCents, expense.AmountCents)
+	}
+
+	stats := &ExpenseStats{
+		Count:        len(expenses),
+		Applications: len(applications),
+		ByCurrency:   make([]CurrencyExpenseStats, 0, len(byCurrency)),
+	}
+	for _, totals := range byCurrency {
+		stats.ByCurrency = append(stats.ByCurrency, *totals)
+	}
+	sort.Slice(stats.ByCurrency, func(i, j int) bool {
+		return stats.ByCurrency[i].Currency < stats.ByCurrency[j].Currency
+	})
+
+	return stats
+}
+
+// AddExpense records an expense for the user's application. A blank currency falls
+// back to the application's salary currency.
+func (s *service) AddExpense(ctx context.Context, userID, applicationID uuid.UUID, details ExpenseDetails) (*ApplicationExpense, error) {
+	application, err := s.getOwnedApplication(ctx, userID, applicationID)
+	if err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(details.Currency) == "" {
+		details.Currency = application.SalaryCurrency
+	}
+
+	expense, err := NewApplicationExpense(application, details)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repo.CreateExpense(ctx, expense); err != nil {
+		return nil, err
+	}
+	return expense, nil
+}
+
+// ListExpenses returns the expenses of the user's application, most recent first.
+func (s *service) ListExpenses(ctx context.Context, userID, applicationID uuid.UUID) ([]ApplicationExpense, error) {
+	if _, err := s.getOwnedApplication(ctx, userID, applicationID); err != nil {
+		return nil, err
+	}
+	return s.repo.ListExpenses(ctx, applicationID)
+}
+
+// GetExpenseStats totals the user's expenses across all applications per currency.
+func (s *service) GetExpenseStats(ctx context.Context, userID uuid.UUID) (*ExpenseStats, error) {
+	expenses, err := s.repo.ListExpensesByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return NewExpenseStats(expenses), nil
+}
+
+// AddExpense records a cost incurred for the application.
+func (h *handler) AddExpense(c *fiber.Ctx) error {
+	userID, err := middleware.GetUserIDFromFiberContext(c)
+	if err != nil {
+		return response.Error(c, fiber.StatusUnauthorized, 401, fiber.Map{
+			"message": "authentication required",
+		})
+	}
+
+	applicationID, err := middleware.UUIDParam(c, "id")
+	if err != nil {
+		return middleware.InvalidUUIDParam(c, "id")
+	}
+
+	var payload expensePayload
+	if err := c.BodyParser(&payload); err != nil {
+		return response.Error(c, fiber.StatusBadRequest, ErrCodeInvalidPayload, fiber.Map{
+			"message": "invalid request payload",
+		})
+	}
+
+	details, err := ValidateExpensePayload(&payload)
+	if err != nil {
+		return response.Error(c, fiber.StatusBadRequest, ErrCodeInvalidPayload, fiber.Map{
+			"message": err.Error(),
+		})
+	}
+
+	expense, err := h.service.AddExpense(c.Context(), userID, applicationID, details)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return response.Success(c, fiber.StatusCreated, expense)
+}
+
+// ListExpenses returns the application's expenses, most recent first.
+func (h *handler) ListExpenses(c *fiber.Ctx) error {
+	userID, err := middleware.GetUserIDFromFiberContext(c)
+	if err != nil {
+		return response.Error(c, fiber.StatusUnauthorized, 401, fiber.Map{
+			"message": "authentication required",
+		})
+	}
+
+	applicationID, err := middleware.UUIDParam(c, "id")
+	if err != nil {
+		return middleware.InvalidUUIDParam(c, "id")
+	}
+
+	expenses, err := h.service.ListExpenses(c.Context(), userID, applicationID)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return response.Success(c, fiber.StatusOK, expenses)
+}
+
+func (h *handler) GetExpenseStats(c *fiber.Ctx) error {
+	userID, err := middleware.GetUserIDFromFiberContext(c)
+	if err != nil {
+		return response.Error(c, fiber.StatusUnauthorized, 401, fiber.Map{
+			"message": "authentication required",
+		})
+	}
+
+	stats, err := h.service.GetExpenseStats(c.Context(), userID)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return response.Success(c, fiber.StatusOK, stats)
+}
@@ -0,0 +1,95 @@
+package jobapplications
+
+import (
+	"context"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeExpenseRepo serves one application and records the expenses created for it.
+type fakeExpenseRepo struct {
+	Repository
+	application *JobApplication
+	expenses    []ApplicationExpense
+}
+
+func (r *fakeExpenseRepo) GetJobApplication(_ context.Context, _ uuid.UUID) (*JobApplication, error) {
+	return r.application, nil
+}
+
+func (r *fakeExpenseRepo) CreateExpense(_ context.Context, expense *ApplicationExpense) error {
+	r.expenses = append(r.expenses, *expense)
+	return nil
+}
+
+func TestAddExpense(t *testing.T) {
+	userID := uuid.New()
+	repo := &fakeExpenseRepo{application: &JobApplication{ID: uuid.New(), UserID: userID, SalaryCurrency: "eur"}}
+	app := fiber.New()
+	app.Use(func(c *fiber.Ctx) error {
+		c.Locals("userID", userID)
+		return c.Next()
+	})
+	app.Post("/job-applications/:id/expenses", NewHandler(NewService(repo, nil, nil), nil).AddExpense)
+	path := "/job-applications/" + repo.application.ID.String() + "/expenses"
+
+	post := func(body string) int {
+		req := httptest.NewRequest("POST", path, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		return resp.StatusCode
+	}
+
+	assert.Equal(t, fiber.StatusCreated, post(`{"description":" Train to onsite ","amountCents":4999,"incurredAt":"2026-03-02T10:00:00Z"}`))
+	require.Len(t, repo.expenses, 1)
+	expense := repo.expenses[0]
+	assert.Equal(t, "Train to onsite", expense.Description)
+	assert.Equal(t, int64(4999), expense.AmountCents)
+	assert.Equal(t, "EUR", expense.Currency, "defaults to the salary currency")
+	assert.Equal(t, time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC), expense.IncurredAt)
+	assert.Equal(t, userID, expense.UserID)
+
+	for name, body := range map[string]string{
+		"no description": `{"amountCents":100}`,
+		"zero amount":    `{"description":"Exam","amountCents":0}`,
+		"bad currency":   `{"description":"Exam","amountCents":100,"currency":"EURO"}`,
+		"future date":    `{"description":"Exam","amountCents":100,"incurredAt":"` + time.Now().Add(time.Hour).Format(time.RFC3339) + `"}`,
+	} {
+		assert.Equal(t, fiber.StatusBadRequest, post(body), name)
+	}
+
+	repo.application.SalaryCurrency = ""
+	assert.Equal(t, fiber.StatusUnprocessableEntity, post(`{"description":"Exam","amountCents":100}`), "no currency to fall back to")
+
+	repo.application.UserID = uuid.New()
+	assert.Equal(t, fiber.StatusNotFound, post(`{"description":"Exam","amountCents":100,"currency":"USD"}`), "another user's application")
+	assert.Len(t, repo.expenses, 1)
+}
+
+func TestNewExpenseStats(t *testing.T) {
+	first, second := uuid.New(), uuid.New()
+	stats := NewExpenseStats([]ApplicationExpense{
+		{JobApplicationID: first, AmountCents: 25000, Currency: "USD"},
+		{JobApplicationID: first, AmountCents: 4999, Currency: "EUR"},
+		{JobApplicationID: second, AmountCents: 1500, Currency: "USD"},
+	})
+
+	assert.Equal(t, 3, stats.Count)
+	assert.Equal(t, 2, stats.Applications)
+	assert.Equal(t, []CurrencyExpenseStats{
+		{Currency: "EUR", Count: 1, TotalCents: 4999, LargestCents: 4999},
+		{Currency: "USD", Count: 2, TotalCents: 26500, LargestCents: 25000},
+	}, stats.ByCurrency)
+
+	empty := NewExpenseStats(nil)
+	assert.Equal(t, 0, empty.Count)
+	assert.NotNil(t, empty.ByCurrency)
+}
@@ -2,6 +2,8 @@ package jobapplications
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
 	"strings"
@@ -10,7 +12,9 @@ import (
 	"github.com/gofiber/fiber/v2"
 	"github.com/google/uuid"
 
+	"woragis-jobs-service/pkg/jsonpatch"
 	"woragis-jobs-service/pkg/middleware"
+	"woragis-jobs-service/pkg/pagination"
 	"woragis-jobs-service/pkg/response"
 )
 
@@ -46,6 +50,12 @@ type ResumeService interface {
 	GetResume(ctx context.Context, userID uuid.UUID, resumeID uuid.UUID) (*Resume, error)
 }
 
+// DefaultResumeProvider picks the resume auto-attached to new applications.
+// GetBestResume returns nil without an error when the user has no resumes.
+type DefaultResumeProvider interface {
+	GetBestResume(ctx context.Context, userID uuid.UUID) (*Resume, error)
+}
+
 // Resume represents a resume (minimal interface to avoid import cycle).
 type Resume struct {
 	ID         uuid.UUID `json:"id"`
@@ -91,7 +101,7 @@ type JobInfo struct {
 
 // CoverLetterGenerator is an interface for generating cover letters.
 type CoverLetterGenerator interface {
-	GenerateCoverLetterWithContext(ctx context.Context, profile UserProfile, job JobInfo, additionalContext string) (string, error)
+	GenerateCoverLetterWithContext(ctx context.Context, profile UserProfile, job JobInfo, additionalContext string, agent string) (*GeneratedCoverLetter, error)
 }
 
 // Handler exposes job application endpoints.
@@ -99,10 +109,48 @@ type Handler interface {
 	CreateJobApplication(c *fiber.Ctx) error
 	GetJobApplication(c *fiber.Ctx) error
 	ListJobApplications(c *fiber.Ctx) error
+	ListJobApplicationsByCompany(c *fiber.Ctx) error
+	ListCompanies(c *fiber.Ctx) error
+	ListUpcomingDeadlines(c *fiber.Ctx) error
 	UpdateJobApplicationStatus(c *fiber.Ctx) error
 	UpdateJobApplication(c *fiber.Ctx) error
 	DeleteJobApplication(c *fiber.Ctx) error
+	BulkDeleteJobApplications(c *fiber.Ctx) error
 	GenerateCoverLetter(c *fiber.Ctx) error
+	RefineCoverLetter(c *fiber.Ctx) error
+	ListCoverLetterRevisions(c *fiber.Ctx) error
+	GetCoverLetterPrompt(c *fiber.Ctx) error
+	GetCoverLetter(c *fiber.Ctx) error
+	GenerateCoverLetterBatch(c *fiber.Ctx) error
+	BulkAddTag(c *fiber.Ctx) error
+	BulkRemoveTag(c *fiber.Ctx) error
+	BulkSetInterestLevel(c *fiber.Ctx) error
+	BulkContact(c *fiber.Ctx) error
+	CompareJobApplications(c *fiber.Ctx) error
+	BatchGetJobApplications(c *fiber.Ctx) error
+	DetectLanguage(c *fiber.Ctx) error
+	SuggestTags(c *fiber.Ctx) error
+	DraftFromURL(c *fiber.Ctx) error
+	IngestJobApplication(c *fiber.Ctx) error
+	GetApplicationTimeSeries(c *fiber.Ctx) error
+	SnoozeJobApplication(c *fiber.Ctx) error
+	UnsnoozeJobApplication(c *fiber.Ctx) error
+	PinJobApplication(c *fiber.Ctx) error
+	UnpinJobApplication(c *fiber.Ctx) error
+	MuteNotifications(c *fiber.Ctx) error
+	UnmuteNotifications(c *fiber.Ctx) error
+	ReopenJobApplication(c *fiber.Ctx) error
+	DuplicateJobApplication(c *fiber.Ctx) error
+	ListStatusChanges(c *fiber.Ctx) error
+	SaveOffer(c *fiber.Ctx) error
+	GetOffer(c *fiber.Ctx) error
+	DeleteOffer(c *fiber.Ctx) error
+	GetOfferStats(c *fiber.Ctx) error
+	AddExpense(c *fiber.Ctx) error
+	ListExpenses(c *fiber.Ctx) error
+	GetExpenseStats(c *fiber.Ctx) error
+	GetInterestLevelStats(c *fiber.Ctx) error
+	GetBoard(c *fiber.Ctx) error
 }
 
 type handler struct {
@@ -110,6 +158,7 @@ type handler struct {
 	conversationCreator ConversationCreator // Optional: for auto-creating conversations
 	resumeService    ResumeService          // Optional: for including resume data in responses
 	coverLetterGenerator CoverLetterGenerator // Optional: for generating cover letters
+	defaultResume    DefaultResumeProvider  // Optional: auto-attaches a resume on create (opt-in)
 	logger          *slog.Logger
 }
 
@@ -141,16 +190,92 @@ func NewHandlerWithDependencies(service Service, conversationCreator Conversatio
 	}
 }
 
+// NewHandlerWithDefaultResume constructs a job application handler that attaches the
+// user's best resume to applications created without one.
+func NewHandlerWithDefaultResume(service Service, conversationCreator ConversationCreator, resumeService ResumeService, coverLetterGenerator CoverLetterGenerator, defaultResume DefaultResumeProvider, logger *slog.Logger) Handler {
+	return &handler{
+		service:              service,
+		conversationCreator:  conversationCreator,
+		resumeService:        resumeService,
+		coverLetterGenerator: coverLetterGenerator,
+		defaultResume:        defaultResume,
+		logger:               logger,
+	}
+}
+
 type createJobApplicationPayload struct {
 	CompanyName   string   `json:"companyName"`
 	Location      string   `json:"location"`
 	JobTitle      string   `json:"jobTitle"`
 	JobURL        string   `json:"jobUrl"`
 	Website       string   `json:"website"`
-	InterestLevel string   `json:"interestLevel,omitempty"`
+	InterestLevel InterestLevel `json:"interestLevel,omitempty"`
 	Tags          []string `json:"tags,omitempty"`
 	FollowUpDate  string   `json:"followUpDate,omitempty"`
 	Notes         string   `json:"notes,omitempty"`
+	JobDescription string  `json:"jobDescription,omitempty"`
+	Language      string   `json:"language,omitempty"` // Detected from jobDescription when blank
+	ApplicationMethod string `json:"applicationMethod,omitempty"` // Defaults to the website's default method
+}
+
+type offerPayload struct {
+	BaseSalary    int    `json:"baseSalary"`
+	Currency      string `json:"currency,omitempty"` // Defaults to the application's salaryCurrency
+	Bonus         *int   `json:"bonus,omitempty"`
+	EquityValue   *int   `json:"equityValue,omitempty"`
+	EquityDetails string `json:"equityDetails,omitempty"`
+	StartDate     string `json:"startDate,omitempty"` // ISO 8601 format
+	AcceptBy      string `json:"acceptBy,omitempty"`  // ISO 8601 format
+	Notes         string `json:"notes,omitempty"`
+}
+
+type expensePayload struct {
+	Description string `json:"description"`
+	AmountCents int64  `json:"amountCents"`
+	Currency    string `json:"currency,omitempty"`   // Defaults to the application's salaryCurrency
+	IncurredAt  string `json:"incurredAt,omitempty"` // ISO 8601 format; defaults to now
+}
+
+type fromURLPayload struct {
+	URL string `json:"url"`
+}
+
+type ingestPayload struct {
+	SourceURL string              `json:"sourceUrl"`
+	HTML      string              `json:"html,omitempty"` // Raw snippet of the posting page
+	Fields    ingestFieldsPayload `json:"fields"`
+}
+
+type ingestFieldsPayload struct {
+	CompanyName    string `json:"companyName,omitempty"`
+	JobTitle       string `json:"jobTitle,omitempty"`
+	Location       string `json:"location,omitempty"`
+	JobDescription string `json:"jobDescription,omitempty"`
+	Website        string `json:"website,omitempty"`
+	SalaryMin      *int   `json:"salaryMin,omitempty"`
+	SalaryMax      *int   `json:"salaryMax,omitempty"`
+	SalaryCurrency string `json:"salaryCurrency,omitempty"`
+	Language       string `json:"language,omitempty"`
+}
+
+type detectLanguagePayload struct {
+	Text string `json:"text"`
+}
+
+type suggestTagsPayload struct {
+	JobDescription string `json:"jobDescription"`
+}
+
+type snoozePayload struct {
+	Until string `json:"until"` // RFC 3339 or YYYY-MM-DD (midnight UTC)
+}
+
+type pinPayload struct {
+	Order *int `json:"order"` // 1 is the top of the list
+}
+
+type reopenPayload struct {
+	Reason string `json:"reason"` // why the application is active again, kept in the status history
 }
 
 type updateStatusPayload struct {
@@ -175,9 +300,7 @@ func (h *handler) CreateJobApplication(c *fiber.Ctx) error {
 
 	// Validate payload
 	if err := ValidateCreateJobApplicationPayload(&payload); err != nil {
-		return response.Error(c, fiber.StatusBadRequest, ErrCodeInvalidPayload, fiber.Map{
-			"message": err.Error(),
-		})
+		return h.validationError(c, err)
 	}
 
 	// Normalize website to lowercase
@@ -194,6 +317,7 @@ func (h *handler) CreateJobApplication(c *fiber.Ctx) error {
 		payload.JobTitle,
 		payload.JobURL,
 		payload.Website,
+		payload.ApplicationMethod,
 	)
 	if err != nil {
 		return h.handleError(c, err)
@@ -222,13 +346,41 @@ func (h *handler) CreateJobApplication(c *fiber.Ctx) error {
 		updates.Notes = &payload.Notes
 		hasUpdates = true
 	}
+	if payload.JobDescription != "" {
+		updates.JobDescription = &payload.JobDescription
+		hasUpdates = true
+	}
+	if application.ResumeID == nil && h.defaultResume != nil {
+		// Best effort: a lookup failure or a user without resumes never blocks creation
+		if resume, err := h.defaultResume.GetBestResume(c.Context(), userID); err != nil {
+			if h.logger != nil {
+				h.logger.WarnContext(c.UserContext(), "failed to look up default resume", slog.Any("error", err))
+			}
+		} else if resume != nil {
+			updates.ResumeID = &resume.ID
+			hasUpdates = true
+		}
+	}
+	if payload.Language != "" {
+		updates.Language = &payload.Language
+		hasUpdates = true
+	} else if payload.JobDescription != "" {
+		// Detection from the posting itself takes precedence over the user's default language.
+		// Creation stays off the AI service; /detect-language offers the more accurate check.
+		if language, err := h.service.DetectJobLanguageLocally(c.Context(), payload.JobDescription); err == nil {
+			updates.Language = &language
+			hasUpdates = true
+		} else if h.logger != nil {
+			h.logger.WarnContext(c.UserContext(), "failed to detect job application language", slog.Any("error", err))
+		}
+	}
 
 	if hasUpdates {
 		application, err = h.service.UpdateJobApplication(c.Context(), application.ID, updates)
 		if err != nil {
 			// Log error but don't fail the request
 			if h.logger != nil {
-				h.logger.Warn("failed to update application fields", slog.Any("error", err))
+				h.logger.WarnContext(c.UserContext(), "failed to update application fields", slog.Any("error", err))
 			}
 		}
 	}
@@ -248,7 +400,7 @@ func (h *handler) CreateJobApplication(c *fiber.Ctx) error {
 		if err != nil {
 			// Log error but don't fail the request
 			if h.logger != nil {
-				h.logger.Warn("failed to auto-create conversation for job application", 
+				h.logger.WarnContext(c.UserContext(), "failed to auto-create conversation for job application", 
 					slog.String("application_id", jobAppID.String()),
 					slog.Any("error", err))
 			}
@@ -259,11 +411,9 @@ func (h *handler) CreateJobApplication(c *fiber.Ctx) error {
 }
 
 func (h *handler) GetJobApplication(c *fiber.Ctx) error {
-	applicationID, err := uuid.Parse(c.Params("id"))
+	applicationID, err := middleware.UUIDParam(c, "id")
 	if err != nil {
-		return response.Error(c, fiber.StatusBadRequest, ErrCodeInvalidPayload, fiber.Map{
-			"message": "invalid application id",
-		})
+		return middleware.InvalidUUIDParam(c, "id")
 	}
 
 	application, err := h.service.GetJobApplication(c.Context(), applicationID)
@@ -295,6 +445,8 @@ func (h *handler) GetJobApplication(c *fiber.Ctx) error {
 		"notes":               application.Notes,
 		"tags":                application.Tags,
 		"followUpDate":        application.FollowUpDate,
+		"nextAction":          application.NextAction,
+		"nextActionDue":       application.NextActionDue,
 		"responseReceivedAt":  application.ResponseReceivedAt,
 		"rejectionReason":     application.RejectionReason,
 		"interviewCount":      application.InterviewCount,
@@ -302,14 +454,21 @@ func (h *handler) GetJobApplication(c *fiber.Ctx) error {
 		"source":              application.Source,
 		"applicationMethod":   application.ApplicationMethod,
 		"language":            application.Language,
+		"version":             application.Version,
 		"createdAt":           application.CreatedAt,
 		"updatedAt":           application.UpdatedAt,
 	}
 
+	daysSinceCreated, daysSinceApplied := applicationAge(application, time.Now().UTC())
+	responseData["daysSinceCreated"] = daysSinceCreated
+	responseData["daysSinceApplied"] = daysSinceApplied
+
 	// If resumeId exists and resumeService is available, fetch full resume data
+	var resumeUpdatedAt *time.Time
 	if application.ResumeID != nil && h.resumeService != nil {
 		resume, err := h.resumeService.GetResume(c.Context(), application.UserID, *application.ResumeID)
 		if err == nil {
+			resumeUpdatedAt = &resume.UpdatedAt
 			responseData["resume"] = fiber.Map{
 				"id":         resume.ID,
 				"userId":     resume.UserID,
@@ -326,7 +485,7 @@ func (h *handler) GetJobApplication(c *fiber.Ctx) error {
 		} else {
 			// Log error but don't fail the request
 			if h.logger != nil {
-				h.logger.Warn("failed to fetch resume data",
+				h.logger.WarnContext(c.UserContext(), "failed to fetch resume data",
 					slog.String("resume_id", application.ResumeID.String()),
 					slog.Any("error", err),
 				)
@@ -334,6 +493,14 @@ func (h *handler) GetJobApplication(c *fiber.Ctx) error {
 		}
 	}
 
+	// Polling clients revalidate with If-None-Match and get an empty 304 while nothing changed
+	etag := applicationETag(application, time.Now().UTC(), resumeUpdatedAt)
+	c.Set(fiber.HeaderETag, etag)
+	c.Set(fiber.HeaderCacheControl, "private, no-cache")
+	if etagMatches(c.Get(fiber.HeaderIfNoneMatch), etag) {
+		return c.SendStatus(fiber.StatusNotModified)
+	}
+
 	return response.Success(c, fiber.StatusOK, responseData)
 }
 
@@ -359,11 +526,46 @@ func (h *handler) ListJobApplications(c *fiber.Ctx) error {
 	source := c.Query("source")
 	applicationMethod := c.Query("applicationMethod")
 	language := c.Query("language")
-	limit := c.QueryInt("limit", 50)
-	offset := c.QueryInt("offset", 0)
+	search := strings.TrimSpace(c.Query("search"))
+	stale := c.Query("stale")
+	staleDays := c.QueryInt("staleDays", defaultStaleDays)
+	includeSnoozed := c.Query("includeSnoozed")
+	deep := c.Query("deep")
 
 	// Validate query parameters
-	if err := ValidateListJobApplicationsQueryParams(limit, offset, website, status, resumeIDStr, interestLevel, source, applicationMethod, language); err != nil {
+	page, err := pagination.FromQuery(c)
+	if err != nil {
+		return response.Error(c, fiber.StatusBadRequest, ErrCodeInvalidPayload, fiber.Map{
+			"message": err.Error(),
+		})
+	}
+	if err := ValidateListJobApplicationsQueryParams(website, status, resumeIDStr, interestLevel, source, applicationMethod, language); err != nil {
+		return response.Error(c, fiber.StatusBadRequest, ErrCodeInvalidPayload, fiber.Map{
+			"message": err.Error(),
+		})
+	}
+	if err := ValidateSearchQuery(search); err != nil {
+		return response.Error(c, fiber.StatusBadRequest, ErrCodeInvalidPayload, fiber.Map{
+			"message": err.Error(),
+		})
+	}
+	if err := ValidateStaleFilterParams(stale, staleDays); err != nil {
+		return response.Error(c, fiber.StatusBadRequest, ErrCodeInvalidPayload, fiber.Map{
+			"message": err.Error(),
+		})
+	}
+	if includeSnoozed != "" && includeSnoozed != "true" && includeSnoozed != "false" {
+		return response.Error(c, fiber.StatusBadRequest, ErrCodeInvalidPayload, fiber.Map{
+			"message": "includeSnoozed: must be true or false",
+		})
+	}
+	if deep != "" && deep != "true" && deep != "false" {
+		return response.Error(c, fiber.StatusBadRequest, ErrCodeInvalidPayload, fiber.Map{
+			"message": "deep: must be true or false",
+		})
+	}
+	tags, tagsMatchAny, err := ParseTagFilter(c.Query("tags"), c.Query("tagMatch"))
+	if err != nil {
 		return response.Error(c, fiber.StatusBadRequest, ErrCodeInvalidPayload, fiber.Map{
 			"message": err.Error(),
 		})
@@ -384,7 +586,8 @@ func (h *handler) ListJobApplications(c *fiber.Ctx) error {
 		}
 	}
 	if interestLevel != "" {
-		filters.InterestLevel = &interestLevel
+		level, _ := ParseInterestLevel(interestLevel)
+		filters.InterestLevel = &level
 	}
 	if source != "" {
 		filters.Source = &source
@@ -395,32 +598,128 @@ func (h *handler) ListJobApplications(c *fiber.Ctx) error {
 	if language != "" {
 		filters.Language = &language
 	}
-
-	// Pagination
-	if limit > 0 {
-		filters.Limit = limit
+	if search != "" {
+		filters.Search = &search
+		// Searching stage notes and responses costs two subqueries per row, so it is opt-in
+		filters.DeepSearch = deep == "true"
+	}
+	if stale == "true" {
+		appliedBefore := time.Now().UTC().AddDate(0, 0, -staleDays)
+		filters.StaleAppliedBefore = &appliedBefore
 	}
-	if offset > 0 {
-		filters.Offset = offset
+	if includeSnoozed != "true" {
+		now := time.Now().UTC()
+		filters.SnoozedAt = &now
 	}
+	if len(tags) > 0 {
+		filters.Tags = tags
+		filters.TagsMatchAny = tagsMatchAny
+	}
+
+	filters.Limit = page.Limit
+	filters.Offset = page.Offset
 
 	applications, err := h.service.ListJobApplications(c.Context(), filters)
 	if err != nil {
 		return h.handleError(c, err)
 	}
+	total, err := h.service.CountJobApplications(c.Context(), filters)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	now := time.Now().UTC()
+	items := make([]applicationWithAge, 0, len(applications))
+	for _, application := range applications {
+		daysSinceCreated, daysSinceApplied := applicationAge(&application, now)
+		items = append(items, applicationWithAge{
+			JobApplication:   application,
+			DaysSinceCreated: daysSinceCreated,
+			DaysSinceApplied: daysSinceApplied,
+		})
+	}
+
+	page.SetHeaders(c)
+	page.SetLinkHeader(c, len(items), total)
+	return response.Success(c, fiber.StatusOK, fiber.Map{
+		"applications": items,
+		"count":        len(items),
+		"total":        total,
+		"limit":        page.Limit,
+		"offset":       page.Offset,
+	})
+}
+
+// ListJobApplicationsByCompany lists the user's applications to one company across
+// roles, newest first, e.g. to prepare for an interview there.
+func (h *handler) ListJobApplicationsByCompany(c *fiber.Ctx) error {
+	userID, err := middleware.GetUserIDFromFiberContext(c)
+	if err != nil {
+		return response.Error(c, fiber.StatusUnauthorized, 401, fiber.Map{
+			"message": "authentication required",
+		})
+	}
+
+	name := c.Query("name")
+	if err := ValidateCompanyNameQuery(name); err != nil {
+		return response.Error(c, fiber.StatusBadRequest, ErrCodeInvalidPayload, fiber.Map{
+			"message": err.Error(),
+		})
+	}
+	page, err := pagination.FromQuery(c)
+	if err != nil {
+		return response.Error(c, fiber.StatusBadRequest, ErrCodeInvalidPayload, fiber.Map{
+			"message": err.Error(),
+		})
+	}
+
+	applications, err := h.service.ListJobApplicationsByCompany(c.Context(), userID, name, page.Limit, page.Offset)
+	if err != nil {
+		return h.handleError(c, err)
+	}
 
+	page.SetHeaders(c)
+	page.SetLinkHeader(c, len(applications), pagination.UnknownTotal)
 	return response.Success(c, fiber.StatusOK, fiber.Map{
 		"applications": applications,
 		"count":        len(applications),
+		"limit":        page.Limit,
+		"offset":       page.Offset,
 	})
 }
 
+// defaultStaleDays is how long an application may go without a response before stale=true matches it.
+const defaultStaleDays = 14
+
+// applicationWithAge adds computed, non-persisted age fields to a job application.
+type applicationWithAge struct {
+	JobApplication
+	DaysSinceCreated int  `json:"daysSinceCreated"`
+	DaysSinceApplied *int `json:"daysSinceApplied"`
+}
+
+// applicationAge returns whole days since the application was created and,
+// if it has been applied, since it was applied.
+func applicationAge(application *JobApplication, now time.Time) (int, *int) {
+	daysSinceCreated := daysBetween(application.CreatedAt, now)
+	if application.AppliedAt == nil {
+		return daysSinceCreated, nil
+	}
+	daysSinceApplied := daysBetween(*application.AppliedAt, now)
+	return daysSinceCreated, &daysSinceApplied
+}
+
+func daysBetween(from, to time.Time) int {
+	if from.IsZero() || to.Before(from) {
+		return 0
+	}
+	return int(to.Sub(from).Hours() / 24)
+}
+
 func (h *handler) UpdateJobApplicationStatus(c *fiber.Ctx) error {
-	applicationID, err := uuid.Parse(c.Params("id"))
+	applicationID, err := middleware.UUIDParam(c, "id")
 	if err != nil {
-		return response.Error(c, fiber.StatusBadRequest, ErrCodeInvalidPayload, fiber.Map{
-			"message": "invalid application id",
-		})
+		return middleware.InvalidUUIDParam(c, "id")
 	}
 
 	var payload updateStatusPayload
@@ -430,8 +729,14 @@ func (h *handler) UpdateJobApplicationStatus(c *fiber.Ctx) error {
 		})
 	}
 
-	// Validate payload
+	// Validate payload; an unknown status lists the accepted ones
 	if err := ValidateUpdateStatusPayload(&payload); err != nil {
+		if _, ok := AsDomainError(err); ok {
+			return response.Error(c, fiber.StatusUnprocessableEntity, ErrCodeInvalidStatus, fiber.Map{
+				"message":         err.Error(),
+				"allowedStatuses": ApplicationStatuses,
+			})
+		}
 		return response.Error(c, fiber.StatusBadRequest, ErrCodeInvalidPayload, fiber.Map{
 			"message": err.Error(),
 		})
@@ -456,7 +761,7 @@ type updateJobApplicationPayload struct {
 	SalaryCurrency    *string   `json:"salaryCurrency,omitempty"`
 	JobDescription    *string   `json:"jobDescription,omitempty"`
 	Deadline          *string   `json:"deadline,omitempty"` // ISO 8601 format
-	InterestLevel     *string   `json:"interestLevel,omitempty"`
+	InterestLevel     *InterestLevel `json:"interestLevel,omitempty"`
 	Notes             *string   `json:"notes,omitempty"`
 	Tags              JSONArray `json:"tags,omitempty"`
 	FollowUpDate      *string   `json:"followUpDate,omitempty"` // ISO 8601 format
@@ -466,14 +771,18 @@ type updateJobApplicationPayload struct {
 	Source            *string   `json:"source,omitempty"`
 	ApplicationMethod *string  `json:"applicationMethod,omitempty"`
 	Language          *string   `json:"language,omitempty"` // ISO 639-1 language code (2 characters)
+	NextAction        *string   `json:"nextAction,omitempty"` // Overrides the suggestion; "" clears it
+	NextActionDue     *string   `json:"nextActionDue,omitempty"` // ISO 8601 format; "" clears the due date
 }
 
 func (h *handler) UpdateJobApplication(c *fiber.Ctx) error {
-	applicationID, err := uuid.Parse(c.Params("id"))
+	applicationID, err := middleware.UUIDParam(c, "id")
 	if err != nil {
-		return response.Error(c, fiber.StatusBadRequest, ErrCodeInvalidPayload, fiber.Map{
-			"message": "invalid application id",
-		})
+		return middleware.InvalidUUIDParam(c, "id")
+	}
+
+	if strings.HasPrefix(c.Get(fiber.HeaderContentType), jsonpatch.ContentType) {
+		return h.patchJobApplication(c, applicationID)
 	}
 
 	var payload updateJobApplicationPayload
@@ -485,9 +794,7 @@ func (h *handler) UpdateJobApplication(c *fiber.Ctx) error {
 
 	// Validate payload
 	if err := ValidateUpdateJobApplicationPayload(&payload); err != nil {
-		return response.Error(c, fiber.StatusBadRequest, ErrCodeInvalidPayload, fiber.Map{
-			"message": err.Error(),
-		})
+		return h.validationError(c, err)
 	}
 
 	updates := UpdateJobApplicationRequest{}
@@ -558,6 +865,20 @@ func (h *handler) UpdateJobApplication(c *fiber.Ctx) error {
 		}
 		updates.Language = payload.Language
 	}
+	updates.NextAction = payload.NextAction
+	if payload.NextActionDue != nil {
+		if *payload.NextActionDue == "" {
+			updates.ClearNextActionDue = true
+		} else {
+			nextActionDue, err := time.Parse(time.RFC3339, *payload.NextActionDue)
+			if err != nil {
+				return response.Error(c, fiber.StatusBadRequest, ErrCodeInvalidPayload, fiber.Map{
+					"message": "invalid next action due date format, use ISO 8601",
+				})
+			}
+			updates.NextActionDue = &nextActionDue
+		}
+	}
 
 	application, err := h.service.UpdateJobApplication(c.Context(), applicationID, updates)
 	if err != nil {
@@ -567,14 +888,80 @@ func (h *handler) UpdateJobApplication(c *fiber.Ctx) error {
 	return response.Success(c, fiber.StatusOK, application)
 }
 
-func (h *handler) DeleteJobApplication(c *fiber.Ctx) error {
-	applicationID, err := uuid.Parse(c.Params("id"))
+// patchJobApplication applies an RFC 6902 JSON Patch to the stored application document.
+func (h *handler) patchJobApplication(c *fiber.Ctx, applicationID uuid.UUID) error {
+	userID, err := middleware.GetUserIDFromFiberContext(c)
+	if err != nil {
+		return response.Error(c, fiber.StatusUnauthorized, 401, fiber.Map{
+			"message": "authentication required",
+		})
+	}
+
+	patch, err := jsonpatch.Decode(c.Body())
+	if err != nil {
+		return response.Error(c, fiber.StatusBadRequest, ErrCodeInvalidPayload, fiber.Map{
+			"message": err.Error(),
+		})
+	}
+
+	if err := ValidateJobApplicationPatch(patch); err != nil {
+		return response.Error(c, fiber.StatusBadRequest, ErrCodeInvalidPayload, fiber.Map{
+			"message": err.Error(),
+		})
+	}
+
+	application, err := h.service.GetJobApplication(c.Context(), applicationID)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	if application.UserID != userID {
+		return response.Error(c, fiber.StatusForbidden, ErrCodeAccessDenied, fiber.Map{
+			"message": "access denied",
+		})
+	}
+
+	document, err := json.Marshal(application)
 	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	patchedDocument, err := patch.Apply(document)
+	if err != nil {
+		status := fiber.StatusBadRequest
+		if errors.Is(err, jsonpatch.ErrTestFailed) {
+			status = fiber.StatusConflict
+		}
+		return response.Error(c, status, ErrCodeInvalidPayload, fiber.Map{
+			"message": err.Error(),
+		})
+	}
+
+	var patched JobApplication
+	if err := json.Unmarshal(patchedDocument, &patched); err != nil {
 		return response.Error(c, fiber.StatusBadRequest, ErrCodeInvalidPayload, fiber.Map{
-			"message": "invalid application id",
+			"message": "patched document is not a valid job application",
 		})
 	}
 
+	if err := ValidatePatchedJobApplication(&patched); err != nil {
+		return h.validationError(c, err)
+	}
+
+	updated, err := h.service.ReplaceJobApplication(c.Context(), applicationID, &patched)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return response.Success(c, fiber.StatusOK, updated)
+}
+
+func (h *handler) DeleteJobApplication(c *fiber.Ctx) error {
+	applicationID, err := middleware.UUIDParam(c, "id")
+	if err != nil {
+		return middleware.InvalidUUIDParam(c, "id")
+	}
+
 	userID, err := middleware.GetUserIDFromFiberContext(c)
 	if err != nil {
 		return response.Error(c, fiber.StatusUnauthorized, 401, fiber.Map{
@@ -606,34 +993,713 @@ func (h *handler) DeleteJobApplication(c *fiber.Ctx) error {
 	})
 }
 
-func (h *handler) handleError(c *fiber.Ctx, err error) error {
-	if domainErr, ok := AsDomainError(err); ok {
-		statusCode := fiber.StatusInternalServerError
-		switch domainErr.Code {
-		case ErrCodeNotFound:
-			statusCode = fiber.StatusNotFound
-		case ErrCodeInvalidPayload, ErrCodeInvalidStatus:
-			statusCode = fiber.StatusBadRequest
-		case ErrCodeJobQueueFailure, ErrCodeAIServiceFailure, ErrCodePlaywrightFailure:
-			statusCode = fiber.StatusServiceUnavailable
-		case ErrCodeDatabaseConstraint, ErrCodeDatabaseValueTooLong, ErrCodeDatabaseUniqueViolation, ErrCodeDatabaseForeignKeyViolation:
-			statusCode = fiber.StatusBadRequest
-		case ErrCodeDatabaseConnection:
-			statusCode = fiber.StatusServiceUnavailable
-		case ErrCodeAccessDenied:
-			statusCode = fiber.StatusForbidden
-		}
+// BulkDeleteJobApplications deletes every application of the user matching the
+// query filters, e.g. DELETE /job-applications?status=rejected&appliedBefore=2024-01-01
+func (h *handler) BulkDeleteJobApplications(c *fiber.Ctx) error {
+	userID, err := middleware.GetUserIDFromFiberContext(c)
+	if err != nil {
+		return response.Error(c, fiber.StatusUnauthorized, 401, fiber.Map{
+			"message": "authentication required",
+		})
+	}
+
+	filter, err := ValidateBulkDeleteParams(c.Query("status"), c.Query("website"), c.Query("appliedBefore"), c.Query("createdBefore"))
+	if err != nil {
+		return response.Error(c, fiber.StatusBadRequest, ErrCodeInvalidPayload, fiber.Map{
+			"message": err.Error(),
+		})
+	}
+
+	deleted, err := h.service.DeleteJobApplicationsByFilter(c.Context(), userID, filter)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return response.Success(c, fiber.StatusOK, fiber.Map{
+		"deleted":        len(deleted),
+		"applicationIds": deleted,
+	})
+}
+
+type batchGetPayload struct {
+	IDs []string `json:"ids"`
+}
+
+type bulkTagPayload struct {
+	ApplicationIDs []string `json:"applicationIds"`
+	Tag            string   `json:"tag"`
+}
+
+func (h *handler) BulkAddTag(c *fiber.Ctx) error {
+	return h.bulkTag(c, h.service.AddTagToApplications)
+}
+
+func (h *handler) BulkRemoveTag(c *fiber.Ctx) error {
+	return h.bulkTag(c, h.service.RemoveTagFromApplications)
+}
+
+func (h *handler) bulkTag(c *fiber.Ctx, apply func(ctx context.Context, userID uuid.UUID, applicationIDs []uuid.UUID, tag string) (*BulkTagResult, error)) error {
+	userID, err := middleware.GetUserIDFromFiberContext(c)
+	if err != nil {
+		return response.Error(c, fiber.StatusUnauthorized, 401, fiber.Map{
+			"message": "authentication required",
+		})
+	}
+
+	var payload bulkTagPayload
+	if err := c.BodyParser(&payload); err != nil {
+		return response.Error(c, fiber.StatusBadRequest, ErrCodeInvalidPayload, fiber.Map{
+			"message": "invalid request payload",
+		})
+	}
 
-		return response.Error(c, statusCode, domainErr.Code, fiber.Map{
-			"message": domainErr.Message,
+	if err := ValidateBulkTagPayload(&payload); err != nil {
+		return h.validationError(c, err)
+	}
+
+	applicationIDs := make([]uuid.UUID, 0, len(payload.ApplicationIDs))
+	for _, rawID := range payload.ApplicationIDs {
+		id, _ := uuid.Parse(rawID)
+		applicationIDs = append(applicationIDs, id)
+	}
+
+	result, err := apply(c.Context(), userID, applicationIDs, payload.Tag)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return response.Success(c, fiber.StatusOK, result)
+}
+
+type bulkInterestPayload struct {
+	ApplicationIDs []string `json:"applicationIds"`
+	InterestLevel  string   `json:"interestLevel"`
+}
+
+// BulkSetInterestLevel sets one interest level on many applications, e.g. to downgrade
+// a batch from high to low.
+func (h *handler) BulkSetInterestLevel(c *fiber.Ctx) error {
+	userID, err := middleware.GetUserIDFromFiberContext(c)
+	if err != nil {
+		return response.Error(c, fiber.StatusUnauthorized, 401, fiber.Map{
+			"message": "authentication required",
 		})
 	}
 
-	if h.logger != nil {
-		h.logger.Error("unhandled error", slog.Any("error", err))
+	var payload bulkInterestPayload
+	if err := c.BodyParser(&payload); err != nil {
+		return response.Error(c, fiber.StatusBadRequest, ErrCodeInvalidPayload, fiber.Map{
+			"message": "invalid request payload",
+		})
+	}
+
+	applicationIDs, level, err := ValidateBulkInterestPayload(&payload)
+	if err != nil {
+		return h.validationError(c, err)
+	}
+
+	result, err := h.service.SetInterestLevel(c.Context(), userID, applicationIDs, level)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return response.Success(c, fiber.StatusOK, result)
+}
+
+type bulkContactPayload struct {
+	ApplicationIDs  []string `json:"applicationIds"`
+	Message         string   `json:"message"`
+	ResponseChannel string   `json:"responseChannel,omitempty"`
+	ResponseDate    string   `json:"responseDate,omitempty"` // ISO 8601, defaults to now
+}
+
+func (h *handler) BulkContact(c *fiber.Ctx) error {
+	userID, err := middleware.GetUserIDFromFiberContext(c)
+	if err != nil {
+		return response.Error(c, fiber.StatusUnauthorized, 401, fiber.Map{
+			"message": "authentication required",
+		})
+	}
+
+	var payload bulkContactPayload
+	if err := c.BodyParser(&payload); err != nil {
+		return response.Error(c, fiber.StatusBadRequest, ErrCodeInvalidPayload, fiber.Map{
+			"message": "invalid request payload",
+		})
+	}
+
+	applicationIDs, contact, err := ValidateBulkContactPayload(&payload)
+	if err != nil {
+		return h.validationError(c, err)
+	}
+
+	result, err := h.service.ContactApplications(c.Context(), userID, applicationIDs, contact)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return response.Success(c, fiber.StatusOK, result)
+}
+
+func (h *handler) CompareJobApplications(c *fiber.Ctx) error {
+	userID, err := middleware.GetUserIDFromFiberContext(c)
+	if err != nil {
+		return response.Error(c, fiber.StatusUnauthorized, 401, fiber.Map{
+			"message": "authentication required",
+		})
+	}
+
+	applicationIDs, err := ValidateCompareIDs(c.Query("ids"))
+	if err != nil {
+		return response.Error(c, fiber.StatusBadRequest, ErrCodeInvalidPayload, fiber.Map{
+			"message": err.Error(),
+		})
+	}
+
+	comparison, err := h.service.CompareJobApplications(c.Context(), userID, applicationIDs)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return response.Success(c, fiber.StatusOK, comparison)
+}
+
+func (h *handler) BatchGetJobApplications(c *fiber.Ctx) error {
+	userID, err := middleware.GetUserIDFromFiberContext(c)
+	if err != nil {
+		return response.Error(c, fiber.StatusUnauthorized, 401, fiber.Map{
+			"message": "authentication required",
+		})
+	}
+
+	var payload batchGetPayload
+	if err := c.BodyParser(&payload); err != nil {
+		return response.Error(c, fiber.StatusBadRequest, ErrCodeInvalidPayload, fiber.Map{
+			"message": "invalid request payload",
+		})
+	}
+
+	applicationIDs, err := ValidateBatchGetPayload(&payload)
+	if err != nil {
+		return response.Error(c, fiber.StatusBadRequest, ErrCodeInvalidPayload, fiber.Map{
+			"message": err.Error(),
+		})
+	}
+
+	applications, err := h.service.BatchGetJobApplications(c.Context(), userID, applicationIDs)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return response.Success(c, fiber.StatusOK, fiber.Map{
+		"applications": applications,
+		"count":        len(applications),
+	})
+}
+
+func (h *handler) GetApplicationTimeSeries(c *fiber.Ctx) error {
+	userID, err := middleware.GetUserIDFromFiberContext(c)
+	if err != nil {
+		return response.Error(c, fiber.StatusUnauthorized, 401, fiber.Map{
+			"message": "authentication required",
+		})
+	}
+
+	metric, days, err := ValidateTimeSeriesParams(c.Query("metric"), c.Query("days"))
+	if err != nil {
+		return response.Error(c, fiber.StatusBadRequest, ErrCodeInvalidPayload, fiber.Map{
+			"message": err.Error(),
+		})
+	}
+
+	series, err := h.service.GetApplicationTimeSeries(c.Context(), userID, metric, days)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return response.Success(c, fiber.StatusOK, series)
+}
+
+func (h *handler) SnoozeJobApplication(c *fiber.Ctx) error {
+	userID, err := middleware.GetUserIDFromFiberContext(c)
+	if err != nil {
+		return response.Error(c, fiber.StatusUnauthorized, 401, fiber.Map{
+			"message": "authentication required",
+		})
+	}
+
+	applicationID, err := middleware.UUIDParam(c, "id")
+	if err != nil {
+		return middleware.InvalidUUIDParam(c, "id")
+	}
+
+	var payload snoozePayload
+	if err := c.BodyParser(&payload); err != nil {
+		return response.Error(c, fiber.StatusBadRequest, ErrCodeInvalidPayload, fiber.Map{
+			"message": "invalid request payload",
+		})
+	}
+
+	until, err := ValidateSnoozePayload(&payload)
+	if err != nil {
+		return response.Error(c, fiber.StatusBadRequest, ErrCodeInvalidPayload, fiber.Map{
+			"message": err.Error(),
+		})
+	}
+
+	application, err := h.service.SnoozeJobApplication(c.Context(), userID, applicationID, until)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return response.Success(c, fiber.StatusOK, application)
+}
+
+func (h *handler) UnsnoozeJobApplication(c *fiber.Ctx) error {
+	userID, err := middleware.GetUserIDFromFiberContext(c)
+	if err != nil {
+		return response.Error(c, fiber.StatusUnauthorized, 401, fiber.Map{
+			"message": "authentication required",
+		})
+	}
+
+	applicationID, err := middleware.UUIDParam(c, "id")
+	if err != nil {
+		return middleware.InvalidUUIDParam(c, "id")
+	}
+
+	application, err := h.service.UnsnoozeJobApplication(c.Context(), userID, applicationID)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return response.Success(c, fiber.StatusOK, application)
+}
+
+func (h *handler) PinJobApplication(c *fiber.Ctx) error {
+	userID, err := middleware.GetUserIDFromFiberContext(c)
+	if err != nil {
+		return response.Error(c, fiber.StatusUnauthorized, 401, fiber.Map{
+			"message": "authentication required",
+		})
+	}
+
+	applicationID, err := middleware.UUIDParam(c, "id")
+	if err != nil {
+		return middleware.InvalidUUIDParam(c, "id")
+	}
+
+	var payload pinPayload
+	if err := c.BodyParser(&payload); err != nil {
+		return response.Error(c, fiber.StatusBadRequest, ErrCodeInvalidPayload, fiber.Map{
+			"message": "invalid request payload",
+		})
+	}
+
+	order, err := ValidatePinPayload(&payload)
+	if err != nil {
+		return response.Error(c, fiber.StatusBadRequest, ErrCodeInvalidPayload, fiber.Map{
+			"message": err.Error(),
+		})
+	}
+
+	application, err := h.service.PinJobApplication(c.Context(), userID, applicationID, order)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return response.Success(c, fiber.StatusOK, application)
+}
+
+func (h *handler) UnpinJobApplication(c *fiber.Ctx) error {
+	userID, err := middleware.GetUserIDFromFiberContext(c)
+	if err != nil {
+		return response.Error(c, fiber.StatusUnauthorized, 401, fiber.Map{
+			"message": "authentication required",
+		})
+	}
+
+	applicationID, err := middleware.UUIDParam(c, "id")
+	if err != nil {
+		return middleware.InvalidUUIDParam(c, "id")
+	}
+
+	application, err := h.service.UnpinJobApplication(c.Context(), userID, applicationID)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return response.Success(c, fiber.StatusOK, application)
+}
+
+func (h *handler) MuteNotifications(c *fiber.Ctx) error {
+	userID, err := middleware.GetUserIDFromFiberContext(c)
+	if err != nil {
+		return response.Error(c, fiber.StatusUnauthorized, 401, fiber.Map{
+			"message": "authentication required",
+		})
+	}
+
+	applicationID, err := middleware.UUIDParam(c, "id")
+	if err != nil {
+		return middleware.InvalidUUIDParam(c, "id")
+	}
+
+	application, err := h.service.MuteNotifications(c.Context(), userID, applicationID)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return response.Success(c, fiber.StatusOK, application)
+}
+
+func (h *handler) UnmuteNotifications(c *fiber.Ctx) error {
+	userID, err := middleware.GetUserIDFromFiberContext(c)
+	if err != nil {
+		return response.Error(c, fiber.StatusUnauthorized, 401, fiber.Map{
+			"message": "authentication required",
+		})
+	}
+
+	applicationID, err := middleware.UUIDParam(c, "id")
+	if err != nil {
+		return middleware.InvalidUUIDParam(c, "id")
+	}
+
+	application, err := h.service.UnmuteNotifications(c.Context(), userID, applicationID)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return response.Success(c, fiber.StatusOK, application)
+}
+
+func (h *handler) ReopenJobApplication(c *fiber.Ctx) error {
+	userID, err := middleware.GetUserIDFromFiberContext(c)
+	if err != nil {
+		return response.Error(c, fiber.StatusUnauthorized, 401, fiber.Map{
+			"message": "authentication required",
+		})
+	}
+
+	applicationID, err := middleware.UUIDParam(c, "id")
+	if err != nil {
+		return middleware.InvalidUUIDParam(c, "id")
+	}
+
+	var payload reopenPayload
+	if err := c.BodyParser(&payload); err != nil {
+		return response.Error(c, fiber.StatusBadRequest, ErrCodeInvalidPayload, fiber.Map{
+			"message": "invalid request payload",
+		})
+	}
+
+	reason, err := ValidateReopenPayload(&payload)
+	if err != nil {
+		return response.Error(c, fiber.StatusBadRequest, ErrCodeInvalidPayload, fiber.Map{
+			"message": err.Error(),
+		})
+	}
+
+	application, err := h.service.ReopenJobApplication(c.Context(), userID, applicationID, reason)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return response.Success(c, fiber.StatusOK, application)
+}
+
+// DuplicateJobApplication copies an application into a new pending one for a similar role.
+func (h *handler) DuplicateJobApplication(c *fiber.Ctx) error {
+	userID, err := middleware.GetUserIDFromFiberContext(c)
+	if err != nil {
+		return response.Error(c, fiber.StatusUnauthorized, 401, fiber.Map{
+			"message": "authentication required",
+		})
+	}
+
+	applicationID, err := middleware.UUIDParam(c, "id")
+	if err != nil {
+		return middleware.InvalidUUIDParam(c, "id")
+	}
+
+	application, err := h.service.DuplicateJobApplication(c.Context(), userID, applicationID)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return response.Success(c, fiber.StatusCreated, application)
+}
+
+// ListStatusChanges returns the application's status history, newest first.
+func (h *handler) ListStatusChanges(c *fiber.Ctx) error {
+	userID, err := middleware.GetUserIDFromFiberContext(c)
+	if err != nil {
+		return response.Error(c, fiber.StatusUnauthorized, 401, fiber.Map{
+			"message": "authentication required",
+		})
+	}
+
+	applicationID, err := middleware.UUIDParam(c, "id")
+	if err != nil {
+		return middleware.InvalidUUIDParam(c, "id")
+	}
+
+	changes, err := h.service.ListStatusChanges(c.Context(), userID, applicationID)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return response.Success(c, fiber.StatusOK, changes)
+}
+
+func (h *handler) SaveOffer(c *fiber.Ctx) error {
+	userID, err := middleware.GetUserIDFromFiberContext(c)
+	if err != nil {
+		return response.Error(c, fiber.StatusUnauthorized, 401, fiber.Map{
+			"message": "authentication required",
+		})
+	}
+
+	applicationID, err := middleware.UUIDParam(c, "id")
+	if err != nil {
+		return middleware.InvalidUUIDParam(c, "id")
+	}
+
+	var payload offerPayload
+	if err := c.BodyParser(&payload); err != nil {
+		return response.Error(c, fiber.StatusBadRequest, ErrCodeInvalidPayload, fiber.Map{
+			"message": "invalid request payload",
+		})
+	}
+
+	details, err := ValidateOfferPayload(&payload)
+	if err != nil {
+		return response.Error(c, fiber.StatusBadRequest, ErrCodeInvalidPayload, fiber.Map{
+			"message": err.Error(),
+		})
+	}
+
+	offer, err := h.service.SaveOffer(c.Context(), userID, applicationID, details)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return response.Success(c, fiber.StatusOK, offer)
+}
+
+func (h *handler) GetOffer(c *fiber.Ctx) error {
+	userID, err := middleware.GetUserIDFromFiberContext(c)
+	if err != nil {
+		return response.Error(c, fiber.StatusUnauthorized, 401, fiber.Map{
+			"message": "authentication required",
+		})
+	}
+
+	applicationID, err := middleware.UUIDParam(c, "id")
+	if err != nil {
+		return middleware.InvalidUUIDParam(c, "id")
+	}
+
+	offer, err := h.service.GetOffer(c.Context(), userID, applicationID)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return response.Success(c, fiber.StatusOK, offer)
+}
+
+func (h *handler) DeleteOffer(c *fiber.Ctx) error {
+	userID, err := middleware.GetUserIDFromFiberContext(c)
+	if err != nil {
+		return response.Error(c, fiber.StatusUnauthorized, 401, fiber.Map{
+			"message": "authentication required",
+		})
+	}
+
+	applicationID, err := middleware.UUIDParam(c, "id")
+	if err != nil {
+		return middleware.InvalidUUIDParam(c, "id")
+	}
+
+	if err := h.service.DeleteOffer(c.Context(), userID, applicationID); err != nil {
+		return h.handleError(c, err)
+	}
+
+	return response.Success(c, fiber.StatusOK, fiber.Map{
+		"message": "offer deleted successfully",
+	})
+}
+
+func (h *handler) GetOfferStats(c *fiber.Ctx) error {
+	userID, err := middleware.GetUserIDFromFiberContext(c)
+	if err != nil {
+		return response.Error(c, fiber.StatusUnauthorized, 401, fiber.Map{
+			"message": "authentication required",
+		})
+	}
+
+	stats, err := h.service.GetOfferStats(c.Context(), userID)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return response.Success(c, fiber.StatusOK, stats)
+}
+
+func (h *handler) GetInterestLevelStats(c *fiber.Ctx) error {
+	userID, err := middleware.GetUserIDFromFiberContext(c)
+	if err != nil {
+		return response.Error(c, fiber.StatusUnauthorized, 401, fiber.Map{
+			"message": "authentication required",
+		})
+	}
+
+	stats, err := h.service.GetInterestLevelStats(c.Context(), userID)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return response.Success(c, fiber.StatusOK, stats)
+}
+
+func (h *handler) DraftFromURL(c *fiber.Ctx) error {
+	var payload fromURLPayload
+	if err := c.BodyParser(&payload); err != nil {
+		return response.Error(c, fiber.StatusBadRequest, ErrCodeInvalidPayload, fiber.Map{
+			"message": "invalid request payload",
+		})
+	}
+
+	if err := ValidateFromURLPayload(&payload); err != nil {
+		return response.Error(c, fiber.StatusBadRequest, ErrCodeInvalidPayload, fiber.Map{
+			"message": err.Error(),
+		})
+	}
+
+	draft, err := h.service.DraftFromURL(c.Context(), payload.URL)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return response.Success(c, fiber.StatusOK, draft)
+}
+
+// IngestJobApplication accepts a posting captured by the browser extension and
+// returns the application it created (201) or the existing one for that URL (200).
+func (h *handler) IngestJobApplication(c *fiber.Ctx) error {
+	userID, err := middleware.GetUserIDFromFiberContext(c)
+	if err != nil {
+		return response.Error(c, fiber.StatusUnauthorized, 401, fiber.Map{
+			"message": "authentication required",
+		})
+	}
+
+	var payload ingestPayload
+	if err := c.BodyParser(&payload); err != nil {
+		return response.Error(c, fiber.StatusBadRequest, ErrCodeInvalidPayload, fiber.Map{
+			"message": "invalid request payload",
+		})
+	}
+
+	if err := ValidateIngestPayload(&payload); err != nil {
+		return response.Error(c, fiber.StatusBadRequest, ErrCodeInvalidPayload, fiber.Map{
+			"message": err.Error(),
+		})
+	}
+
+	fields := payload.Fields
+	result, err := h.service.IngestJobApplication(c.Context(), userID, IngestedPosting{
+		SourceURL: payload.SourceURL,
+		HTML:      payload.HTML,
+		Fields: IngestedFields{
+			CompanyName:    fields.CompanyName,
+			JobTitle:       fields.JobTitle,
+			Location:       fields.Location,
+			JobDescription: fields.JobDescription,
+			Website:        fields.Website,
+			SalaryMin:      fields.SalaryMin,
+			SalaryMax:      fields.SalaryMax,
+			SalaryCurrency: fields.SalaryCurrency,
+			Language:       fields.Language,
+		},
+	})
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	status := fiber.StatusOK
+	if result.Created {
+		status = fiber.StatusCreated
+	}
+	return response.Success(c, status, result)
+}
+
+func (h *handler) DetectLanguage(c *fiber.Ctx) error {
+	var payload detectLanguagePayload
+	if err := c.BodyParser(&payload); err != nil {
+		return response.Error(c, fiber.StatusBadRequest, ErrCodeInvalidPayload, fiber.Map{
+			"message": "invalid request payload",
+		})
+	}
+
+	if err := ValidateDetectLanguagePayload(&payload); err != nil {
+		return response.Error(c, fiber.StatusBadRequest, ErrCodeInvalidPayload, fiber.Map{
+			"message": err.Error(),
+		})
+	}
+
+	language, err := h.service.DetectJobLanguage(c.Context(), payload.Text)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return response.Success(c, fiber.StatusOK, fiber.Map{
+		"language": language,
+	})
+}
+
+// SuggestTags returns AI-suggested tags for a pasted job description for the user to accept.
+func (h *handler) SuggestTags(c *fiber.Ctx) error {
+	var payload suggestTagsPayload
+	if err := c.BodyParser(&payload); err != nil {
+		return response.Error(c, fiber.StatusBadRequest, ErrCodeInvalidPayload, fiber.Map{
+			"message": "invalid request payload",
+		})
+	}
+
+	if err := ValidateSuggestTagsPayload(&payload); err != nil {
+		return response.Error(c, fiber.StatusBadRequest, ErrCodeInvalidPayload, fiber.Map{
+			"message": err.Error(),
+		})
+	}
+
+	tags, err := h.service.SuggestTags(c.Context(), payload.JobDescription)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return response.Success(c, fiber.StatusOK, fiber.Map{
+		"tags": tags,
+	})
+}
+
+func (h *handler) handleError(c *fiber.Ctx, err error) error {
+	if _, ok := AsDomainError(err); !ok && h.logger != nil {
+		h.logger.ErrorContext(c.UserContext(), "unhandled error", slog.Any("error", err))
+	}
+	return response.FromDomainError(c, err)
+}
+
+// validationError answers a payload that failed validation. Domain errors such as an
+// invalid interest level or too many tags keep their own status (422); plain messages are a 400.
+func (h *handler) validationError(c *fiber.Ctx, err error) error {
+	var domainErr response.DomainError
+	if errors.As(err, &domainErr) {
+		return response.FromDomainError(c, err)
 	}
-	return response.Error(c, fiber.StatusInternalServerError, 500, fiber.Map{
-		"message": "internal server error",
+	return response.Error(c, fiber.StatusBadRequest, ErrCodeInvalidPayload, fiber.Map{
+		"message": err.Error(),
 	})
 }
 
@@ -0,0 +1,180 @@
+package jobapplications
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// IngestSource is the source recorded on applications created through ingestion.
+const IngestSource = "browser-extension"
+
+const (
+	// ingestPlaceholderTitle stands in for a job title the capture did not include
+	ingestPlaceholderTitle = "Untitled position"
+	// ingestFieldMaxRunes matches the size of the short text columns
+	ingestFieldMaxRunes = 255
+)
+
+// trackingParams are query parameters dropped before comparing posting URLs.
+var trackingParams = map[string]bool{
+	"gclid":      true,
+	"fbclid":     true,
+	"trk":        true,
+	"trackingid": true,
+	"refid":      true,
+}
+
+// IngestedPosting is what a browser extension captured while the user browsed a
+// posting. Every field except SourceURL may be blank or contain stray markup.
+type IngestedPosting struct {
+	SourceURL string
+	HTML      string // Raw snippet of the posting page, parsed like DraftFromURL
+	Fields    IngestedFields
+}
+
+// IngestedFields are the values the extension read off the page. They take
+// precedence over what is parsed from HTML.
+type IngestedFields struct {
+	CompanyName    string
+	JobTitle       string
+	Location       string
+	JobDescription string
+	Website        string
+	SalaryMin      *int
+	SalaryMax      *int
+	SalaryCurrency string
+	Language       string
+}
+
+// IngestResult is the application an ingestion created or matched.
+type IngestResult struct {
+	Application   *JobApplication `json:"application"`
+	Created       bool            `json:"created"`       // False when an application with the same URL already existed
+	MissingFields []string        `json:"missingFields"` // Fields filled with placeholders that the user should review
+}
+
+// canonicalPostingURL normalizes a posting URL for deduplication: lowercase scheme
+// and host, no fragment, no tracking parameters and no trailing slash.
+func canonicalPostingURL(raw string) string {
+	normalized := normalizeURL(raw)
+	parsed, err := url.Parse(normalized)
+	if err != nil || parsed.Host == "" {
+		return normalized
+	}
+
+	parsed.Scheme = strings.ToLower(parsed.Scheme)
+	parsed.Host = strings.ToLower(parsed.Host)
+	parsed.Fragment = ""
+	parsed.RawFragment = ""
+	parsed.Path = strings.TrimSuffix(parsed.Path, "/")
+	parsed.RawPath = ""
+
+	query := parsed.Query()
+	for key := range query {
+		lower := strings.ToLower(key)
+		if strings.HasPrefix(lower, "utm_") || trackingParams[lower] {
+			query.Del(key)
+		}
+	}
+	parsed.RawQuery = query.Encode()
+
+	return parsed.String()
+}
+
+// newIngestDraft merges the captured fields over whatever the HTML snippet yields.
+func newIngestDraft(posting IngestedPosting, canonicalURL string) *JobPostingDraft {
+	draft := NewJobPostingDraft(canonicalURL)
+	if posting.HTML != "" {
+		draft.ParseHTML(posting.HTML)
+	}
+
+	fields := posting.Fields
+	draft.CompanyName = firstNonEmpty(ingestText(fields.CompanyName), draft.CompanyName)
+	draft.JobTitle = firstNonEmpty(ingestText(fields.JobTitle), draft.JobTitle)
+	draft.Location = firstNonEmpty(ingestText(fields.Location), draft.Location)
+	draft.Website = firstNonEmpty(strings.ToLower(ingestText(fields.Website)), draft.Website)
+	if description := htmlToText(fields.JobDescription); description != "" {
+		draft.JobDescription = truncateRunes(description, postingDescriptionMaxRunes)
+	}
+	if fields.SalaryMin != nil || fields.SalaryMax != nil {
+		draft.SalaryMin, draft.SalaryMax = fields.SalaryMin, fields.SalaryMax
+		draft.SalaryCurrency = strings.ToUpper(strings.TrimSpace(fields.SalaryCurrency))
+	}
+	if language := strings.ToLower(strings.TrimSpace(fields.Language)); len(language) == 2 {
+		draft.Language = language
+	}
+
+	// Discard salaries that cannot be right rather than failing the capture
+	if (draft.SalaryMin != nil && *draft.SalaryMin < 0) || (draft.SalaryMax != nil && *draft.SalaryMax < 0) ||
+		(draft.SalaryMin != nil && draft.SalaryMax != nil && *draft.SalaryMin > *draft.SalaryMax) {
+		draft.SalaryMin, draft.SalaryMax, draft.SalaryCurrency = nil, nil, ""
+	}
+	if len(draft.SalaryCurrency) > 10 {
+		draft.SalaryCurrency = ""
+	}
+
+	draft.Finalize()
+	return draft
+}
+
+// ingestText strips markup and collapses a captured field onto one line.
+func ingestText(s string) string {
+	return truncateRunes(strings.Join(strings.Fields(htmlToText(s)), " "), ingestFieldMaxRunes)
+}
+
+// IngestJobApplication turns a capture into a pending application for the user to
+// review, or returns the existing application for the same posting URL. Nothing is
+// queued for automatic submission.
+func (s *service) IngestJobApplication(ctx context.Context, userID uuid.UUID, posting IngestedPosting) (*IngestResult, error) {
+	canonicalURL := canonicalPostingURL(posting.SourceURL)
+	if canonicalURL == "" {
+		return nil, NewDomainError(ErrCodeInvalidPayload, ErrEmptyJobURL)
+	}
+
+	existing, err := s.repo.FindJobApplicationByURL(ctx, userID, []string{canonicalURL, normalizeURL(posting.SourceURL)})
+	if err == nil {
+		return &IngestResult{Application: existing, Created: false, MissingFields: []string{}}, nil
+	}
+	if domainErr, ok := AsDomainError(err); !ok || domainErr.Code != ErrCodeNotFound {
+		return nil, err
+	}
+
+	draft := newIngestDraft(posting, canonicalURL)
+	if draft.Website == "" {
+		return nil, NewDomainError(ErrCodeInvalidPayload, ErrEmptyWebsite)
+	}
+	companyName := firstNonEmpty(draft.CompanyName, draft.Website)
+	jobTitle := firstNonEmpty(draft.JobTitle, ingestPlaceholderTitle)
+
+	application, err := NewJobApplication(userID, companyName, draft.Location, jobTitle, canonicalURL, draft.Website)
+	if err != nil {
+		return nil, err
+	}
+	application.JobDescription = draft.JobDescription
+	application.SalaryMin, application.SalaryMax = draft.SalaryMin, draft.SalaryMax
+	application.SalaryCurrency = draft.SalaryCurrency
+	application.Source = IngestSource
+	application.Language = draft.Language
+	if application.Language == "" && application.JobDescription != "" {
+		if language, err := s.DetectJobLanguageLocally(ctx, application.JobDescription); err == nil {
+			application.Language = language
+		}
+	}
+
+	if err := s.repo.CreateJobApplication(ctx, application); err != nil {
+		return nil, err
+	}
+
+	if s.logger != nil {
+		s.logger.InfoContext(ctx, "job application ingested",
+			"user_id", userID.String(),
+			"job_application_id", application.ID.String(),
+			"website", application.Website,
+			"missing_fields", len(draft.MissingFields))
+	}
+
+	return &IngestResult{Application: application, Created: true, MissingFields: draft.MissingFields}, nil
+}
@@ -0,0 +1,92 @@
+package jobapplications
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeIngestRepo stores created applications and matches them by URL.
+type fakeIngestRepo struct {
+	Repository
+	applications []*JobApplication
+}
+
+func (r *fakeIngestRepo) FindJobApplicationByURL(_ context.Context, userID uuid.UUID, jobURLs []string) (*JobApplication, error) {
+	for _, application := range r.applications {
+		for _, jobURL := range jobURLs {
+			if application.UserID == userID && application.JobURL == jobURL {
+				return application, nil
+			}
+		}
+	}
+	return nil, NewDomainError(ErrCodeNotFound, ErrApplicationNotFound)
+}
+
+func (r *fakeIngestRepo) CreateJobApplication(_ context.Context, application *JobApplication) error {
+	r.applications = append(r.applications, application)
+	return nil
+}
+
+func TestCanonicalPostingURL(t *testing.T) {
+	assert.Equal(t, "https://www.linkedin.com/jobs/view/123",
+		canonicalPostingURL("WWW.LinkedIn.com/jobs/view/123/?utm_source=share&trk=abc#apply"))
+	assert.Equal(t, "https://boards.greenhouse.io/acme/jobs/42?gh_jid=42",
+		canonicalPostingURL("https://boards.greenhouse.io/acme/jobs/42?gh_jid=42&gclid=x"))
+}
+
+func TestIngestJobApplication_MergesCaptureOverHTML(t *testing.T) {
+	repo := &fakeIngestRepo{}
+	svc := NewService(repo, nil, nil)
+	userID := uuid.New()
+	salaryMin, salaryMax := 9000, 1000
+
+	result, err := svc.IngestJobApplication(context.Background(), userID, IngestedPosting{
+		SourceURL: "https://www.linkedin.com/jobs/view/123?utm_campaign=x",
+		HTML:      `<html><head><title>Backend Engineer - Acme - Lisbon | LinkedIn</title></head></html>`,
+		Fields: IngestedFields{
+			CompanyName: "  <b>Acme Corp</b>\n",
+			SalaryMin:   &salaryMin,
+			SalaryMax:   &salaryMax,
+		},
+	})
+	require.NoError(t, err)
+	assert.True(t, result.Created)
+	assert.Empty(t, result.MissingFields)
+
+	application := result.Application
+	assert.Equal(t, "Acme Corp", application.CompanyName)
+	assert.Equal(t, "Backend Engineer", application.JobTitle)
+	assert.Equal(t, "Lisbon", application.Location)
+	assert.Equal(t, "linkedin", application.Website)
+	assert.Equal(t, "https://www.linkedin.com/jobs/view/123", application.JobURL)
+	assert.Equal(t, IngestSource, application.Source)
+	assert.Equal(t, ApplicationStatusPending, application.Status)
+	assert.Nil(t, application.SalaryMin, "an inverted salary range is dropped, not rejected")
+}
+
+func TestIngestJobApplication_DedupesAndToleratesPartialInput(t *testing.T) {
+	repo := &fakeIngestRepo{}
+	svc := NewService(repo, nil, nil)
+	userID := uuid.New()
+
+	first, err := svc.IngestJobApplication(context.Background(), userID, IngestedPosting{SourceURL: "https://jobs.example.com/posting/7"})
+	require.NoError(t, err)
+	assert.True(t, first.Created)
+	assert.Equal(t, []string{"companyName", "jobTitle"}, first.MissingFields)
+	assert.Equal(t, "example", first.Application.CompanyName)
+	assert.Equal(t, ingestPlaceholderTitle, first.Application.JobTitle)
+
+	second, err := svc.IngestJobApplication(context.Background(), userID, IngestedPosting{SourceURL: "jobs.example.com/posting/7/#top"})
+	require.NoError(t, err)
+	assert.False(t, second.Created)
+	assert.Equal(t, first.Application.ID, second.Application.ID)
+	assert.Len(t, repo.applications, 1)
+
+	other, err := svc.IngestJobApplication(context.Background(), uuid.New(), IngestedPosting{SourceURL: "https://jobs.example.com/posting/7"})
+	require.NoError(t, err)
+	assert.True(t, other.Created, "another user's application is not a duplicate")
+}
@@ -0,0 +1,78 @@
+package jobapplications
+
+import (
+	"slices"
+	"strings"
+	"sync/atomic"
+)
+
+// InterestLevel is how keen the user is on an application.
+type InterestLevel string
+
+const (
+	InterestLevelLow    InterestLevel = "low"
+	InterestLevelMedium InterestLevel = "medium"
+	InterestLevelHigh   InterestLevel = "high"
+)
+
+// InterestLevels lists the accepted interest levels, lowest first.
+var InterestLevels = []InterestLevel{InterestLevelLow, InterestLevelMedium, InterestLevelHigh}
+
+// InterestLevelUnset groups applications stored without an interest level in stats.
+const InterestLevelUnset = "unset"
+
+// defaultInterestLevel is given to applications created without an interest level.
+var defaultInterestLevel atomic.Value
+
+func init() {
+	defaultInterestLevel.Store(InterestLevelMedium)
+}
+
+// ParseInterestLevel normalizes raw and checks it is one of InterestLevels.
+func ParseInterestLevel(raw string) (InterestLevel, error) {
+	level := InterestLevel(strings.ToLower(strings.TrimSpace(raw)))
+	if !slices.Contains(InterestLevels, level) {
+		return "", NewDomainError(ErrCodeInvalidPayload, ErrInvalidInterestLevel)
+	}
+	return level, nil
+}
+
+// SetDefaultInterestLevel changes the level given to new applications created without one.
+func SetDefaultInterestLevel(raw string) error {
+	level, err := ParseInterestLevel(raw)
+	if err != nil {
+		return err
+	}
+	defaultInterestLevel.Store(level)
+	return nil
+}
+
+// DefaultInterestLevel returns the level given to new applications created without one.
+func DefaultInterestLevel() InterestLevel {
+	return defaultInterestLevel.Load().(InterestLevel)
+}
+
+// InterestLevelStats counts a user's applications per interest level.
+type InterestLevelStats struct {
+	// Counts has every level in InterestLevels, plus InterestLevelUnset and any legacy
+	// value still stored when such applications exist
+	Counts map[string]int64 `json:"counts"`
+	Total  int64            `json:"total"`
+}
+
+// NewInterestLevelStats builds stats from per-level counts keyed by stored value, where
+// "" stands for applications without an interest level.
+func NewInterestLevelStats(counts map[string]int64) *InterestLevelStats {
+	stats := &InterestLevelStats{Counts: make(map[string]int64, len(InterestLevels)+1)}
+	for _, level := range InterestLevels {
+		stats.Counts[string(level)] = 0
+	}
+	for level, count := range counts {
+		if level == "" {
+			level = InterestLevelUnset
+		}
+		stats.Counts[level] += count
+		stats.Total += count
+	}
+	return stats
+}
@@ -0,0 +1,69 @@
+package jobapplications
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseInterestLevel(t *testing.T) {
+	level, err := ParseInterestLevel(" High ")
+	require.NoError(t, err)
+	assert.Equal(t, InterestLevelHigh, level)
+
+	for _, raw := range []string{"", "very_high", "urgent"} {
+		_, err := ParseInterestLevel(raw)
+		domainErr, ok := AsDomainError(err)
+		require.True(t, ok, raw)
+		assert.Equal(t, ErrInvalidInterestLevel, domainErr.Message)
+	}
+}
+
+func TestNewJobApplication_DefaultInterestLevel(t *testing.T) {
+	t.Cleanup(func() { _ = SetDefaultInterestLevel(string(InterestLevelMedium)) })
+
+	application, err := NewJobApplication(uuid.New(), "Acme", "Remote", "Engineer", "https://acme.example/jobs/1", "linkedin")
+	require.NoError(t, err)
+	assert.Equal(t, InterestLevelMedium, application.InterestLevel)
+
+	require.NoError(t, SetDefaultInterestLevel("low"))
+	application, err = NewJobApplication(uuid.New(), "Acme", "Remote", "Engineer", "https://acme.example/jobs/1", "linkedin")
+	require.NoError(t, err)
+	assert.Equal(t, InterestLevelLow, application.InterestLevel)
+
+	assert.Error(t, SetDefaultInterestLevel("extreme"))
+	assert.Equal(t, InterestLevelLow, DefaultInterestLevel(), "an invalid default is ignored")
+}
+
+func TestNewInterestLevelStats(t *testing.T) {
+	stats := NewInterestLevelStats(map[string]int64{"high": 3, "": 2, "very_high": 1})
+
+	assert.Equal(t, map[string]int64{"low": 0, "medium": 0, "high": 3, InterestLevelUnset: 2, "very_high": 1}, stats.Counts)
+	assert.Equal(t, int64(6), stats.Total)
+}
+
+func TestUpdateJobApplication_InvalidInterestLevel(t *testing.T) {
+	app := fiber.New()
+	h := NewHandlerWithDependencies(nil, nil, nil, nil, nil)
+	app.Patch("/job-applications/:id", h.UpdateJobApplication)
+
+	req := httptest.NewRequest("PATCH", "/job-applications/"+uuid.NewString(), strings.NewReader(`{"interestLevel":"very_high"}`))
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := app.Test(req)
+	require.NoError(t, err)
+	assert.Equal(t, fiber.StatusUnprocessableEntity, resp.StatusCode)
+
+	var body struct {
+		Data struct {
+			Message string `json:"message"`
+		} `json:"data"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Contains(t, body.Data.Message, "low, medium, high")
+}
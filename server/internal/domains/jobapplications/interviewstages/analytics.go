@@ -0,0 +1,91 @@
+package interviewstages
+
+import "sort"
+
+// StageOutcomeCount is one row of the stage type × outcome aggregate.
+type StageOutcomeCount struct {
+	StageType StageType
+	Outcome   StageOutcome
+	Count     int64
+}
+
+// OutcomeStats counts stages by outcome. Only passed and failed stages count
+// as attempted; pending and cancelled ones never produced a result.
+type OutcomeStats struct {
+	Total     int64 `json:"total"`
+	Attempted int64 `json:"attempted"`
+	Passed    int64 `json:"passed"`
+	Failed    int64 `json:"failed"`
+	Pending   int64 `json:"pending"`
+	Cancelled int64 `json:"cancelled"`
+	// PassRate is Passed / Attempted, or nil when nothing was attempted yet
+	PassRate *float64 `json:"passRate"`
+}
+
+// StageTypeStats is the outcome breakdown for one stage type.
+type StageTypeStats struct {
+	StageType StageType `json:"stageType"`
+	OutcomeStats
+}
+
+// InterviewAnalytics summarizes how a user performs across interview stages.
+type InterviewAnalytics struct {
+	ByStageType []StageTypeStats `json:"byStageType"`
+	Overall     OutcomeStats     `json:"overall"`
+}
+
+func (s *OutcomeStats) add(outcome StageOutcome, count int64) {
+	s.Total += count
+	switch outcome {
+	case StageOutcomePassed:
+		s.Passed += count
+	case StageOutcomeFailed:
+		s.Failed += count
+	case StageOutcomePending:
+		s.Pending += count
+	case StageOutcomeCancelled:
+		s.Cancelled += count
+	}
+	s.Attempted = s.Passed + s.Failed
+}
+
+func (s *OutcomeStats) finalize() {
+	if s.Attempted == 0 {
+		return
+	}
+	rate := float64(s.Passed) / float64(s.Attempted)
+	s.PassRate = &rate
+}
+
+// NewInterviewAnalytics aggregates outcome counts per stage type. Stage types
+// are ordered by most attempted first so the rounds that matter surface on top.
+func NewInterviewAnalytics(counts []StageOutcomeCount) *InterviewAnalytics {
+	byType := make(map[StageType]*StageTypeStats)
+	analytics := &InterviewAnalytics{ByStageType: []StageTypeStats{}}
+
+	for _, row := range counts {
+		stats, ok := byType[row.StageType]
+		if !ok {
+			stats = &StageTypeStats{StageType: row.StageType}
+			byType[row.StageType] = stats
+		}
+		stats.add(row.Outcome, row.Count)
+		analytics.Overall.add(row.Outcome, row.Count)
+	}
+
+	for _, stats := range byType {
+		stats.finalize()
+		analytics.ByStageType = append(analytics.ByStageType, *stats)
+	}
+	analytics.Overall.finalize()
+
+	sort.Slice(analytics.ByStageType, func(i, j int) bool {
+		a, b := analytics.ByStageType[i], analytics.ByStageType[j]
+		if a.Attempted != b.Attempted {
+			return a.Attempted > b.Attempted
+		}
+		return a.StageType < b.StageType
+	})
+
+	return analytics
+}
@@ -1,6 +1,10 @@
 package interviewstages
 
-import "errors"
+import (
+	"errors"
+
+	"woragis-jobs-service/pkg/response"
+)
 
 const (
 	ErrCodeInvalidPayload    = 10200
@@ -8,6 +12,7 @@ const (
 	ErrCodeInvalidOutcome     = 10202
 	ErrCodeRepositoryFailure  = 10203
 	ErrCodeNotFound           = 10204
+	ErrCodeAccessDenied       = 10205
 )
 
 const (
@@ -20,17 +25,48 @@ const (
 	ErrUnableToPersist         = "interviewstages: unable to persist data"
 	ErrUnableToFetch           = "interviewstages: unable to fetch data"
 	ErrUnableToUpdate          = "interviewstages: unable to update data"
+	ErrEmptyTemplateID         = "interviewstages: template id cannot be empty"
+	ErrInvalidTemplateName     = "interviewstages: template name must be 1-100 characters"
+	ErrInvalidTemplateStages   = "interviewstages: template must have between 1 and 20 stages"
+	ErrTemplateNotFound        = "interviewstages: template not found"
+	ErrBuiltInTemplateReadOnly = "interviewstages: built-in templates cannot be modified"
 )
 
 type DomainError struct {
 	Code    int
 	Message string
+	// cause is the underlying database error, kept so retries can tell transient failures apart
+	cause error
 }
 
 func (e *DomainError) Error() string {
 	return e.Message
 }
 
+// Unwrap returns the underlying error, if any.
+func (e *DomainError) Unwrap() error {
+	return e.cause
+}
+
+// ErrorKind classifies the error for response.FromDomainError.
+func (e *DomainError) ErrorKind() response.ErrorKind {
+	switch e.Code {
+	case ErrCodeInvalidPayload, ErrCodeInvalidStageType, ErrCodeInvalidOutcome:
+		return response.KindValidation
+	case ErrCodeNotFound:
+		return response.KindNotFound
+	case ErrCodeAccessDenied:
+		return response.KindForbidden
+	default:
+		return response.KindInternal
+	}
+}
+
+// ErrorCode returns the numeric code sent in the error envelope.
+func (e *DomainError) ErrorCode() int {
+	return e.Code
+}
+
 func NewDomainError(code int, message string) *DomainError {
 	return &DomainError{
 		Code:    code,
@@ -38,6 +74,15 @@ func NewDomainError(code int, message string) *DomainError {
 	}
 }
 
+// newRepositoryError reports a failed database operation, keeping err as the cause.
+func newRepositoryError(message string, err error) *DomainError {
+	return &DomainError{
+		Code:    ErrCodeRepositoryFailure,
+		Message: message,
+		cause:   err,
+	}
+}
+
 func AsDomainError(err error) (*DomainError, bool) {
 	var domainErr *DomainError
 	if errors.As(err, &domainErr) {
@@ -7,6 +7,8 @@ import (
 	"github.com/gofiber/fiber/v2"
 	"github.com/google/uuid"
 
+	"woragis-jobs-service/pkg/middleware"
+	"woragis-jobs-service/pkg/pagination"
 	"woragis-jobs-service/pkg/response"
 )
 
@@ -19,6 +21,11 @@ type Handler interface {
 	DeleteStage(c *fiber.Ctx) error
 	ScheduleStage(c *fiber.Ctx) error
 	CompleteStage(c *fiber.Ctx) error
+	ApplyTemplate(c *fiber.Ctx) error
+	CreateTemplate(c *fiber.Ctx) error
+	ListTemplates(c *fiber.Ctx) error
+	DeleteTemplate(c *fiber.Ctx) error
+	GetInterviewAnalytics(c *fiber.Ctx) error
 }
 
 type handler struct {
@@ -57,6 +64,11 @@ type scheduleStagePayload struct {
 	ScheduledDate string `json:"scheduledDate"` // ISO 8601 format
 }
 
+type createTemplatePayload struct {
+	Name   string      `json:"name"`
+	Stages []StageType `json:"stages"`
+}
+
 type completeStagePayload struct {
 	CompletedDate string       `json:"completedDate"` // ISO 8601 format
 	Outcome       StageOutcome `json:"outcome"`
@@ -128,11 +140,9 @@ func (h *handler) CreateStage(c *fiber.Ctx) error {
 }
 
 func (h *handler) GetStage(c *fiber.Ctx) error {
-	stageID, err := uuid.Parse(c.Params("id"))
+	stageID, err := middleware.UUIDParam(c, "id")
 	if err != nil {
-		return response.Error(c, fiber.StatusBadRequest, ErrCodeInvalidPayload, fiber.Map{
-			"message": "invalid stage id",
-		})
+		return middleware.InvalidUUIDParam(c, "id")
 	}
 
 	stage, err := h.service.GetStage(c.Context(), stageID)
@@ -167,31 +177,58 @@ func (h *handler) ListStages(c *fiber.Ctx) error {
 		filters.Outcome = &outcome
 	}
 
-	// Pagination
-	if limit := c.QueryInt("limit", 50); limit > 0 {
-		filters.Limit = limit
+	// Ordering: ?sort=createdAt|scheduledDate&order=asc|desc, oldest first by default
+	switch sortBy := c.Query("sort", SortByCreatedAt); sortBy {
+	case SortByCreatedAt, SortByScheduledDate:
+		filters.SortBy = sortBy
+	default:
+		return response.Error(c, fiber.StatusBadRequest, ErrCodeInvalidPayload, fiber.Map{
+			"message": "sort: must be createdAt or scheduledDate",
+		})
+	}
+	switch order := c.Query("order", "asc"); order {
+	case "asc", "desc":
+		filters.SortDesc = order == "desc"
+	default:
+		return response.Error(c, fiber.StatusBadRequest, ErrCodeInvalidPayload, fiber.Map{
+			"message": "order: must be asc or desc",
+		})
 	}
-	if offset := c.QueryInt("offset", 0); offset > 0 {
-		filters.Offset = offset
+
+	// Pagination
+	page, err := pagination.FromQuery(c)
+	if err != nil {
+		return response.Error(c, fiber.StatusBadRequest, ErrCodeInvalidPayload, fiber.Map{
+			"message": err.Error(),
+		})
 	}
+	filters.Limit = page.Limit
+	filters.Offset = page.Offset
+	page.SetHeaders(c)
 
 	stages, err := h.service.ListStages(c.Context(), filters)
 	if err != nil {
 		return h.handleError(c, err)
 	}
+	total, err := h.service.CountStages(c.Context(), filters)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+	page.SetLinkHeader(c, len(stages), total)
 
 	return response.Success(c, fiber.StatusOK, fiber.Map{
 		"stages": stages,
 		"count":  len(stages),
+		"total":  total,
+		"limit":  page.Limit,
+		"offset": page.Offset,
 	})
 }
 
 func (h *handler) UpdateStage(c *fiber.Ctx) error {
-	stageID, err := uuid.Parse(c.Params("id"))
+	stageID, err := middleware.UUIDParam(c, "id")
 	if err != nil {
-		return response.Error(c, fiber.StatusBadRequest, ErrCodeInvalidPayload, fiber.Map{
-			"message": "invalid stage id",
-		})
+		return middleware.InvalidUUIDParam(c, "id")
 	}
 
 	var payload updateStagePayload
@@ -226,11 +263,9 @@ func (h *handler) UpdateStage(c *fiber.Ctx) error {
 }
 
 func (h *handler) DeleteStage(c *fiber.Ctx) error {
-	stageID, err := uuid.Parse(c.Params("id"))
+	stageID, err := middleware.UUIDParam(c, "id")
 	if err != nil {
-		return response.Error(c, fiber.StatusBadRequest, ErrCodeInvalidPayload, fiber.Map{
-			"message": "invalid stage id",
-		})
+		return middleware.InvalidUUIDParam(c, "id")
 	}
 
 	if err := h.service.DeleteStage(c.Context(), stageID); err != nil {
@@ -244,11 +279,9 @@ func (h *handler) DeleteStage(c *fiber.Ctx) error {
 
 
 func (h *handler) ScheduleStage(c *fiber.Ctx) error {
-	stageID, err := uuid.Parse(c.Params("id"))
+	stageID, err := middleware.UUIDParam(c, "id")
 	if err != nil {
-		return response.Error(c, fiber.StatusBadRequest, ErrCodeInvalidPayload, fiber.Map{
-			"message": "invalid stage id",
-		})
+		return middleware.InvalidUUIDParam(c, "id")
 	}
 
 	var payload scheduleStagePayload
@@ -274,11 +307,9 @@ func (h *handler) ScheduleStage(c *fiber.Ctx) error {
 }
 
 func (h *handler) CompleteStage(c *fiber.Ctx) error {
-	stageID, err := uuid.Parse(c.Params("id"))
+	stageID, err := middleware.UUIDParam(c, "id")
 	if err != nil {
-		return response.Error(c, fiber.StatusBadRequest, ErrCodeInvalidPayload, fiber.Map{
-			"message": "invalid stage id",
-		})
+		return middleware.InvalidUUIDParam(c, "id")
 	}
 
 	var payload completeStagePayload
@@ -303,24 +334,118 @@ func (h *handler) CompleteStage(c *fiber.Ctx) error {
 	return response.Success(c, fiber.StatusOK, stage)
 }
 
-func (h *handler) handleError(c *fiber.Ctx, err error) error {
-	if domainErr, ok := AsDomainError(err); ok {
-		statusCode := fiber.StatusInternalServerError
-		switch domainErr.Code {
-		case ErrCodeNotFound:
-			statusCode = fiber.StatusNotFound
-		case ErrCodeInvalidPayload, ErrCodeInvalidStageType, ErrCodeInvalidOutcome:
-			statusCode = fiber.StatusBadRequest
-		}
+func (h *handler) ApplyTemplate(c *fiber.Ctx) error {
+	userID, err := middleware.GetUserIDFromFiberContext(c)
+	if err != nil {
+		return response.Error(c, fiber.StatusUnauthorized, 401, fiber.Map{
+			"message": "authentication required",
+		})
+	}
+
+	applicationID, err := middleware.UUIDParam(c, "applicationId")
+	if err != nil {
+		return middleware.InvalidUUIDParam(c, "applicationId")
+	}
+
+	templateID, err := middleware.UUIDParam(c, "templateId")
+	if err != nil {
+		return middleware.InvalidUUIDParam(c, "templateId")
+	}
+
+	stages, err := h.service.ApplyTemplate(c.Context(), userID, applicationID, templateID)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return response.Success(c, fiber.StatusCreated, fiber.Map{
+		"stages": stages,
+		"count":  len(stages),
+	})
+}
 
-		return response.Error(c, statusCode, domainErr.Code, fiber.Map{
-			"message": domainErr.Message,
+func (h *handler) CreateTemplate(c *fiber.Ctx) error {
+	userID, err := middleware.GetUserIDFromFiberContext(c)
+	if err != nil {
+		return response.Error(c, fiber.StatusUnauthorized, 401, fiber.Map{
+			"message": "authentication required",
 		})
 	}
 
-	h.logger.Error("unhandled error", slog.Any("error", err))
-	return response.Error(c, fiber.StatusInternalServerError, 500, fiber.Map{
-		"message": "internal server error",
+	var payload createTemplatePayload
+	if err := c.BodyParser(&payload); err != nil {
+		return response.Error(c, fiber.StatusBadRequest, ErrCodeInvalidPayload, fiber.Map{
+			"message": "invalid request payload",
+		})
+	}
+
+	template, err := h.service.CreateTemplate(c.Context(), userID, payload.Name, payload.Stages)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return response.Success(c, fiber.StatusCreated, template)
+}
+
+func (h *handler) ListTemplates(c *fiber.Ctx) error {
+	userID, err := middleware.GetUserIDFromFiberContext(c)
+	if err != nil {
+		return response.Error(c, fiber.StatusUnauthorized, 401, fiber.Map{
+			"message": "authentication required",
+		})
+	}
+
+	templates, err := h.service.ListTemplates(c.Context(), userID)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return response.Success(c, fiber.StatusOK, fiber.Map{
+		"templates": templates,
+		"count":     len(templates),
 	})
 }
 
+func (h *handler) DeleteTemplate(c *fiber.Ctx) error {
+	userID, err := middleware.GetUserIDFromFiberContext(c)
+	if err != nil {
+		return response.Error(c, fiber.StatusUnauthorized, 401, fiber.Map{
+			"message": "authentication required",
+		})
+	}
+
+	templateID, err := middleware.UUIDParam(c, "templateId")
+	if err != nil {
+		return middleware.InvalidUUIDParam(c, "templateId")
+	}
+
+	if err := h.service.DeleteTemplate(c.Context(), userID, templateID); err != nil {
+		return h.handleError(c, err)
+	}
+
+	return response.Success(c, fiber.StatusOK, fiber.Map{
+		"message": "template deleted successfully",
+	})
+}
+
+func (h *handler) GetInterviewAnalytics(c *fiber.Ctx) error {
+	userID, err := middleware.GetUserIDFromFiberContext(c)
+	if err != nil {
+		return response.Error(c, fiber.StatusUnauthorized, 401, fiber.Map{
+			"message": "authentication required",
+		})
+	}
+
+	analytics, err := h.service.GetInterviewAnalytics(c.Context(), userID)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return response.Success(c, fiber.StatusOK, analytics)
+}
+
+func (h *handler) handleError(c *fiber.Ctx, err error) error {
+	if _, ok := AsDomainError(err); !ok {
+		h.logger.ErrorContext(c.UserContext(), "unhandled error", slog.Any("error", err))
+	}
+	return response.FromDomainError(c, err)
+}
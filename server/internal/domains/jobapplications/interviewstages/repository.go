@@ -14,8 +14,28 @@ type Repository interface {
 	UpdateStage(ctx context.Context, stage *InterviewStage) error
 	GetStage(ctx context.Context, stageID uuid.UUID) (*InterviewStage, error)
 	ListStages(ctx context.Context, filters StageFilters) ([]InterviewStage, error)
+	CountStages(ctx context.Context, filters StageFilters) (int64, error)
 	DeleteStage(ctx context.Context, stageID uuid.UUID) error
 	GetStagesByApplicationID(ctx context.Context, applicationID uuid.UUID) ([]InterviewStage, error)
+	CreateStages(ctx context.Context, stages []InterviewStage) error
+	CreateTemplate(ctx context.Context, template *InterviewTemplate) error
+	GetTemplate(ctx context.Context, templateID uuid.UUID) (*InterviewTemplate, error)
+	ListTemplates(ctx context.Context, userID uuid.UUID) ([]InterviewTemplate, error)
+	DeleteTemplate(ctx context.Context, userID, templateID uuid.UUID) error
+	CountStageOutcomes(ctx context.Context, userID uuid.UUID) ([]StageOutcomeCount, error)
+}
+
+// Fields stages can be sorted by
+const (
+	SortByCreatedAt     = "createdAt"
+	SortByScheduledDate = "scheduledDate"
+)
+
+// sortColumns maps the sort fields to their columns. Unscheduled stages sort last
+// either way.
+var sortColumns = map[string]string{
+	SortByCreatedAt:     "created_at",
+	SortByScheduledDate: "scheduled_date",
 }
 
 // StageFilters represents filtering options for listing interview stages.
@@ -23,6 +43,8 @@ type StageFilters struct {
 	JobApplicationID *uuid.UUID
 	StageType        *StageType
 	Outcome          *StageOutcome
+	SortBy           string // SortByCreatedAt (default) or SortByScheduledDate
+	SortDesc         bool
 	Limit            int
 	Offset           int
 }
@@ -56,24 +78,16 @@ func (r *gormRepository) GetStage(ctx context.Context, stageID uuid.UUID) (*Inte
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, NewDomainError(ErrCodeNotFound, ErrStageNotFound)
 		}
-		return nil, NewDomainError(ErrCodeRepositoryFailure, ErrUnableToFetch)
+		return nil, newRepositoryError(ErrUnableToFetch, err)
 	}
 	return &stage, nil
 }
 
+// ListStages returns the stages matching filters, oldest first unless another order is
+// requested. Ties are broken by id so pages do not overlap.
 func (r *gormRepository) ListStages(ctx context.Context, filters StageFilters) ([]InterviewStage, error) {
 	var stages []InterviewStage
-	query := r.db.WithContext(ctx).Model(&InterviewStage{})
-
-	if filters.JobApplicationID != nil {
-		query = query.Where("job_application_id = ?", *filters.JobApplicationID)
-	}
-	if filters.StageType != nil {
-		query = query.Where("stage_type = ?", *filters.StageType)
-	}
-	if filters.Outcome != nil {
-		query = query.Where("outcome = ?", *filters.Outcome)
-	}
+	query := r.filteredQuery(ctx, filters)
 
 	if filters.Limit > 0 {
 		query = query.Limit(filters.Limit)
@@ -82,19 +96,50 @@ func (r *gormRepository) ListStages(ctx context.Context, filters StageFilters) (
 		query = query.Offset(filters.Offset)
 	}
 
-	query = query.Order("scheduled_date ASC NULLS LAST, created_at ASC")
+	column, ok := sortColumns[filters.SortBy]
+	if !ok {
+		column = sortColumns[SortByCreatedAt]
+	}
+	direction := "ASC"
+	if filters.SortDesc {
+		direction = "DESC"
+	}
+	query = query.Order(column + " " + direction + " NULLS LAST").Order("id " + direction)
 
 	if err := query.Find(&stages).Error; err != nil {
-		return nil, NewDomainError(ErrCodeRepositoryFailure, ErrUnableToFetch)
+		return nil, newRepositoryError(ErrUnableToFetch, err)
 	}
 
 	return stages, nil
 }
 
+// CountStages returns how many stages match filters, ignoring the limit and offset.
+func (r *gormRepository) CountStages(ctx context.Context, filters StageFilters) (int64, error) {
+	var total int64
+	if err := r.filteredQuery(ctx, filters).Count(&total).Error; err != nil {
+		return 0, newRepositoryError(ErrUnableToFetch, err)
+	}
+	return total, nil
+}
+
+func (r *gormRepository) filteredQuery(ctx context.Context, filters StageFilters) *gorm.DB {
+	query := r.db.WithContext(ctx).Model(&InterviewStage{})
+	if filters.JobApplicationID != nil {
+		query = query.Where("job_application_id = ?", *filters.JobApplicationID)
+	}
+	if filters.StageType != nil {
+		query = query.Where("stage_type = ?", *filters.StageType)
+	}
+	if filters.Outcome != nil {
+		query = query.Where("outcome = ?", *filters.Outcome)
+	}
+	return query
+}
+
 func (r *gormRepository) DeleteStage(ctx context.Context, stageID uuid.UUID) error {
 	result := r.db.WithContext(ctx).Delete(&InterviewStage{}, stageID)
 	if result.Error != nil {
-		return NewDomainError(ErrCodeRepositoryFailure, ErrUnableToUpdate)
+		return newRepositoryError(ErrUnableToUpdate, result.Error)
 	}
 	if result.RowsAffected == 0 {
 		return NewDomainError(ErrCodeNotFound, ErrStageNotFound)
@@ -105,6 +150,80 @@ func (r *gormRepository) DeleteStage(ctx context.Context, stageID uuid.UUID) err
 func (r *gormRepository) GetStagesByApplicationID(ctx context.Context, applicationID uuid.UUID) ([]InterviewStage, error) {
 	return r.ListStages(ctx, StageFilters{
 		JobApplicationID: &applicationID,
+		SortBy:           SortByScheduledDate,
 	})
 }
 
+
+// CreateStages inserts all stages in a single transaction.
+func (r *gormRepository) CreateStages(ctx context.Context, stages []InterviewStage) error {
+	for i := range stages {
+		if err := stages[i].Validate(); err != nil {
+			return err
+		}
+	}
+	if err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return tx.Create(&stages).Error
+	}); err != nil {
+		return newRepositoryError(ErrUnableToPersist, err)
+	}
+	return nil
+}
+
+func (r *gormRepository) CreateTemplate(ctx context.Context, template *InterviewTemplate) error {
+	if err := template.Validate(); err != nil {
+		return err
+	}
+	if err := r.db.WithContext(ctx).Create(template).Error; err != nil {
+		return newRepositoryError(ErrUnableToPersist, err)
+	}
+	return nil
+}
+
+func (r *gormRepository) GetTemplate(ctx context.Context, templateID uuid.UUID) (*InterviewTemplate, error) {
+	var template InterviewTemplate
+	if err := r.db.WithContext(ctx).Where("id = ?", templateID).First(&template).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, NewDomainError(ErrCodeNotFound, ErrTemplateNotFound)
+		}
+		return nil, newRepositoryError(ErrUnableToFetch, err)
+	}
+	return &template, nil
+}
+
+// ListTemplates returns the user's own templates, oldest first.
+func (r *gormRepository) ListTemplates(ctx context.Context, userID uuid.UUID) ([]InterviewTemplate, error) {
+	var templates []InterviewTemplate
+	if err := r.db.WithContext(ctx).Where("user_id = ?", userID).Order("created_at ASC").Find(&templates).Error; err != nil {
+		return nil, newRepositoryError(ErrUnableToFetch, err)
+	}
+	return templates, nil
+}
+
+func (r *gormRepository) DeleteTemplate(ctx context.Context, userID, templateID uuid.UUID) error {
+	result := r.db.WithContext(ctx).Where("id = ? AND user_id = ?", templateID, userID).Delete(&InterviewTemplate{})
+	if result.Error != nil {
+		return newRepositoryError(ErrUnableToUpdate, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return NewDomainError(ErrCodeNotFound, ErrTemplateNotFound)
+	}
+	return nil
+}
+
+// CountStageOutcomes counts the user's stages grouped by stage type and outcome.
+// Stages carry no user_id, so ownership is resolved through the parent application.
+func (r *gormRepository) CountStageOutcomes(ctx context.Context, userID uuid.UUID) ([]StageOutcomeCount, error) {
+	var counts []StageOutcomeCount
+	err := r.db.WithContext(ctx).
+		Table("job_application_interview_stages AS s").
+		Select("s.stage_type AS stage_type, s.outcome AS outcome, COUNT(*) AS count").
+		Joins("JOIN job_applications a ON a.id = s.job_application_id").
+		Where("a.user_id = ?", userID).
+		Group("s.stage_type, s.outcome").
+		Scan(&counts).Error
+	if err != nil {
+		return nil, newRepositoryError(ErrUnableToFetch, err)
+	}
+	return counts, nil
+}
@@ -0,0 +1,99 @@
+package interviewstages
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"woragis-jobs-service/internal/database"
+)
+
+// retryingRepository retries calls to the wrapped repository after transient connection
+// errors, so a brief Postgres failover does not fail the request. Services are unaware of it.
+type retryingRepository struct {
+	next   Repository
+	policy database.RetryPolicy
+}
+
+// NewRetryingRepository wraps repo so every call is retried according to policy.
+func NewRetryingRepository(repo Repository, policy database.RetryPolicy) Repository {
+	return &retryingRepository{next: repo, policy: policy}
+}
+
+func (r *retryingRepository) CreateStage(ctx context.Context, stage *InterviewStage) error {
+	return r.policy.Do(ctx, "CreateStage", func() error {
+		return r.next.CreateStage(ctx, stage)
+	})
+}
+
+func (r *retryingRepository) UpdateStage(ctx context.Context, stage *InterviewStage) error {
+	return r.policy.Do(ctx, "UpdateStage", func() error {
+		return r.next.UpdateStage(ctx, stage)
+	})
+}
+
+func (r *retryingRepository) GetStage(ctx context.Context, stageID uuid.UUID) (*InterviewStage, error) {
+	return database.Retry(ctx, r.policy, "GetStage", func() (*InterviewStage, error) {
+		return r.next.GetStage(ctx, stageID)
+	})
+}
+
+func (r *retryingRepository) ListStages(ctx context.Context, filters StageFilters) ([]InterviewStage, error) {
+	return database.Retry(ctx, r.policy, "ListStages", func() ([]InterviewStage, error) {
+		return r.next.ListStages(ctx, filters)
+	})
+}
+
+func (r *retryingRepository) CountStages(ctx context.Context, filters StageFilters) (int64, error) {
+	return database.Retry(ctx, r.policy, "CountStages", func() (int64, error) {
+		return r.next.CountStages(ctx, filters)
+	})
+}
+
+func (r *retryingRepository) DeleteStage(ctx context.Context, stageID uuid.UUID) error {
+	return r.policy.Do(ctx, "DeleteStage", func() error {
+		return r.next.DeleteStage(ctx, stageID)
+	})
+}
+
+func (r *retryingRepository) GetStagesByApplicationID(ctx context.Context, applicationID uuid.UUID) ([]InterviewStage, error) {
+	return database.Retry(ctx, r.policy, "GetStagesByApplicationID", func() ([]InterviewStage, error) {
+		return r.next.GetStagesByApplicationID(ctx, applicationID)
+	})
+}
+
+func (r *retryingRepository) CreateStages(ctx context.Context, stages []InterviewStage) error {
+	return r.policy.Do(ctx, "CreateStages", func() error {
+		return r.next.CreateStages(ctx, stages)
+	})
+}
+
+func (r *retryingRepository) CreateTemplate(ctx context.Context, template *InterviewTemplate) error {
+	return r.policy.Do(ctx, "CreateTemplate", func() error {
+		return r.next.CreateTemplate(ctx, template)
+	})
+}
+
+func (r *retryingRepository) GetTemplate(ctx context.Context, templateID uuid.UUID) (*InterviewTemplate, error) {
+	return database.Retry(ctx, r.policy, "GetTemplate", func() (*InterviewTemplate, error) {
+		return r.next.GetTemplate(ctx, templateID)
+	})
+}
+
+func (r *retryingRepository) ListTemplates(ctx context.Context, userID uuid.UUID) ([]InterviewTemplate, error) {
+	return database.Retry(ctx, r.policy, "ListTemplates", func() ([]InterviewTemplate, error) {
+		return r.next.ListTemplates(ctx, userID)
+	})
+}
+
+func (r *retryingRepository) DeleteTemplate(ctx context.Context, userID, templateID uuid.UUID) error {
+	return r.policy.Do(ctx, "DeleteTemplate", func() error {
+		return r.next.DeleteTemplate(ctx, userID, templateID)
+	})
+}
+
+func (r *retryingRepository) CountStageOutcomes(ctx context.Context, userID uuid.UUID) ([]StageOutcomeCount, error) {
+	return database.Retry(ctx, r.policy, "CountStageOutcomes", func() ([]StageOutcomeCount, error) {
+		return r.next.CountStageOutcomes(ctx, userID)
+	})
+}
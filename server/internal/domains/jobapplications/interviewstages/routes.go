@@ -1,17 +1,37 @@
 package interviewstages
 
-import "github.com/gofiber/fiber/v2"
+import (
+	"github.com/gofiber/fiber/v2"
+
+	"woragis-jobs-service/pkg/middleware"
+)
 
 // SetupRoutes registers interview stage endpoints.
 // The routes are nested under /job-applications/:applicationId/interview-stages
 // so applicationId is available in the route params.
 func SetupRoutes(api fiber.Router, handler Handler) {
+	id := middleware.ValidateUUIDParam("id")
+	templateID := middleware.ValidateUUIDParam("templateId")
 	api.Post("/", handler.CreateStage)
 	api.Get("/", handler.ListStages)
-	api.Get("/:id", handler.GetStage)
-	api.Patch("/:id", handler.UpdateStage)
-	api.Delete("/:id", handler.DeleteStage)
-	api.Post("/:id/schedule", handler.ScheduleStage)
-	api.Post("/:id/complete", handler.CompleteStage)
+	api.Post("/apply-template/:templateId", templateID, handler.ApplyTemplate) // Create all stages of a template at once
+	api.Get("/:id", id, handler.GetStage)
+	api.Patch("/:id", id, handler.UpdateStage)
+	api.Delete("/:id", id, handler.DeleteStage)
+	api.Post("/:id/schedule", id, handler.ScheduleStage)
+	api.Post("/:id/complete", id, handler.CompleteStage)
 }
 
+
+// SetupTemplateRoutes registers interview template endpoints under /interview-templates.
+func SetupTemplateRoutes(api fiber.Router, handler Handler) {
+	templateID := middleware.ValidateUUIDParam("templateId")
+	api.Get("/", handler.ListTemplates) // Built-in templates followed by the user's own
+	api.Post("/", handler.CreateTemplate)
+	api.Delete("/:templateId", templateID, handler.DeleteTemplate)
+}
+
+// SetupAnalyticsRoutes registers interview analytics endpoints under /analytics.
+func SetupAnalyticsRoutes(api fiber.Router, handler Handler) {
+	api.Get("/interviews", handler.GetInterviewAnalytics) // Pass/fail rates per stage type
+}
@@ -13,11 +13,17 @@ type Service interface {
 	CreateStage(ctx context.Context, jobApplicationID uuid.UUID, stageType StageType) (*InterviewStage, error)
 	GetStage(ctx context.Context, stageID uuid.UUID) (*InterviewStage, error)
 	ListStages(ctx context.Context, filters StageFilters) ([]InterviewStage, error)
+	CountStages(ctx context.Context, filters StageFilters) (int64, error)
 	UpdateStage(ctx context.Context, stageID uuid.UUID, updates UpdateStageRequest) (*InterviewStage, error)
 	DeleteStage(ctx context.Context, stageID uuid.UUID) error
 	GetStagesByApplicationID(ctx context.Context, applicationID uuid.UUID) ([]InterviewStage, error)
 	ScheduleStage(ctx context.Context, stageID uuid.UUID, scheduledDate time.Time) (*InterviewStage, error)
 	CompleteStage(ctx context.Context, stageID uuid.UUID, completedDate time.Time, outcome StageOutcome) (*InterviewStage, error)
+	CreateTemplate(ctx context.Context, userID uuid.UUID, name string, stages []StageType) (*InterviewTemplate, error)
+	ListTemplates(ctx context.Context, userID uuid.UUID) ([]InterviewTemplate, error)
+	DeleteTemplate(ctx context.Context, userID, templateID uuid.UUID) error
+	ApplyTemplate(ctx context.Context, userID, jobApplicationID, templateID uuid.UUID) ([]InterviewStage, error)
+	GetInterviewAnalytics(ctx context.Context, userID uuid.UUID) (*InterviewAnalytics, error)
 }
 
 // UpdateStageRequest represents fields that can be updated on a stage.
@@ -92,6 +98,11 @@ func (s *service) ListStages(ctx context.Context, filters StageFilters) ([]Inter
 	return s.repo.ListStages(ctx, filters)
 }
 
+// CountStages returns how many stages match filters, ignoring the limit and offset.
+func (s *service) CountStages(ctx context.Context, filters StageFilters) (int64, error) {
+	return s.repo.CountStages(ctx, filters)
+}
+
 func (s *service) UpdateStage(ctx context.Context, stageID uuid.UUID, updates UpdateStageRequest) (*InterviewStage, error) {
 	stage, err := s.repo.GetStage(ctx, stageID)
 	if err != nil {
@@ -178,7 +189,7 @@ func (s *service) CompleteStage(ctx context.Context, stageID uuid.UUID, complete
 		application, err := s.jobApplicationService.GetJobApplication(ctx, stage.JobApplicationID)
 		if err == nil && application != nil && application.ResumeID != nil {
 			if err := s.resumeMetricsService.RecalculateResumeMetrics(ctx, *application.ResumeID); err != nil {
-				s.logger.Warn("failed to recalculate resume metrics after completing interview", "resume_id", application.ResumeID.String(), "error", err)
+				s.logger.WarnContext(ctx, "failed to recalculate resume metrics after completing interview", "resume_id", application.ResumeID.String(), "error", err)
 				// Don't fail the request if metric recalculation fails
 			}
 		}
@@ -187,3 +198,76 @@ func (s *service) CompleteStage(ctx context.Context, stageID uuid.UUID, complete
 	return stage, nil
 }
 
+
+func (s *service) CreateTemplate(ctx context.Context, userID uuid.UUID, name string, stages []StageType) (*InterviewTemplate, error) {
+	template, err := NewInterviewTemplate(userID, name, stages)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.CreateTemplate(ctx, template); err != nil {
+		return nil, err
+	}
+
+	return template, nil
+}
+
+// ListTemplates returns the built-in templates followed by the user's own templates.
+func (s *service) ListTemplates(ctx context.Context, userID uuid.UUID) ([]InterviewTemplate, error) {
+	userTemplates, err := s.repo.ListTemplates(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return append(BuiltInTemplates(), userTemplates...), nil
+}
+
+func (s *service) DeleteTemplate(ctx context.Context, userID, templateID uuid.UUID) error {
+	if _, ok := findBuiltInTemplate(templateID); ok {
+		return NewDomainError(ErrCodeAccessDenied, ErrBuiltInTemplateReadOnly)
+	}
+	return s.repo.DeleteTemplate(ctx, userID, templateID)
+}
+
+// ApplyTemplate creates all of the template's stages for the application in one transaction.
+// The template must be built-in or belong to userID.
+func (s *service) ApplyTemplate(ctx context.Context, userID, jobApplicationID, templateID uuid.UUID) ([]InterviewStage, error) {
+	template, ok := findBuiltInTemplate(templateID)
+	if !ok {
+		var err error
+		template, err = s.repo.GetTemplate(ctx, templateID)
+		if err != nil {
+			return nil, err
+		}
+		if template.UserID == nil || *template.UserID != userID {
+			// Don't reveal other users' templates
+			return nil, NewDomainError(ErrCodeNotFound, ErrTemplateNotFound)
+		}
+	}
+
+	stages, err := template.Instantiate(jobApplicationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.CreateStages(ctx, stages); err != nil {
+		return nil, err
+	}
+
+	if s.logger != nil {
+		s.logger.InfoContext(ctx, "interview template applied",
+			"template_id", templateID.String(),
+			"job_application_id", jobApplicationID.String(),
+			"stages", len(stages))
+	}
+
+	return stages, nil
+}
+
+// GetInterviewAnalytics returns the user's pass/fail rates per stage type and overall.
+func (s *service) GetInterviewAnalytics(ctx context.Context, userID uuid.UUID) (*InterviewAnalytics, error) {
+	counts, err := s.repo.CountStageOutcomes(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return NewInterviewAnalytics(counts), nil
+}
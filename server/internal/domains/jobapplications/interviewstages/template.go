@@ -0,0 +1,147 @@
+package interviewstages
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// maxTemplateStages caps how many stages a template may instantiate.
+const maxTemplateStages = 20
+
+// StageTypeList is an ordered list of stage types stored as a JSON array.
+type StageTypeList []StageType
+
+// Value implements the driver.Valuer interface.
+func (l StageTypeList) Value() (driver.Value, error) {
+	if l == nil {
+		return nil, nil
+	}
+	return json.Marshal(l)
+}
+
+// Scan implements the sql.Scanner interface.
+func (l *StageTypeList) Scan(value interface{}) error {
+	if value == nil {
+		*l = nil
+		return nil
+	}
+	bytes, ok := value.([]byte)
+	if !ok {
+		return json.Unmarshal([]byte(value.(string)), l)
+	}
+	return json.Unmarshal(bytes, l)
+}
+
+// InterviewTemplate is an ordered set of stages that can be applied to an application in one call.
+// Built-in templates are shared by all users and are not stored in the database.
+type InterviewTemplate struct {
+	ID        uuid.UUID     `gorm:"column:id;type:uuid;primaryKey" json:"id"`
+	UserID    *uuid.UUID    `gorm:"column:user_id;type:uuid;index" json:"userId,omitempty"`
+	Name      string        `gorm:"column:name;size:100;not null" json:"name"`
+	Stages    StageTypeList `gorm:"column:stages;type:jsonb;not null" json:"stages"`
+	BuiltIn   bool          `gorm:"-" json:"builtIn"`
+	CreatedAt time.Time     `gorm:"column:created_at" json:"createdAt"`
+	UpdatedAt time.Time     `gorm:"column:updated_at" json:"updatedAt"`
+}
+
+// TableName specifies the table name for InterviewTemplate.
+func (InterviewTemplate) TableName() string {
+	return "interview_templates"
+}
+
+// builtInTemplates are available to every user. Their IDs are fixed so clients can reference them.
+var builtInTemplates = []InterviewTemplate{
+	{
+		ID:   uuid.MustParse("6f1c2b9e-0d4a-4a51-9d0e-5b7a4c3e2a01"),
+		Name: "Standard tech loop",
+		Stages: StageTypeList{
+			StageTypePhoneScreen,
+			StageTypeTechnical,
+			StageTypeSystemDesign,
+			StageTypeBehavioral,
+			StageTypeFinal,
+		},
+		BuiltIn: true,
+	},
+	{
+		ID:   uuid.MustParse("6f1c2b9e-0d4a-4a51-9d0e-5b7a4c3e2a02"),
+		Name: "Short startup loop",
+		Stages: StageTypeList{
+			StageTypePhoneScreen,
+			StageTypeTechnical,
+			StageTypeManager,
+		},
+		BuiltIn: true,
+	},
+}
+
+// BuiltInTemplates returns a copy of the built-in templates.
+func BuiltInTemplates() []InterviewTemplate {
+	templates := make([]InterviewTemplate, len(builtInTemplates))
+	copy(templates, builtInTemplates)
+	return templates
+}
+
+func findBuiltInTemplate(templateID uuid.UUID) (*InterviewTemplate, bool) {
+	for i := range builtInTemplates {
+		if builtInTemplates[i].ID == templateID {
+			template := builtInTemplates[i]
+			return &template, true
+		}
+	}
+	return nil, false
+}
+
+// NewInterviewTemplate creates a user-defined template.
+func NewInterviewTemplate(userID uuid.UUID, name string, stages []StageType) (*InterviewTemplate, error) {
+	template := &InterviewTemplate{
+		ID:        uuid.New(),
+		UserID:    &userID,
+		Name:      strings.TrimSpace(name),
+		Stages:    StageTypeList(stages),
+		CreatedAt: time.Now().UTC(),
+		UpdatedAt: time.Now().UTC(),
+	}
+
+	return template, template.Validate()
+}
+
+// Validate ensures template invariants hold.
+func (t *InterviewTemplate) Validate() error {
+	if t.ID == uuid.Nil {
+		return NewDomainError(ErrCodeInvalidPayload, ErrEmptyTemplateID)
+	}
+	if t.Name == "" || len(t.Name) > 100 {
+		return NewDomainError(ErrCodeInvalidPayload, ErrInvalidTemplateName)
+	}
+	if len(t.Stages) == 0 || len(t.Stages) > maxTemplateStages {
+		return NewDomainError(ErrCodeInvalidPayload, ErrInvalidTemplateStages)
+	}
+	for _, stageType := range t.Stages {
+		if !isValidStageType(stageType) {
+			return NewDomainError(ErrCodeInvalidStageType, ErrUnsupportedStageType)
+		}
+	}
+	return nil
+}
+
+// Instantiate creates one pending stage per template entry for the application.
+// Creation times are spaced by a microsecond so stages list in template order.
+func (t *InterviewTemplate) Instantiate(jobApplicationID uuid.UUID) ([]InterviewStage, error) {
+	now := time.Now().UTC()
+	stages := make([]InterviewStage, 0, len(t.Stages))
+	for i, stageType := range t.Stages {
+		stage, err := NewInterviewStage(jobApplicationID, stageType)
+		if err != nil {
+			return nil, err
+		}
+		stage.CreatedAt = now.Add(time.Duration(i) * time.Microsecond)
+		stage.UpdatedAt = stage.CreatedAt
+		stages = append(stages, *stage)
+	}
+	return stages, nil
+}
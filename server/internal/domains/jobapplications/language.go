@@ -0,0 +1,131 @@
+package jobapplications
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"unicode"
+
+	"woragis-jobs-service/pkg/aiservice"
+)
+
+// LanguageDetector identifies the language of a job posting.
+type LanguageDetector interface {
+	// DetectLanguage returns the ISO 639-1 code of the text's language.
+	DetectLanguage(ctx context.Context, text string) (string, error)
+}
+
+// languageSampleSize caps how much of a posting is sent for detection; the
+// first few thousand characters are plenty to tell the language apart.
+const languageSampleSize = 2000
+
+// AIServiceLanguageDetector implements LanguageDetector using the AI service,
+// falling back to stop-word matching when the AI service fails.
+type AIServiceLanguageDetector struct {
+	client   *aiservice.Client
+	fallback LanguageDetector
+	logger   *slog.Logger
+}
+
+// NewAIServiceLanguageDetector creates a new AI service language detector
+func NewAIServiceLanguageDetector(client *aiservice.Client, logger *slog.Logger) LanguageDetector {
+	return &AIServiceLanguageDetector{
+		client:   client,
+		fallback: NewStopWordLanguageDetector(),
+		logger:   logger,
+	}
+}
+
+// DetectLanguage asks the AI service for the language of text
+func (d *AIServiceLanguageDetector) DetectLanguage(ctx context.Context, text string) (string, error) {
+	systemPrompt := "Identify the language of the job posting provided by the user. " +
+		"Reply with only the two-letter lowercase ISO 639-1 code (for example: en, pt, es) and nothing else."
+
+	req := aiservice.ChatRequest{
+		Agent:       "language_detection",
+		Input:       truncateRunes(text, languageSampleSize),
+		System:      &systemPrompt,
+		Temperature: func() *float64 { t := 0.0; return &t }(),
+		MaxTokens:   func() *int { t := 5; return &t }(),
+	}
+
+	resp, err := d.client.Chat(ctx, req)
+	if err == nil {
+		if code, ok := parseLanguageCode(resp.Output); ok {
+			return code, nil
+		}
+		err = fmt.Errorf("unexpected output %q", resp.Output)
+	}
+
+	d.logger.WarnContext(ctx, "AI language detection failed, using stop-word detection", "error", err)
+	return d.fallback.DetectLanguage(ctx, text)
+}
+
+// parseLanguageCode extracts an ISO 639-1 code from a model reply such as "en", "EN." or "`pt`".
+func parseLanguageCode(output string) (string, bool) {
+	code := strings.ToLower(strings.TrimFunc(output, func(r rune) bool {
+		return !unicode.IsLetter(r)
+	}))
+	if len(code) != 2 || code[0] < 'a' || code[0] > 'z' || code[1] < 'a' || code[1] > 'z' {
+		return "", false
+	}
+	return code, true
+}
+
+// stopWords lists very common function words per language. They are frequent
+// enough in any posting that counting them reliably separates the languages
+// job postings are usually written in.
+var stopWords = map[string][]string{
+	"en": {"the", "and", "of", "to", "with", "for", "you", "our", "are", "will", "is", "in", "we", "your", "this"},
+	"pt": {"de", "e", "para", "com", "você", "uma", "em", "os", "as", "que", "nossa", "nosso", "experiência", "são", "na"},
+	"es": {"de", "y", "para", "con", "el", "la", "los", "las", "que", "en", "una", "nuestro", "experiencia", "del", "usted"},
+	"fr": {"de", "et", "pour", "avec", "le", "la", "les", "des", "vous", "nous", "une", "en", "est", "du", "votre"},
+	"de": {"und", "der", "die", "das", "mit", "für", "sie", "wir", "ein", "eine", "ist", "zu", "von", "unsere", "ihre"},
+	"it": {"di", "e", "per", "con", "il", "la", "che", "un", "una", "nostro", "sono", "del", "della", "esperienza", "le"},
+}
+
+// stopWordLanguageDetector is a lightweight, dependency-free LanguageDetector.
+type stopWordLanguageDetector struct{}
+
+// NewStopWordLanguageDetector creates a detector that scores text by stop-word frequency.
+func NewStopWordLanguageDetector() LanguageDetector {
+	return stopWordLanguageDetector{}
+}
+
+// DetectLanguage returns the language whose stop words occur most often in text.
+func (stopWordLanguageDetector) DetectLanguage(_ context.Context, text string) (string, error) {
+	words := strings.FieldsFunc(strings.ToLower(truncateRunes(text, languageSampleSize)), func(r rune) bool {
+		return !unicode.IsLetter(r)
+	})
+
+	counts := make(map[string]int, len(words))
+	for _, word := range words {
+		counts[word]++
+	}
+
+	bestLanguage, bestScore := "", 0
+	for _, language := range []string{"en", "pt", "es", "fr", "de", "it"} {
+		score := 0
+		for _, word := range stopWords[language] {
+			score += counts[word]
+		}
+		if score > bestScore {
+			bestLanguage, bestScore = language, score
+		}
+	}
+
+	if bestLanguage == "" {
+		return "", fmt.Errorf("unable to determine language")
+	}
+	return bestLanguage, nil
+}
+
+// truncateRunes shortens s to at most n runes without splitting a character.
+func truncateRunes(s string, n int) string {
+	runes := []rune(s)
+	if len(runes) <= n {
+		return s
+	}
+	return string(runes[:n])
+}
@@ -0,0 +1,60 @@
+package jobapplications
+
+import (
+	"strings"
+	"time"
+)
+
+// MaxNextActionLength bounds the next action text.
+const MaxNextActionLength = 255
+
+// nextActionSuggestion is the default next action of a status and how long after the
+// status change it is due; a zero dueIn leaves it undated.
+type nextActionSuggestion struct {
+	action string
+	dueIn  time.Duration
+}
+
+// nextActionSuggestions holds the defaults per status. Statuses without one (processing
+// and the terminal statuses) clear the next action.
+var nextActionSuggestions = map[ApplicationStatus]nextActionSuggestion{
+	ApplicationStatusPending:   {action: "submit application"},
+	ApplicationStatusApplied:   {action: "follow up", dueIn: 7 * 24 * time.Hour},
+	ApplicationStatusContacted: {action: "reply to recruiter", dueIn: 2 * 24 * time.Hour},
+	ApplicationStatusReopened:  {action: "follow up", dueIn: 7 * 24 * time.Hour},
+}
+
+// SuggestNextAction returns the default next action for an application that entered
+// status at changedAt, and when it is due. Both are empty when the status has none.
+func SuggestNextAction(status ApplicationStatus, changedAt time.Time) (string, *time.Time) {
+	suggestion, ok := nextActionSuggestions[status]
+	if !ok {
+		return "", nil
+	}
+	if suggestion.dueIn == 0 {
+		return suggestion.action, nil
+	}
+	due := changedAt.UTC().Add(suggestion.dueIn)
+	return suggestion.action, &due
+}
+
+// suggestNextAction replaces the next action with the default for the current status.
+// It runs on every status change, so a user's override lasts until the next one.
+func (j *JobApplication) suggestNextAction(now time.Time) {
+	j.NextAction, j.NextActionDue = SuggestNextAction(j.Status, now)
+}
+
+// SetNextAction overrides the suggested next action. A blank action clears both the
+// action and its due date.
+func (j *JobApplication) SetNextAction(action string, due *time.Time) {
+	j.NextAction = strings.TrimSpace(action)
+	if j.NextAction == "" {
+		j.NextActionDue = nil
+		return
+	}
+	if due != nil {
+		utc := due.UTC()
+		due = &utc
+	}
+	j.NextActionDue = due
+}
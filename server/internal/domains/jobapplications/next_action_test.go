@@ -0,0 +1,74 @@
+package jobapplications
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSuggestNextAction(t *testing.T) {
+	changedAt := time.Date(2026, 3, 10, 9, 0, 0, 0, time.UTC)
+
+	action, due := SuggestNextAction(ApplicationStatusApplied, changedAt)
+	assert.Equal(t, "follow up", action)
+	require.NotNil(t, due)
+	assert.Equal(t, changedAt.AddDate(0, 0, 7), *due)
+
+	action, due = SuggestNextAction(ApplicationStatusPending, changedAt)
+	assert.Equal(t, "submit application", action)
+	assert.Nil(t, due)
+
+	action, due = SuggestNextAction(ApplicationStatusRejected, changedAt)
+	assert.Empty(t, action)
+	assert.Nil(t, due)
+}
+
+func TestJobApplication_StatusChangeReplacesNextAction(t *testing.T) {
+	application, err := NewJobApplication(uuid.New(), "Acme", "", "Engineer", "https://acme.com/jobs/1", "linkedin")
+	require.NoError(t, err)
+	assert.Equal(t, "submit application", application.NextAction)
+
+	application.MarkApplied("")
+	assert.Equal(t, "follow up", application.NextAction)
+	require.NotNil(t, application.NextActionDue)
+
+	due := time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)
+	application.SetNextAction(" send portfolio ", &due)
+	require.NoError(t, application.UpdateStatus(ApplicationStatusApplied))
+	assert.Equal(t, "send portfolio", application.NextAction, "an unchanged status keeps the override")
+	assert.Equal(t, &due, application.NextActionDue)
+
+	require.NoError(t, application.UpdateStatus(ApplicationStatusRejected))
+	assert.Empty(t, application.NextAction)
+	assert.Nil(t, application.NextActionDue)
+}
+
+func TestJobApplication_SetNextAction_BlankClears(t *testing.T) {
+	application, err := NewJobApplication(uuid.New(), "Acme", "", "Engineer", "https://acme.com/jobs/1", "linkedin")
+	require.NoError(t, err)
+	application.MarkApplied("")
+
+	application.SetNextAction("  ", application.NextActionDue)
+	assert.Empty(t, application.NextAction)
+	assert.Nil(t, application.NextActionDue)
+}
+
+func TestReminderScanner_NextActionDue(t *testing.T) {
+	now := time.Date(2026, 3, 10, 9, 0, 0, 0, time.UTC)
+	due := now.Add(3 * time.Hour)
+	tomorrow := now.AddDate(0, 0, 1)
+	repo := &fakeReminderRepo{applications: []*JobApplication{
+		{ID: uuid.New(), NextAction: "follow up", NextActionDue: &due},
+		{ID: uuid.New(), NextAction: "follow up", NextActionDue: &tomorrow},
+	}}
+	notifier := &fakeNotifier{}
+
+	sent, err := newTestScanner(repo, notifier, now).Scan(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, sent)
+	assert.Equal(t, []string{ReminderEventNextActionDue}, notifier.delivered)
+}
@@ -0,0 +1,232 @@
+package jobapplications
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Offer records the specifics of an offer received for a job application.
+// An application has at most one offer; saving again replaces it.
+type Offer struct {
+	ID               uuid.UUID  `gorm:"column:id;type:uuid;primaryKey" json:"id"`
+	JobApplicationID uuid.UUID  `gorm:"column:job_application_id;type:uuid;uniqueIndex;not null" json:"jobApplicationId"`
+	UserID           uuid.UUID  `gorm:"column:user_id;type:uuid;index;not null" json:"userId"`
+	BaseSalary       int        `gorm:"column:base_salary;not null" json:"baseSalary"` // Annual, in Currency
+	Currency         string     `gorm:"column:currency;size:3;not null" json:"currency"`
+	Bonus            *int       `gorm:"column:bonus" json:"bonus,omitempty"`               // Annual target bonus
+	EquityValue      *int       `gorm:"column:equity_value" json:"equityValue,omitempty"` // Estimated annual equity value
+	EquityDetails    string     `gorm:"column:equity_details;type:text" json:"equityDetails,omitempty"`
+	StartDate        *time.Time `gorm:"column:start_date" json:"startDate,omitempty"`
+	AcceptBy         *time.Time `gorm:"column:accept_by" json:"acceptBy,omitempty"` // Deadline to accept
+	Notes            string     `gorm:"column:notes;type:text" json:"notes,omitempty"`
+	CreatedAt        time.Time  `gorm:"column:created_at" json:"createdAt"`
+	UpdatedAt        time.Time  `gorm:"column:updated_at" json:"updatedAt"`
+}
+
+// TableName specifies the table name for Offer.
+func (Offer) TableName() string {
+	return "job_application_offers"
+}
+
+// OfferDetails holds the user-supplied fields of an offer.
+type OfferDetails struct {
+	BaseSalary    int
+	Currency      string
+	Bonus         *int
+	EquityValue   *int
+	EquityDetails string
+	StartDate     *time.Time
+	AcceptBy      *time.Time
+	Notes         string
+}
+
+// NewOffer creates an offer for the given application.
+func NewOffer(application *JobApplication, details OfferDetails) (*Offer, error) {
+	now := time.Now().UTC()
+	offer := &Offer{
+		ID:               uuid.New(),
+		JobApplicationID: application.ID,
+		UserID:           application.UserID,
+		CreatedAt:        now,
+	}
+	offer.apply(details, now)
+
+	return offer, offer.Validate()
+}
+
+// Replace overwrites the offer's details, keeping its identity.
+func (o *Offer) Replace(details OfferDetails) error {
+	o.apply(details, time.Now().UTC())
+	return o.Validate()
+}
+
+func (o *Offer) apply(details OfferDetails, now time.Time) {
+	o.BaseSalary = details.BaseSalary
+	o.Currency = strings.ToUpper(strings.TrimSpace(details.Currency))
+	o.Bonus = details.Bonus
+	o.EquityValue = details.EquityValue
+	o.EquityDetails = strings.TrimSpace(details.EquityDetails)
+	o.StartDate = details.StartDate
+	o.AcceptBy = details.AcceptBy
+	o.Notes = strings.TrimSpace(details.Notes)
+	o.UpdatedAt = now
+}
+
+// Validate ensures offer invariants hold.
+func (o *Offer) Validate() error {
+	if o.JobApplicationID == uuid.Nil {
+		return NewDomainError(ErrCodeInvalidPayload, ErrEmptyApplicationID)
+	}
+	if o.BaseSalary <= 0 {
+		return NewDomainError(ErrCodeInvalidPayload, ErrInvalidOfferBaseSalary)
+	}
+	if len(o.Currency) != 3 {
+		return NewDomainError(ErrCodeInvalidPayload, ErrInvalidOfferCurrency)
+	}
+	if (o.Bonus != nil && *o.Bonus < 0) || (o.EquityValue != nil && *o.EquityValue < 0) {
+		return NewDomainError(ErrCodeInvalidPayload, ErrInvalidOfferAmount)
+	}
+	return nil
+}
+
+// TotalCompensation is base salary plus target bonus plus estimated equity.
+func (o *Offer) TotalCompensation() int {
+	total := o.BaseSalary
+	if o.Bonus != nil {
+		total += *o.Bonus
+	}
+	if o.EquityValue != nil {
+		total += *o.EquityValue
+	}
+	return total
+}
+
+// ComparedOffer is the offer as shown in a comparison table.
+type ComparedOffer struct {
+	BaseSalary        int        `json:"baseSalary"`
+	Bonus             *int       `json:"bonus"`
+	EquityValue       *int       `json:"equityValue"`
+	TotalCompensation int        `json:"totalCompensation"`
+	Currency          string     `json:"currency"`
+	StartDate         *time.Time `json:"startDate"`
+	AcceptBy          *time.Time `json:"acceptBy"`
+}
+
+func newComparedOffer(offer *Offer) *ComparedOffer {
+	if offer == nil {
+		return nil
+	}
+	return &ComparedOffer{
+		BaseSalary:        offer.BaseSalary,
+		Bonus:             offer.Bonus,
+		EquityValue:       offer.EquityValue,
+		TotalCompensation: offer.TotalCompensation(),
+		Currency:          offer.Currency,
+		StartDate:         offer.StartDate,
+		AcceptBy:          offer.AcceptBy,
+	}
+}
+
+// OfferComparison summarizes whether competing offers can be ranked against each other.
+type OfferComparison struct {
+	// Comparable is true when at least two applications have an offer and all share one currency.
+	Comparable bool `json:"comparable"`
+	// Currency is the shared currency when Comparable is true.
+	Currency string `json:"currency,omitempty"`
+	// HighestTotalCompensationID is the application with the best total compensation when Comparable is true.
+	HighestTotalCompensationID *uuid.UUID `json:"highestTotalCompensationId,omitempty"`
+}
+
+func compareOffers(applications []ComparedApplication) OfferComparison {
+	var (
+		currency   string
+		withOffers int
+		best       *ComparedApplication
+	)
+	for i := range applications {
+		offer := applications[i].Offer
+		if offer == nil {
+			continue
+		}
+		if withOffers > 0 && offer.Currency != currency {
+			return OfferComparison{}
+		}
+		currency = offer.Currency
+		withOffers++
+		if best == nil || offer.TotalCompensation > best.Offer.TotalCompensation {
+			best = &applications[i]
+		}
+	}
+
+	if withOffers < 2 {
+		return OfferComparison{}
+	}
+	bestID := best.ID
+	return OfferComparison{
+		Comparable:                 true,
+		Currency:                   currency,
+		HighestTotalCompensationID: &bestID,
+	}
+}
+
+// OfferStats aggregates a user's offers. Amounts are never mixed across
+// currencies, so averages are reported per currency.
+type OfferStats struct {
+	Count      int                  `json:"count"`
+	ByCurrency []CurrencyOfferStats `json:"byCurrency"`
+}
+
+// CurrencyOfferStats aggregates the offers made in one currency.
+type CurrencyOfferStats struct {
+	Currency                 string `json:"currency"`
+	Count                    int    `json:"count"`
+	AverageBaseSalary        int    `json:"averageBaseSalary"`
+	AverageTotalCompensation int    `json:"averageTotalCompensation"`
+	HighestBaseSalary        int    `json:"highestBaseSalary"`
+	HighestTotalCompensation int    `json:"highestTotalCompensation"`
+}
+
+// NewOfferStats aggregates offers per currency, ordered by currency code.
+func NewOfferStats(offers []Offer) *OfferStats {
+	type totals struct {
+		count, base, total, highestBase, highestTotal int
+	}
+	byCurrency := make(map[string]*totals)
+	for i := range offers {
+		offer := &offers[i]
+		t, ok := byCurrency[offer.Currency]
+		if !ok {
+			t = &totals{}
+			byCurrency[offer.Currency] = t
+		}
+		compensation := offer.TotalCompensation()
+		t.count++
+		t.base += offer.BaseSalary
+		t.total += compensation
+		t.highestBase = max(t.highestBase, offer.BaseSalary)
+		t.highestTotal = max(t.highestTotal, compensation)
+	}
+
+	stats := &OfferStats{
+		Count:      len(offers),
+		ByCurrency: make([]CurrencyOfferStats, 0, len(byCurrency)),
+	}
+	for currency, t := range byCurrency {
+		stats.ByCurrency = append(stats.ByCurrency, CurrencyOfferStats{
+			Currency:                 currency,
+			Count:                    t.count,
+			AverageBaseSalary:        t.base / t.count,
+			AverageTotalCompensation: t.total / t.count,
+			HighestBaseSalary:        t.highestBase,
+			HighestTotalCompensation: t.highestTotal,
+		})
+	}
+	sort.Slice(stats.ByCurrency, func(i, j int) bool {
+		return stats.ByCurrency[i].Currency < stats.ByCurrency[j].Currency
+	})
+
+	return stats
+}
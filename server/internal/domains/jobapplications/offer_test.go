@@ -0,0 +1,75 @@
+package jobapplications
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewOffer_Validation(t *testing.T) {
+	application := &JobApplication{ID: uuid.New(), UserID: uuid.New()}
+
+	offer, err := NewOffer(application, OfferDetails{BaseSalary: 100000, Currency: " usd "})
+	require.NoError(t, err)
+	assert.Equal(t, "USD", offer.Currency)
+	assert.Equal(t, application.UserID, offer.UserID)
+
+	_, err = NewOffer(application, OfferDetails{BaseSalary: 0, Currency: "USD"})
+	assert.EqualError(t, err, ErrInvalidOfferBaseSalary)
+
+	_, err = NewOffer(application, OfferDetails{BaseSalary: 100000, Currency: "US"})
+	assert.EqualError(t, err, ErrInvalidOfferCurrency)
+
+	_, err = NewOffer(application, OfferDetails{BaseSalary: 100000, Currency: "USD", Bonus: intPtr(-1)})
+	assert.EqualError(t, err, ErrInvalidOfferAmount)
+}
+
+func TestOffer_TotalCompensation(t *testing.T) {
+	offer := &Offer{BaseSalary: 100000}
+	assert.Equal(t, 100000, offer.TotalCompensation())
+
+	offer.Bonus = intPtr(10000)
+	offer.EquityValue = intPtr(25000)
+	assert.Equal(t, 135000, offer.TotalCompensation())
+}
+
+func TestNewOfferStats(t *testing.T) {
+	offers := []Offer{
+		{BaseSalary: 100000, Currency: "USD", Bonus: intPtr(20000)},
+		{BaseSalary: 80000, Currency: "EUR"},
+		{BaseSalary: 150000, Currency: "USD"},
+		{BaseSalary: 60000, Currency: "EUR", EquityValue: intPtr(10000)},
+	}
+
+	stats := NewOfferStats(offers)
+
+	assert.Equal(t, 4, stats.Count)
+	assert.Equal(t, []CurrencyOfferStats{
+		{
+			Currency:                 "EUR",
+			Count:                    2,
+			AverageBaseSalary:        70000,
+			AverageTotalCompensation: 75000,
+			HighestBaseSalary:        80000,
+			HighestTotalCompensation: 80000,
+		},
+		{
+			Currency:                 "USD",
+			Count:                    2,
+			AverageBaseSalary:        125000,
+			AverageTotalCompensation: 135000,
+			HighestBaseSalary:        150000,
+			HighestTotalCompensation: 150000,
+		},
+	}, stats.ByCurrency)
+}
+
+func TestNewOfferStats_Empty(t *testing.T) {
+	stats := NewOfferStats(nil)
+
+	assert.Equal(t, 0, stats.Count)
+	assert.NotNil(t, stats.ByCurrency, "byCurrency should serialize as an empty list")
+	assert.Empty(t, stats.ByCurrency)
+}
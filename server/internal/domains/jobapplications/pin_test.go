@@ -0,0 +1,105 @@
+package jobapplications
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakePinRepo stores one application and reports a fixed number of pinned applications.
+type fakePinRepo struct {
+	Repository
+	application *JobApplication
+	pinned      int64
+	updated     bool
+}
+
+func (r *fakePinRepo) GetJobApplication(_ context.Context, _ uuid.UUID) (*JobApplication, error) {
+	return r.application, nil
+}
+
+func (r *fakePinRepo) CountPinnedApplications(_ context.Context, _ uuid.UUID) (int64, error) {
+	return r.pinned, nil
+}
+
+func (r *fakePinRepo) UpdateJobApplication(_ context.Context, _ *JobApplication) error {
+	r.updated = true
+	return nil
+}
+
+func TestJobApplication_Pin(t *testing.T) {
+	application := &JobApplication{}
+
+	assert.EqualError(t, application.Pin(0), ErrInvalidPinOrder)
+	assert.EqualError(t, application.Pin(MaxPinnedApplications+1), ErrInvalidPinOrder)
+	assert.False(t, application.IsPinned())
+
+	require.NoError(t, application.Pin(2))
+	require.True(t, application.IsPinned())
+	assert.Equal(t, 2, *application.PinOrder)
+
+	application.Unpin()
+	assert.False(t, application.IsPinned())
+}
+
+func TestValidatePinPayload(t *testing.T) {
+	order := 3
+	got, err := ValidatePinPayload(&pinPayload{Order: &order})
+	require.NoError(t, err)
+	assert.Equal(t, 3, got)
+
+	_, err = ValidatePinPayload(&pinPayload{})
+	assert.EqualError(t, err, "order: is required")
+
+	for _, invalid := range []int{0, -1, MaxPinnedApplications + 1} {
+		_, err := ValidatePinPayload(&pinPayload{Order: &invalid})
+		assert.Error(t, err, "order=%d", invalid)
+	}
+}
+
+func TestService_PinJobApplication(t *testing.T) {
+	userID := uuid.New()
+
+	t.Run("enforces the pin limit", func(t *testing.T) {
+		repo := &fakePinRepo{application: &JobApplication{ID: uuid.New(), UserID: userID}, pinned: MaxPinnedApplications}
+		_, err := NewService(repo, nil, nil).PinJobApplication(context.Background(), userID, repo.application.ID, 1)
+		assert.EqualError(t, err, ErrPinLimitReached)
+		assert.False(t, repo.updated)
+	})
+
+	t.Run("reorders an already pinned application at the limit", func(t *testing.T) {
+		order := 5
+		repo := &fakePinRepo{application: &JobApplication{ID: uuid.New(), UserID: userID, PinOrder: &order}, pinned: MaxPinnedApplications}
+		application, err := NewService(repo, nil, nil).PinJobApplication(context.Background(), userID, repo.application.ID, 1)
+		require.NoError(t, err)
+		assert.Equal(t, 1, *application.PinOrder)
+		assert.True(t, repo.updated)
+	})
+
+	t.Run("hides applications of other users", func(t *testing.T) {
+		repo := &fakePinRepo{application: &JobApplication{ID: uuid.New(), UserID: uuid.New()}}
+		_, err := NewService(repo, nil, nil).PinJobApplication(context.Background(), userID, repo.application.ID, 1)
+		assert.EqualError(t, err, ErrApplicationNotFound)
+	})
+}
+
+func TestService_MuteNotifications(t *testing.T) {
+	userID := uuid.New()
+	repo := &fakePinRepo{application: &JobApplication{ID: uuid.New(), UserID: userID}}
+	svc := NewService(repo, nil, nil)
+
+	application, err := svc.MuteNotifications(context.Background(), userID, repo.application.ID)
+	require.NoError(t, err)
+	assert.True(t, application.NotificationsMuted)
+	assert.True(t, repo.updated)
+
+	application, err = svc.UnmuteNotifications(context.Background(), userID, repo.application.ID)
+	require.NoError(t, err)
+	assert.False(t, application.NotificationsMuted)
+
+	_, err = svc.MuteNotifications(context.Background(), uuid.New(), repo.application.ID)
+	assert.EqualError(t, err, ErrApplicationNotFound)
+}
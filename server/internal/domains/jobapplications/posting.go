@@ -0,0 +1,398 @@
+package jobapplications
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"syscall"
+	"time"
+)
+
+const (
+	// DefaultPostingFetchTimeout bounds the whole fetch of a job posting page
+	DefaultPostingFetchTimeout = 10 * time.Second
+	// DefaultPostingMaxBytes caps how much of a posting page is read; job boards
+	// put the structured data near the top, so a truncated page still parses
+	DefaultPostingMaxBytes = 2 << 20
+	// postingDescriptionMaxRunes keeps drafts within what the create endpoint accepts
+	postingDescriptionMaxRunes = 20000
+)
+
+var errBlockedPostingAddress = errors.New("refusing to fetch from a private or loopback address")
+
+// PostingFetcher retrieves the HTML of a job posting page.
+type PostingFetcher interface {
+	FetchPosting(ctx context.Context, pageURL string) (string, error)
+}
+
+// httpPostingFetcher fetches postings over HTTP with a timeout and body size limit.
+type httpPostingFetcher struct {
+	client   *http.Client
+	maxBytes int64
+}
+
+// NewHTTPPostingFetcher creates a PostingFetcher that refuses to connect to
+// private, loopback and link-local addresses so user-supplied URLs cannot
+// reach internal services.
+func NewHTTPPostingFetcher(timeout time.Duration, maxBytes int64) PostingFetcher {
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		Control: blockPrivatePostingAddress,
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &httpPostingFetcher{
+		client: &http.Client{
+			Timeout:   timeout,
+			Transport: transport,
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				if len(via) >= 5 {
+					return errors.New("stopped after 5 redirects")
+				}
+				return nil
+			},
+		},
+		maxBytes: maxBytes,
+	}
+}
+
+// blockPrivatePostingAddress is the dialer control hook of the posting fetcher.
+// It runs after DNS resolution, so hostnames resolving to internal addresses
+// are refused as well.
+func blockPrivatePostingAddress(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() {
+		return errBlockedPostingAddress
+	}
+	return nil
+}
+
+// FetchPosting returns at most maxBytes of the page body.
+func (f *httpPostingFetcher) FetchPosting(ctx context.Context, pageURL string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, pageURL, nil)
+	if err != nil {
+		return "", err
+	}
+	// Some boards serve an empty shell to unknown clients
+	req.Header.Set("User-Agent", "Mozilla/5.0 (compatible; woragis-jobs/1.0)")
+	req.Header.Set("Accept", "text/html,application/xhtml+xml")
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return "", fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, f.maxBytes))
+	if err != nil {
+		return "", err
+	}
+	return string(body), nil
+}
+
+// JobPostingDraft is a pre-filled application built from a posting URL. It is
+// not persisted; the user reviews it and submits it to the create endpoint.
+type JobPostingDraft struct {
+	JobURL         string   `json:"jobUrl"`
+	Website        string   `json:"website"`
+	CompanyName    string   `json:"companyName"`
+	JobTitle       string   `json:"jobTitle"`
+	Location       string   `json:"location"`
+	JobDescription string   `json:"jobDescription"`
+	Language       string   `json:"language,omitempty"`
+	SalaryMin      *int     `json:"salaryMin,omitempty"`
+	SalaryMax      *int     `json:"salaryMax,omitempty"`
+	SalaryCurrency string   `json:"salaryCurrency,omitempty"`
+	MissingFields  []string `json:"missingFields"`        // Required create fields that still need user input
+	FetchError     string   `json:"fetchError,omitempty"` // Set when the page could not be retrieved
+	Strategy       string   `json:"strategy,omitempty"`   // How the page was parsed: selectors, structured-data or ai
+}
+
+// NewJobPostingDraft starts a draft from what the URL alone tells us.
+func NewJobPostingDraft(pageURL string) *JobPostingDraft {
+	draft := &JobPostingDraft{JobURL: pageURL}
+	if parsed, err := url.Parse(pageURL); err == nil {
+		draft.Website = websiteFromHost(parsed.Hostname())
+	}
+	return draft
+}
+
+// ParseHTML fills the draft from a posting page. Structured JobPosting data
+// (schema.org JSON-LD) is preferred; Open Graph tags and the page title fill
+// whatever it leaves blank.
+func (d *JobPostingDraft) ParseHTML(page string) {
+	if posting := findJSONLDJobPosting(page); posting != nil {
+		d.applyJSONLD(posting)
+	}
+
+	meta := parseMetaTags(page)
+	title := firstNonEmpty(meta["og:title"], meta["twitter:title"], pageTitle(page))
+	if title != "" {
+		jobTitle, company, location := splitPostingTitle(title)
+		d.JobTitle = firstNonEmpty(d.JobTitle, jobTitle)
+		d.CompanyName = firstNonEmpty(d.CompanyName, company)
+		d.Location = firstNonEmpty(d.Location, location)
+	}
+	d.JobDescription = firstNonEmpty(d.JobDescription, htmlToText(firstNonEmpty(meta["og:description"], meta["description"])))
+	d.JobDescription = truncateRunes(d.JobDescription, postingDescriptionMaxRunes)
+}
+
+// Finalize records which required fields are still blank.
+func (d *JobPostingDraft) Finalize() {
+	d.MissingFields = []string{}
+	for _, field := range []struct{ name, value string }{
+		{"companyName", d.CompanyName},
+		{"jobTitle", d.JobTitle},
+		{"website", d.Website},
+	} {
+		if field.value == "" {
+			d.MissingFields = append(d.MissingFields, field.name)
+		}
+	}
+}
+
+func (d *JobPostingDraft) applyJSONLD(posting map[string]interface{}) {
+	d.JobTitle = htmlToText(stringField(posting["title"]))
+	d.JobDescription = htmlToText(stringField(posting["description"]))
+
+	switch org := posting["hiringOrganization"].(type) {
+	case map[string]interface{}:
+		d.CompanyName = htmlToText(stringField(org["name"]))
+	case string:
+		d.CompanyName = htmlToText(org)
+	}
+
+	d.Location = jsonLDLocation(posting["jobLocation"])
+	if d.Location == "" && strings.EqualFold(stringField(posting["jobLocationType"]), "TELECOMMUTE") {
+		d.Location = "Remote"
+	}
+
+	if salary, ok := posting["baseSalary"].(map[string]interface{}); ok {
+		d.SalaryCurrency = strings.ToUpper(stringField(salary["currency"]))
+		if value, ok := salary["value"].(map[string]interface{}); ok {
+			d.SalaryMin = intField(value["minValue"])
+			d.SalaryMax = intField(value["maxValue"])
+			if d.SalaryMin == nil && d.SalaryMax == nil {
+				d.SalaryMin = intField(value["value"])
+				d.SalaryMax = d.SalaryMin
+			}
+		}
+		if d.SalaryMin == nil && d.SalaryMax == nil {
+			d.SalaryCurrency = ""
+		}
+	}
+}
+
+// websiteFromHost maps a posting host to the website identifier used on
+// applications, e.g. "www.linkedin.com" -> "linkedin", "br.indeed.com" -> "indeed".
+func websiteFromHost(host string) string {
+	labels := strings.Split(strings.ToLower(strings.TrimSuffix(host, ".")), ".")
+	if len(labels) < 2 {
+		return strings.Join(labels, "")
+	}
+	name := labels[len(labels)-2]
+	// Country-code second-level domains such as indeed.co.uk or catho.com.br
+	if len(labels) >= 3 && len(labels[len(labels)-1]) == 2 && (name == "co" || name == "com") {
+		name = labels[len(labels)-3]
+	}
+	return name
+}
+
+var (
+	jsonLDPattern  = regexp.MustCompile(`(?is)<script[^>]+type\s*=\s*["']application/ld\+json["'][^>]*>(.*?)</script>`)
+	metaTagPattern = regexp.MustCompile(`(?is)<meta\s[^>]*>`)
+	attrPattern    = regexp.MustCompile(`(?is)([a-z][a-z0-9:_-]*)\s*=\s*(?:"([^"]*)"|'([^']*)')`)
+	titlePattern   = regexp.MustCompile(`(?is)<title[^>]*>(.*?)</title>`)
+	breakPattern   = regexp.MustCompile(`(?i)<br\s*/?>|</p>|</li>|</h[1-6]>|</div>`)
+	tagPattern     = regexp.MustCompile(`(?s)<[^>]*>`)
+	spacePattern   = regexp.MustCompile(`[ \t\r\f\v]+`)
+	newlinePattern = regexp.MustCompile(`\n\s*\n\s*`)
+)
+
+// findJSONLDJobPosting returns the first schema.org JobPosting object on the page.
+func findJSONLDJobPosting(page string) map[string]interface{} {
+	for _, match := range jsonLDPattern.FindAllStringSubmatch(page, -1) {
+		var data interface{}
+		if err := json.Unmarshal([]byte(strings.TrimSpace(match[1])), &data); err != nil {
+			continue
+		}
+		if posting := findJobPosting(data); posting != nil {
+			return posting
+		}
+	}
+	return nil
+}
+
+func findJobPosting(data interface{}) map[string]interface{} {
+	switch value := data.(type) {
+	case []interface{}:
+		for _, item := range value {
+			if posting := findJobPosting(item); posting != nil {
+				return posting
+			}
+		}
+	case map[string]interface{}:
+		if isJobPostingType(value["@type"]) {
+			return value
+		}
+		return findJobPosting(value["@graph"])
+	}
+	return nil
+}
+
+func isJobPostingType(t interface{}) bool {
+	switch value := t.(type) {
+	case string:
+		return value == "JobPosting"
+	case []interface{}:
+		for _, item := range value {
+			if item == "JobPosting" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func jsonLDLocation(data interface{}) string {
+	switch value := data.(type) {
+	case []interface{}:
+		if len(value) > 0 {
+			return jsonLDLocation(value[0])
+		}
+	case map[string]interface{}:
+		address, ok := value["address"].(map[string]interface{})
+		if !ok {
+			return htmlToText(stringField(value["name"]))
+		}
+		var parts []string
+		for _, key := range []string{"addressLocality", "addressRegion", "addressCountry"} {
+			part := address[key]
+			if country, ok := part.(map[string]interface{}); ok {
+				part = country["name"]
+			}
+			if s := strings.TrimSpace(stringField(part)); s != "" {
+				parts = append(parts, s)
+			}
+		}
+		return strings.Join(parts, ", ")
+	}
+	return ""
+}
+
+// parseMetaTags maps meta property/name keys (lowercased) to their content.
+func parseMetaTags(page string) map[string]string {
+	tags := make(map[string]string)
+	for _, tag := range metaTagPattern.FindAllString(page, -1) {
+		attrs := make(map[string]string)
+		for _, attr := range attrPattern.FindAllStringSubmatch(tag, -1) {
+			attrs[strings.ToLower(attr[1])] = attr[2] + attr[3]
+		}
+		key := strings.ToLower(firstNonEmpty(attrs["property"], attrs["name"]))
+		if key != "" && attrs["content"] != "" {
+			if _, seen := tags[key]; !seen {
+				tags[key] = html.UnescapeString(attrs["content"])
+			}
+		}
+	}
+	return tags
+}
+
+func pageTitle(page string) string {
+	if match := titlePattern.FindStringSubmatch(page); match != nil {
+		return htmlToText(match[1])
+	}
+	return ""
+}
+
+// splitPostingTitle extracts job title, company and location from the page
+// titles job boards generate, such as "Acme hiring Backend Engineer in Lisbon,
+// Portugal | LinkedIn" or "Backend Engineer - Acme - Lisbon | Indeed.com".
+func splitPostingTitle(title string) (jobTitle, company, location string) {
+	if i := strings.LastIndex(title, " | "); i > 0 {
+		title = title[:i]
+	}
+	title = strings.TrimSpace(title)
+
+	if hiring, rest, ok := strings.Cut(title, " hiring "); ok {
+		jobTitle, location, _ = strings.Cut(rest, " in ")
+		return strings.TrimSpace(jobTitle), strings.TrimSpace(hiring), strings.TrimSpace(location)
+	}
+
+	parts := strings.Split(title, " - ")
+	for i := range parts {
+		parts[i] = strings.TrimSpace(parts[i])
+	}
+	switch len(parts) {
+	case 1:
+		return parts[0], "", ""
+	case 2:
+		return parts[0], parts[1], ""
+	default:
+		return parts[0], parts[1], parts[2]
+	}
+}
+
+// htmlToText strips markup, keeping paragraph and list breaks as newlines.
+func htmlToText(s string) string {
+	if s == "" {
+		return ""
+	}
+	s = html.UnescapeString(s) // JSON-LD descriptions are often entity-encoded HTML
+	s = breakPattern.ReplaceAllString(s, "\n")
+	s = tagPattern.ReplaceAllString(s, "")
+	s = html.UnescapeString(s)
+	s = spacePattern.ReplaceAllString(s, " ")
+	s = newlinePattern.ReplaceAllString(s, "\n\n")
+	return strings.TrimSpace(s)
+}
+
+func stringField(v interface{}) string {
+	if s, ok := v.(string); ok {
+		return s
+	}
+	return ""
+}
+
+func intField(v interface{}) *int {
+	switch value := v.(type) {
+	case float64:
+		n := int(value)
+		return &n
+	case string:
+		var f float64
+		if _, err := fmt.Sscanf(value, "%g", &f); err == nil {
+			n := int(f)
+			return &n
+		}
+	}
+	return nil
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v = strings.TrimSpace(v); v != "" {
+			return v
+		}
+	}
+	return ""
+}
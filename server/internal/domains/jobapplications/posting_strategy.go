@@ -0,0 +1,208 @@
+package jobapplications
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"golang.org/x/net/html"
+
+	"woragis-jobs-service/pkg/aiservice"
+	"woragis-jobs-service/pkg/htmlselect"
+)
+
+// Posting strategies a job website can configure for the from-url quick-create.
+const (
+	// PostingStrategySelectors reads fields from CSS selectors over the structured data
+	PostingStrategySelectors = "selectors"
+	// PostingStrategyStructuredData reads schema.org JobPosting data and meta tags only
+	PostingStrategyStructuredData = "structured-data"
+	// PostingStrategyAI marks drafts whose blanks were filled by AI extraction
+	PostingStrategyAI = "ai"
+)
+
+const (
+	// postingExtractionSampleSize caps how much page text is sent for AI extraction
+	postingExtractionSampleSize = 8000
+)
+
+// PostingStrategy holds the parsing hints configured for a website.
+type PostingStrategy struct {
+	Strategy  string
+	Selectors map[string]string // Draft field (companyName, jobTitle, location, jobDescription) -> CSS selector
+}
+
+// PostingStrategyProvider looks up the parsing hints of a job website by name. It
+// returns nil without an error for websites without hints.
+type PostingStrategyProvider interface {
+	GetPostingStrategy(ctx context.Context, website string) (*PostingStrategy, error)
+}
+
+// PostingExtractor reads posting fields from page text, for pages without structured data.
+type PostingExtractor interface {
+	ExtractPosting(ctx context.Context, pageText string) (*ExtractedPosting, error)
+}
+
+// ExtractedPosting holds the fields a PostingExtractor found; blanks were not found.
+type ExtractedPosting struct {
+	CompanyName    string `json:"companyName"`
+	JobTitle       string `json:"jobTitle"`
+	Location       string `json:"location"`
+	JobDescription string `json:"jobDescription"`
+}
+
+// ApplySelectors overwrites draft fields with the text of the first element each selector
+// matches. Selectors that do not compile or match leave the field as it was.
+func (d *JobPostingDraft) ApplySelectors(page string, selectors map[string]string) {
+	doc, err := html.Parse(strings.NewReader(page))
+	if err != nil {
+		return
+	}
+
+	for field, raw := range selectors {
+		selector, err := htmlselect.Compile(raw)
+		if err != nil {
+			continue
+		}
+		node := selector.First(doc)
+		if node == nil {
+			continue
+		}
+		text := selectedText(node)
+		if text == "" {
+			continue
+		}
+		switch field {
+		case "companyName":
+			d.CompanyName = strings.Join(strings.Fields(text), " ")
+		case "jobTitle":
+			d.JobTitle = strings.Join(strings.Fields(text), " ")
+		case "location":
+			d.Location = strings.Join(strings.Fields(text), " ")
+		case "jobDescription":
+			d.JobDescription = truncateRunes(text, postingDescriptionMaxRunes)
+		}
+	}
+}
+
+// selectedText returns the content of meta elements and the text of any other element.
+func selectedText(node *html.Node) string {
+	if node.Data == "meta" {
+		content, _ := htmlselect.Attr(node, "content")
+		return strings.TrimSpace(html.UnescapeString(content))
+	}
+	return htmlToText(htmlselect.InnerHTML(node))
+}
+
+// needsExtraction reports whether a required field or the description is still blank.
+func (d *JobPostingDraft) needsExtraction() bool {
+	return d.CompanyName == "" || d.JobTitle == "" || d.JobDescription == ""
+}
+
+// applyExtraction fills the blank fields from an extraction; parsed values are kept.
+func (d *JobPostingDraft) applyExtraction(extracted *ExtractedPosting) {
+	d.CompanyName = firstNonEmpty(d.CompanyName, ingestText(extracted.CompanyName))
+	d.JobTitle = firstNonEmpty(d.JobTitle, ingestText(extracted.JobTitle))
+	d.Location = firstNonEmpty(d.Location, ingestText(extracted.Location))
+	if d.JobDescription == "" {
+		d.JobDescription = truncateRunes(htmlToText(extracted.JobDescription), postingDescriptionMaxRunes)
+	}
+}
+
+// parsePosting fills draft from page using the website's configured strategy. Websites
+// without one are parsed generically and, when that leaves required fields blank, the
+// extractor fills them from the page text.
+func (s *service) parsePosting(ctx context.Context, draft *JobPostingDraft, page string) {
+	draft.ParseHTML(page)
+	draft.Strategy = PostingStrategyStructuredData
+
+	if strategy := s.lookupPostingStrategy(ctx, draft.Website); strategy != nil {
+		if strategy.Strategy == PostingStrategySelectors {
+			draft.ApplySelectors(page, strategy.Selectors)
+			draft.Strategy = PostingStrategySelectors
+		}
+		return
+	}
+
+	if s.postingExtractor == nil || !draft.needsExtraction() {
+		return
+	}
+	extracted, err := s.postingExtractor.ExtractPosting(ctx, truncateRunes(htmlToText(page), postingExtractionSampleSize))
+	if err != nil {
+		if s.logger != nil {
+			s.logger.WarnContext(ctx, "failed to extract job posting", "url", draft.JobURL, "error", err)
+		}
+		return
+	}
+	draft.applyExtraction(extracted)
+	draft.Strategy = PostingStrategyAI
+}
+
+// lookupPostingStrategy returns nil when no provider is configured, the website has no
+// hints or the lookup fails.
+func (s *service) lookupPostingStrategy(ctx context.Context, website string) *PostingStrategy {
+	if s.postingStrategies == nil || website == "" {
+		return nil
+	}
+	strategy, err := s.postingStrategies.GetPostingStrategy(ctx, website)
+	if err != nil {
+		if s.logger != nil {
+			s.logger.WarnContext(ctx, "failed to look up website posting strategy", "website", website, "error", err)
+		}
+		return nil
+	}
+	return strategy
+}
+
+// AIServicePostingExtractor implements PostingExtractor using the AI service.
+type AIServicePostingExtractor struct {
+	client *aiservice.Client
+	logger *slog.Logger
+}
+
+// NewAIServicePostingExtractor creates a new AI service posting extractor
+func NewAIServicePostingExtractor(client *aiservice.Client, logger *slog.Logger) PostingExtractor {
+	return &AIServicePostingExtractor{
+		client: client,
+		logger: logger,
+	}
+}
+
+// ExtractPosting asks the AI service's extraction agent for the posting fields as JSON
+func (e *AIServicePostingExtractor) ExtractPosting(ctx context.Context, pageText string) (*ExtractedPosting, error) {
+	systemPrompt := "Extract the job posting from the web page text provided by the user. Reply with only a JSON object " +
+		`with the string keys "companyName", "jobTitle", "location" and "jobDescription", using an empty string ` +
+		"for anything the page does not state. Copy the description as written; do not summarize it."
+
+	req := aiservice.ChatRequest{
+		Agent:       "job_posting_extraction",
+		Input:       pageText,
+		System:      &systemPrompt,
+		Temperature: func() *float64 { t := 0.0; return &t }(),
+		MaxTokens:   func() *int { t := 2000; return &t }(),
+	}
+
+	resp, err := e.client.Chat(ctx, req)
+	if err != nil {
+		e.logger.WarnContext(ctx, "AI posting extraction failed", "error", err)
+		return nil, err
+	}
+
+	return parseExtractedPosting(resp.Output)
+}
+
+// parseExtractedPosting decodes the JSON object in a model reply, ignoring any text or
+// code fences around it.
+func parseExtractedPosting(output string) (*ExtractedPosting, error) {
+	start, end := strings.Index(output, "{"), strings.LastIndex(output, "}")
+	if start < 0 || end < start {
+		return nil, fmt.Errorf("posting extraction reply has no JSON object")
+	}
+	var extracted ExtractedPosting
+	if err := json.Unmarshal([]byte(output[start:end+1]), &extracted); err != nil {
+		return nil, fmt.Errorf("posting extraction reply is not valid JSON: %w", err)
+	}
+	return &extracted, nil
+}
@@ -0,0 +1,128 @@
+package jobapplications
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const selectorTestPage = `<html><head>
+<title>Careers</title>
+<meta property="og:site_name" content="Acme Careers">
+</head><body>
+<div class="posting"><h1 class="posting-title">Staff Engineer</h1>
+<span class="posting-company">Acme</span><span class="posting-location"> Lisbon,
+ Portugal </span>
+<div id="description"><p>Own the platform.</p><ul><li>Go</li><li>Postgres</li></ul></div></div>
+</body></html>`
+
+type fakePostingStrategies struct {
+	strategy *PostingStrategy
+	err      error
+}
+
+func (p *fakePostingStrategies) GetPostingStrategy(_ context.Context, _ string) (*PostingStrategy, error) {
+	return p.strategy, p.err
+}
+
+type fakePostingExtractor struct {
+	extracted *ExtractedPosting
+	calls     int
+}
+
+func (e *fakePostingExtractor) ExtractPosting(_ context.Context, _ string) (*ExtractedPosting, error) {
+	e.calls++
+	return e.extracted, nil
+}
+
+func TestJobPostingDraft_ApplySelectors(t *testing.T) {
+	draft := NewJobPostingDraft("https://jobs.acme.com/postings/1")
+	draft.ApplySelectors(selectorTestPage, map[string]string{
+		"jobTitle":       "h1.posting-title",
+		"companyName":    "meta[property=og:site_name]",
+		"location":       ".posting .posting-location",
+		"jobDescription": "#description",
+		"unknownField":   "h1",
+	})
+
+	assert.Equal(t, "Staff Engineer", draft.JobTitle)
+	assert.Equal(t, "Acme Careers", draft.CompanyName)
+	assert.Equal(t, "Lisbon, Portugal", draft.Location)
+	assert.Contains(t, draft.JobDescription, "Own the platform.")
+	assert.Contains(t, draft.JobDescription, "Postgres")
+}
+
+func TestParsePosting_UsesConfiguredSelectors(t *testing.T) {
+	extractor := &fakePostingExtractor{extracted: &ExtractedPosting{CompanyName: "From AI"}}
+	s := &service{
+		postingStrategies: &fakePostingStrategies{strategy: &PostingStrategy{
+			Strategy:  PostingStrategySelectors,
+			Selectors: map[string]string{"jobTitle": "h1", "companyName": ".posting-company"},
+		}},
+		postingExtractor: extractor,
+	}
+
+	draft := NewJobPostingDraft("https://jobs.acme.com/postings/1")
+	s.parsePosting(context.Background(), draft, selectorTestPage)
+
+	assert.Equal(t, PostingStrategySelectors, draft.Strategy)
+	assert.Equal(t, "Staff Engineer", draft.JobTitle)
+	assert.Equal(t, "Acme", draft.CompanyName)
+	assert.Zero(t, extractor.calls, "configured websites are never sent for AI extraction")
+}
+
+func TestParsePosting_FallsBackToExtraction(t *testing.T) {
+	extractor := &fakePostingExtractor{extracted: &ExtractedPosting{
+		CompanyName:    "Acme",
+		JobTitle:       "Ignored, the page title is kept",
+		JobDescription: "Own the platform.",
+	}}
+
+	for name, strategies := range map[string]PostingStrategyProvider{
+		"no provider":     nil,
+		"website unknown": &fakePostingStrategies{},
+		"lookup failed":   &fakePostingStrategies{err: errors.New("database down")},
+	} {
+		t.Run(name, func(t *testing.T) {
+			extractor.calls = 0
+			s := &service{postingStrategies: strategies, postingExtractor: extractor}
+
+			draft := NewJobPostingDraft("https://jobs.acme.com/postings/1")
+			s.parsePosting(context.Background(), draft, selectorTestPage)
+
+			assert.Equal(t, 1, extractor.calls)
+			assert.Equal(t, PostingStrategyAI, draft.Strategy)
+			assert.Equal(t, "Careers", draft.JobTitle)
+			assert.Equal(t, "Acme", draft.CompanyName)
+			assert.Equal(t, "Own the platform.", draft.JobDescription)
+		})
+	}
+}
+
+func TestParsePosting_StructuredDataStrategySkipsExtraction(t *testing.T) {
+	extractor := &fakePostingExtractor{extracted: &ExtractedPosting{CompanyName: "Acme"}}
+	s := &service{
+		postingStrategies: &fakePostingStrategies{strategy: &PostingStrategy{Strategy: PostingStrategyStructuredData}},
+		postingExtractor:  extractor,
+	}
+
+	draft := NewJobPostingDraft("https://jobs.acme.com/postings/1")
+	s.parsePosting(context.Background(), draft, selectorTestPage)
+
+	assert.Equal(t, PostingStrategyStructuredData, draft.Strategy)
+	assert.Empty(t, draft.CompanyName)
+	assert.Zero(t, extractor.calls)
+}
+
+func TestParseExtractedPosting(t *testing.T) {
+	extracted, err := parseExtractedPosting("```json\n{\"companyName\": \"Acme\", \"jobTitle\": \"Engineer\"}\n```")
+	require.NoError(t, err)
+	assert.Equal(t, "Acme", extracted.CompanyName)
+	assert.Equal(t, "Engineer", extracted.JobTitle)
+
+	_, err = parseExtractedPosting("I could not find a posting.")
+	assert.Error(t, err)
+}
@@ -0,0 +1,117 @@
+package jobapplications
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJobPostingDraft_ParseHTML_JSONLD(t *testing.T) {
+	page := `<html><head>
+<title>Ignored | Board</title>
+<script type="application/ld+json">
+{"@context": "https://schema.org", "@graph": [
+  {"@type": "Organization", "name": "Board"},
+  {"@type": "JobPosting",
+   "title": "Backend Engineer",
+   "description": "&lt;p&gt;Build APIs.&lt;/p&gt;&lt;ul&gt;&lt;li&gt;Go&lt;/li&gt;&lt;/ul&gt;",
+   "hiringOrganization": {"@type": "Organization", "name": "Acme"},
+   "jobLocation": {"@type": "Place", "address": {"addressLocality": "Lisbon", "addressCountry": {"name": "Portugal"}}},
+   "baseSalary": {"currency": "eur", "value": {"minValue": 50000, "maxValue": "70000"}}}
+]}
+</script>
+</head></html>`
+
+	draft := NewJobPostingDraft("https://www.linkedin.com/jobs/view/123")
+	draft.ParseHTML(page)
+	draft.Finalize()
+
+	assert.Equal(t, "linkedin", draft.Website)
+	assert.Equal(t, "Backend Engineer", draft.JobTitle)
+	assert.Equal(t, "Acme", draft.CompanyName)
+	assert.Equal(t, "Lisbon, Portugal", draft.Location)
+	assert.Equal(t, "Build APIs.\nGo", draft.JobDescription)
+	require.NotNil(t, draft.SalaryMin)
+	require.NotNil(t, draft.SalaryMax)
+	assert.Equal(t, 50000, *draft.SalaryMin)
+	assert.Equal(t, 70000, *draft.SalaryMax)
+	assert.Equal(t, "EUR", draft.SalaryCurrency)
+	assert.Empty(t, draft.MissingFields)
+}
+
+func TestJobPostingDraft_ParseHTML_MetaFallback(t *testing.T) {
+	page := `<html><head>
+<meta property="og:title" content="Acme hiring Backend Engineer in Lisbon, Portugal | LinkedIn">
+<meta name="description" content="Build APIs &amp; services.">
+<script type="application/ld+json">{not json</script>
+</head></html>`
+
+	draft := NewJobPostingDraft("https://uk.indeed.co.uk/viewjob?jk=1")
+	draft.ParseHTML(page)
+	draft.Finalize()
+
+	assert.Equal(t, "indeed", draft.Website)
+	assert.Equal(t, "Backend Engineer", draft.JobTitle)
+	assert.Equal(t, "Acme", draft.CompanyName)
+	assert.Equal(t, "Lisbon, Portugal", draft.Location)
+	assert.Equal(t, "Build APIs & services.", draft.JobDescription)
+	assert.Nil(t, draft.SalaryMin)
+	assert.Empty(t, draft.SalaryCurrency)
+	assert.Empty(t, draft.MissingFields)
+}
+
+func TestJobPostingDraft_Finalize_ReportsMissingFields(t *testing.T) {
+	draft := NewJobPostingDraft("https://example.com/careers/1")
+	draft.ParseHTML(`<html><head><title>Careers</title></head></html>`)
+	draft.Finalize()
+
+	assert.Equal(t, "Careers", draft.JobTitle)
+	assert.Equal(t, []string{"companyName"}, draft.MissingFields)
+}
+
+func TestSplitPostingTitle(t *testing.T) {
+	jobTitle, company, location := splitPostingTitle("Backend Engineer - Acme - Lisbon | Indeed.com")
+	assert.Equal(t, "Backend Engineer", jobTitle)
+	assert.Equal(t, "Acme", company)
+	assert.Equal(t, "Lisbon", location)
+}
+
+func TestBlockPrivatePostingAddress(t *testing.T) {
+	blocked := []string{
+		"127.0.0.1:80",
+		"[::1]:443",
+		"10.0.0.5:80",
+		"172.16.3.4:80",
+		"192.168.1.1:8080",
+		"169.254.169.254:80", // cloud metadata endpoint
+		"[fe80::1]:80",
+		"0.0.0.0:80",
+		"[fd00::1]:80",
+	}
+	for _, address := range blocked {
+		assert.ErrorIs(t, blockPrivatePostingAddress("tcp", address, nil), errBlockedPostingAddress, address)
+	}
+
+	for _, address := range []string{"93.184.216.34:443", "[2606:2800:220:1::1]:80"} {
+		assert.NoError(t, blockPrivatePostingAddress("tcp", address, nil), address)
+	}
+
+	assert.Error(t, blockPrivatePostingAddress("tcp", "missing-port", nil))
+}
+
+func TestHTTPPostingFetcher_RefusesLoopback(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("<html></html>"))
+	}))
+	defer server.Close()
+
+	fetcher := NewHTTPPostingFetcher(2*time.Second, DefaultPostingMaxBytes)
+	_, err := fetcher.FetchPosting(context.Background(), server.URL)
+
+	assert.ErrorIs(t, err, errBlockedPostingAddress)
+}
@@ -0,0 +1,305 @@
+package jobapplications
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Reminder event types delivered to the notifier.
+const (
+	ReminderEventFollowUpDue         = "follow_up.due"
+	ReminderEventDeadlineApproaching = "deadline.approaching"
+	ReminderEventSnoozeEnded         = "snooze.ended"
+	ReminderEventNextActionDue       = "next_action.due"
+)
+
+// reminderScanBatchSize caps how many applications one scan pass loads.
+const reminderScanBatchSize = 500
+
+// ReminderEvent is the payload sent when an application needs attention.
+type ReminderEvent struct {
+	Event             string     `json:"event"`
+	ApplicationID     uuid.UUID  `json:"applicationId"`
+	UserID            uuid.UUID  `json:"userId"`
+	CompanyName       string     `json:"companyName"`
+	JobTitle          string     `json:"jobTitle"`
+	FollowUpDate      *time.Time `json:"followUpDate,omitempty"`
+	Deadline          *time.Time `json:"deadline,omitempty"`
+	DaysUntilDeadline *int       `json:"daysUntilDeadline,omitempty"`
+	SnoozedUntil      *time.Time `json:"snoozedUntil,omitempty"`
+	NextAction        string     `json:"nextAction,omitempty"`
+	NextActionDue     *time.Time `json:"nextActionDue,omitempty"`
+	OccurredAt        time.Time  `json:"occurredAt"`
+}
+
+// ReminderNotifier delivers reminder events.
+type ReminderNotifier interface {
+	Notify(ctx context.Context, event *ReminderEvent) error
+}
+
+type webhookReminderNotifier struct {
+	url    string
+	secret string
+	client *http.Client
+}
+
+// NewWebhookReminderNotifier posts reminder events as JSON to url. When secret is set,
+// the body is signed with HMAC-SHA256 in the X-Webhook-Signature header.
+func NewWebhookReminderNotifier(url, secret string, client *http.Client) ReminderNotifier {
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+	return &webhookReminderNotifier{url: url, secret: secret, client: client}
+}
+
+func (n *webhookReminderNotifier) Notify(ctx context.Context, event *ReminderEvent) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("marshal reminder event: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("build reminder webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Webhook-Event", event.Event)
+	if n.secret != "" {
+		mac := hmac.New(sha256.New, []byte(n.secret))
+		mac.Write(body)
+		req.Header.Set("X-Webhook-Signature", "sha256="+hex.EncodeToString(mac.Sum(nil)))
+	}
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("deliver reminder webhook: %w", err)
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("reminder webhook returned status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+type logReminderNotifier struct {
+	logger *slog.Logger
+}
+
+// NewLogReminderNotifier logs reminder events; used when no webhook is configured.
+func NewLogReminderNotifier(logger *slog.Logger) ReminderNotifier {
+	return &logReminderNotifier{logger: logger}
+}
+
+func (n *logReminderNotifier) Notify(ctx context.Context, event *ReminderEvent) error {
+	if n.logger != nil {
+		n.logger.Info("reminder due",
+			"event", event.Event,
+			"application_id", event.ApplicationID.String(),
+			"user_id", event.UserID.String())
+	}
+	return nil
+}
+
+// ReminderScanner finds applications with a follow-up or next action due today or a deadline
+// deadlineDaysBefore days away and sends one reminder per application per day.
+// Applications whose snooze has ended get one snooze.ended reminder, even on a
+// day another reminder was already sent. Applications with muted notifications are skipped.
+// Days are UTC calendar days. last_reminded_at records the last delivery so that
+// repeated scans, restarts and multiple replicas do not fire the same reminder twice.
+type ReminderScanner struct {
+	repo               Repository
+	notifier           ReminderNotifier
+	deadlineDaysBefore int
+	logger             *slog.Logger
+	now                func() time.Time
+}
+
+// NewReminderScanner constructs a reminder scanner.
+func NewReminderScanner(repo Repository, notifier ReminderNotifier, deadlineDaysBefore int, logger *slog.Logger) *ReminderScanner {
+	return &ReminderScanner{
+		repo:               repo,
+		notifier:           notifier,
+		deadlineDaysBefore: deadlineDaysBefore,
+		logger:             logger,
+		now:                time.Now,
+	}
+}
+
+// Run scans immediately and then every interval until ctx is cancelled.
+func (s *ReminderScanner) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if sent, err := s.Scan(ctx); err != nil {
+			if s.logger != nil {
+				s.logger.Error("reminder scan failed", "error", err)
+			}
+		} else if sent > 0 && s.logger != nil {
+			s.logger.Info("reminders sent", "count", sent)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Scan sends all reminders due now and returns how many events were delivered.
+func (s *ReminderScanner) Scan(ctx context.Context) (int, error) {
+	// Postgres stores microseconds; truncate so ReleaseReminder can match the claimed value
+	now := s.now().UTC().Truncate(time.Microsecond)
+	dayStart := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	deadlineDayStart := dayStart.AddDate(0, 0, s.deadlineDaysBefore)
+
+	candidates, err := s.repo.ListReminderCandidates(ctx, dayStart, deadlineDayStart, now, reminderScanBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	for i := range candidates {
+		application := &candidates[i]
+		if application.NotificationsMuted {
+			continue
+		}
+		events, remindedBefore := s.dueEvents(application, dayStart, deadlineDayStart, now)
+		if len(events) == 0 {
+			continue
+		}
+
+		// Claim before delivering so concurrent scanners cannot send the same reminder
+		claimed, err := s.repo.ClaimReminder(ctx, application.ID, remindedBefore, now)
+		if err != nil {
+			return sent, err
+		}
+		if !claimed {
+			continue
+		}
+
+		delivered := 0
+		for _, event := range events {
+			if err := s.notifier.Notify(ctx, event); err != nil {
+				if s.logger != nil {
+					s.logger.Warn("failed to deliver reminder",
+						"event", event.Event,
+						"application_id", application.ID.String(),
+						"error", err)
+				}
+				s.releaseUndelivered(ctx, application, now, delivered, len(events))
+				break
+			}
+			delivered++
+		}
+		sent += delivered
+	}
+
+	return sent, nil
+}
+
+// releaseUndelivered gives up the claim after a failed delivery so the next scan
+// retries, but only if none of the application's events went out: the claim is
+// the only record of delivery, so releasing it after a partial send would repeat
+// the events that were already delivered. The undelivered rest is dropped instead.
+func (s *ReminderScanner) releaseUndelivered(ctx context.Context, application *JobApplication, claimedAt time.Time, delivered, total int) {
+	if delivered > 0 {
+		if s.logger != nil {
+			s.logger.Error("dropping undelivered reminders after a partial delivery",
+				"application_id", application.ID.String(),
+				"delivered", delivered,
+				"dropped", total-delivered)
+		}
+		return
+	}
+
+	if err := s.repo.ReleaseReminder(ctx, application.ID, claimedAt, application.LastRemindedAt); err != nil && s.logger != nil {
+		s.logger.Error("failed to release reminder claim", "application_id", application.ID.String(), "error", err)
+	}
+}
+
+// dueEvents returns the reminders due for the application and the time the claim must
+// not have been made since: the start of the day for daily reminders, or the end of
+// the snooze when only the snooze reminder is due.
+func (s *ReminderScanner) dueEvents(application *JobApplication, dayStart, deadlineDayStart, now time.Time) ([]*ReminderEvent, time.Time) {
+	var events []*ReminderEvent
+	remindedBefore := now
+	remindedToday := application.LastRemindedAt != nil && !application.LastRemindedAt.Before(dayStart)
+
+	if !remindedToday && application.FollowUpDate != nil && sameUTCDay(*application.FollowUpDate, dayStart) {
+		events = append(events, &ReminderEvent{
+			Event:         ReminderEventFollowUpDue,
+			ApplicationID: application.ID,
+			UserID:        application.UserID,
+			CompanyName:   application.CompanyName,
+			JobTitle:      application.JobTitle,
+			FollowUpDate:  application.FollowUpDate,
+			NextAction:    application.NextAction,
+			NextActionDue: application.NextActionDue,
+			OccurredAt:    now,
+		})
+		remindedBefore = dayStart
+	}
+	if !remindedToday && application.NextAction != "" && application.NextActionDue != nil && sameUTCDay(*application.NextActionDue, dayStart) {
+		events = append(events, &ReminderEvent{
+			Event:         ReminderEventNextActionDue,
+			ApplicationID: application.ID,
+			UserID:        application.UserID,
+			CompanyName:   application.CompanyName,
+			JobTitle:      application.JobTitle,
+			NextAction:    application.NextAction,
+			NextActionDue: application.NextActionDue,
+			OccurredAt:    now,
+		})
+		remindedBefore = dayStart
+	}
+	if !remindedToday && application.Deadline != nil && sameUTCDay(*application.Deadline, deadlineDayStart) {
+		daysUntil := s.deadlineDaysBefore
+		events = append(events, &ReminderEvent{
+			Event:             ReminderEventDeadlineApproaching,
+			ApplicationID:     application.ID,
+			UserID:            application.UserID,
+			CompanyName:       application.CompanyName,
+			JobTitle:          application.JobTitle,
+			Deadline:          application.Deadline,
+			DaysUntilDeadline: &daysUntil,
+			OccurredAt:        now,
+		})
+		remindedBefore = dayStart
+	}
+	if snoozedUntil := application.SnoozedUntil; snoozedUntil != nil && !snoozedUntil.After(now) &&
+		(application.LastRemindedAt == nil || application.LastRemindedAt.Before(*snoozedUntil)) {
+		events = append(events, &ReminderEvent{
+			Event:         ReminderEventSnoozeEnded,
+			ApplicationID: application.ID,
+			UserID:        application.UserID,
+			CompanyName:   application.CompanyName,
+			JobTitle:      application.JobTitle,
+			SnoozedUntil:  snoozedUntil,
+			OccurredAt:    now,
+		})
+		if snoozedUntil.Before(remindedBefore) {
+			remindedBefore = *snoozedUntil
+		}
+	}
+	return events, remindedBefore
+}
+
+func sameUTCDay(t, dayStart time.Time) bool {
+	t = t.UTC()
+	return !t.Before(dayStart) && t.Before(dayStart.AddDate(0, 0, 1))
+}
@@ -0,0 +1,169 @@
+package jobapplications
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeReminderRepo implements the reminder queries over an in-memory list and
+// mirrors the conditional updates of the GORM repository.
+type fakeReminderRepo struct {
+	Repository
+	applications []*JobApplication
+	released     int
+}
+
+func (r *fakeReminderRepo) ListReminderCandidates(_ context.Context, _, _, _ time.Time, _ int) ([]JobApplication, error) {
+	result := make([]JobApplication, 0, len(r.applications))
+	for _, application := range r.applications {
+		result = append(result, *application)
+	}
+	return result, nil
+}
+
+func (r *fakeReminderRepo) ClaimReminder(_ context.Context, applicationID uuid.UUID, remindedBefore, remindedAt time.Time) (bool, error) {
+	application := r.find(applicationID)
+	if application.LastRemindedAt != nil && !application.LastRemindedAt.Before(remindedBefore) {
+		return false, nil
+	}
+	application.LastRemindedAt = &remindedAt
+	return true, nil
+}
+
+func (r *fakeReminderRepo) ReleaseReminder(_ context.Context, applicationID uuid.UUID, remindedAt time.Time, previous *time.Time) error {
+	application := r.find(applicationID)
+	if application.LastRemindedAt != nil && application.LastRemindedAt.Equal(remindedAt) {
+		application.LastRemindedAt = previous
+		r.released++
+	}
+	return nil
+}
+
+func (r *fakeReminderRepo) find(id uuid.UUID) *JobApplication {
+	for _, application := range r.applications {
+		if application.ID == id {
+			return application
+		}
+	}
+	panic("unknown application " + id.String())
+}
+
+// fakeNotifier records delivered events and fails the events listed in failEvents.
+type fakeNotifier struct {
+	delivered  []string
+	failEvents map[string]bool
+}
+
+func (n *fakeNotifier) Notify(_ context.Context, event *ReminderEvent) error {
+	if n.failEvents[event.Event] {
+		return errors.New("webhook down")
+	}
+	n.delivered = append(n.delivered, event.Event)
+	return nil
+}
+
+func newTestScanner(repo Repository, notifier ReminderNotifier, now time.Time) *ReminderScanner {
+	scanner := NewReminderScanner(repo, notifier, 3, nil)
+	scanner.now = func() time.Time { return now }
+	return scanner
+}
+
+func TestReminderScanner_SingleEventFiresOnce(t *testing.T) {
+	now := time.Date(2026, 3, 10, 9, 0, 0, 0, time.UTC)
+	followUp := now.Add(2 * time.Hour)
+	repo := &fakeReminderRepo{applications: []*JobApplication{{ID: uuid.New(), FollowUpDate: &followUp}}}
+	notifier := &fakeNotifier{}
+	scanner := newTestScanner(repo, notifier, now)
+
+	sent, err := scanner.Scan(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, sent)
+
+	sent, err = scanner.Scan(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 0, sent, "a second scan on the same day must not repeat the reminder")
+	assert.Equal(t, []string{ReminderEventFollowUpDue}, notifier.delivered)
+}
+
+func TestReminderScanner_FailedSingleEventIsRetried(t *testing.T) {
+	now := time.Date(2026, 3, 10, 9, 0, 0, 0, time.UTC)
+	followUp := now
+	repo := &fakeReminderRepo{applications: []*JobApplication{{ID: uuid.New(), FollowUpDate: &followUp}}}
+	notifier := &fakeNotifier{failEvents: map[string]bool{ReminderEventFollowUpDue: true}}
+	scanner := newTestScanner(repo, notifier, now)
+
+	sent, err := scanner.Scan(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 0, sent)
+	assert.Equal(t, 1, repo.released)
+	assert.Nil(t, repo.applications[0].LastRemindedAt)
+
+	notifier.failEvents = nil
+	sent, err = scanner.Scan(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, sent)
+}
+
+func TestReminderScanner_PartialFailureDoesNotRepeatDeliveredEvent(t *testing.T) {
+	now := time.Date(2026, 3, 10, 9, 0, 0, 0, time.UTC)
+	followUp := now
+	deadline := now.AddDate(0, 0, 3)
+	repo := &fakeReminderRepo{applications: []*JobApplication{{ID: uuid.New(), FollowUpDate: &followUp, Deadline: &deadline}}}
+	notifier := &fakeNotifier{failEvents: map[string]bool{ReminderEventDeadlineApproaching: true}}
+	scanner := newTestScanner(repo, notifier, now)
+
+	sent, err := scanner.Scan(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, sent)
+	assert.Equal(t, 0, repo.released, "the claim must be kept once an event was delivered")
+
+	notifier.failEvents = nil
+	sent, err = scanner.Scan(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 0, sent)
+	assert.Equal(t, []string{ReminderEventFollowUpDue}, notifier.delivered)
+}
+
+func TestReminderScanner_SnoozeEndedFiresAfterDailyReminder(t *testing.T) {
+	now := time.Date(2026, 3, 10, 15, 0, 0, 0, time.UTC)
+	remindedAt := time.Date(2026, 3, 10, 8, 0, 0, 0, time.UTC)
+	snoozedUntil := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	followUp := now
+	repo := &fakeReminderRepo{applications: []*JobApplication{{
+		ID:             uuid.New(),
+		FollowUpDate:   &followUp,
+		SnoozedUntil:   &snoozedUntil,
+		LastRemindedAt: &remindedAt,
+	}}}
+	notifier := &fakeNotifier{}
+	scanner := newTestScanner(repo, notifier, now)
+
+	sent, err := scanner.Scan(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, sent)
+	assert.Equal(t, []string{ReminderEventSnoozeEnded}, notifier.delivered, "the follow-up was already sent today")
+
+	sent, err = scanner.Scan(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 0, sent)
+}
+
+func TestReminderScanner_SkipsMutedApplications(t *testing.T) {
+	now := time.Date(2026, 3, 10, 9, 0, 0, 0, time.UTC)
+	followUp := now.Add(2 * time.Hour)
+	muted := &JobApplication{ID: uuid.New(), FollowUpDate: &followUp, NotificationsMuted: true}
+	repo := &fakeReminderRepo{applications: []*JobApplication{muted}}
+	notifier := &fakeNotifier{}
+
+	sent, err := newTestScanner(repo, notifier, now).Scan(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 0, sent)
+	assert.Empty(t, notifier.delivered)
+	assert.Nil(t, muted.LastRemindedAt, "muted applications are not claimed")
+}
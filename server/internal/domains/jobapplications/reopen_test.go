@@ -0,0 +1,103 @@
+package jobapplications
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"woragis-jobs-service/pkg/response"
+)
+
+// fakeStatusRepo stores one application and captures the status changes saved for it.
+type fakeStatusRepo struct {
+	Repository
+	application *JobApplication
+	changes     []*StatusChange
+	updated     bool
+}
+
+func (r *fakeStatusRepo) GetJobApplication(_ context.Context, _ uuid.UUID) (*JobApplication, error) {
+	return r.application, nil
+}
+
+func (r *fakeStatusRepo) SaveStatusChange(_ context.Context, _ *JobApplication, change *StatusChange) error {
+	r.changes = append(r.changes, change)
+	return nil
+}
+
+func (r *fakeStatusRepo) UpdateJobApplication(_ context.Context, _ *JobApplication) error {
+	r.updated = true
+	return nil
+}
+
+func TestJobApplication_Reopen(t *testing.T) {
+	application := &JobApplication{Status: ApplicationStatusRejected, RejectionReason: "position filled", ErrorMessage: "stale"}
+	require.NoError(t, application.Reopen())
+	assert.Equal(t, ApplicationStatusReopened, application.Status)
+	assert.Empty(t, application.RejectionReason)
+	assert.Empty(t, application.ErrorMessage)
+
+	for _, status := range []ApplicationStatus{ApplicationStatusPending, ApplicationStatusApplied, ApplicationStatusReopened} {
+		application := &JobApplication{Status: status}
+		assert.EqualError(t, application.Reopen(), ErrReopenNotTerminal, "status=%s", status)
+		assert.Equal(t, status, application.Status)
+	}
+}
+
+func TestValidateReopenPayload(t *testing.T) {
+	reason, err := ValidateReopenPayload(&reopenPayload{Reason: "  recruiter reached out again "})
+	require.NoError(t, err)
+	assert.Equal(t, "recruiter reached out again", reason)
+
+	_, err = ValidateReopenPayload(&reopenPayload{Reason: "   "})
+	assert.EqualError(t, err, "reason: is required")
+}
+
+func TestService_ReopenJobApplication(t *testing.T) {
+	userID := uuid.New()
+
+	t.Run("records the reason in the status history", func(t *testing.T) {
+		repo := &fakeStatusRepo{application: &JobApplication{ID: uuid.New(), UserID: userID, Status: ApplicationStatusFailed, ErrorMessage: "timeout"}}
+		application, err := NewService(repo, nil, nil).ReopenJobApplication(context.Background(), userID, repo.application.ID, "retrying manually")
+		require.NoError(t, err)
+		assert.Equal(t, ApplicationStatusReopened, application.Status)
+		require.Len(t, repo.changes, 1)
+		assert.Equal(t, ApplicationStatusFailed, repo.changes[0].FromStatus)
+		assert.Equal(t, ApplicationStatusReopened, repo.changes[0].ToStatus)
+		assert.Equal(t, "retrying manually", repo.changes[0].Reason)
+	})
+
+	t.Run("rejects applications that are still active", func(t *testing.T) {
+		repo := &fakeStatusRepo{application: &JobApplication{ID: uuid.New(), UserID: userID, Status: ApplicationStatusApplied}}
+		_, err := NewService(repo, nil, nil).ReopenJobApplication(context.Background(), userID, repo.application.ID, "why not")
+		assert.EqualError(t, err, ErrReopenNotTerminal)
+		var domainErr *DomainError
+		require.ErrorAs(t, err, &domainErr)
+		assert.Equal(t, response.KindConflict, domainErr.ErrorKind())
+		assert.Empty(t, repo.changes)
+	})
+
+	t.Run("hides applications of other users", func(t *testing.T) {
+		repo := &fakeStatusRepo{application: &JobApplication{ID: uuid.New(), UserID: uuid.New(), Status: ApplicationStatusRejected}}
+		_, err := NewService(repo, nil, nil).ReopenJobApplication(context.Background(), userID, repo.application.ID, "reason")
+		assert.EqualError(t, err, ErrApplicationNotFound)
+	})
+}
+
+func TestService_UpdateJobApplicationStatusRecordsHistory(t *testing.T) {
+	application := &JobApplication{ID: uuid.New(), UserID: uuid.New(), CompanyName: "Acme", JobTitle: "Engineer", JobURL: "https://acme.test/jobs/1", Website: "linkedin", Status: ApplicationStatusApplied}
+	repo := &fakeStatusRepo{application: application}
+	svc := NewService(repo, nil, nil)
+
+	require.NoError(t, svc.UpdateJobApplicationStatus(context.Background(), application.ID, ApplicationStatusRejected))
+	require.Len(t, repo.changes, 1)
+	assert.Equal(t, ApplicationStatusApplied, repo.changes[0].FromStatus)
+	assert.Equal(t, ApplicationStatusRejected, repo.changes[0].ToStatus)
+
+	require.NoError(t, svc.UpdateJobApplicationStatus(context.Background(), application.ID, ApplicationStatusRejected))
+	assert.Len(t, repo.changes, 1)
+	assert.True(t, repo.updated)
+}
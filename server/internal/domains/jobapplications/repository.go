@@ -2,10 +2,17 @@ package jobapplications
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"woragis-jobs-service/internal/domains/jobapplications/responses"
 )
 
 // Repository defines persistence operations for job applications.
@@ -13,8 +20,79 @@ type Repository interface {
 	CreateJobApplication(ctx context.Context, application *JobApplication) error
 	UpdateJobApplication(ctx context.Context, application *JobApplication) error
 	GetJobApplication(ctx context.Context, applicationID uuid.UUID) (*JobApplication, error)
+	GetJobApplicationsByIDs(ctx context.Context, userID uuid.UUID, applicationIDs []uuid.UUID) ([]JobApplication, error)
+	FilterOwnedIDs(ctx context.Context, userID uuid.UUID, applicationIDs []uuid.UUID) ([]uuid.UUID, error)
+	FindJobApplicationByURL(ctx context.Context, userID uuid.UUID, jobURLs []string) (*JobApplication, error)
 	ListJobApplications(ctx context.Context, filters JobApplicationFilters) ([]JobApplication, error)
+	CountJobApplications(ctx context.Context, filters JobApplicationFilters) (int64, error)
+	ListJobApplicationsByCompany(ctx context.Context, userID uuid.UUID, companyName string, limit, offset int) ([]JobApplication, error)
+	ListCompanies(ctx context.Context, userID uuid.UUID, limit, offset int) ([]CompanySummary, error)
+	CountCompanies(ctx context.Context, userID uuid.UUID) (int64, error)
+	ListUpcomingDeadlines(ctx context.Context, userID uuid.UUID, after time.Time, activeOnly bool, limit, offset int) ([]JobApplication, error)
 	DeleteJobApplication(ctx context.Context, applicationID uuid.UUID) error
+	DeleteJobApplicationsByFilter(ctx context.Context, userID uuid.UUID, filter BulkDeleteFilter) ([]uuid.UUID, error)
+	ReassignWebsite(ctx context.Context, userID uuid.UUID, from, to string) ([]uuid.UUID, error)
+	AddTagToApplications(ctx context.Context, userID uuid.UUID, applicationIDs []uuid.UUID, tag string) (*BulkTagResult, error)
+	RemoveTagFromApplications(ctx context.Context, userID uuid.UUID, applicationIDs []uuid.UUID, tag string) (*BulkTagResult, error)
+	SetInterestLevel(ctx context.Context, userID uuid.UUID, applicationIDs []uuid.UUID, level InterestLevel) (*BulkInterestResult, error)
+	ContactApplications(ctx context.Context, userID uuid.UUID, applicationIDs []uuid.UUID, response responses.Response) (*BulkContactResult, error)
+	ListReminderCandidates(ctx context.Context, dayStart, deadlineDayStart, now time.Time, limit int) ([]JobApplication, error)
+	ClaimReminder(ctx context.Context, applicationID uuid.UUID, remindedBefore, remindedAt time.Time) (bool, error)
+	ReleaseReminder(ctx context.Context, applicationID uuid.UUID, remindedAt time.Time, previous *time.Time) error
+	SaveOffer(ctx context.Context, offer *Offer) error
+	GetOffer(ctx context.Context, applicationID uuid.UUID) (*Offer, error)
+	GetOffersByApplicationIDs(ctx context.Context, applicationIDs []uuid.UUID) (map[uuid.UUID]Offer, error)
+	ListOffers(ctx context.Context, userID uuid.UUID) ([]Offer, error)
+	DeleteOffer(ctx context.Context, applicationID uuid.UUID) error
+	CreateExpense(ctx context.Context, expense *ApplicationExpense) error
+	ListExpenses(ctx context.Context, applicationID uuid.UUID) ([]ApplicationExpense, error)
+	ListExpensesByUser(ctx context.Context, userID uuid.UUID) ([]ApplicationExpense, error)
+	SaveCoverLetterRevision(ctx context.Context, application *JobApplication, revision *CoverLetterRevision) error
+	ListCoverLetterRevisions(ctx context.Context, applicationID uuid.UUID) ([]CoverLetterRevision, error)
+	CountApplicationsPerDay(ctx context.Context, userID uuid.UUID, metric TimeSeriesMetric, since time.Time) (map[string]int64, error)
+	CountPinnedApplications(ctx context.Context, userID uuid.UUID) (int64, error)
+	CountApplicationsByInterestLevel(ctx context.Context, userID uuid.UUID) (map[string]int64, error)
+	SaveStatusChange(ctx context.Context, application *JobApplication, change *StatusChange) error
+	ListStatusChanges(ctx context.Context, applicationID uuid.UUID) ([]StatusChange, error)
+}
+
+// BulkTagResult reports the outcome of a bulk tag operation.
+type BulkTagResult struct {
+	Requested  int         `json:"requested"`
+	Updated    int         `json:"updated"`
+	Unchanged  int         `json:"unchanged"`
+	Skipped    []uuid.UUID `json:"skipped"`
+	AtTagLimit []uuid.UUID `json:"atTagLimit,omitempty"` // Already carry the maximum number of tags
+}
+
+// BulkInterestResult reports the outcome of a bulk interest level update.
+type BulkInterestResult struct {
+	Requested int         `json:"requested"`
+	Updated   int         `json:"updated"`
+	Unchanged int         `json:"unchanged"` // Already at the requested level
+	Skipped   []uuid.UUID `json:"skipped"`   // Missing or owned by another user
+}
+
+// BulkContactResult reports the outcome of a bulk contact.
+type BulkContactResult struct {
+	Requested         int         `json:"requested"`
+	Contacted         []uuid.UUID `json:"contacted"`
+	Skipped           []uuid.UUID `json:"skipped"`                     // Missing or owned by another user
+	InvalidTransition []uuid.UUID `json:"invalidTransition,omitempty"` // Not applied or reopened, left untouched
+}
+
+// BulkDeleteFilter selects the applications removed by a bulk delete. At
+// least one criterion must be set so a request can never match everything.
+type BulkDeleteFilter struct {
+	Status        *ApplicationStatus
+	Website       *string
+	AppliedBefore *time.Time
+	CreatedBefore *time.Time
+}
+
+// IsEmpty reports whether no criterion is set.
+func (f BulkDeleteFilter) IsEmpty() bool {
+	return f.Status == nil && f.Website == nil && f.AppliedBefore == nil && f.CreatedBefore == nil
 }
 
 // JobApplicationFilters represents filtering options for listing job applications.
@@ -23,10 +101,24 @@ type JobApplicationFilters struct {
 	Website          *string
 	Status           *ApplicationStatus
 	ResumeID         *uuid.UUID
-	InterestLevel    *string
+	InterestLevel    *InterestLevel
 	Source           *string
 	ApplicationMethod *string
 	Language         *string
+	// Search matches company name, job title or location, ignoring case
+	Search           *string
+	// DeepSearch extends Search to the application's interview stage notes and feedback
+	// and its recruiter response messages
+	DeepSearch       bool
+	// StaleAppliedBefore limits results to applications applied before this time
+	// that have not received a recruiter response yet.
+	StaleAppliedBefore *time.Time
+	// SnoozedAt hides applications still snoozed at this time; nil includes them.
+	SnoozedAt        *time.Time
+	// Tags limits results to applications carrying every tag, or any of them when
+	// TagsMatchAny is set. Tags match exactly, including case.
+	Tags             []string
+	TagsMatchAny     bool
 	Limit            int
 	Offset           int
 }
@@ -54,7 +146,9 @@ func (r *gormRepository) UpdateJobApplication(ctx context.Context, application *
 	if err := application.Validate(); err != nil {
 		return err
 	}
+	application.Version++
 	if err := r.db.WithContext(ctx).Save(application).Error; err != nil {
+		application.Version--
 		return handleDatabaseError(err)
 	}
 	return nil
@@ -66,13 +160,36 @@ func (r *gormRepository) GetJobApplication(ctx context.Context, applicationID uu
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, NewDomainError(ErrCodeNotFound, ErrApplicationNotFound)
 		}
-		return nil, NewDomainError(ErrCodeRepositoryFailure, ErrUnableToFetch)
+		return nil, newRepositoryError(ErrUnableToFetch, err)
 	}
 	return &application, nil
 }
 
-func (r *gormRepository) ListJobApplications(ctx context.Context, filters JobApplicationFilters) ([]JobApplication, error) {
+// FindJobApplicationByURL returns the user's oldest application whose job URL is one of jobURLs.
+func (r *gormRepository) FindJobApplicationByURL(ctx context.Context, userID uuid.UUID, jobURLs []string) (*JobApplication, error) {
+	var application JobApplication
+	if err := r.db.WithContext(ctx).Where("user_id = ? AND job_url IN ?", userID, jobURLs).
+		Order("created_at ASC").First(&application).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, NewDomainError(ErrCodeNotFound, ErrApplicationNotFound)
+		}
+		return nil, handleDatabaseError(err)
+	}
+	return &application, nil
+}
+
+// GetJobApplicationsByIDs returns the applications among applicationIDs that belong to userID.
+func (r *gormRepository) GetJobApplicationsByIDs(ctx context.Context, userID uuid.UUID, applicationIDs []uuid.UUID) ([]JobApplication, error) {
 	var applications []JobApplication
+	if err := r.db.WithContext(ctx).Where("id IN ? AND user_id = ?", applicationIDs, userID).Find(&applications).Error; err != nil {
+		return nil, handleDatabaseError(err)
+	}
+	return applications, nil
+}
+
+// filteredJobApplications scopes a query to the applications matching filters,
+// ignoring the limit and offset.
+func (r *gormRepository) filteredJobApplications(ctx context.Context, filters JobApplicationFilters) *gorm.DB {
 	query := r.db.WithContext(ctx).Model(&JobApplication{})
 
 	if filters.UserID != nil {
@@ -99,6 +216,57 @@ func (r *gormRepository) ListJobApplications(ctx context.Context, filters JobApp
 	if filters.Language != nil {
 		query = query.Where("language = ?", *filters.Language)
 	}
+	if filters.Search != nil {
+		searchPattern := "%" + *filters.Search + "%"
+		if filters.DeepSearch {
+			query = query.Where("(company_name ILIKE ? OR job_title ILIKE ? OR location ILIKE ?"+
+				" OR EXISTS (SELECT 1 FROM job_application_interview_stages s WHERE s.job_application_id = job_applications.id AND (s.notes ILIKE ? OR s.feedback ILIKE ?))"+
+				" OR EXISTS (SELECT 1 FROM job_application_responses r WHERE r.job_application_id = job_applications.id AND r.message ILIKE ?))",
+				searchPattern, searchPattern, searchPattern, searchPattern, searchPattern, searchPattern)
+		} else {
+			query = query.Where("(company_name ILIKE ? OR job_title ILIKE ? OR location ILIKE ?)",
+				searchPattern, searchPattern, searchPattern)
+		}
+	}
+	if filters.StaleAppliedBefore != nil {
+		query = query.
+			Where("applied_at IS NOT NULL AND applied_at < ?", *filters.StaleAppliedBefore).
+			Where("response_received_at IS NULL").
+			Where("NOT EXISTS (SELECT 1 FROM job_application_responses r WHERE r.job_application_id = job_applications.id)")
+	}
+	if filters.SnoozedAt != nil {
+		query = query.Where("snoozed_until IS NULL OR snoozed_until <= ?", *filters.SnoozedAt)
+	}
+	if len(filters.Tags) > 0 {
+		query = whereTags(query, filters.Tags, filters.TagsMatchAny)
+	}
+	return query
+}
+
+// whereTags filters on the tags column with jsonb containment, which the GIN index on
+// tags serves. Matching any tag ORs one containment check per tag.
+func whereTags(query *gorm.DB, tags []string, matchAny bool) *gorm.DB {
+	if !matchAny {
+		return query.Where("tags @> ?::jsonb", jsonTags(tags...))
+	}
+	conditions := make([]string, len(tags))
+	args := make([]interface{}, len(tags))
+	for i, tag := range tags {
+		conditions[i] = "tags @> ?::jsonb"
+		args[i] = jsonTags(tag)
+	}
+	return query.Where("("+strings.Join(conditions, " OR ")+")", args...)
+}
+
+// jsonTags encodes tags as a JSON array for comparison with the jsonb tags column.
+func jsonTags(tags ...string) string {
+	encoded, _ := json.Marshal(tags)
+	return string(encoded)
+}
+
+func (r *gormRepository) ListJobApplications(ctx context.Context, filters JobApplicationFilters) ([]JobApplication, error) {
+	var applications []JobApplication
+	query := r.filteredJobApplications(ctx, filters)
 
 	if filters.Limit > 0 {
 		query = query.Limit(filters.Limit)
@@ -107,7 +275,8 @@ func (r *gormRepository) ListJobApplications(ctx context.Context, filters JobApp
 		query = query.Offset(filters.Offset)
 	}
 
-	query = query.Order("created_at DESC")
+	// Pinned applications come first in the user's chosen order, the rest newest first
+	query = query.Order("pin_order ASC NULLS LAST").Order("created_at DESC")
 
 	if err := query.Find(&applications).Error; err != nil {
 		return nil, handleDatabaseError(err)
@@ -116,14 +285,679 @@ func (r *gormRepository) ListJobApplications(ctx context.Context, filters JobApp
 	return applications, nil
 }
 
+// CountJobApplications returns how many applications match filters, ignoring the limit and offset.
+func (r *gormRepository) CountJobApplications(ctx context.Context, filters JobApplicationFilters) (int64, error) {
+	var total int64
+	if err := r.filteredJobApplications(ctx, filters).Count(&total).Error; err != nil {
+		return 0, handleDatabaseError(err)
+	}
+	return total, nil
+}
+
+// ListJobApplicationsByCompany returns the user's applications whose company name equals
+// companyName ignoring case, newest first.
+func (r *gormRepository) ListJobApplicationsByCompany(ctx context.Context, userID uuid.UUID, companyName string, limit, offset int) ([]JobApplication, error) {
+	var applications []JobApplication
+	query := r.db.WithContext(ctx).
+		Where("user_id = ? AND LOWER(company_name) = LOWER(?)", userID, companyName).
+		Order("created_at DESC").Order("id ASC")
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+	if offset > 0 {
+		query = query.Offset(offset)
+	}
+	if err := query.Find(&applications).Error; err != nil {
+		return nil, handleDatabaseError(err)
+	}
+	return applications, nil
+}
+
+// ListCompanies groups the user's applications by company name, ignoring case, most
+// applications first. Each company is reported under the name and status of its newest
+// application.
+func (r *gormRepository) ListCompanies(ctx context.Context, userID uuid.UUID, limit, offset int) ([]CompanySummary, error) {
+	ranked := r.db.WithContext(ctx).Model(&JobApplication{}).
+		Select("company_name, status, "+
+			"COUNT(*) OVER (PARTITION BY LOWER(company_name)) AS application_count, "+
+			"ROW_NUMBER() OVER (PARTITION BY LOWER(company_name) ORDER BY created_at DESC, id DESC) AS position").
+		Where("user_id = ?", userID)
+
+	query := r.db.WithContext(ctx).Table("(?) AS companies", ranked).
+		Select("company_name AS company, application_count AS count, status AS latest_status").
+		Where("position = 1").
+		Order("application_count DESC").Order("LOWER(company_name) ASC")
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+	if offset > 0 {
+		query = query.Offset(offset)
+	}
+
+	companies := []CompanySummary{}
+	if err := query.Scan(&companies).Error; err != nil {
+		return nil, handleDatabaseError(err)
+	}
+	return companies, nil
+}
+
+// CountCompanies returns how many distinct companies, ignoring case, the user has applied to.
+func (r *gormRepository) CountCompanies(ctx context.Context, userID uuid.UUID) (int64, error) {
+	var total int64
+	if err := r.db.WithContext(ctx).Model(&JobApplication{}).
+		Select("COUNT(DISTINCT LOWER(company_name))").
+		Where("user_id = ?", userID).
+		Scan(&total).Error; err != nil {
+		return 0, handleDatabaseError(err)
+	}
+	return total, nil
+}
+
+// FilterOwnedIDs returns the subset of applicationIDs that exist and belong to the user,
+// in no particular order.
+func (r *gormRepository) FilterOwnedIDs(ctx context.Context, userID uuid.UUID, applicationIDs []uuid.UUID) ([]uuid.UUID, error) {
+	owned := []uuid.UUID{}
+	if len(applicationIDs) == 0 {
+		return owned, nil
+	}
+	if err := r.db.WithContext(ctx).
+		Model(&JobApplication{}).
+		Where("id IN ? AND user_id = ?", applicationIDs, userID).
+		Pluck("id", &owned).Error; err != nil {
+		return nil, handleDatabaseError(err)
+	}
+	return owned, nil
+}
+
+// ListUpcomingDeadlines returns the user's applications with a deadline after the given
+// time, soonest first. activeOnly excludes terminal statuses.
+func (r *gormRepository) ListUpcomingDeadlines(ctx context.Context, userID uuid.UUID, after time.Time, activeOnly bool, limit, offset int) ([]JobApplication, error) {
+	var applications []JobApplication
+	query := r.db.WithContext(ctx).
+		Where("user_id = ? AND deadline IS NOT NULL AND deadline > ?", userID, after)
+	if activeOnly {
+		query = query.Where("status NOT IN ?", terminalStatuses)
+	}
+	query = query.Order("deadline ASC").Order("id ASC")
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+	if offset > 0 {
+		query = query.Offset(offset)
+	}
+	if err := query.Find(&applications).Error; err != nil {
+		return nil, handleDatabaseError(err)
+	}
+	return applications, nil
+}
+
 func (r *gormRepository) DeleteJobApplication(ctx context.Context, applicationID uuid.UUID) error {
-	result := r.db.WithContext(ctx).Delete(&JobApplication{}, applicationID)
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("job_application_id = ?", applicationID).Delete(&Offer{}).Error; err != nil {
+			return handleDatabaseError(err)
+		}
+		if err := tx.Where("job_application_id = ?", applicationID).Delete(&CoverLetterRevision{}).Error; err != nil {
+			return handleDatabaseError(err)
+		}
+		if err := tx.Where("job_application_id = ?", applicationID).Delete(&StatusChange{}).Error; err != nil {
+			return handleDatabaseError(err)
+		}
+		if err := tx.Where("job_application_id = ?", applicationID).Delete(&ApplicationExpense{}).Error; err != nil {
+			return handleDatabaseError(err)
+		}
+		result := tx.Delete(&JobApplication{}, applicationID)
+		if result.Error != nil {
+			return handleDatabaseError(result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return NewDomainError(ErrCodeNotFound, ErrApplicationNotFound)
+		}
+		return nil
+	})
+}
+
+// DeleteJobApplicationsByFilter deletes the user's applications matching filter
+// in one transaction and returns the ids that were removed.
+func (r *gormRepository) DeleteJobApplicationsByFilter(ctx context.Context, userID uuid.UUID, filter BulkDeleteFilter) ([]uuid.UUID, error) {
+	if filter.IsEmpty() {
+		return nil, NewDomainError(ErrCodeInvalidPayload, ErrEmptyBulkDeleteFilter)
+	}
+
+	deleted := []uuid.UUID{}
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		query := tx.Model(&JobApplication{}).Where("user_id = ?", userID)
+		if filter.Status != nil {
+			query = query.Where("status = ?", *filter.Status)
+		}
+		if filter.Website != nil {
+			query = query.Where("website = ?", *filter.Website)
+		}
+		if filter.AppliedBefore != nil {
+			query = query.Where("applied_at IS NOT NULL AND applied_at < ?", *filter.AppliedBefore)
+		}
+		if filter.CreatedBefore != nil {
+			query = query.Where("created_at < ?", *filter.CreatedBefore)
+		}
+
+		if err := query.Clauses(clause.Locking{Strength: "UPDATE"}).Pluck("id", &deleted).Error; err != nil {
+			return handleDatabaseError(err)
+		}
+		if len(deleted) == 0 {
+			return nil
+		}
+
+		if err := tx.Where("job_application_id IN ?", deleted).Delete(&Offer{}).Error; err != nil {
+			return handleDatabaseError(err)
+		}
+		if err := tx.Where("job_application_id IN ?", deleted).Delete(&CoverLetterRevision{}).Error; err != nil {
+			return handleDatabaseError(err)
+		}
+		if err := tx.Where("job_application_id IN ?", deleted).Delete(&StatusChange{}).Error; err != nil {
+			return handleDatabaseError(err)
+		}
+		if err := tx.Where("job_application_id IN ?", deleted).Delete(&ApplicationExpense{}).Error; err != nil {
+			return handleDatabaseError(err)
+		}
+		if err := tx.Where("id IN ?", deleted).Delete(&JobApplication{}).Error; err != nil {
+			return handleDatabaseError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return deleted, nil
+}
+
+// ReassignWebsite moves the user's applications on website from to website to in one
+// transaction and returns the ids of the applications moved.
+func (r *gormRepository) ReassignWebsite(ctx context.Context, userID uuid.UUID, from, to string) ([]uuid.UUID, error) {
+	moved := []uuid.UUID{}
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		query := tx.Model(&JobApplication{}).Where("user_id = ? AND website = ?", userID, from)
+		if err := query.Clauses(clause.Locking{Strength: "UPDATE"}).Pluck("id", &moved).Error; err != nil {
+			return handleDatabaseError(err)
+		}
+		if len(moved) == 0 {
+			return nil
+		}
+
+		if err := tx.Model(&JobApplication{}).Where("id IN ?", moved).Updates(map[string]interface{}{
+			"website":    to,
+			"updated_at": time.Now().UTC(),
+			"version":    gorm.Expr("version + 1"),
+		}).Error; err != nil {
+			return handleDatabaseError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return moved, nil
+}
+
+func (r *gormRepository) AddTagToApplications(ctx context.Context, userID uuid.UUID, applicationIDs []uuid.UUID, tag string) (*BulkTagResult, error) {
+	return r.bulkUpdateTags(ctx, userID, applicationIDs, func(application *JobApplication, result *BulkTagResult) bool {
+		if application.AddTag(tag) {
+			return true
+		}
+		if !application.HasTagCapacity() && !application.HasTag(tag) {
+			result.AtTagLimit = append(result.AtTagLimit, application.ID)
+		}
+		return false
+	})
+}
+
+func (r *gormRepository) RemoveTagFromApplications(ctx context.Context, userID uuid.UUID, applicationIDs []uuid.UUID, tag string) (*BulkTagResult, error) {
+	return r.bulkUpdateTags(ctx, userID, applicationIDs, func(application *JobApplication, _ *BulkTagResult) bool {
+		return application.RemoveTag(tag)
+	})
+}
+
+// bulkUpdateTags applies mutate to every application owned by userID in a single transaction.
+// IDs that do not exist or belong to another user are reported as skipped.
+func (r *gormRepository) bulkUpdateTags(ctx context.Context, userID uuid.UUID, applicationIDs []uuid.UUID, mutate func(*JobApplication, *BulkTagResult) bool) (*BulkTagResult, error) {
+	result := &BulkTagResult{
+		Requested: len(applicationIDs),
+		Skipped:   []uuid.UUID{},
+	}
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var applications []JobApplication
+		if err := tx.Where("id IN ? AND user_id = ?", applicationIDs, userID).Find(&applications).Error; err != nil {
+			return err
+		}
+
+		found := make(map[uuid.UUID]struct{}, len(applications))
+		for i := range applications {
+			application := &applications[i]
+			found[application.ID] = struct{}{}
+			if !mutate(application, result) {
+				result.Unchanged++
+				continue
+			}
+			if err := tx.Model(application).Updates(map[string]interface{}{
+				"tags":       application.Tags,
+				"updated_at": application.UpdatedAt,
+				"version":    gorm.Expr("version + 1"),
+			}).Error; err != nil {
+				return err
+			}
+			result.Updated++
+		}
+
+		for _, id := range applicationIDs {
+			if _, ok := found[id]; !ok {
+				result.Skipped = append(result.Skipped, id)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, handleDatabaseError(err)
+	}
+
+	return result, nil
+}
+
+// SetInterestLevel sets the interest level of the user's applications among applicationIDs
+// in a single transaction. IDs that do not exist or belong to another user are reported
+// as skipped.
+func (r *gormRepository) SetInterestLevel(ctx context.Context, userID uuid.UUID, applicationIDs []uuid.UUID, level InterestLevel) (*BulkInterestResult, error) {
+	result := &BulkInterestResult{
+		Requested: len(applicationIDs),
+		Skipped:   []uuid.UUID{},
+	}
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var applications []JobApplication
+		if err := tx.Select("id", "interest_level").
+			Where("id IN ? AND user_id = ?", applicationIDs, userID).
+			Find(&applications).Error; err != nil {
+			return err
+		}
+
+		found := make(map[uuid.UUID]struct{}, len(applications))
+		changed := make([]uuid.UUID, 0, len(applications))
+		for _, application := range applications {
+			found[application.ID] = struct{}{}
+			if application.InterestLevel == level {
+				result.Unchanged++
+				continue
+			}
+			changed = append(changed, application.ID)
+		}
+
+		if len(changed) > 0 {
+			if err := tx.Model(&JobApplication{}).Where("id IN ?", changed).Updates(map[string]interface{}{
+				"interest_level": level,
+				"updated_at":     time.Now().UTC(),
+				"version":        gorm.Expr("version + 1"),
+			}).Error; err != nil {
+				return err
+			}
+		}
+		result.Updated = len(changed)
+
+		for _, id := range applicationIDs {
+			if _, ok := found[id]; !ok {
+				result.Skipped = append(result.Skipped, id)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, handleDatabaseError(err)
+	}
+
+	return result, nil
+}
+
+// ContactApplications moves the user's applied or reopened applications to contacted in one
+// transaction, recording the status change and a copy of response for each of them.
+func (r *gormRepository) ContactApplications(ctx context.Context, userID uuid.UUID, applicationIDs []uuid.UUID, response responses.Response) (*BulkContactResult, error) {
+	result := &BulkContactResult{
+		Requested: len(applicationIDs),
+		Contacted: []uuid.UUID{},
+		Skipped:   []uuid.UUID{},
+	}
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var applications []JobApplication
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id IN ? AND user_id = ?", applicationIDs, userID).
+			Order("id").
+			Find(&applications).Error; err != nil {
+			return err
+		}
+
+		byID := make(map[uuid.UUID]*JobApplication, len(applications))
+		for i := range applications {
+			byID[applications[i].ID] = &applications[i]
+		}
+
+		for _, id := range applicationIDs {
+			application, ok := byID[id]
+			if !ok {
+				result.Skipped = append(result.Skipped, id)
+				continue
+			}
+			from := application.Status
+			if err := application.RecordContact(response.ResponseDate); err != nil {
+				result.InvalidTransition = append(result.InvalidTransition, id)
+				continue
+			}
+			if err := tx.Model(application).Updates(map[string]interface{}{
+				"status":               application.Status,
+				"response_received_at": application.ResponseReceivedAt,
+				"next_action":          application.NextAction,
+				"next_action_due":      application.NextActionDue,
+				"updated_at":           application.UpdatedAt,
+				"version":              gorm.Expr("version + 1"),
+			}).Error; err != nil {
+				return err
+			}
+			if err := tx.Create(NewStatusChange(application, from, "")).Error; err != nil {
+				return err
+			}
+			reply := response
+			reply.ID = uuid.New()
+			reply.JobApplicationID = application.ID
+			if err := tx.Create(&reply).Error; err != nil {
+				return err
+			}
+			result.Contacted = append(result.Contacted, id)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, handleDatabaseError(err)
+	}
+
+	return result, nil
+}
+
+// ListReminderCandidates returns open applications not yet reminded today whose follow-up
+// or next action falls on dayStart's day or whose deadline falls on deadlineDayStart's day,
+// plus those whose snooze ended by now without a reminder since.
+func (r *gormRepository) ListReminderCandidates(ctx context.Context, dayStart, deadlineDayStart, now time.Time, limit int) ([]JobApplication, error) {
+	var applications []JobApplication
+	dayEnd := dayStart.AddDate(0, 0, 1)
+	err := r.db.WithContext(ctx).
+		Where("status NOT IN ?", terminalStatuses).
+		Where("NOT notifications_muted").
+		Where("((last_reminded_at IS NULL OR last_reminded_at < ?) AND ((follow_up_date >= ? AND follow_up_date < ?) OR (deadline >= ? AND deadline < ?)"+
+			" OR (next_action <> '' AND next_action_due >= ? AND next_action_due < ?)))"+
+			" OR (snoozed_until <= ? AND (last_reminded_at IS NULL OR last_reminded_at < snoozed_until))",
+			dayStart, dayStart, dayEnd, deadlineDayStart, deadlineDayStart.AddDate(0, 0, 1), dayStart, dayEnd, now).
+		Order("id").
+		Limit(limit).
+		Find(&applications).Error
+	if err != nil {
+		return nil, handleDatabaseError(err)
+	}
+	return applications, nil
+}
+
+// ClaimReminder marks the application as reminded at remindedAt unless it was already
+// reminded since remindedBefore. It reports whether this caller won the claim.
+func (r *gormRepository) ClaimReminder(ctx context.Context, applicationID uuid.UUID, remindedBefore, remindedAt time.Time) (bool, error) {
+	result := r.db.WithContext(ctx).Model(&JobApplication{}).
+		Where("id = ?", applicationID).
+		Where("last_reminded_at IS NULL OR last_reminded_at < ?", remindedBefore).
+		Update("last_reminded_at", remindedAt)
+	if result.Error != nil {
+		return false, handleDatabaseError(result.Error)
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// ReleaseReminder restores last_reminded_at to previous if it still holds the claim made at remindedAt.
+func (r *gormRepository) ReleaseReminder(ctx context.Context, applicationID uuid.UUID, remindedAt time.Time, previous *time.Time) error {
+	err := r.db.WithContext(ctx).Model(&JobApplication{}).
+		Where("id = ? AND last_reminded_at = ?", applicationID, remindedAt).
+		Update("last_reminded_at", previous).Error
+	return handleDatabaseError(err)
+}
+
+// CountApplicationsPerDay groups the user's applications by the UTC day of the
+// metric's timestamp, counting only days on or after since. Days without
+// applications are absent from the result.
+func (r *gormRepository) CountApplicationsPerDay(ctx context.Context, userID uuid.UUID, metric TimeSeriesMetric, since time.Time) (map[string]int64, error) {
+	column, ok := timeSeriesColumns[metric]
+	if !ok {
+		return nil, NewDomainError(ErrCodeInvalidPayload, ErrUnsupportedTimeSeriesMetric)
+	}
+
+	var rows []struct {
+		Day   string
+		Count int64
+	}
+	day := fmt.Sprintf("to_char(%s AT TIME ZONE 'UTC', 'YYYY-MM-DD')", column)
+	err := r.db.WithContext(ctx).Model(&JobApplication{}).
+		Select(day+" AS day, COUNT(*) AS count").
+		Where("user_id = ?", userID).
+		Where(column+" >= ?", since).
+		Group("day").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, handleDatabaseError(err)
+	}
+
+	counts := make(map[string]int64, len(rows))
+	for _, row := range rows {
+		counts[row.Day] = row.Count
+	}
+	return counts, nil
+}
+
+// CountApplicationsByInterestLevel groups the user's applications by lowercased interest
+// level. Applications without one are counted under "".
+func (r *gormRepository) CountApplicationsByInterestLevel(ctx context.Context, userID uuid.UUID) (map[string]int64, error) {
+	var rows []struct {
+		Level string
+		Count int64
+	}
+	err := r.db.WithContext(ctx).Model(&JobApplication{}).
+		Select("LOWER(COALESCE(interest_level, '')) AS level, COUNT(*) AS count").
+		Where("user_id = ?", userID).
+		Group("level").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, handleDatabaseError(err)
+	}
+
+	counts := make(map[string]int64, len(rows))
+	for _, row := range rows {
+		counts[row.Level] = row.Count
+	}
+	return counts, nil
+}
+
+// SaveOffer inserts the offer or updates it in place.
+func (r *gormRepository) SaveOffer(ctx context.Context, offer *Offer) error {
+	if err := offer.Validate(); err != nil {
+		return err
+	}
+	if err := r.db.WithContext(ctx).Save(offer).Error; err != nil {
+		return handleDatabaseError(err)
+	}
+	return nil
+}
+
+func (r *gormRepository) GetOffer(ctx context.Context, applicationID uuid.UUID) (*Offer, error) {
+	var offer Offer
+	if err := r.db.WithContext(ctx).Where("job_application_id = ?", applicationID).First(&offer).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, NewDomainError(ErrCodeNotFound, ErrOfferNotFound)
+		}
+		return nil, handleDatabaseError(err)
+	}
+	return &offer, nil
+}
+
+// GetOffersByApplicationIDs returns the offers of the given applications keyed by application ID.
+func (r *gormRepository) GetOffersByApplicationIDs(ctx context.Context, applicationIDs []uuid.UUID) (map[uuid.UUID]Offer, error) {
+	var offers []Offer
+	if err := r.db.WithContext(ctx).Where("job_application_id IN ?", applicationIDs).Find(&offers).Error; err != nil {
+		return nil, handleDatabaseError(err)
+	}
+	byApplication := make(map[uuid.UUID]Offer, len(offers))
+	for _, offer := range offers {
+		byApplication[offer.JobApplicationID] = offer
+	}
+	return byApplication, nil
+}
+
+func (r *gormRepository) ListOffers(ctx context.Context, userID uuid.UUID) ([]Offer, error) {
+	var offers []Offer
+	if err := r.db.WithContext(ctx).Where("user_id = ?", userID).Order("created_at ASC").Find(&offers).Error; err != nil {
+		return nil, handleDatabaseError(err)
+	}
+	return offers, nil
+}
+
+func (r *gormRepository) DeleteOffer(ctx context.Context, applicationID uuid.UUID) error {
+	result := r.db.WithContext(ctx).Where("job_application_id = ?", applicationID).Delete(&Offer{})
 	if result.Error != nil {
 		return handleDatabaseError(result.Error)
 	}
 	if result.RowsAffected == 0 {
-		return NewDomainError(ErrCodeNotFound, ErrApplicationNotFound)
+		return NewDomainError(ErrCodeNotFound, ErrOfferNotFound)
+	}
+	return nil
+}
+
+func (r *gormRepository) CreateExpense(ctx context.Context, expense *ApplicationExpense) error {
+	if err := expense.Validate(); err != nil {
+		return err
+	}
+	if err := r.db.WithContext(ctx).Create(expense).Error; err != nil {
+		return handleDatabaseError(err)
 	}
 	return nil
 }
 
+// ListExpenses returns the application's expenses, most recent first.
+func (r *gormRepository) ListExpenses(ctx context.Context, applicationID uuid.UUID) ([]ApplicationExpense, error) {
+	expenses := []ApplicationExpense{}
+	if err := r.db.WithContext(ctx).Where("job_application_id = ?", applicationID).
+		Order("incurred_at DESC").Order("created_at DESC").Find(&expenses).Error; err != nil {
+		return nil, handleDatabaseError(err)
+	}
+	return expenses, nil
+}
+
+func (r *gormRepository) ListExpensesByUser(ctx context.Context, userID uuid.UUID) ([]ApplicationExpense, error) {
+	var expenses []ApplicationExpense
+	if err := r.db.WithContext(ctx).Where("user_id = ?", userID).Find(&expenses).Error; err != nil {
+		return nil, handleDatabaseError(err)
+	}
+	return expenses, nil
+}
+
+// SaveCoverLetterRevision numbers and stores the revision and makes it the
+// application's current cover letter in one transaction. The application row is
+// locked so concurrent refinements get distinct revision numbers.
+func (r *gormRepository) SaveCoverLetterRevision(ctx context.Context, application *JobApplication, revision *CoverLetterRevision) error {
+	if err := revision.Validate(); err != nil {
+		return err
+	}
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var locked JobApplication
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id").Where("id = ?", application.ID).First(&locked).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return NewDomainError(ErrCodeNotFound, ErrApplicationNotFound)
+			}
+			return handleDatabaseError(err)
+		}
+
+		var latest int
+		if err := tx.Model(&CoverLetterRevision{}).Where("job_application_id = ?", application.ID).
+			Select("COALESCE(MAX(revision), 0)").Scan(&latest).Error; err != nil {
+			return handleDatabaseError(err)
+		}
+		revision.Revision = latest + 1
+		if err := tx.Create(revision).Error; err != nil {
+			return handleDatabaseError(err)
+		}
+
+		now := time.Now().UTC()
+		if err := tx.Model(&JobApplication{}).Where("id = ?", application.ID).Updates(map[string]interface{}{
+			"cover_letter": revision.Content,
+			"updated_at":   now,
+			"version":      gorm.Expr("version + 1"),
+		}).Error; err != nil {
+			return handleDatabaseError(err)
+		}
+		application.CoverLetter = revision.Content
+		application.UpdatedAt = now
+		application.Version++
+		return nil
+	})
+}
+
+// ListCoverLetterRevisions returns the application's revisions, newest first.
+func (r *gormRepository) ListCoverLetterRevisions(ctx context.Context, applicationID uuid.UUID) ([]CoverLetterRevision, error) {
+	revisions := []CoverLetterRevision{}
+	if err := r.db.WithContext(ctx).Where("job_application_id = ?", applicationID).Order("revision DESC").Find(&revisions).Error; err != nil {
+		return nil, handleDatabaseError(err)
+	}
+	return revisions, nil
+}
+
+// CountPinnedApplications returns how many of the user's applications are pinned.
+func (r *gormRepository) CountPinnedApplications(ctx context.Context, userID uuid.UUID) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&JobApplication{}).
+		Where("user_id = ? AND pin_order IS NOT NULL", userID).
+		Count(&count).Error
+	if err != nil {
+		return 0, handleDatabaseError(err)
+	}
+	return count, nil
+}
+
+// SaveStatusChange saves the application and appends change to its status history in one
+// transaction. The application row is locked and must still have change.FromStatus, so two
+// concurrent transitions cannot both start from the same status.
+func (r *gormRepository) SaveStatusChange(ctx context.Context, application *JobApplication, change *StatusChange) error {
+	if err := application.Validate(); err != nil {
+		return err
+	}
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var locked JobApplication
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id", "status").Where("id = ?", application.ID).First(&locked).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return NewDomainError(ErrCodeNotFound, ErrApplicationNotFound)
+			}
+			return handleDatabaseError(err)
+		}
+		if locked.Status != change.FromStatus {
+			return NewDomainError(ErrCodeInvalidTransition, ErrStatusChanged)
+		}
+
+		application.Version++
+		if err := tx.Save(application).Error; err != nil {
+			application.Version--
+			return handleDatabaseError(err)
+		}
+		if err := tx.Create(change).Error; err != nil {
+			application.Version--
+			return handleDatabaseError(err)
+		}
+		return nil
+	})
+}
+
+// ListStatusChanges returns the application's status history, newest first.
+func (r *gormRepository) ListStatusChanges(ctx context.Context, applicationID uuid.UUID) ([]StatusChange, error) {
+	changes := []StatusChange{}
+	if err := r.db.WithContext(ctx).Where("job_application_id = ?", applicationID).Order("created_at DESC").Find(&changes).Error; err != nil {
+		return nil, handleDatabaseError(err)
+	}
+	return changes, nil
+}
@@ -27,6 +27,7 @@ type Response struct {
 	ContactEmail      string       `gorm:"column:contact_email;size:255" json:"contactEmail,omitempty"`
 	ContactPhone      string       `gorm:"column:contact_phone;size:50" json:"contactPhone,omitempty"`
 	ResponseChannel   string       `gorm:"column:response_channel;size:50" json:"responseChannel,omitempty"` // "email", "phone", "linkedin", etc.
+	ContactID         *uuid.UUID   `gorm:"column:contact_id;type:uuid;index" json:"contactId,omitempty"`     // Links to a tracked application contact
 	CreatedAt         time.Time    `gorm:"column:created_at" json:"createdAt"`
 	UpdatedAt         time.Time    `gorm:"column:updated_at" json:"updatedAt"`
 }
@@ -86,6 +87,12 @@ func (r *Response) UpdateContactInfo(name, email, phone string) {
 	r.UpdatedAt = time.Now().UTC()
 }
 
+// LinkContact associates the response with a tracked application contact.
+func (r *Response) LinkContact(contactID *uuid.UUID) {
+	r.ContactID = contactID
+	r.UpdatedAt = time.Now().UTC()
+}
+
 // UpdateResponseChannel updates the response channel.
 func (r *Response) UpdateResponseChannel(channel string) {
 	r.ResponseChannel = channel
@@ -1,6 +1,10 @@
 package responses
 
-import "errors"
+import (
+	"errors"
+
+	"woragis-jobs-service/pkg/response"
+)
 
 const (
 	ErrCodeInvalidPayload        = 10100
@@ -18,17 +22,42 @@ const (
 	ErrUnableToPersist            = "responses: unable to persist data"
 	ErrUnableToFetch              = "responses: unable to fetch data"
 	ErrUnableToUpdate             = "responses: unable to update data"
+	ErrContactNotInApplication    = "responses: contact does not belong to this job application"
 )
 
 type DomainError struct {
 	Code    int
 	Message string
+	// cause is the underlying database error, kept so retries can tell transient failures apart
+	cause error
 }
 
 func (e *DomainError) Error() string {
 	return e.Message
 }
 
+// Unwrap returns the underlying error, if any.
+func (e *DomainError) Unwrap() error {
+	return e.cause
+}
+
+// ErrorKind classifies the error for response.FromDomainError.
+func (e *DomainError) ErrorKind() response.ErrorKind {
+	switch e.Code {
+	case ErrCodeInvalidPayload, ErrCodeInvalidResponseType:
+		return response.KindValidation
+	case ErrCodeNotFound:
+		return response.KindNotFound
+	default:
+		return response.KindInternal
+	}
+}
+
+// ErrorCode returns the numeric code sent in the error envelope.
+func (e *DomainError) ErrorCode() int {
+	return e.Code
+}
+
 func NewDomainError(code int, message string) *DomainError {
 	return &DomainError{
 		Code:    code,
@@ -36,6 +65,15 @@ func NewDomainError(code int, message string) *DomainError {
 	}
 }
 
+// newRepositoryError reports a failed database operation, keeping err as the cause.
+func newRepositoryError(message string, err error) *DomainError {
+	return &DomainError{
+		Code:    ErrCodeRepositoryFailure,
+		Message: message,
+		cause:   err,
+	}
+}
+
 func AsDomainError(err error) (*DomainError, bool) {
 	var domainErr *DomainError
 	if errors.As(err, &domainErr) {
@@ -7,6 +7,8 @@ import (
 	"github.com/gofiber/fiber/v2"
 	"github.com/google/uuid"
 
+	"woragis-jobs-service/pkg/middleware"
+	"woragis-jobs-service/pkg/pagination"
 	"woragis-jobs-service/pkg/response"
 )
 
@@ -41,6 +43,7 @@ type createResponsePayload struct {
 	ContactEmail     string       `json:"contactEmail,omitempty"`
 	ContactPhone     string       `json:"contactPhone,omitempty"`
 	ResponseChannel  string       `json:"responseChannel,omitempty"`
+	ContactID        *uuid.UUID   `json:"contactId,omitempty"`
 }
 
 type updateResponsePayload struct {
@@ -48,7 +51,8 @@ type updateResponsePayload struct {
 	ContactPerson   *string `json:"contactPerson,omitempty"`
 	ContactEmail    *string `json:"contactEmail,omitempty"`
 	ContactPhone    *string `json:"contactPhone,omitempty"`
-	ResponseChannel *string `json:"responseChannel,omitempty"`
+	ResponseChannel *string    `json:"responseChannel,omitempty"`
+	ContactID       *uuid.UUID `json:"contactId,omitempty"` // uuid.Nil unlinks the contact
 }
 
 func (h *handler) CreateResponse(c *fiber.Ctx) error {
@@ -95,7 +99,7 @@ func (h *handler) CreateResponse(c *fiber.Ctx) error {
 	}
 
 	// Update additional fields if provided
-	if payload.Message != "" || payload.ContactPerson != "" || payload.ContactEmail != "" || payload.ContactPhone != "" || payload.ResponseChannel != "" {
+	if payload.Message != "" || payload.ContactPerson != "" || payload.ContactEmail != "" || payload.ContactPhone != "" || payload.ResponseChannel != "" || payload.ContactID != nil {
 		updates := UpdateResponseRequest{}
 		if payload.Message != "" {
 			updates.Message = &payload.Message
@@ -112,6 +116,7 @@ func (h *handler) CreateResponse(c *fiber.Ctx) error {
 		if payload.ResponseChannel != "" {
 			updates.ResponseChannel = &payload.ResponseChannel
 		}
+		updates.ContactID = payload.ContactID
 		resp, err = h.service.UpdateResponse(c.Context(), resp.ID, updates)
 		if err != nil {
 			return h.handleError(c, err)
@@ -122,11 +127,9 @@ func (h *handler) CreateResponse(c *fiber.Ctx) error {
 }
 
 func (h *handler) GetResponse(c *fiber.Ctx) error {
-	responseID, err := uuid.Parse(c.Params("id"))
+	responseID, err := middleware.UUIDParam(c, "id")
 	if err != nil {
-		return response.Error(c, fiber.StatusBadRequest, ErrCodeInvalidPayload, fiber.Map{
-			"message": "invalid response id",
-		})
+		return middleware.InvalidUUIDParam(c, "id")
 	}
 
 	resp, err := h.service.GetResponse(c.Context(), responseID)
@@ -157,31 +160,58 @@ func (h *handler) ListResponses(c *fiber.Ctx) error {
 		filters.ResponseType = &responseType
 	}
 
-	// Pagination
-	if limit := c.QueryInt("limit", 50); limit > 0 {
-		filters.Limit = limit
+	// Ordering: ?sort=createdAt|responseDate&order=asc|desc, oldest first by default
+	switch sortBy := c.Query("sort", SortByCreatedAt); sortBy {
+	case SortByCreatedAt, SortByResponseDate:
+		filters.SortBy = sortBy
+	default:
+		return response.Error(c, fiber.StatusBadRequest, ErrCodeInvalidPayload, fiber.Map{
+			"message": "sort: must be createdAt or responseDate",
+		})
 	}
-	if offset := c.QueryInt("offset", 0); offset > 0 {
-		filters.Offset = offset
+	switch order := c.Query("order", "asc"); order {
+	case "asc", "desc":
+		filters.SortDesc = order == "desc"
+	default:
+		return response.Error(c, fiber.StatusBadRequest, ErrCodeInvalidPayload, fiber.Map{
+			"message": "order: must be asc or desc",
+		})
+	}
+
+	// Pagination
+	page, err := pagination.FromQuery(c)
+	if err != nil {
+		return response.Error(c, fiber.StatusBadRequest, ErrCodeInvalidPayload, fiber.Map{
+			"message": err.Error(),
+		})
 	}
+	filters.Limit = page.Limit
+	filters.Offset = page.Offset
+	page.SetHeaders(c)
 
 	responses, err := h.service.ListResponses(c.Context(), filters)
 	if err != nil {
 		return h.handleError(c, err)
 	}
+	total, err := h.service.CountResponses(c.Context(), filters)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+	page.SetLinkHeader(c, len(responses), total)
 
 	return response.Success(c, fiber.StatusOK, fiber.Map{
 		"responses": responses,
 		"count":     len(responses),
+		"total":     total,
+		"limit":     page.Limit,
+		"offset":    page.Offset,
 	})
 }
 
 func (h *handler) UpdateResponse(c *fiber.Ctx) error {
-	responseID, err := uuid.Parse(c.Params("id"))
+	responseID, err := middleware.UUIDParam(c, "id")
 	if err != nil {
-		return response.Error(c, fiber.StatusBadRequest, ErrCodeInvalidPayload, fiber.Map{
-			"message": "invalid response id",
-		})
+		return middleware.InvalidUUIDParam(c, "id")
 	}
 
 	var payload updateResponsePayload
@@ -202,11 +232,9 @@ func (h *handler) UpdateResponse(c *fiber.Ctx) error {
 }
 
 func (h *handler) DeleteResponse(c *fiber.Ctx) error {
-	responseID, err := uuid.Parse(c.Params("id"))
+	responseID, err := middleware.UUIDParam(c, "id")
 	if err != nil {
-		return response.Error(c, fiber.StatusBadRequest, ErrCodeInvalidPayload, fiber.Map{
-			"message": "invalid response id",
-		})
+		return middleware.InvalidUUIDParam(c, "id")
 	}
 
 	if err := h.service.DeleteResponse(c.Context(), responseID); err != nil {
@@ -220,23 +248,9 @@ func (h *handler) DeleteResponse(c *fiber.Ctx) error {
 
 
 func (h *handler) handleError(c *fiber.Ctx, err error) error {
-	if domainErr, ok := AsDomainError(err); ok {
-		statusCode := fiber.StatusInternalServerError
-		switch domainErr.Code {
-		case ErrCodeNotFound:
-			statusCode = fiber.StatusNotFound
-		case ErrCodeInvalidPayload, ErrCodeInvalidResponseType:
-			statusCode = fiber.StatusBadRequest
-		}
-
-		return response.Error(c, statusCode, domainErr.Code, fiber.Map{
-			"message": domainErr.Message,
-		})
+	if _, ok := AsDomainError(err); !ok {
+		h.logger.ErrorContext(c.UserContext(), "unhandled error", slog.Any("error", err))
 	}
-
-	h.logger.Error("unhandled error", slog.Any("error", err))
-	return response.Error(c, fiber.StatusInternalServerError, 500, fiber.Map{
-		"message": "internal server error",
-	})
+	return response.FromDomainError(c, err)
 }
 
@@ -14,14 +14,29 @@ type Repository interface {
 	UpdateResponse(ctx context.Context, response *Response) error
 	GetResponse(ctx context.Context, responseID uuid.UUID) (*Response, error)
 	ListResponses(ctx context.Context, filters ResponseFilters) ([]Response, error)
+	CountResponses(ctx context.Context, filters ResponseFilters) (int64, error)
 	DeleteResponse(ctx context.Context, responseID uuid.UUID) error
 	GetResponsesByApplicationID(ctx context.Context, applicationID uuid.UUID) ([]Response, error)
 }
 
+// Fields responses can be sorted by
+const (
+	SortByCreatedAt    = "createdAt"
+	SortByResponseDate = "responseDate"
+)
+
+// sortColumns maps the sort fields to their columns.
+var sortColumns = map[string]string{
+	SortByCreatedAt:    "created_at",
+	SortByResponseDate: "response_date",
+}
+
 // ResponseFilters represents filtering options for listing responses.
 type ResponseFilters struct {
 	JobApplicationID *uuid.UUID
 	ResponseType     *ResponseType
+	SortBy           string // SortByCreatedAt (default) or SortByResponseDate
+	SortDesc         bool
 	Limit            int
 	Offset           int
 }
@@ -55,21 +70,16 @@ func (r *gormRepository) GetResponse(ctx context.Context, responseID uuid.UUID)
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, NewDomainError(ErrCodeNotFound, ErrResponseNotFound)
 		}
-		return nil, NewDomainError(ErrCodeRepositoryFailure, ErrUnableToFetch)
+		return nil, newRepositoryError(ErrUnableToFetch, err)
 	}
 	return &response, nil
 }
 
+// ListResponses returns the responses matching filters, oldest first unless another
+// order is requested. Ties are broken by id so pages do not overlap.
 func (r *gormRepository) ListResponses(ctx context.Context, filters ResponseFilters) ([]Response, error) {
 	var responses []Response
-	query := r.db.WithContext(ctx).Model(&Response{})
-
-	if filters.JobApplicationID != nil {
-		query = query.Where("job_application_id = ?", *filters.JobApplicationID)
-	}
-	if filters.ResponseType != nil {
-		query = query.Where("response_type = ?", *filters.ResponseType)
-	}
+	query := r.filteredQuery(ctx, filters)
 
 	if filters.Limit > 0 {
 		query = query.Limit(filters.Limit)
@@ -78,19 +88,47 @@ func (r *gormRepository) ListResponses(ctx context.Context, filters ResponseFilt
 		query = query.Offset(filters.Offset)
 	}
 
-	query = query.Order("response_date DESC")
+	column, ok := sortColumns[filters.SortBy]
+	if !ok {
+		column = sortColumns[SortByCreatedAt]
+	}
+	direction := "ASC"
+	if filters.SortDesc {
+		direction = "DESC"
+	}
+	query = query.Order(column + " " + direction).Order("id " + direction)
 
 	if err := query.Find(&responses).Error; err != nil {
-		return nil, NewDomainError(ErrCodeRepositoryFailure, ErrUnableToFetch)
+		return nil, newRepositoryError(ErrUnableToFetch, err)
 	}
 
 	return responses, nil
 }
 
+// CountResponses returns how many responses match filters, ignoring the limit and offset.
+func (r *gormRepository) CountResponses(ctx context.Context, filters ResponseFilters) (int64, error) {
+	var total int64
+	if err := r.filteredQuery(ctx, filters).Count(&total).Error; err != nil {
+		return 0, newRepositoryError(ErrUnableToFetch, err)
+	}
+	return total, nil
+}
+
+func (r *gormRepository) filteredQuery(ctx context.Context, filters ResponseFilters) *gorm.DB {
+	query := r.db.WithContext(ctx).Model(&Response{})
+	if filters.JobApplicationID != nil {
+		query = query.Where("job_application_id = ?", *filters.JobApplicationID)
+	}
+	if filters.ResponseType != nil {
+		query = query.Where("response_type = ?", *filters.ResponseType)
+	}
+	return query
+}
+
 func (r *gormRepository) DeleteResponse(ctx context.Context, responseID uuid.UUID) error {
 	result := r.db.WithContext(ctx).Delete(&Response{}, responseID)
 	if result.Error != nil {
-		return NewDomainError(ErrCodeRepositoryFailure, ErrUnableToUpdate)
+		return newRepositoryError(ErrUnableToUpdate, result.Error)
 	}
 	if result.RowsAffected == 0 {
 		return NewDomainError(ErrCodeNotFound, ErrResponseNotFound)
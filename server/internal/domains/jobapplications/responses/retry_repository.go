@@ -0,0 +1,63 @@
+package responses
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"woragis-jobs-service/internal/database"
+)
+
+// retryingRepository retries calls to the wrapped repository after transient connection
+// errors, so a brief Postgres failover does not fail the request. Services are unaware of it.
+type retryingRepository struct {
+	next   Repository
+	policy database.RetryPolicy
+}
+
+// NewRetryingRepository wraps repo so every call is retried according to policy.
+func NewRetryingRepository(repo Repository, policy database.RetryPolicy) Repository {
+	return &retryingRepository{next: repo, policy: policy}
+}
+
+func (r *retryingRepository) CreateResponse(ctx context.Context, response *Response) error {
+	return r.policy.Do(ctx, "CreateResponse", func() error {
+		return r.next.CreateResponse(ctx, response)
+	})
+}
+
+func (r *retryingRepository) UpdateResponse(ctx context.Context, response *Response) error {
+	return r.policy.Do(ctx, "UpdateResponse", func() error {
+		return r.next.UpdateResponse(ctx, response)
+	})
+}
+
+func (r *retryingRepository) GetResponse(ctx context.Context, responseID uuid.UUID) (*Response, error) {
+	return database.Retry(ctx, r.policy, "GetResponse", func() (*Response, error) {
+		return r.next.GetResponse(ctx, responseID)
+	})
+}
+
+func (r *retryingRepository) ListResponses(ctx context.Context, filters ResponseFilters) ([]Response, error) {
+	return database.Retry(ctx, r.policy, "ListResponses", func() ([]Response, error) {
+		return r.next.ListResponses(ctx, filters)
+	})
+}
+
+func (r *retryingRepository) CountResponses(ctx context.Context, filters ResponseFilters) (int64, error) {
+	return database.Retry(ctx, r.policy, "CountResponses", func() (int64, error) {
+		return r.next.CountResponses(ctx, filters)
+	})
+}
+
+func (r *retryingRepository) DeleteResponse(ctx context.Context, responseID uuid.UUID) error {
+	return r.policy.Do(ctx, "DeleteResponse", func() error {
+		return r.next.DeleteResponse(ctx, responseID)
+	})
+}
+
+func (r *retryingRepository) GetResponsesByApplicationID(ctx context.Context, applicationID uuid.UUID) ([]Response, error) {
+	return database.Retry(ctx, r.policy, "GetResponsesByApplicationID", func() ([]Response, error) {
+		return r.next.GetResponsesByApplicationID(ctx, applicationID)
+	})
+}
@@ -1,15 +1,20 @@
 package responses
 
-import "github.com/gofiber/fiber/v2"
+import (
+	"github.com/gofiber/fiber/v2"
+
+	"woragis-jobs-service/pkg/middleware"
+)
 
 // SetupRoutes registers response endpoints.
 // The routes are nested under /job-applications/:applicationId/responses
 // so applicationId is available in the route params.
 func SetupRoutes(api fiber.Router, handler Handler) {
+	id := middleware.ValidateUUIDParam("id")
 	api.Post("/", handler.CreateResponse)
 	api.Get("/", handler.ListResponses)
-	api.Get("/:id", handler.GetResponse)
-	api.Patch("/:id", handler.UpdateResponse)
-	api.Delete("/:id", handler.DeleteResponse)
+	api.Get("/:id", id, handler.GetResponse)
+	api.Patch("/:id", id, handler.UpdateResponse)
+	api.Delete("/:id", id, handler.DeleteResponse)
 }
 
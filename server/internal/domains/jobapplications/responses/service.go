@@ -13,6 +13,7 @@ type Service interface {
 	CreateResponse(ctx context.Context, jobApplicationID uuid.UUID, responseType ResponseType, responseDate time.Time) (*Response, error)
 	GetResponse(ctx context.Context, responseID uuid.UUID) (*Response, error)
 	ListResponses(ctx context.Context, filters ResponseFilters) ([]Response, error)
+	CountResponses(ctx context.Context, filters ResponseFilters) (int64, error)
 	UpdateResponse(ctx context.Context, responseID uuid.UUID, updates UpdateResponseRequest) (*Response, error)
 	DeleteResponse(ctx context.Context, responseID uuid.UUID) error
 	GetResponsesByApplicationID(ctx context.Context, applicationID uuid.UUID) ([]Response, error)
@@ -25,6 +26,7 @@ type UpdateResponseRequest struct {
 	ContactEmail    *string
 	ContactPhone    *string
 	ResponseChannel *string
+	ContactID       *uuid.UUID
 }
 
 // ResumeMetricsService is an interface to avoid circular dependencies
@@ -37,6 +39,12 @@ type JobApplicationService interface {
 	GetJobApplication(ctx context.Context, applicationID uuid.UUID) (*JobApplication, error)
 }
 
+// ContactDirectory looks up the contacts tracked for a job application (minimal interface)
+type ContactDirectory interface {
+	ContactBelongsToApplication(ctx context.Context, applicationID, contactID uuid.UUID) (bool, error)
+	FindContactIDByEmail(ctx context.Context, applicationID uuid.UUID, email string) (*uuid.UUID, error)
+}
+
 // JobApplication represents a job application (minimal interface)
 type JobApplication struct {
 	ID       uuid.UUID
@@ -47,6 +55,7 @@ type service struct {
 	repo                 Repository
 	jobApplicationService JobApplicationService // Optional: to get resumeId
 	resumeMetricsService ResumeMetricsService   // Optional: for updating resume metrics
+	contacts             ContactDirectory       // Optional: for linking responses to contacts
 	logger               *slog.Logger
 }
 
@@ -68,6 +77,15 @@ func NewServiceWithDependencies(repo Repository, jobApplicationService JobApplic
 	}
 }
 
+// NewServiceWithContacts constructs a Service that links responses to application contacts.
+func NewServiceWithContacts(repo Repository, contacts ContactDirectory, logger *slog.Logger) Service {
+	return &service{
+		repo:     repo,
+		contacts: contacts,
+		logger:   logger,
+	}
+}
+
 func (s *service) CreateResponse(ctx context.Context, jobApplicationID uuid.UUID, responseType ResponseType, responseDate time.Time) (*Response, error) {
 	response, err := NewResponse(jobApplicationID, responseType, responseDate)
 	if err != nil {
@@ -83,7 +101,7 @@ func (s *service) CreateResponse(ctx context.Context, jobApplicationID uuid.UUID
 		application, err := s.jobApplicationService.GetJobApplication(ctx, jobApplicationID)
 		if err == nil && application != nil && application.ResumeID != nil {
 			if err := s.resumeMetricsService.RecalculateResumeMetrics(ctx, *application.ResumeID); err != nil {
-				s.logger.Warn("failed to recalculate resume metrics after creating offer response", "resume_id", application.ResumeID.String(), "error", err)
+				s.logger.WarnContext(ctx, "failed to recalculate resume metrics after creating offer response", "resume_id", application.ResumeID.String(), "error", err)
 				// Don't fail the request if metric recalculation fails
 			}
 		}
@@ -100,6 +118,11 @@ func (s *service) ListResponses(ctx context.Context, filters ResponseFilters) ([
 	return s.repo.ListResponses(ctx, filters)
 }
 
+// CountResponses returns how many responses match filters, ignoring the limit and offset.
+func (s *service) CountResponses(ctx context.Context, filters ResponseFilters) (int64, error) {
+	return s.repo.CountResponses(ctx, filters)
+}
+
 func (s *service) UpdateResponse(ctx context.Context, responseID uuid.UUID, updates UpdateResponseRequest) (*Response, error) {
 	response, err := s.repo.GetResponse(ctx, responseID)
 	if err != nil {
@@ -136,6 +159,10 @@ func (s *service) UpdateResponse(ctx context.Context, responseID uuid.UUID, upda
 		response.UpdateResponseChannel(*updates.ResponseChannel)
 	}
 
+	if err := s.linkContact(ctx, response, updates.ContactID); err != nil {
+		return nil, err
+	}
+
 	if err := s.repo.UpdateResponse(ctx, response); err != nil {
 		return nil, err
 	}
@@ -143,6 +170,45 @@ func (s *service) UpdateResponse(ctx context.Context, responseID uuid.UUID, upda
 	return response, nil
 }
 
+// linkContact attaches the response to an application contact, either the one given
+// explicitly or, when none is linked yet, the contact whose email matches contactEmail.
+func (s *service) linkContact(ctx context.Context, response *Response, contactID *uuid.UUID) error {
+	if s.contacts == nil {
+		return nil
+	}
+
+	if contactID != nil {
+		if *contactID == uuid.Nil {
+			response.LinkContact(nil)
+			return nil
+		}
+		ok, err := s.contacts.ContactBelongsToApplication(ctx, response.JobApplicationID, *contactID)
+		if err != nil {
+			return NewDomainError(ErrCodeRepositoryFailure, ErrUnableToFetch)
+		}
+		if !ok {
+			return NewDomainError(ErrCodeInvalidPayload, ErrContactNotInApplication)
+		}
+		response.LinkContact(contactID)
+		return nil
+	}
+
+	if response.ContactID != nil || response.ContactEmail == "" {
+		return nil
+	}
+
+	matchedID, err := s.contacts.FindContactIDByEmail(ctx, response.JobApplicationID, response.ContactEmail)
+	if err != nil {
+		// Auto-linking is best effort; the response itself is still valid
+		s.logger.WarnContext(ctx, "failed to match response to contact", "response_id", response.ID.String(), "error", err)
+		return nil
+	}
+	if matchedID != nil {
+		response.LinkContact(matchedID)
+	}
+	return nil
+}
+
 func (s *service) DeleteResponse(ctx context.Context, responseID uuid.UUID) error {
 	return s.repo.DeleteResponse(ctx, responseID)
 }
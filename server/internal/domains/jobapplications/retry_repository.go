@@ -0,0 +1,245 @@
+package jobapplications
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"woragis-jobs-service/internal/database"
+	"woragis-jobs-service/internal/domains/jobapplications/responses"
+)
+
+// retryingRepository retries calls to the wrapped repository after transient connection
+// errors, so a brief Postgres failover does not fail the request. Services are unaware of it.
+type retryingRepository struct {
+	next   Repository
+	policy database.RetryPolicy
+}
+
+// NewRetryingRepository wraps repo so every call is retried according to policy.
+func NewRetryingRepository(repo Repository, policy database.RetryPolicy) Repository {
+	return &retryingRepository{next: repo, policy: policy}
+}
+
+func (r *retryingRepository) CreateJobApplication(ctx context.Context, application *JobApplication) error {
+	return r.policy.Do(ctx, "CreateJobApplication", func() error {
+		return r.next.CreateJobApplication(ctx, application)
+	})
+}
+
+func (r *retryingRepository) UpdateJobApplication(ctx context.Context, application *JobApplication) error {
+	return r.policy.Do(ctx, "UpdateJobApplication", func() error {
+		return r.next.UpdateJobApplication(ctx, application)
+	})
+}
+
+func (r *retryingRepository) GetJobApplication(ctx context.Context, applicationID uuid.UUID) (*JobApplication, error) {
+	return database.Retry(ctx, r.policy, "GetJobApplication", func() (*JobApplication, error) {
+		return r.next.GetJobApplication(ctx, applicationID)
+	})
+}
+
+func (r *retryingRepository) GetJobApplicationsByIDs(ctx context.Context, userID uuid.UUID, applicationIDs []uuid.UUID) ([]JobApplication, error) {
+	return database.Retry(ctx, r.policy, "GetJobApplicationsByIDs", func() ([]JobApplication, error) {
+		return r.next.GetJobApplicationsByIDs(ctx, userID, applicationIDs)
+	})
+}
+
+func (r *retryingRepository) FindJobApplicationByURL(ctx context.Context, userID uuid.UUID, jobURLs []string) (*JobApplication, error) {
+	return database.Retry(ctx, r.policy, "FindJobApplicationByURL", func() (*JobApplication, error) {
+		return r.next.FindJobApplicationByURL(ctx, userID, jobURLs)
+	})
+}
+
+func (r *retryingRepository) ListJobApplications(ctx context.Context, filters JobApplicationFilters) ([]JobApplication, error) {
+	return database.Retry(ctx, r.policy, "ListJobApplications", func() ([]JobApplication, error) {
+		return r.next.ListJobApplications(ctx, filters)
+	})
+}
+
+func (r *retryingRepository) CountJobApplications(ctx context.Context, filters JobApplicationFilters) (int64, error) {
+	return database.Retry(ctx, r.policy, "CountJobApplications", func() (int64, error) {
+		return r.next.CountJobApplications(ctx, filters)
+	})
+}
+
+func (r *retryingRepository) ListJobApplicationsByCompany(ctx context.Context, userID uuid.UUID, companyName string, limit, offset int) ([]JobApplication, error) {
+	return database.Retry(ctx, r.policy, "ListJobApplicationsByCompany", func() ([]JobApplication, error) {
+		return r.next.ListJobApplicationsByCompany(ctx, userID, companyName, limit, offset)
+	})
+}
+
+func (r *retryingRepository) ListCompanies(ctx context.Context, userID uuid.UUID, limit, offset int) ([]CompanySummary, error) {
+	return database.Retry(ctx, r.policy, "ListCompanies", func() ([]CompanySummary, error) {
+		return r.next.ListCompanies(ctx, userID, limit, offset)
+	})
+}
+
+func (r *retryingRepository) CountCompanies(ctx context.Context, userID uuid.UUID) (int64, error) {
+	return database.Retry(ctx, r.policy, "CountCompanies", func() (int64, error) {
+		return r.next.CountCompanies(ctx, userID)
+	})
+}
+
+func (r *retryingRepository) ListUpcomingDeadlines(ctx context.Context, userID uuid.UUID, after time.Time, activeOnly bool, limit, offset int) ([]JobApplication, error) {
+	return database.Retry(ctx, r.policy, "ListUpcomingDeadlines", func() ([]JobApplication, error) {
+		return r.next.ListUpcomingDeadlines(ctx, userID, after, activeOnly, limit, offset)
+	})
+}
+
+func (r *retryingRepository) DeleteJobApplication(ctx context.Context, applicationID uuid.UUID) error {
+	return r.policy.Do(ctx, "DeleteJobApplication", func() error {
+		return r.next.DeleteJobApplication(ctx, applicationID)
+	})
+}
+
+func (r *retryingRepository) DeleteJobApplicationsByFilter(ctx context.Context, userID uuid.UUID, filter BulkDeleteFilter) ([]uuid.UUID, error) {
+	return database.Retry(ctx, r.policy, "DeleteJobApplicationsByFilter", func() ([]uuid.UUID, error) {
+		return r.next.DeleteJobApplicationsByFilter(ctx, userID, filter)
+	})
+}
+
+func (r *retryingRepository) ReassignWebsite(ctx context.Context, userID uuid.UUID, from, to string) ([]uuid.UUID, error) {
+	return database.Retry(ctx, r.policy, "ReassignWebsite", func() ([]uuid.UUID, error) {
+		return r.next.ReassignWebsite(ctx, userID, from, to)
+	})
+}
+
+func (r *retryingRepository) FilterOwnedIDs(ctx context.Context, userID uuid.UUID, applicationIDs []uuid.UUID) ([]uuid.UUID, error) {
+	return database.Retry(ctx, r.policy, "FilterOwnedIDs", func() ([]uuid.UUID, error) {
+		return r.next.FilterOwnedIDs(ctx, userID, applicationIDs)
+	})
+}
+
+func (r *retryingRepository) AddTagToApplications(ctx context.Context, userID uuid.UUID, applicationIDs []uuid.UUID, tag string) (*BulkTagResult, error) {
+	return database.Retry(ctx, r.policy, "AddTagToApplications", func() (*BulkTagResult, error) {
+		return r.next.AddTagToApplications(ctx, userID, applicationIDs, tag)
+	})
+}
+
+func (r *retryingRepository) SetInterestLevel(ctx context.Context, userID uuid.UUID, applicationIDs []uuid.UUID, level InterestLevel) (*BulkInterestResult, error) {
+	return database.Retry(ctx, r.policy, "SetInterestLevel", func() (*BulkInterestResult, error) {
+		return r.next.SetInterestLevel(ctx, userID, applicationIDs, level)
+	})
+}
+
+func (r *retryingRepository) RemoveTagFromApplications(ctx context.Context, userID uuid.UUID, applicationIDs []uuid.UUID, tag string) (*BulkTagResult, error) {
+	return database.Retry(ctx, r.policy, "RemoveTagFromApplications", func() (*BulkTagResult, error) {
+		return r.next.RemoveTagFromApplications(ctx, userID, applicationIDs, tag)
+	})
+}
+
+func (r *retryingRepository) ContactApplications(ctx context.Context, userID uuid.UUID, applicationIDs []uuid.UUID, response responses.Response) (*BulkContactResult, error) {
+	return database.Retry(ctx, r.policy, "ContactApplications", func() (*BulkContactResult, error) {
+		return r.next.ContactApplications(ctx, userID, applicationIDs, response)
+	})
+}
+
+func (r *retryingRepository) ListReminderCandidates(ctx context.Context, dayStart, deadlineDayStart, now time.Time, limit int) ([]JobApplication, error) {
+	return database.Retry(ctx, r.policy, "ListReminderCandidates", func() ([]JobApplication, error) {
+		return r.next.ListReminderCandidates(ctx, dayStart, deadlineDayStart, now, limit)
+	})
+}
+
+func (r *retryingRepository) ClaimReminder(ctx context.Context, applicationID uuid.UUID, remindedBefore, remindedAt time.Time) (bool, error) {
+	return database.Retry(ctx, r.policy, "ClaimReminder", func() (bool, error) {
+		return r.next.ClaimReminder(ctx, applicationID, remindedBefore, remindedAt)
+	})
+}
+
+func (r *retryingRepository) ReleaseReminder(ctx context.Context, applicationID uuid.UUID, remindedAt time.Time, previous *time.Time) error {
+	return r.policy.Do(ctx, "ReleaseReminder", func() error {
+		return r.next.ReleaseReminder(ctx, applicationID, remindedAt, previous)
+	})
+}
+
+func (r *retryingRepository) SaveOffer(ctx context.Context, offer *Offer) error {
+	return r.policy.Do(ctx, "SaveOffer", func() error {
+		return r.next.SaveOffer(ctx, offer)
+	})
+}
+
+func (r *retryingRepository) GetOffer(ctx context.Context, applicationID uuid.UUID) (*Offer, error) {
+	return database.Retry(ctx, r.policy, "GetOffer", func() (*Offer, error) {
+		return r.next.GetOffer(ctx, applicationID)
+	})
+}
+
+func (r *retryingRepository) GetOffersByApplicationIDs(ctx context.Context, applicationIDs []uuid.UUID) (map[uuid.UUID]Offer, error) {
+	return database.Retry(ctx, r.policy, "GetOffersByApplicationIDs", func() (map[uuid.UUID]Offer, error) {
+		return r.next.GetOffersByApplicationIDs(ctx, applicationIDs)
+	})
+}
+
+func (r *retryingRepository) ListOffers(ctx context.Context, userID uuid.UUID) ([]Offer, error) {
+	return database.Retry(ctx, r.policy, "ListOffers", func() ([]Offer, error) {
+		return r.next.ListOffers(ctx, userID)
+	})
+}
+
+func (r *retryingRepository) CreateExpense(ctx context.Context, expense *ApplicationExpense) error {
+	return r.policy.Do(ctx, "CreateExpense", func() error {
+		return r.next.CreateExpense(ctx, expense)
+	})
+}
+
+func (r *retryingRepository) ListExpenses(ctx context.Context, applicationID uuid.UUID) ([]ApplicationExpense, error) {
+	return database.Retry(ctx, r.policy, "ListExpenses", func() ([]ApplicationExpense, error) {
+		return r.next.ListExpenses(ctx, applicationID)
+	})
+}
+
+func (r *retryingRepository) ListExpensesByUser(ctx context.Context, userID uuid.UUID) ([]ApplicationExpense, error) {
+	return database.Retry(ctx, r.policy, "ListExpensesByUser", func() ([]ApplicationExpense, error) {
+		return r.next.ListExpensesByUser(ctx, userID)
+	})
+}
+
+func (r *retryingRepository) DeleteOffer(ctx context.Context, applicationID uuid.UUID) error {
+	return r.policy.Do(ctx, "DeleteOffer", func() error {
+		return r.next.DeleteOffer(ctx, applicationID)
+	})
+}
+
+func (r *retryingRepository) SaveCoverLetterRevision(ctx context.Context, application *JobApplication, revision *CoverLetterRevision) error {
+	return r.policy.Do(ctx, "SaveCoverLetterRevision", func() error {
+		return r.next.SaveCoverLetterRevision(ctx, application, revision)
+	})
+}
+
+func (r *retryingRepository) ListCoverLetterRevisions(ctx context.Context, applicationID uuid.UUID) ([]CoverLetterRevision, error) {
+	return database.Retry(ctx, r.policy, "ListCoverLetterRevisions", func() ([]CoverLetterRevision, error) {
+		return r.next.ListCoverLetterRevisions(ctx, applicationID)
+	})
+}
+
+func (r *retryingRepository) CountPinnedApplications(ctx context.Context, userID uuid.UUID) (int64, error) {
+	return database.Retry(ctx, r.policy, "CountPinnedApplications", func() (int64, error) {
+		return r.next.CountPinnedApplications(ctx, userID)
+	})
+}
+
+func (r *retryingRepository) CountApplicationsByInterestLevel(ctx context.Context, userID uuid.UUID) (map[string]int64, error) {
+	return database.Retry(ctx, r.policy, "CountApplicationsByInterestLevel", func() (map[string]int64, error) {
+		return r.next.CountApplicationsByInterestLevel(ctx, userID)
+	})
+}
+
+func (r *retryingRepository) CountApplicationsPerDay(ctx context.Context, userID uuid.UUID, metric TimeSeriesMetric, since time.Time) (map[string]int64, error) {
+	return database.Retry(ctx, r.policy, "CountApplicationsPerDay", func() (map[string]int64, error) {
+		return r.next.CountApplicationsPerDay(ctx, userID, metric, since)
+	})
+}
+
+func (r *retryingRepository) SaveStatusChange(ctx context.Context, application *JobApplication, change *StatusChange) error {
+	return r.policy.Do(ctx, "SaveStatusChange", func() error {
+		return r.next.SaveStatusChange(ctx, application, change)
+	})
+}
+
+func (r *retryingRepository) ListStatusChanges(ctx context.Context, applicationID uuid.UUID) ([]StatusChange, error) {
+	return database.Retry(ctx, r.policy, "ListStatusChanges", func() ([]StatusChange, error) {
+		return r.next.ListStatusChanges(ctx, applicationID)
+	})
+}
@@ -0,0 +1,64 @@
+package jobapplications
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"woragis-jobs-service/internal/database"
+)
+
+// flakyRepo fails the first failures calls with err, then serves the stored application.
+type flakyRepo struct {
+	Repository
+	failures    int
+	err         error
+	calls       int
+	application *JobApplication
+}
+
+func (r *flakyRepo) GetJobApplication(_ context.Context, _ uuid.UUID) (*JobApplication, error) {
+	r.calls++
+	if r.calls <= r.failures {
+		return nil, r.err
+	}
+	return r.application, nil
+}
+
+func (r *flakyRepo) UpdateJobApplication(_ context.Context, _ *JobApplication) error {
+	r.calls++
+	if r.calls <= r.failures {
+		return r.err
+	}
+	return nil
+}
+
+func TestRetryingRepository(t *testing.T) {
+	policy := database.RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond, MaxBackoff: time.Millisecond}
+	application := &JobApplication{ID: uuid.New()}
+
+	t.Run("retries a failover", func(t *testing.T) {
+		// The GORM repository converts driver errors to domain errors; the cause must survive
+		flaky := &flakyRepo{failures: 2, err: handleDatabaseError(&pgconn.PgError{Code: "57P01"}), application: application}
+		repo := NewRetryingRepository(flaky, policy)
+
+		got, err := repo.GetJobApplication(context.Background(), application.ID)
+		require.NoError(t, err)
+		assert.Equal(t, application, got)
+		assert.Equal(t, 3, flaky.calls)
+	})
+
+	t.Run("does not retry constraint violations", func(t *testing.T) {
+		flaky := &flakyRepo{failures: 1, err: handleDatabaseError(&pgconn.PgError{Code: "23505"})}
+		repo := NewRetryingRepository(flaky, policy)
+
+		err := repo.UpdateJobApplication(context.Background(), application)
+		assert.EqualError(t, err, ErrDatabaseUniqueViolation)
+		assert.Equal(t, 1, flaky.calls)
+	})
+}
@@ -3,23 +3,67 @@ package jobapplications
 import (
 	"github.com/gofiber/fiber/v2"
 	
+	"woragis-jobs-service/internal/domains/jobapplications/contacts"
 	"woragis-jobs-service/internal/domains/jobapplications/responses"
 	"woragis-jobs-service/internal/domains/jobapplications/interviewstages"
+	"woragis-jobs-service/pkg/middleware"
 )
 
 // SetupRoutes registers job application endpoints and subdomain routes.
-func SetupRoutes(api fiber.Router, handler Handler, responseHandler responses.Handler, stageHandler interviewstages.Handler) {
+func SetupRoutes(api fiber.Router, handler Handler, responseHandler responses.Handler, stageHandler interviewstages.Handler, contactHandler contacts.Handler) {
+	id := middleware.ValidateUUIDParam("id")
+	applicationID := middleware.ValidateUUIDParam("applicationId")
+
 	// Main job application routes
 	api.Post("/", handler.CreateJobApplication)
 	api.Get("/", handler.ListJobApplications)
-	api.Get("/:id", handler.GetJobApplication)
-	api.Patch("/:id/status", handler.UpdateJobApplicationStatus)
-	api.Patch("/:id", handler.UpdateJobApplication)
-	api.Delete("/:id", handler.DeleteJobApplication)
-	api.Post("/:id/generate-cover-letter", handler.GenerateCoverLetter)
+	api.Delete("/", handler.BulkDeleteJobApplications) // ?status=rejected&appliedBefore=... (at least one filter)
+	api.Post("/tags/add", handler.BulkAddTag)
+	api.Post("/tags/remove", handler.BulkRemoveTag)
+	api.Post("/bulk-interest", handler.BulkSetInterestLevel) // {"applicationIds": [...], "interestLevel": "low"}
+	api.Post("/bulk-contact", handler.BulkContact) // Applied or reopened -> contacted, one interview response each
+	api.Get("/by-company", handler.ListJobApplicationsByCompany) // ?name=; case-insensitive exact match, newest first
+	api.Get("/companies", handler.ListCompanies) // Application count and latest status per company, most applications first
+	api.Get("/deadlines", handler.ListUpcomingDeadlines) // ?active=true; future deadlines, soonest first, with daysRemaining
+	api.Get("/compare", handler.CompareJobApplications) // ?ids=a,b,c (must be before /:id)
+	api.Post("/batch-get", handler.BatchGetJobApplications) // {"ids": [...]}, omits ids the user does not own
+	api.Post("/cover-letters/batch", handler.GenerateCoverLetterBatch) // {"applicationIds": [...]}, results keyed by id
+	api.Post("/detect-language", handler.DetectLanguage)
+	api.Post("/suggest-tags", handler.SuggestTags) // Suggestions only; accepting them is a normal update
+	api.Post("/from-url", handler.DraftFromURL) // Returns an unsaved draft to confirm via POST /
+	api.Post("/ingest", handler.IngestJobApplication) // Browser extension capture; saved as pending, deduped by URL
+	api.Get("/timeseries", handler.GetApplicationTimeSeries) // ?days=30&metric=applied
+	api.Get("/board", handler.GetBoard) // ?limitPerStatus=20&website=&search=; one column per status
+	api.Get("/offers/stats", handler.GetOfferStats)
+	api.Get("/expenses/stats", handler.GetExpenseStats) // Totals per currency across all applications
+	api.Get("/interest-levels/stats", handler.GetInterestLevelStats) // Counts per level; legacy blank values under "unset"
+	api.Get("/:id", id, handler.GetJobApplication)
+	api.Patch("/:id/status", id, handler.UpdateJobApplicationStatus)
+	api.Patch("/:id", id, handler.UpdateJobApplication)
+	api.Delete("/:id", id, handler.DeleteJobApplication)
+	api.Post("/:id/generate-cover-letter", id, handler.GenerateCoverLetter)
+	api.Get("/:id/cover-letter", id, handler.GetCoverLetter) // ?format=json|text|pdf or Accept; pdf downloads as an attachment
+	api.Post("/:id/cover-letter/refine", id, handler.RefineCoverLetter) // Revises the letter using feedback
+	api.Get("/:id/cover-letter/revisions", id, handler.ListCoverLetterRevisions)
+	api.Get("/:id/cover-letter/prompt", id, handler.GetCoverLetterPrompt) // ?agent=; the prompt generation would send, nothing is generated
+	api.Post("/:id/snooze", id, handler.SnoozeJobApplication) // Hide from the default list until a date
+	api.Delete("/:id/snooze", id, handler.UnsnoozeJobApplication)
+	api.Post("/:id/pin", id, handler.PinJobApplication) // {"order": 1} lists it first; pinned applications sort by order
+	api.Delete("/:id/pin", id, handler.UnpinJobApplication)
+	api.Post("/:id/mute", id, handler.MuteNotifications) // Stop reminder webhooks; the application stays listed
+	api.Delete("/:id/mute", id, handler.UnmuteNotifications)
+	api.Post("/:id/reopen", id, handler.ReopenJobApplication) // {"reason": "..."}; only from rejected, accepted or failed
+	api.Post("/:id/duplicate", id, handler.DuplicateJobApplication) // New pending copy; stages, responses and history are not copied
+	api.Get("/:id/status-history", id, handler.ListStatusChanges)
+	api.Post("/:id/offer", id, handler.SaveOffer)
+	api.Get("/:id/offer", id, handler.GetOffer)
+	api.Delete("/:id/offer", id, handler.DeleteOffer)
+	api.Post("/:id/expenses", id, handler.AddExpense) // {"description": "...", "amountCents": 4999, "currency": "USD"}
+	api.Get("/:id/expenses", id, handler.ListExpenses)
 	
 	// Subdomain routes
-	responses.SetupRoutes(api.Group("/:applicationId/responses"), responseHandler)
-	interviewstages.SetupRoutes(api.Group("/:applicationId/interview-stages"), stageHandler)
+	responses.SetupRoutes(api.Group("/:applicationId/responses", applicationID), responseHandler)
+	interviewstages.SetupRoutes(api.Group("/:applicationId/interview-stages", applicationID), stageHandler)
+	contacts.SetupRoutes(api.Group("/:applicationId/contacts", applicationID), contactHandler)
 }
 
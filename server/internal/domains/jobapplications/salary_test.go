@@ -0,0 +1,81 @@
+package jobapplications
+
+import (
+	"context"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateSalaryRange(t *testing.T) {
+	assert.NoError(t, ValidateSalaryRange(nil, nil))
+	assert.NoError(t, ValidateSalaryRange(intPtr(100), intPtr(100)), "equal bounds are a fixed salary")
+	assert.NoError(t, ValidateSalaryRange(intPtr(0), nil))
+
+	for name, tc := range map[string]struct {
+		min, max *int
+		want     string
+	}{
+		"inverted":     {intPtr(101), intPtr(100), ErrSalaryRangeInverted},
+		"negative min": {intPtr(-1), nil, ErrNegativeSalary},
+		"negative max": {nil, intPtr(-1), ErrNegativeSalary},
+	} {
+		t.Run(name, func(t *testing.T) {
+			domainErr, ok := AsDomainError(ValidateSalaryRange(tc.min, tc.max))
+			require.True(t, ok)
+			assert.Equal(t, tc.want, domainErr.Message)
+		})
+	}
+}
+
+func TestUpdateJobApplication_InvalidSalary(t *testing.T) {
+	app := fiber.New()
+	h := NewHandlerWithDependencies(nil, nil, nil, nil, nil)
+	app.Patch("/job-applications/:id", h.UpdateJobApplication)
+
+	for name, body := range map[string]string{
+		"inverted range":   `{"salaryMin":150000,"salaryMax":100000}`,
+		"unknown currency": `{"salaryMin":100000,"salaryCurrency":"ABC"}`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			req := httptest.NewRequest("PATCH", "/job-applications/"+uuid.NewString(), strings.NewReader(body))
+			req.Header.Set("Content-Type", "application/json")
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+			assert.Equal(t, fiber.StatusUnprocessableEntity, resp.StatusCode)
+		})
+	}
+}
+
+// fakeSalaryRepo stores one application and records updates.
+type fakeSalaryRepo struct {
+	Repository
+	application JobApplication
+	updated     bool
+}
+
+func (r *fakeSalaryRepo) GetJobApplication(_ context.Context, _ uuid.UUID) (*JobApplication, error) {
+	application := r.application
+	return &application, nil
+}
+
+func (r *fakeSalaryRepo) UpdateJobApplication(_ context.Context, _ *JobApplication) error {
+	r.updated = true
+	return nil
+}
+
+func TestServiceUpdateJobApplication_ChecksSingleBoundAgainstStored(t *testing.T) {
+	repo := &fakeSalaryRepo{application: JobApplication{ID: uuid.New(), SalaryMin: intPtr(100000), SalaryMax: intPtr(120000)}}
+	svc := NewService(repo, nil, nil)
+
+	_, err := svc.UpdateJobApplication(context.Background(), repo.application.ID, UpdateJobApplicationRequest{SalaryMin: intPtr(130000)})
+	domainErr, ok := AsDomainError(err)
+	require.True(t, ok)
+	assert.Equal(t, ErrSalaryRangeInverted, domainErr.Message)
+	assert.False(t, repo.updated)
+}
@@ -0,0 +1,42 @@
+package jobapplications
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListJobApplications_DeepSearch(t *testing.T) {
+	tests := []struct {
+		query string
+		deep  bool
+	}{
+		{query: "?search=cto", deep: false},
+		{query: "?search=cto&deep=false", deep: false},
+		{query: "?search=cto&deep=true", deep: true},
+		{query: "?deep=true", deep: false},
+	}
+	for _, tt := range tests {
+		svc := &fakeListService{}
+		app := newListTestApp(svc)
+
+		resp, err := app.Test(httptest.NewRequest("GET", "/job-applications"+tt.query, nil))
+		require.NoError(t, err)
+		assert.Equal(t, fiber.StatusOK, resp.StatusCode, tt.query)
+		require.NotNil(t, svc.filters, tt.query)
+		assert.Equal(t, tt.deep, svc.filters.DeepSearch, tt.query)
+	}
+}
+
+func TestListJobApplications_RejectsInvalidDeep(t *testing.T) {
+	svc := &fakeListService{}
+	app := newListTestApp(svc)
+
+	resp, err := app.Test(httptest.NewRequest("GET", "/job-applications?search=cto&deep=yes", nil))
+	require.NoError(t, err)
+	assert.Equal(t, fiber.StatusBadRequest, resp.StatusCode)
+	assert.Nil(t, svc.filters)
+}
@@ -8,19 +8,69 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+
+	"woragis-jobs-service/internal/domains/jobapplications/responses"
+	applogger "woragis-jobs-service/pkg/logger"
 )
 
 // Service orchestrates job application workflows.
 type Service interface {
-	RequestJobApplication(ctx context.Context, userID uuid.UUID, companyName, location, jobTitle, jobURL, website string) (*JobApplication, error)
+	RequestJobApplication(ctx context.Context, userID uuid.UUID, companyName, location, jobTitle, jobURL, website, applicationMethod string) (*JobApplication, error)
 	GetJobApplication(ctx context.Context, applicationID uuid.UUID) (*JobApplication, error)
 	ListJobApplications(ctx context.Context, filters JobApplicationFilters) ([]JobApplication, error)
+	CountJobApplications(ctx context.Context, filters JobApplicationFilters) (int64, error)
+	ListJobApplicationsByCompany(ctx context.Context, userID uuid.UUID, companyName string, limit, offset int) ([]JobApplication, error)
+	ListCompanies(ctx context.Context, userID uuid.UUID, limit, offset int) ([]CompanySummary, int64, error)
+	ListUpcomingDeadlines(ctx context.Context, userID uuid.UUID, activeOnly bool, limit, offset int) ([]UpcomingDeadline, error)
+	CompareJobApplications(ctx context.Context, userID uuid.UUID, applicationIDs []uuid.UUID) (*ApplicationComparison, error)
+	BatchGetJobApplications(ctx context.Context, userID uuid.UUID, applicationIDs []uuid.UUID) ([]JobApplication, error)
+	DetectJobLanguage(ctx context.Context, text string) (string, error)
+	DetectJobLanguageLocally(ctx context.Context, text string) (string, error)
+	SuggestTags(ctx context.Context, jobDescription string) ([]string, error)
+	DraftFromURL(ctx context.Context, pageURL string) (*JobPostingDraft, error)
+	IngestJobApplication(ctx context.Context, userID uuid.UUID, posting IngestedPosting) (*IngestResult, error)
+	SnoozeJobApplication(ctx context.Context, userID, applicationID uuid.UUID, until time.Time) (*JobApplication, error)
+	UnsnoozeJobApplication(ctx context.Context, userID, applicationID uuid.UUID) (*JobApplication, error)
+	PinJobApplication(ctx context.Context, userID, applicationID uuid.UUID, order int) (*JobApplication, error)
+	UnpinJobApplication(ctx context.Context, userID, applicationID uuid.UUID) (*JobApplication, error)
+	MuteNotifications(ctx context.Context, userID, applicationID uuid.UUID) (*JobApplication, error)
+	UnmuteNotifications(ctx context.Context, userID, applicationID uuid.UUID) (*JobApplication, error)
+	ReopenJobApplication(ctx context.Context, userID, applicationID uuid.UUID, reason string) (*JobApplication, error)
+	DuplicateJobApplication(ctx context.Context, userID, applicationID uuid.UUID) (*JobApplication, error)
+	ListStatusChanges(ctx context.Context, userID, applicationID uuid.UUID) ([]StatusChange, error)
+	SaveOffer(ctx context.Context, userID, applicationID uuid.UUID, details OfferDetails) (*Offer, error)
+	GetOffer(ctx context.Context, userID, applicationID uuid.UUID) (*Offer, error)
+	DeleteOffer(ctx context.Context, userID, applicationID uuid.UUID) error
+	GetOfferStats(ctx context.Context, userID uuid.UUID) (*OfferStats, error)
+	AddExpense(ctx context.Context, userID, applicationID uuid.UUID, details ExpenseDetails) (*ApplicationExpense, error)
+	ListExpenses(ctx context.Context, userID, applicationID uuid.UUID) ([]ApplicationExpense, error)
+	GetExpenseStats(ctx context.Context, userID uuid.UUID) (*ExpenseStats, error)
+	SaveCoverLetterRevision(ctx context.Context, userID, applicationID uuid.UUID, letter GeneratedCoverLetter, feedback string) (*JobApplication, *CoverLetterRevision, error)
+	ListCoverLetterRevisions(ctx context.Context, userID, applicationID uuid.UUID) ([]CoverLetterRevision, error)
+	GetCoverLetter(ctx context.Context, userID, applicationID uuid.UUID) (*JobApplication, error)
+	GetApplicationTimeSeries(ctx context.Context, userID uuid.UUID, metric TimeSeriesMetric, days int) (*ApplicationTimeSeries, error)
+	GetInterestLevelStats(ctx context.Context, userID uuid.UUID) (*InterestLevelStats, error)
+	GetBoard(ctx context.Context, filters JobApplicationFilters, limitPerStatus int) (*Board, error)
 	UpdateJobApplicationStatus(ctx context.Context, applicationID uuid.UUID, status ApplicationStatus) error
 	UpdateJobApplication(ctx context.Context, applicationID uuid.UUID, updates UpdateJobApplicationRequest) (*JobApplication, error)
+	ReplaceJobApplication(ctx context.Context, applicationID uuid.UUID, replacement *JobApplication) (*JobApplication, error)
 	DeleteJobApplication(ctx context.Context, applicationID uuid.UUID) error
+	DeleteJobApplicationsByFilter(ctx context.Context, userID uuid.UUID, filter BulkDeleteFilter) ([]uuid.UUID, error)
+	ReassignWebsite(ctx context.Context, userID uuid.UUID, from, to string) (int, error)
+	AddTagToApplications(ctx context.Context, userID uuid.UUID, applicationIDs []uuid.UUID, tag string) (*BulkTagResult, error)
+	RemoveTagFromApplications(ctx context.Context, userID uuid.UUID, applicationIDs []uuid.UUID, tag string) (*BulkTagResult, error)
+	SetInterestLevel(ctx context.Context, userID uuid.UUID, applicationIDs []uuid.UUID, level InterestLevel) (*BulkInterestResult, error)
+	ContactApplications(ctx context.Context, userID uuid.UUID, applicationIDs []uuid.UUID, contact BulkContact) (*BulkContactResult, error)
 	ProcessJobApplicationJob(ctx context.Context, job *JobApplicationJob) error
 }
 
+// BulkContact is the recruiter reply shared by every application of a bulk contact.
+type BulkContact struct {
+	Message         string
+	ResponseChannel string
+	ResponseDate    time.Time
+}
+
 // UpdateJobApplicationRequest represents fields that can be updated on a job application.
 type UpdateJobApplicationRequest struct {
 	ResumeID          *uuid.UUID
@@ -30,7 +80,7 @@ type UpdateJobApplicationRequest struct {
 	JobDescription    *string
 	CoverLetter       *string
 	Deadline          *time.Time
-	InterestLevel     *string
+	InterestLevel     *InterestLevel
 	Notes             *string
 	Tags              JSONArray
 	FollowUpDate      *time.Time
@@ -40,6 +90,9 @@ type UpdateJobApplicationRequest struct {
 	Source            *string
 	ApplicationMethod *string
 	Language          *string
+	NextAction        *string    // Blank clears the next action and its due date
+	NextActionDue     *time.Time
+	ClearNextActionDue bool
 }
 
 // ResumeMetricsService is an interface to avoid circular dependencies
@@ -53,6 +106,12 @@ type service struct {
 	chatsRepo           ChatsRepository // For unlinking conversations on delete
 	preferencesService  UserPreferencesService // For getting user defaults
 	resumeMetricsService ResumeMetricsService // Optional: for updating resume metrics
+	languageDetector    LanguageDetector     // Optional: defaults to stop-word detection
+	tagSuggester        TagSuggester         // Optional: tag suggestions are unavailable without it
+	postingFetcher      PostingFetcher       // Optional: defaults to a bounded HTTP fetcher
+	websiteMethods      WebsiteMethodsProvider // Optional: defaults and validates application methods per website
+	postingStrategies   PostingStrategyProvider // Optional: per-website parsing hints for from-url drafts
+	postingExtractor    PostingExtractor        // Optional: fills drafts of websites without hints
 	logger              *slog.Logger
 }
 
@@ -110,7 +169,57 @@ func NewServiceWithResumeMetrics(repo Repository, queue Queue, chatsRepo ChatsRe
 	}
 }
 
-func (s *service) RequestJobApplication(ctx context.Context, userID uuid.UUID, companyName, location, jobTitle, jobURL, website string) (*JobApplication, error) {
+// NewServiceWithLanguageDetector constructs a Service that detects posting languages with detector.
+func NewServiceWithLanguageDetector(repo Repository, queue Queue, detector LanguageDetector, logger *slog.Logger) Service {
+	return &service{
+		repo:             repo,
+		queue:            queue,
+		languageDetector: detector,
+		logger:           logger,
+	}
+}
+
+// NewServiceWithWebsiteMethods constructs a Service that also defaults and validates
+// application methods against the linked job website.
+func NewServiceWithWebsiteMethods(repo Repository, queue Queue, detector LanguageDetector, websiteMethods WebsiteMethodsProvider, logger *slog.Logger) Service {
+	return &service{
+		repo:             repo,
+		queue:            queue,
+		languageDetector: detector,
+		websiteMethods:   websiteMethods,
+		logger:           logger,
+	}
+}
+
+// NewServiceWithTagSuggester constructs a Service like NewServiceWithWebsiteMethods that
+// also suggests tags for job postings with suggester.
+func NewServiceWithTagSuggester(repo Repository, queue Queue, detector LanguageDetector, websiteMethods WebsiteMethodsProvider, suggester TagSuggester, logger *slog.Logger) Service {
+	return &service{
+		repo:             repo,
+		queue:            queue,
+		languageDetector: detector,
+		websiteMethods:   websiteMethods,
+		tagSuggester:     suggester,
+		logger:           logger,
+	}
+}
+
+// NewServiceWithPostingExtraction constructs a Service like NewServiceWithTagSuggester that
+// also parses from-url drafts with per-website strategies, falling back to extractor.
+func NewServiceWithPostingExtraction(repo Repository, queue Queue, detector LanguageDetector, websiteMethods WebsiteMethodsProvider, suggester TagSuggester, strategies PostingStrategyProvider, extractor PostingExtractor, logger *slog.Logger) Service {
+	return &service{
+		repo:              repo,
+		queue:             queue,
+		languageDetector:  detector,
+		websiteMethods:    websiteMethods,
+		tagSuggester:      suggester,
+		postingStrategies: strategies,
+		postingExtractor:  extractor,
+		logger:            logger,
+	}
+}
+
+func (s *service) RequestJobApplication(ctx context.Context, userID uuid.UUID, companyName, location, jobTitle, jobURL, website, applicationMethod string) (*JobApplication, error) {
 	// Normalize website to lowercase
 	website = strings.ToLower(strings.TrimSpace(website))
 	
@@ -129,6 +238,12 @@ func (s *service) RequestJobApplication(ctx context.Context, userID uuid.UUID, c
 	if err != nil {
 		return nil, err
 	}
+	tagApplicationLogs(ctx, application.ID)
+
+	application.ApplicationMethod, err = s.resolveApplicationMethod(ctx, application.Website, applicationMethod)
+	if err != nil {
+		return nil, err
+	}
 
 	// Apply user defaults if not already set
 	if s.preferencesService != nil {
@@ -168,7 +283,7 @@ func (s *service) RequestJobApplication(ctx context.Context, userID uuid.UUID, c
 			if updateErr := s.repo.UpdateJobApplication(ctx, application); updateErr != nil {
 				// Log error but don't fail the request - the main error is the queue failure
 				if s.logger != nil {
-					s.logger.Error("failed to update application status after queue failure", "error", updateErr)
+					s.logger.ErrorContext(ctx, "failed to update application status after queue failure", "error", updateErr)
 				}
 			}
 			return nil, err
@@ -179,7 +294,7 @@ func (s *service) RequestJobApplication(ctx context.Context, userID uuid.UUID, c
 		if updateErr := s.repo.UpdateJobApplication(ctx, application); updateErr != nil {
 			// Log error but don't fail the request
 			if s.logger != nil {
-				s.logger.Warn("failed to update application status to processing", "error", updateErr)
+				s.logger.WarnContext(ctx, "failed to update application status to processing", "error", updateErr)
 			}
 		}
 	}
@@ -193,7 +308,124 @@ func (s *service) RequestJobApplication(ctx context.Context, userID uuid.UUID, c
 	return application, nil
 }
 
+// DetectJobLanguage returns the ISO 639-1 code of the language a job posting is written in.
+func (s *service) DetectJobLanguage(ctx context.Context, text string) (string, error) {
+	detector := s.languageDetector
+	if detector == nil {
+		detector = NewStopWordLanguageDetector()
+	}
+	return s.detectJobLanguage(ctx, detector, text)
+}
+
+// DetectJobLanguageLocally is DetectJobLanguage without the AI service: it only
+// uses stop-word matching, so it is cheap enough to run on every write.
+func (s *service) DetectJobLanguageLocally(ctx context.Context, text string) (string, error) {
+	return s.detectJobLanguage(ctx, NewStopWordLanguageDetector(), text)
+}
+
+func (s *service) detectJobLanguage(ctx context.Context, detector LanguageDetector, text string) (string, error) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return "", NewDomainError(ErrCodeInvalidPayload, ErrEmptyLanguageDetectionText)
+	}
+
+	language, err := detector.DetectLanguage(ctx, text)
+	if err != nil {
+		if s.logger != nil {
+			s.logger.WarnContext(ctx, "failed to detect job language", "error", err)
+		}
+		return "", NewDomainError(ErrCodeInvalidPayload, ErrLanguageNotDetected)
+	}
+
+	return language, nil
+}
+
+// SuggestTags asks the tag suggester for tags describing the job description and
+// returns at most MaxSuggestedTags cleaned, deduplicated tags. Nothing is saved.
+func (s *service) SuggestTags(ctx context.Context, jobDescription string) ([]string, error) {
+	jobDescription = strings.TrimSpace(jobDescription)
+	if jobDescription == "" {
+		return nil, NewDomainError(ErrCodeInvalidPayload, ErrEmptyTagSuggestionText)
+	}
+	if s.tagSuggester == nil {
+		return nil, NewDomainError(ErrCodeAIServiceFailure, ErrAIServiceUnavailable)
+	}
+
+	candidates, err := s.tagSuggester.SuggestTags(ctx, jobDescription)
+	if err != nil {
+		if s.logger != nil {
+			s.logger.WarnContext(ctx, "failed to suggest tags", "error", err)
+		}
+		return nil, NewDomainError(ErrCodeAIServiceFailure, ErrAIServiceUnavailable)
+	}
+
+	return normalizeSuggestedTags(candidates, MaxSuggestedTags), nil
+}
+
+// DraftFromURL fetches a job posting and returns a pre-filled, unsaved
+// application, parsed with the website's configured strategy when it has one.
+// Fetch failures are not errors: the draft then carries whatever the URL itself
+// reveals plus the reason the page could not be read.
+func (s *service) DraftFromURL(ctx context.Context, pageURL string) (*JobPostingDraft, error) {
+	pageURL = normalizeURL(pageURL)
+	if pageURL == "" {
+		return nil, NewDomainError(ErrCodeInvalidPayload, ErrEmptyJobURL)
+	}
+
+	draft := NewJobPostingDraft(pageURL)
+
+	fetcher := s.postingFetcher
+	if fetcher == nil {
+		fetcher = NewHTTPPostingFetcher(DefaultPostingFetchTimeout, DefaultPostingMaxBytes)
+	}
+
+	page, err := fetcher.FetchPosting(ctx, pageURL)
+	if err != nil {
+		if s.logger != nil {
+			s.logger.WarnContext(ctx, "failed to fetch job posting", "url", pageURL, "error", err)
+		}
+		draft.FetchError = "unable to fetch the job posting page"
+	} else {
+		s.parsePosting(ctx, draft, page)
+	}
+
+	if draft.JobDescription != "" {
+		if language, err := s.DetectJobLanguage(ctx, draft.JobDescription); err == nil {
+			draft.Language = language
+		}
+	}
+
+	draft.Finalize()
+	return draft, nil
+}
+
+// GetApplicationTimeSeries returns the user's daily application counts for the last days days (UTC), zero-filled.
+func (s *service) GetApplicationTimeSeries(ctx context.Context, userID uuid.UUID, metric TimeSeriesMetric, days int) (*ApplicationTimeSeries, error) {
+	if !metric.IsValid() {
+		return nil, NewDomainError(ErrCodeInvalidPayload, ErrUnsupportedTimeSeriesMetric)
+	}
+
+	now := time.Now().UTC()
+	counts, err := s.repo.CountApplicationsPerDay(ctx, userID, metric, timeSeriesStart(now, days))
+	if err != nil {
+		return nil, err
+	}
+
+	return NewApplicationTimeSeries(metric, days, now, counts), nil
+}
+
+// GetInterestLevelStats counts the user's applications per interest level.
+func (s *service) GetInterestLevelStats(ctx context.Context, userID uuid.UUID) (*InterestLevelStats, error) {
+	counts, err := s.repo.CountApplicationsByInterestLevel(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return NewInterestLevelStats(counts), nil
+}
+
 func (s *service) GetJobApplication(ctx context.Context, applicationID uuid.UUID) (*JobApplication, error) {
+	tagApplicationLogs(ctx, applicationID)
+
 	return s.repo.GetJobApplication(ctx, applicationID)
 }
 
@@ -201,18 +433,329 @@ func (s *service) ListJobApplications(ctx context.Context, filters JobApplicatio
 	return s.repo.ListJobApplications(ctx, filters)
 }
 
+// CountJobApplications returns how many applications match filters, ignoring the limit and offset.
+func (s *service) CountJobApplications(ctx context.Context, filters JobApplicationFilters) (int64, error) {
+	return s.repo.CountJobApplications(ctx, filters)
+}
+
+// ListJobApplicationsByCompany returns the user's applications to one company across
+// roles, newest first. The name is matched in full, ignoring case and surrounding spaces.
+func (s *service) ListJobApplicationsByCompany(ctx context.Context, userID uuid.UUID, companyName string, limit, offset int) ([]JobApplication, error) {
+	return s.repo.ListJobApplicationsByCompany(ctx, userID, strings.TrimSpace(companyName), limit, offset)
+}
+
+// GetBoard lists up to limitPerStatus applications per board column, applying filters
+// to every column. Filter status, limit and offset are ignored.
+func (s *service) GetBoard(ctx context.Context, filters JobApplicationFilters, limitPerStatus int) (*Board, error) {
+	board := NewBoard(limitPerStatus)
+	for _, status := range BoardStatuses {
+		columnFilters := filters
+		columnFilters.Status = &status
+		columnFilters.Limit = limitPerStatus + 1
+		columnFilters.Offset = 0
+
+		applications, err := s.repo.ListJobApplications(ctx, columnFilters)
+		if err != nil {
+			return nil, err
+		}
+		board.SetColumn(status, applications)
+	}
+	return board, nil
+}
+
+// CompareJobApplications returns the given applications side by side, in request order.
+// Every ID must belong to userID; otherwise nothing is returned.
+func (s *service) CompareJobApplications(ctx context.Context, userID uuid.UUID, applicationIDs []uuid.UUID) (*ApplicationComparison, error) {
+	applicationIDs = dedupeIDs(applicationIDs)
+	applications, err := s.repo.GetJobApplicationsByIDs(ctx, userID, applicationIDs)
+	if err != nil {
+		return nil, err
+	}
+	if len(applications) != len(applicationIDs) {
+		return nil, NewDomainError(ErrCodeNotFound, ErrComparedApplicationNotFound)
+	}
+
+	byID := make(map[uuid.UUID]JobApplication, len(applications))
+	for _, application := range applications {
+		byID[application.ID] = application
+	}
+	ordered := make([]JobApplication, 0, len(applicationIDs))
+	for _, id := range applicationIDs {
+		ordered = append(ordered, byID[id])
+	}
+
+	offers, err := s.repo.GetOffersByApplicationIDs(ctx, applicationIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewApplicationComparison(ordered, offers, time.Now().UTC()), nil
+}
+
+// BatchGetJobApplications returns the user's applications among applicationIDs in request
+// order. IDs that do not exist or belong to another user are silently omitted.
+func (s *service) BatchGetJobApplications(ctx context.Context, userID uuid.UUID, applicationIDs []uuid.UUID) ([]JobApplication, error) {
+	applicationIDs = dedupeIDs(applicationIDs)
+	applications, err := s.repo.GetJobApplicationsByIDs(ctx, userID, applicationIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[uuid.UUID]JobApplication, len(applications))
+	for _, application := range applications {
+		byID[application.ID] = application
+	}
+	ordered := make([]JobApplication, 0, len(applications))
+	for _, id := range applicationIDs {
+		if application, ok := byID[id]; ok {
+			ordered = append(ordered, application)
+		}
+	}
+	return ordered, nil
+}
+
+// getOwnedApplication loads the application, reporting it as not found when it belongs to another user.
+func (s *service) getOwnedApplication(ctx context.Context, userID, applicationID uuid.UUID) (*JobApplication, error) {
+	tagApplicationLogs(ctx, applicationID)
+
+	application, err := s.repo.GetJobApplication(ctx, applicationID)
+	if err != nil {
+		return nil, err
+	}
+	if application.UserID != userID {
+		return nil, NewDomainError(ErrCodeNotFound, ErrApplicationNotFound)
+	}
+	return application, nil
+}
+
+// SnoozeJobApplication hides the application from the default list until the given time.
+func (s *service) SnoozeJobApplication(ctx context.Context, userID, applicationID uuid.UUID, until time.Time) (*JobApplication, error) {
+	application, err := s.getOwnedApplication(ctx, userID, applicationID)
+	if err != nil {
+		return nil, err
+	}
+	if err := application.Snooze(until); err != nil {
+		return nil, err
+	}
+	if err := s.repo.UpdateJobApplication(ctx, application); err != nil {
+		return nil, err
+	}
+	return application, nil
+}
+
+// UnsnoozeJobApplication clears the snooze so the application is listed again.
+func (s *service) UnsnoozeJobApplication(ctx context.Context, userID, applicationID uuid.UUID) (*JobApplication, error) {
+	application, err := s.getOwnedApplication(ctx, userID, applicationID)
+	if err != nil {
+		return nil, err
+	}
+	application.Unsnooze()
+	if err := s.repo.UpdateJobApplication(ctx, application); err != nil {
+		return nil, err
+	}
+	return application, nil
+}
+
+// PinJobApplication pins the application at the given position, or moves it there when it
+// is already pinned. A user can pin at most MaxPinnedApplications applications; two pins
+// racing for the last slot may both succeed.
+func (s *service) PinJobApplication(ctx context.Context, userID, applicationID uuid.UUID, order int) (*JobApplication, error) {
+	application, err := s.getOwnedApplication(ctx, userID, applicationID)
+	if err != nil {
+		return nil, err
+	}
+	if !application.IsPinned() {
+		pinned, err := s.repo.CountPinnedApplications(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		if pinned >= MaxPinnedApplications {
+			return nil, NewDomainError(ErrCodePinLimitReached, ErrPinLimitReached)
+		}
+	}
+	if err := application.Pin(order); err != nil {
+		return nil, err
+	}
+	if err := s.repo.UpdateJobApplication(ctx, application); err != nil {
+		return nil, err
+	}
+	return application, nil
+}
+
+// UnpinJobApplication clears the pin so the application follows the normal list order.
+func (s *service) UnpinJobApplication(ctx context.Context, userID, applicationID uuid.UUID) (*JobApplication, error) {
+	application, err := s.getOwnedApplication(ctx, userID, applicationID)
+	if err != nil {
+		return nil, err
+	}
+	application.Unpin()
+	if err := s.repo.UpdateJobApplication(ctx, application); err != nil {
+		return nil, err
+	}
+	return application, nil
+}
+
+// MuteNotifications stops reminder events for the application; it stays listed as usual.
+func (s *service) MuteNotifications(ctx context.Context, userID, applicationID uuid.UUID) (*JobApplication, error) {
+	application, err := s.getOwnedApplication(ctx, userID, applicationID)
+	if err != nil {
+		return nil, err
+	}
+	application.MuteNotifications()
+	if err := s.repo.UpdateJobApplication(ctx, application); err != nil {
+		return nil, err
+	}
+	return application, nil
+}
+
+// UnmuteNotifications resumes reminder events for the application.
+func (s *service) UnmuteNotifications(ctx context.Context, userID, applicationID uuid.UUID) (*JobApplication, error) {
+	application, err := s.getOwnedApplication(ctx, userID, applicationID)
+	if err != nil {
+		return nil, err
+	}
+	application.UnmuteNotifications()
+	if err := s.repo.UpdateJobApplication(ctx, application); err != nil {
+		return nil, err
+	}
+	return application, nil
+}
+
+// SaveOffer records the offer for an application, replacing any earlier one.
+// A blank currency falls back to the application's salary currency.
+func (s *service) SaveOffer(ctx context.Context, userID, applicationID uuid.UUID, details OfferDetails) (*Offer, error) {
+	application, err := s.getOwnedApplication(ctx, userID, applicationID)
+	if err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(details.Currency) == "" {
+		details.Currency = application.SalaryCurrency
+	}
+
+	offer, err := s.repo.GetOffer(ctx, applicationID)
+	if err == nil {
+		err = offer.Replace(details)
+	} else if domainErr, ok := AsDomainError(err); ok && domainErr.Code == ErrCodeNotFound {
+		offer, err = NewOffer(application, details)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.SaveOffer(ctx, offer); err != nil {
+		return nil, err
+	}
+	return offer, nil
+}
+
+func (s *service) GetOffer(ctx context.Context, userID, applicationID uuid.UUID) (*Offer, error) {
+	if _, err := s.getOwnedApplication(ctx, userID, applicationID); err != nil {
+		return nil, err
+	}
+	return s.repo.GetOffer(ctx, applicationID)
+}
+
+func (s *service) DeleteOffer(ctx context.Context, userID, applicationID uuid.UUID) error {
+	if _, err := s.getOwnedApplication(ctx, userID, applicationID); err != nil {
+		return err
+	}
+	return s.repo.DeleteOffer(ctx, applicationID)
+}
+
+// GetOfferStats aggregates the user's offers per currency.
+func (s *service) GetOfferStats(ctx context.Context, userID uuid.UUID) (*OfferStats, error) {
+	offers, err := s.repo.ListOffers(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return NewOfferStats(offers), nil
+}
+
+// SaveCoverLetterRevision stores letter as the application's next cover letter
+// revision and returns the updated application alongside it.
+func (s *service) SaveCoverLetterRevision(ctx context.Context, userID, applicationID uuid.UUID, letter GeneratedCoverLetter, feedback string) (*JobApplication, *CoverLetterRevision, error) {
+	application, err := s.getOwnedApplication(ctx, userID, applicationID)
+	if err != nil {
+		return nil, nil, err
+	}
+	revision, err := NewCoverLetterRevision(application, letter, feedback)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := s.repo.SaveCoverLetterRevision(ctx, application, revision); err != nil {
+		return nil, nil, err
+	}
+	return application, revision, nil
+}
+
+// ReopenJobApplication moves a rejected, accepted or failed application back to active and
+// records why in its status history.
+func (s *service) ReopenJobApplication(ctx context.Context, userID, applicationID uuid.UUID, reason string) (*JobApplication, error) {
+	application, err := s.getOwnedApplication(ctx, userID, applicationID)
+	if err != nil {
+		return nil, err
+	}
+	from := application.Status
+	if err := application.Reopen(); err != nil {
+		return nil, err
+	}
+	if err := s.repo.SaveStatusChange(ctx, application, NewStatusChange(application, from, reason)); err != nil {
+		return nil, err
+	}
+	return application, nil
+}
+
+// ListStatusChanges returns the application's status history, newest first.
+func (s *service) ListStatusChanges(ctx context.Context, userID, applicationID uuid.UUID) ([]StatusChange, error) {
+	if _, err := s.getOwnedApplication(ctx, userID, applicationID); err != nil {
+		return nil, err
+	}
+	return s.repo.ListStatusChanges(ctx, applicationID)
+}
+
+// ListCoverLetterRevisions returns the application's cover letter revisions, newest first.
+func (s *service) ListCoverLetterRevisions(ctx context.Context, userID, applicationID uuid.UUID) ([]CoverLetterRevision, error) {
+	if _, err := s.getOwnedApplication(ctx, userID, applicationID); err != nil {
+		return nil, err
+	}
+	return s.repo.ListCoverLetterRevisions(ctx, applicationID)
+}
+
+// GetCoverLetter returns the user's application for exporting its current cover letter,
+// or a not found error when no letter has been generated or saved yet.
+func (s *service) GetCoverLetter(ctx context.Context, userID, applicationID uuid.UUID) (*JobApplication, error) {
+	application, err := s.getOwnedApplication(ctx, userID, applicationID)
+	if err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(application.CoverLetter) == "" {
+		return nil, NewDomainError(ErrCodeNotFound, ErrCoverLetterNotGenerated)
+	}
+	return application, nil
+}
+
 func (s *service) UpdateJobApplicationStatus(ctx context.Context, applicationID uuid.UUID, status ApplicationStatus) error {
+	tagApplicationLogs(ctx, applicationID)
+
 	application, err := s.repo.GetJobApplication(ctx, applicationID)
 	if err != nil {
 		return err
 	}
 
 	oldStatus := application.Status
+	if status == ApplicationStatusReopened && !oldStatus.IsTerminal() {
+		return NewDomainError(ErrCodeInvalidTransition, ErrReopenNotTerminal)
+	}
 	if err := application.UpdateStatus(status); err != nil {
 		return err
 	}
 
-	if err := s.repo.UpdateJobApplication(ctx, application); err != nil {
+	if oldStatus != status {
+		err = s.repo.SaveStatusChange(ctx, application, NewStatusChange(application, oldStatus, ""))
+	} else {
+		err = s.repo.UpdateJobApplication(ctx, application)
+	}
+	if err != nil {
 		return err
 	}
 
@@ -221,7 +764,7 @@ func (s *service) UpdateJobApplicationStatus(ctx context.Context, applicationID
 		if s.resumeMetricsService != nil && application.ResumeID != nil {
 			if err := s.resumeMetricsService.RecalculateResumeMetrics(ctx, *application.ResumeID); err != nil {
 				if s.logger != nil {
-					s.logger.Warn("failed to recalculate resume metrics", "resume_id", application.ResumeID.String(), "error", err)
+					s.logger.WarnContext(ctx, "failed to recalculate resume metrics", "resume_id", application.ResumeID.String(), "error", err)
 				}
 				// Don't fail the request if metric recalculation fails
 			}
@@ -232,6 +775,8 @@ func (s *service) UpdateJobApplicationStatus(ctx context.Context, applicationID
 }
 
 func (s *service) UpdateJobApplication(ctx context.Context, applicationID uuid.UUID, updates UpdateJobApplicationRequest) (*JobApplication, error) {
+	tagApplicationLogs(ctx, applicationID)
+
 	application, err := s.repo.GetJobApplication(ctx, applicationID)
 	if err != nil {
 		return nil, err
@@ -249,6 +794,12 @@ func (s *service) UpdateJobApplication(ctx context.Context, applicationID uuid.U
 	if updates.SalaryCurrency != nil {
 		application.SalaryCurrency = *updates.SalaryCurrency
 	}
+	// A single bound is checked against the stored one
+	if updates.SalaryMin != nil || updates.SalaryMax != nil {
+		if err := ValidateSalaryRange(application.SalaryMin, application.SalaryMax); err != nil {
+			return nil, err
+		}
+	}
 	if updates.JobDescription != nil {
 		application.JobDescription = *updates.JobDescription
 	}
@@ -283,11 +834,30 @@ func (s *service) UpdateJobApplication(ctx context.Context, applicationID uuid.U
 		application.Source = *updates.Source
 	}
 	if updates.ApplicationMethod != nil {
+		// Only a method that changes is checked, so existing data stays editable
+		if *updates.ApplicationMethod != "" && *updates.ApplicationMethod != application.ApplicationMethod {
+			if _, err := s.resolveApplicationMethod(ctx, application.Website, *updates.ApplicationMethod); err != nil {
+				return nil, err
+			}
+		}
 		application.ApplicationMethod = *updates.ApplicationMethod
 	}
 	if updates.Language != nil {
 		application.Language = *updates.Language
 	}
+	if updates.NextAction != nil || updates.NextActionDue != nil || updates.ClearNextActionDue {
+		action, due := application.NextAction, application.NextActionDue
+		if updates.NextAction != nil {
+			action = *updates.NextAction
+		}
+		if updates.NextActionDue != nil {
+			due = updates.NextActionDue
+		}
+		if updates.ClearNextActionDue {
+			due = nil
+		}
+		application.SetNextAction(action, due)
+	}
 
 	application.UpdatedAt = time.Now().UTC()
 
@@ -306,29 +876,83 @@ func (s *service) UpdateJobApplication(ctx context.Context, applicationID uuid.U
 	}
 
 	// Recalculate metrics for both old and new resume if resumeId changed
-	if s.resumeMetricsService != nil {
-		if oldResumeID != nil && (application.ResumeID == nil || *oldResumeID != *application.ResumeID) {
-			// Old resume metrics need updating
-			if err := s.resumeMetricsService.RecalculateResumeMetrics(ctx, *oldResumeID); err != nil {
-				if s.logger != nil {
-					s.logger.Warn("failed to recalculate old resume metrics", "resume_id", oldResumeID.String(), "error", err)
-				}
+	s.recalculateResumeMetricsAfterUpdate(ctx, oldResumeID, application.ResumeID)
+
+	return application, nil
+}
+
+// ReplaceJobApplication overwrites the mutable fields of an application with those of replacement.
+// Identity, ownership, status and processing fields are always kept from the stored record.
+func (s *service) ReplaceJobApplication(ctx context.Context, applicationID uuid.UUID, replacement *JobApplication) (*JobApplication, error) {
+	tagApplicationLogs(ctx, applicationID)
+
+	application, err := s.repo.GetJobApplication(ctx, applicationID)
+	if err != nil {
+		return nil, err
+	}
+
+	oldResumeID := application.ResumeID
+
+	application.ResumeID = replacement.ResumeID
+	application.SalaryMin = replacement.SalaryMin
+	application.SalaryMax = replacement.SalaryMax
+	application.SalaryCurrency = replacement.SalaryCurrency
+	application.JobDescription = replacement.JobDescription
+	application.Deadline = replacement.Deadline
+	application.InterestLevel = replacement.InterestLevel
+	application.Notes = replacement.Notes
+	application.Tags = replacement.Tags
+	application.FollowUpDate = replacement.FollowUpDate
+	application.ResponseReceivedAt = replacement.ResponseReceivedAt
+	application.RejectionReason = replacement.RejectionReason
+	application.NextInterviewDate = replacement.NextInterviewDate
+	application.Source = replacement.Source
+	if replacement.ApplicationMethod != "" && replacement.ApplicationMethod != application.ApplicationMethod {
+		if _, err := s.resolveApplicationMethod(ctx, application.Website, replacement.ApplicationMethod); err != nil {
+			return nil, err
+		}
+	}
+	application.ApplicationMethod = replacement.ApplicationMethod
+	application.Language = replacement.Language
+	application.SetNextAction(replacement.NextAction, replacement.NextActionDue)
+	application.UpdatedAt = time.Now().UTC()
+
+	if err := s.repo.UpdateJobApplication(ctx, application); err != nil {
+		return nil, err
+	}
+
+	s.recalculateResumeMetricsAfterUpdate(ctx, oldResumeID, application.ResumeID)
+
+	return application, nil
+}
+
+// recalculateResumeMetricsAfterUpdate refreshes metrics for the previous resume when it was
+// unlinked and for the currently linked resume. Failures are logged, never returned.
+func (s *service) recalculateResumeMetricsAfterUpdate(ctx context.Context, oldResumeID, newResumeID *uuid.UUID) {
+	if s.resumeMetricsService == nil {
+		return
+	}
+	if oldResumeID != nil && (newResumeID == nil || *oldResumeID != *newResumeID) {
+		// Old resume metrics need updating
+		if err := s.resumeMetricsService.RecalculateResumeMetrics(ctx, *oldResumeID); err != nil {
+			if s.logger != nil {
+				s.logger.WarnContext(ctx, "failed to recalculate old resume metrics", "resume_id", oldResumeID.String(), "error", err)
 			}
 		}
-		if application.ResumeID != nil {
-			// New resume metrics need updating
-			if err := s.resumeMetricsService.RecalculateResumeMetrics(ctx, *application.ResumeID); err != nil {
-				if s.logger != nil {
-					s.logger.Warn("failed to recalculate new resume metrics", "resume_id", application.ResumeID.String(), "error", err)
-				}
+	}
+	if newResumeID != nil {
+		// New resume metrics need updating
+		if err := s.resumeMetricsService.RecalculateResumeMetrics(ctx, *newResumeID); err != nil {
+			if s.logger != nil {
+				s.logger.WarnContext(ctx, "failed to recalculate new resume metrics", "resume_id", newResumeID.String(), "error", err)
 			}
 		}
 	}
-
-	return application, nil
 }
 
 func (s *service) DeleteJobApplication(ctx context.Context, applicationID uuid.UUID) error {
+	tagApplicationLogs(ctx, applicationID)
+
 	// First, verify the application exists
 	application, err := s.repo.GetJobApplication(ctx, applicationID)
 	if err != nil {
@@ -339,7 +963,7 @@ func (s *service) DeleteJobApplication(ctx context.Context, applicationID uuid.U
 	if s.chatsRepo != nil {
 		if err := s.chatsRepo.UnlinkFromJobApplication(ctx, applicationID); err != nil {
 			if s.logger != nil {
-				s.logger.Warn("failed to unlink conversations from job application",
+				s.logger.WarnContext(ctx, "failed to unlink conversations from job application",
 					"application_id", applicationID.String(),
 					"error", err)
 			}
@@ -353,7 +977,7 @@ func (s *service) DeleteJobApplication(ctx context.Context, applicationID uuid.U
 	}
 
 	if s.logger != nil {
-		s.logger.Info("job application deleted",
+		s.logger.InfoContext(ctx, "job application deleted",
 			"application_id", applicationID.String(),
 			"company_name", application.CompanyName,
 			"job_title", application.JobTitle)
@@ -362,6 +986,215 @@ func (s *service) DeleteJobApplication(ctx context.Context, applicationID uuid.U
 	return nil
 }
 
+// DeleteJobApplicationsByFilter deletes every application of the user matching filter
+// and unlinks their conversations. It returns the ids of the deleted applications.
+func (s *service) DeleteJobApplicationsByFilter(ctx context.Context, userID uuid.UUID, filter BulkDeleteFilter) ([]uuid.UUID, error) {
+	if filter.IsEmpty() {
+		return nil, NewDomainError(ErrCodeInvalidPayload, ErrEmptyBulkDeleteFilter)
+	}
+
+	deleted, err := s.repo.DeleteJobApplicationsByFilter(ctx, userID, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	// Unlink conversations after the fact (preserve chat history); a failure
+	// here only leaves a dangling reference, so deletion still succeeds
+	if s.chatsRepo != nil {
+		for _, applicationID := range deleted {
+			if err := s.chatsRepo.UnlinkFromJobApplication(ctx, applicationID); err != nil && s.logger != nil {
+				s.logger.WarnContext(ctx, "failed to unlink conversations from job application",
+					"application_id", applicationID.String(),
+					"error", err)
+			}
+		}
+	}
+
+	if s.logger != nil {
+		s.logger.InfoContext(ctx, "job applications bulk deleted", "count", len(deleted))
+	}
+
+	return deleted, nil
+}
+
+// ReassignWebsite moves every application of the user from one website to another, e.g.
+// after a job board rebrands, and returns how many were moved. Website names are
+// normalized the same way as on new applications.
+func (s *service) ReassignWebsite(ctx context.Context, userID uuid.UUID, from, to string) (int, error) {
+	from = strings.ToLower(strings.TrimSpace(from))
+	to = strings.ToLower(strings.TrimSpace(to))
+	if from == "" || to == "" {
+		return 0, NewDomainError(ErrCodeInvalidPayload, ErrEmptyWebsite)
+	}
+	if from == to {
+		return 0, NewDomainError(ErrCodeInvalidPayload, ErrReassignSameWebsite)
+	}
+
+	moved, err := s.repo.ReassignWebsite(ctx, userID, from, to)
+	if err != nil {
+		return 0, err
+	}
+
+	if s.logger != nil {
+		s.logger.InfoContext(ctx, "job applications reassigned to another website",
+			"from", from, "to", to, "count", len(moved))
+	}
+
+	return len(moved), nil
+}
+
+func (s *service) AddTagToApplications(ctx context.Context, userID uuid.UUID, applicationIDs []uuid.UUID, tag string) (*BulkTagResult, error) {
+	owned, rejected, err := s.partitionOwnedIDs(ctx, userID, applicationIDs)
+	if err != nil {
+		return nil, err
+	}
+	result := &BulkTagResult{Skipped: []uuid.UUID{}}
+	if len(owned) > 0 {
+		if result, err = s.repo.AddTagToApplications(ctx, userID, owned, strings.TrimSpace(tag)); err != nil {
+			return nil, err
+		}
+	}
+	result.Requested = len(owned) + len(rejected)
+	result.Skipped = append(rejected, result.Skipped...)
+	if s.logger != nil {
+		s.logger.InfoContext(ctx, "bulk tag added",
+			"user_id", userID.String(),
+			"tag", tag,
+			"updated", result.Updated,
+			"skipped", len(result.Skipped))
+	}
+	return result, nil
+}
+
+func (s *service) RemoveTagFromApplications(ctx context.Context, userID uuid.UUID, applicationIDs []uuid.UUID, tag string) (*BulkTagResult, error) {
+	owned, rejected, err := s.partitionOwnedIDs(ctx, userID, applicationIDs)
+	if err != nil {
+		return nil, err
+	}
+	result := &BulkTagResult{Skipped: []uuid.UUID{}}
+	if len(owned) > 0 {
+		if result, err = s.repo.RemoveTagFromApplications(ctx, userID, owned, strings.TrimSpace(tag)); err != nil {
+			return nil, err
+		}
+	}
+	result.Requested = len(owned) + len(rejected)
+	result.Skipped = append(rejected, result.Skipped...)
+	if s.logger != nil {
+		s.logger.InfoContext(ctx, "bulk tag removed",
+			"user_id", userID.String(),
+			"tag", tag,
+			"updated", result.Updated,
+			"skipped", len(result.Skipped))
+	}
+	return result, nil
+}
+
+// SetInterestLevel sets the interest level of many of the user's applications at once,
+// e.g. to downgrade a batch of high-interest applications when reprioritizing.
+func (s *service) SetInterestLevel(ctx context.Context, userID uuid.UUID, applicationIDs []uuid.UUID, level InterestLevel) (*BulkInterestResult, error) {
+	owned, rejected, err := s.partitionOwnedIDs(ctx, userID, applicationIDs)
+	if err != nil {
+		return nil, err
+	}
+	result := &BulkInterestResult{Skipped: []uuid.UUID{}}
+	if len(owned) > 0 {
+		if result, err = s.repo.SetInterestLevel(ctx, userID, owned, level); err != nil {
+			return nil, err
+		}
+	}
+	result.Requested = len(owned) + len(rejected)
+	result.Skipped = append(rejected, result.Skipped...)
+	if s.logger != nil {
+		s.logger.InfoContext(ctx, "bulk interest level set",
+			"user_id", userID.String(),
+			"interest_level", string(level),
+			"updated", result.Updated,
+			"skipped", len(result.Skipped))
+	}
+	return result, nil
+}
+
+// ContactApplications moves the given applications to contacted and records the shared reply
+// as an interview response on each. Applications that are not applied or reopened are
+// reported in InvalidTransition and left untouched.
+func (s *service) ContactApplications(ctx context.Context, userID uuid.UUID, applicationIDs []uuid.UUID, contact BulkContact) (*BulkContactResult, error) {
+	owned, rejected, err := s.partitionOwnedIDs(ctx, userID, applicationIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	reply := responses.Response{
+		ResponseType:    responses.ResponseTypeInterview,
+		ResponseDate:    contact.ResponseDate.UTC(),
+		Message:         strings.TrimSpace(contact.Message),
+		ResponseChannel: strings.TrimSpace(contact.ResponseChannel),
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	}
+	if reply.ResponseDate.IsZero() {
+		reply.ResponseDate = now
+	}
+
+	result := &BulkContactResult{Contacted: []uuid.UUID{}, Skipped: []uuid.UUID{}}
+	if len(owned) > 0 {
+		if result, err = s.repo.ContactApplications(ctx, userID, owned, reply); err != nil {
+			return nil, err
+		}
+	}
+	result.Requested = len(owned) + len(rejected)
+	result.Skipped = append(rejected, result.Skipped...)
+	if s.logger != nil {
+		s.logger.InfoContext(ctx, "applications bulk contacted",
+			"user_id", userID.String(),
+			"contacted", len(result.Contacted),
+			"invalid_transition", len(result.InvalidTransition),
+			"skipped", len(result.Skipped))
+	}
+	return result, nil
+}
+
+// dedupeIDs removes repeated IDs while preserving order.
+func dedupeIDs(ids []uuid.UUID) []uuid.UUID {
+	seen := make(map[uuid.UUID]struct{}, len(ids))
+	unique := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		unique = append(unique, id)
+	}
+	return unique
+}
+
+// partitionOwnedIDs dedupes the requested IDs and splits them into those the user owns
+// and those rejected because they do not exist or belong to another user, both in
+// request order. Bulk operations only pass the owned IDs on to the repository and
+// report the rejected ones as skipped.
+func (s *service) partitionOwnedIDs(ctx context.Context, userID uuid.UUID, applicationIDs []uuid.UUID) (owned, rejected []uuid.UUID, err error) {
+	requested := dedupeIDs(applicationIDs)
+	ownedIDs, err := s.repo.FilterOwnedIDs(ctx, userID, requested)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	isOwned := make(map[uuid.UUID]struct{}, len(ownedIDs))
+	for _, id := range ownedIDs {
+		isOwned[id] = struct{}{}
+	}
+	owned = make([]uuid.UUID, 0, len(ownedIDs))
+	rejected = []uuid.UUID{}
+	for _, id := range requested {
+		if _, ok := isOwned[id]; ok {
+			owned = append(owned, id)
+		} else {
+			rejected = append(rejected, id)
+		}
+	}
+	return owned, rejected, nil
+}
+
 // normalizeURL adds https:// prefix to URL if it's missing.
 // Preserves existing http:// or https:// prefixes.
 func normalizeURL(url string) string {
@@ -430,3 +1263,7 @@ func (s *service) ProcessJobApplicationJob(ctx context.Context, job *JobApplicat
 	return s.repo.UpdateJobApplication(ctx, application)
 }
 
+// tagApplicationLogs adds the job application ID to the request-scoped log fields.
+func tagApplicationLogs(ctx context.Context, id uuid.UUID) {
+	applogger.AddLogFields(ctx, slog.String("job_application_id", id.String()))
+}
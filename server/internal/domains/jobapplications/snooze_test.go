@@ -0,0 +1,134 @@
+package jobapplications
+
+import (
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeListService records the filters the list handler passes down.
+type fakeListService struct {
+	Service
+	filters *JobApplicationFilters
+	total   int64
+}
+
+func (s *fakeListService) ListJobApplications(_ context.Context, filters JobApplicationFilters) ([]JobApplication, error) {
+	s.filters = &filters
+	return []JobApplication{}, nil
+}
+
+func (s *fakeListService) CountJobApplications(_ context.Context, _ JobApplicationFilters) (int64, error) {
+	return s.total, nil
+}
+
+func newListTestApp(svc Service) *fiber.App {
+	app := fiber.New()
+	app.Use(func(c *fiber.Ctx) error {
+		c.Locals("userID", uuid.New())
+		return c.Next()
+	})
+	app.Get("/job-applications", NewHandler(svc, nil).ListJobApplications)
+	return app
+}
+
+func TestJobApplication_Snooze(t *testing.T) {
+	application := &JobApplication{}
+	now := time.Now().UTC()
+
+	err := application.Snooze(now.Add(-time.Minute))
+	assert.EqualError(t, err, ErrSnoozeNotInFuture)
+	assert.Nil(t, application.SnoozedUntil)
+
+	until := now.Add(48 * time.Hour)
+	require.NoError(t, application.Snooze(until))
+	assert.True(t, application.IsSnoozed(now))
+	assert.False(t, application.IsSnoozed(until), "the snooze ends at until")
+
+	application.Unsnooze()
+	assert.Nil(t, application.SnoozedUntil)
+	assert.False(t, application.IsSnoozed(now))
+}
+
+func TestValidateSnoozePayload(t *testing.T) {
+	tomorrow := time.Now().UTC().AddDate(0, 0, 2).Format(timeSeriesDateLayout)
+	until, err := ValidateSnoozePayload(&snoozePayload{Until: tomorrow})
+	require.NoError(t, err)
+	assert.Equal(t, tomorrow, until.Format(timeSeriesDateLayout))
+
+	for _, raw := range []string{
+		"",
+		"next week",
+		time.Now().UTC().Add(-time.Hour).Format(time.RFC3339),
+		time.Now().UTC().AddDate(2, 0, 0).Format(time.RFC3339),
+	} {
+		_, err := ValidateSnoozePayload(&snoozePayload{Until: raw})
+		assert.Error(t, err, "until=%q", raw)
+	}
+}
+
+func TestListJobApplications_HidesSnoozedByDefault(t *testing.T) {
+	svc := &fakeListService{}
+	app := newListTestApp(svc)
+
+	before := time.Now().UTC()
+	resp, err := app.Test(httptest.NewRequest("GET", "/job-applications", nil))
+	require.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+	require.NotNil(t, svc.filters)
+	require.NotNil(t, svc.filters.SnoozedAt)
+	assert.False(t, svc.filters.SnoozedAt.Before(before))
+}
+
+func TestListJobApplications_IncludeSnoozed(t *testing.T) {
+	svc := &fakeListService{}
+	app := newListTestApp(svc)
+
+	resp, err := app.Test(httptest.NewRequest("GET", "/job-applications?includeSnoozed=true", nil))
+	require.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+	require.NotNil(t, svc.filters)
+	assert.Nil(t, svc.filters.SnoozedAt)
+}
+
+func TestListJobApplications_RejectsInvalidIncludeSnoozed(t *testing.T) {
+	svc := &fakeListService{}
+	app := newListTestApp(svc)
+
+	resp, err := app.Test(httptest.NewRequest("GET", "/job-applications?includeSnoozed=yes", nil))
+	require.NoError(t, err)
+	assert.Equal(t, fiber.StatusBadRequest, resp.StatusCode)
+	assert.Nil(t, svc.filters)
+}
+
+func TestListJobApplications_LinkHeader(t *testing.T) {
+	svc := &fakeListService{total: 120}
+	app := newListTestApp(svc)
+
+	resp, err := app.Test(httptest.NewRequest("GET", "/job-applications?status=applied&limit=50&offset=50", nil))
+	require.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	assert.Equal(t,
+		`</job-applications?limit=50&offset=0&status=applied>; rel="first", `+
+			`</job-applications?limit=50&offset=0&status=applied>; rel="prev", `+
+			`</job-applications?limit=50&offset=100&status=applied>; rel="next", `+
+			`</job-applications?limit=50&offset=100&status=applied>; rel="last"`,
+		resp.Header.Get(fiber.HeaderLink))
+
+	var body struct {
+		Data struct {
+			Total int64 `json:"total"`
+		} `json:"data"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Equal(t, int64(120), body.Data.Total)
+}
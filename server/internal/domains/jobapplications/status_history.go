@@ -0,0 +1,40 @@
+package jobapplications
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxStatusChangeReasonLength bounds the reason recorded with a status change.
+const MaxStatusChangeReasonLength = 1000
+
+// StatusChange is one entry of an application's status history.
+type StatusChange struct {
+	ID               uuid.UUID         `gorm:"column:id;type:uuid;primaryKey" json:"id"`
+	JobApplicationID uuid.UUID         `gorm:"column:job_application_id;type:uuid;index;not null" json:"jobApplicationId"`
+	UserID           uuid.UUID         `gorm:"column:user_id;type:uuid;index;not null" json:"userId"`
+	FromStatus       ApplicationStatus `gorm:"column:from_status;type:varchar(20);not null" json:"fromStatus"`
+	ToStatus         ApplicationStatus `gorm:"column:to_status;type:varchar(20);not null" json:"toStatus"`
+	Reason           string            `gorm:"column:reason;type:text" json:"reason,omitempty"` // Blank for plain status updates
+	CreatedAt        time.Time         `gorm:"column:created_at" json:"createdAt"`
+}
+
+// TableName specifies the table name for StatusChange.
+func (StatusChange) TableName() string {
+	return "job_application_status_changes"
+}
+
+// NewStatusChange records that application moved from the given status to its current one.
+func NewStatusChange(application *JobApplication, from ApplicationStatus, reason string) *StatusChange {
+	return &StatusChange{
+		ID:               uuid.New(),
+		JobApplicationID: application.ID,
+		UserID:           application.UserID,
+		FromStatus:       from,
+		ToStatus:         application.Status,
+		Reason:           strings.TrimSpace(reason),
+		CreatedAt:        time.Now().UTC(),
+	}
+}
@@ -0,0 +1,70 @@
+package jobapplications
+
+import (
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeStatusService records the status it was asked to set.
+type fakeStatusService struct {
+	Service
+	status ApplicationStatus
+}
+
+func (s *fakeStatusService) UpdateJobApplicationStatus(_ context.Context, _ uuid.UUID, status ApplicationStatus) error {
+	s.status = status
+	return nil
+}
+
+func (s *fakeStatusService) GetJobApplication(_ context.Context, id uuid.UUID) (*JobApplication, error) {
+	return &JobApplication{ID: id, Status: s.status}, nil
+}
+
+func TestParseApplicationStatus(t *testing.T) {
+	for _, status := range ApplicationStatuses {
+		parsed, err := ParseApplicationStatus(" " + strings.ToUpper(string(status)) + " ")
+		require.NoError(t, err)
+		assert.Equal(t, status, parsed)
+	}
+
+	_, err := ParseApplicationStatus("banana")
+	domainErr, ok := AsDomainError(err)
+	require.True(t, ok)
+	assert.Equal(t, ErrCodeInvalidStatus, domainErr.Code)
+}
+
+func TestUpdateJobApplicationStatus_RejectsUnknownStatus(t *testing.T) {
+	svc := &fakeStatusService{}
+	app := fiber.New()
+	app.Patch("/job-applications/:id/status", NewHandler(svc, nil).UpdateJobApplicationStatus)
+	path := "/job-applications/" + uuid.NewString() + "/status"
+
+	req := httptest.NewRequest("PATCH", path, strings.NewReader(`{"status":"banana"}`))
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := app.Test(req)
+	require.NoError(t, err)
+	assert.Equal(t, fiber.StatusUnprocessableEntity, resp.StatusCode)
+	var decoded struct {
+		Data struct {
+			AllowedStatuses []ApplicationStatus `json:"allowedStatuses"`
+		} `json:"data"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&decoded))
+	assert.Equal(t, ApplicationStatuses, decoded.Data.AllowedStatuses)
+	assert.Empty(t, svc.status, "nothing is persisted")
+
+	req = httptest.NewRequest("PATCH", path, strings.NewReader(`{"status":"Applied"}`))
+	req.Header.Set("Content-Type", "application/json")
+	resp, err = app.Test(req)
+	require.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	assert.Equal(t, ApplicationStatusApplied, svc.status, "status is normalized")
+}
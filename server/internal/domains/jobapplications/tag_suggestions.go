@@ -0,0 +1,133 @@
+package jobapplications
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"woragis-jobs-service/pkg/aiservice"
+)
+
+// TagSuggester proposes tags for a job posting.
+type TagSuggester interface {
+	// SuggestTags returns raw tag candidates for the posting; callers normalize them.
+	SuggestTags(ctx context.Context, jobDescription string) ([]string, error)
+}
+
+const (
+	// MaxSuggestedTags caps how many suggestions are returned
+	MaxSuggestedTags = 10
+	// maxSuggestedTagLength matches the per-tag limit enforced on create/update
+	maxSuggestedTagLength = 50
+	// tagSuggestionSampleSize caps how much of a posting is sent for tagging
+	tagSuggestionSampleSize = 6000
+)
+
+// blockedTagWords are never suggested, even as part of a longer tag.
+var blockedTagWords = map[string]bool{
+	"ass": true, "asshole": true, "bastard": true, "bitch": true, "bullshit": true,
+	"crap": true, "cunt": true, "damn": true, "dick": true, "fuck": true,
+	"fucking": true, "piss": true, "shit": true, "shitty": true, "slut": true,
+	"whore": true,
+}
+
+// tagListMarker matches list bullets, numbering and hashtag signs in front of a tag.
+var tagListMarker = regexp.MustCompile(`^(?:[-*•]+\s+|\d+[.)]\s+|#+)`)
+
+// AIServiceTagSuggester implements TagSuggester using the AI service.
+type AIServiceTagSuggester struct {
+	client *aiservice.Client
+	logger *slog.Logger
+}
+
+// NewAIServiceTagSuggester creates a new AI service tag suggester
+func NewAIServiceTagSuggester(client *aiservice.Client, logger *slog.Logger) TagSuggester {
+	return &AIServiceTagSuggester{
+		client: client,
+		logger: logger,
+	}
+}
+
+// SuggestTags asks the AI service's tagging agent for tags describing the posting
+func (s *AIServiceTagSuggester) SuggestTags(ctx context.Context, jobDescription string) ([]string, error) {
+	systemPrompt := fmt.Sprintf("Suggest up to %d short tags that help a job seeker organize the job posting provided by the user, "+
+		"covering work arrangement (remote, hybrid, onsite), seniority (junior, senior, lead), industry (fintech, healthtech) "+
+		"and main technologies (go, react, kubernetes). Reply with only a comma-separated list of lowercase tags and nothing else.",
+		MaxSuggestedTags)
+
+	req := aiservice.ChatRequest{
+		Agent:       "job_tagging",
+		Input:       truncateRunes(jobDescription, tagSuggestionSampleSize),
+		System:      &systemPrompt,
+		Temperature: func() *float64 { t := 0.2; return &t }(),
+		MaxTokens:   func() *int { t := 120; return &t }(),
+	}
+
+	resp, err := s.client.Chat(ctx, req)
+	if err != nil {
+		s.logger.WarnContext(ctx, "AI tag suggestion failed", "error", err)
+		return nil, err
+	}
+
+	return splitTagList(resp.Output), nil
+}
+
+// splitTagList splits a model reply such as "remote, senior\n- fintech" into candidates.
+func splitTagList(output string) []string {
+	return strings.FieldsFunc(output, func(r rune) bool {
+		return r == ',' || r == ';' || r == '\n' || r == '|'
+	})
+}
+
+// normalizeSuggestedTags lowercases and hyphenates candidates, drops empty, overlong and
+// profane ones, removes duplicates and keeps at most limit tags in their original order.
+func normalizeSuggestedTags(candidates []string, limit int) []string {
+	tags := make([]string, 0, limit)
+	seen := make(map[string]bool, len(candidates))
+	for _, candidate := range candidates {
+		tag := normalizeSuggestedTag(candidate)
+		if tag == "" || len(tag) > maxSuggestedTagLength || seen[tag] || isBlockedTag(tag) {
+			continue
+		}
+		seen[tag] = true
+		tags = append(tags, tag)
+		if len(tags) == limit {
+			break
+		}
+	}
+	return tags
+}
+
+// normalizeSuggestedTag turns "  - Machine Learning." into "machine-learning" while keeping
+// characters that matter in technology names, as in "c++", "c#" and "node.js".
+func normalizeSuggestedTag(candidate string) string {
+	candidate = strings.ToLower(strings.TrimSpace(candidate))
+	candidate = tagListMarker.ReplaceAllString(candidate, "")
+	candidate = strings.Trim(candidate, "\"'` \t")
+
+	var b strings.Builder
+	for _, word := range strings.Fields(candidate) {
+		if b.Len() > 0 {
+			b.WriteByte('-')
+		}
+		for _, r := range word {
+			if unicode.IsLetter(r) || unicode.IsDigit(r) || strings.ContainsRune("-+#.", r) {
+				b.WriteRune(r)
+			}
+		}
+	}
+	return strings.TrimRight(strings.Trim(b.String(), "-"), ".")
+}
+
+// isBlockedTag reports whether any hyphen- or dot-separated part of tag is blocked.
+func isBlockedTag(tag string) bool {
+	for _, part := range strings.FieldsFunc(tag, func(r rune) bool { return r == '-' || r == '.' }) {
+		if blockedTagWords[part] {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,57 @@
+package jobapplications
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeTagSuggester struct {
+	tags []string
+	err  error
+}
+
+func (f *fakeTagSuggester) SuggestTags(_ context.Context, _ string) ([]string, error) {
+	return f.tags, f.err
+}
+
+func TestNormalizeSuggestedTags(t *testing.T) {
+	candidates := splitTagList("Remote, Senior\n- Fintech\n1. Go\n#golang, remote, , C++, .NET, Node.js, Machine Learning., shitty-startup, \"Kubernetes\"")
+
+	tags := normalizeSuggestedTags(candidates, MaxSuggestedTags)
+	assert.Equal(t, []string{"remote", "senior", "fintech", "go", "golang", "c++", ".net", "node.js", "machine-learning", "kubernetes"}, tags)
+}
+
+func TestNormalizeSuggestedTags_CapsAndDropsOverlong(t *testing.T) {
+	var candidates []string
+	for i := 0; i < 15; i++ {
+		candidates = append(candidates, fmt.Sprintf("tag%d", i))
+	}
+	candidates = append([]string{"this tag is far too long to be kept as a tag by anyone at all"}, candidates...)
+
+	tags := normalizeSuggestedTags(candidates, MaxSuggestedTags)
+	require.Len(t, tags, MaxSuggestedTags)
+	assert.Equal(t, "tag0", tags[0])
+}
+
+func TestService_SuggestTags(t *testing.T) {
+	svc := NewServiceWithTagSuggester(nil, nil, nil, nil, &fakeTagSuggester{tags: []string{"Remote", "remote", "Go"}}, nil)
+	tags, err := svc.SuggestTags(context.Background(), "Remote Go role")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"remote", "go"}, tags)
+
+	_, err = svc.SuggestTags(context.Background(), "   ")
+	assert.EqualError(t, err, ErrEmptyTagSuggestionText)
+
+	svc = NewServiceWithTagSuggester(nil, nil, nil, nil, &fakeTagSuggester{err: errors.New("timeout")}, nil)
+	_, err = svc.SuggestTags(context.Background(), "Remote Go role")
+	assert.EqualError(t, err, ErrAIServiceUnavailable)
+
+	svc = NewService(nil, nil, nil)
+	_, err = svc.SuggestTags(context.Background(), "Remote Go role")
+	assert.EqualError(t, err, ErrAIServiceUnavailable)
+}
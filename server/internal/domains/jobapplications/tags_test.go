@@ -0,0 +1,131 @@
+package jobapplications
+
+import (
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+
+	"woragis-jobs-service/pkg/validation"
+)
+
+func TestUpdateJobApplication_TooManyTags(t *testing.T) {
+	app := fiber.New()
+	h := NewHandlerWithDependencies(nil, nil, nil, nil, nil)
+	app.Patch("/job-applications/:id", h.UpdateJobApplication)
+
+	tags := make([]string, validation.CurrentTagLimits().MaxTags+1)
+	for i := range tags {
+		tags[i] = `"t` + string(rune('a'+i)) + `"`
+	}
+	body := `{"tags":[` + strings.Join(tags, ",") + `]}`
+	req := httptest.NewRequest("PATCH", "/job-applications/"+uuid.NewString(), strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := app.Test(req)
+	require.NoError(t, err)
+	assert.Equal(t, fiber.StatusUnprocessableEntity, resp.StatusCode)
+}
+
+func TestValidateCreateJobApplicationPayload_TrimsTags(t *testing.T) {
+	payload := &createJobApplicationPayload{
+		CompanyName: "Acme",
+		JobTitle:    "Engineer",
+		JobURL:      "https://acme.example/jobs/1",
+		Website:     "linkedin",
+		Tags:        []string{" remote ", "go"},
+	}
+	require.NoError(t, ValidateCreateJobApplicationPayload(payload))
+	assert.Equal(t, []string{"remote", "go"}, payload.Tags)
+
+	payload.Tags = []string{"go", ""}
+	assert.ErrorContains(t, ValidateCreateJobApplicationPayload(payload), "tags[1]: must not be empty")
+}
+
+func TestJobApplication_AddTagAtLimit(t *testing.T) {
+	application := &JobApplication{}
+	for i := 0; i < validation.CurrentTagLimits().MaxTags; i++ {
+		require.True(t, application.AddTag(string(rune('a'+i))))
+	}
+	assert.False(t, application.HasTagCapacity())
+	assert.False(t, application.AddTag("overflow"))
+	assert.True(t, application.HasTag("A"), "existing tags match ignoring case")
+}
+
+func TestJSONArray_MarshalsEmptyAsArray(t *testing.T) {
+	for name, tags := range map[string]JSONArray{"nil": nil, "empty": {}} {
+		encoded, err := json.Marshal(JobApplication{Tags: tags})
+		require.NoError(t, err, name)
+		assert.Contains(t, string(encoded), `"tags":[]`, name)
+	}
+
+	encoded, err := json.Marshal(JSONArray{"remote"})
+	require.NoError(t, err)
+	assert.JSONEq(t, `["remote"]`, string(encoded))
+}
+
+func TestJSONArray_ScansNullAsEmpty(t *testing.T) {
+	for name, value := range map[string]interface{}{"SQL NULL": nil, "JSON null": []byte("null"), "empty": "[]"} {
+		tags := JSONArray{"stale"}
+		require.NoError(t, tags.Scan(value), name)
+		assert.NotNil(t, tags, name)
+		assert.Empty(t, tags, name)
+	}
+
+	var tags JSONArray
+	require.NoError(t, tags.Scan([]byte(`["remote","startup"]`)))
+	assert.Equal(t, JSONArray{"remote", "startup"}, tags)
+}
+
+func TestListJobApplications_TagFilter(t *testing.T) {
+	tests := []struct {
+		query    string
+		tags     []string
+		matchAny bool
+	}{
+		{query: "?tags=remote,%20go", tags: []string{"remote", "go"}},
+		{query: "?tags=remote,go&tagMatch=all", tags: []string{"remote", "go"}},
+		{query: "?tags=remote,,go&tagMatch=ANY", tags: []string{"remote", "go"}, matchAny: true},
+		{query: "?tags=,", tags: nil},
+	}
+	for _, tt := range tests {
+		svc := &fakeListService{}
+		app := newListTestApp(svc)
+
+		resp, err := app.Test(httptest.NewRequest("GET", "/job-applications"+tt.query, nil))
+		require.NoError(t, err)
+		assert.Equal(t, fiber.StatusOK, resp.StatusCode, tt.query)
+		require.NotNil(t, svc.filters, tt.query)
+		assert.Equal(t, tt.tags, svc.filters.Tags, tt.query)
+		assert.Equal(t, tt.matchAny, svc.filters.TagsMatchAny, tt.query)
+	}
+
+	svc := &fakeListService{}
+	resp, err := newListTestApp(svc).Test(httptest.NewRequest("GET", "/job-applications?tags=go&tagMatch=some", nil))
+	require.NoError(t, err)
+	assert.Equal(t, fiber.StatusBadRequest, resp.StatusCode)
+	assert.Nil(t, svc.filters)
+}
+
+func TestFilteredJobApplications_Tags(t *testing.T) {
+	db, err := gorm.Open(postgres.New(postgres.Config{DSN: "host=localhost"}), &gorm.Config{DryRun: true, DisableAutomaticPing: true})
+	require.NoError(t, err)
+	repo := &gormRepository{db: db}
+
+	var applications []JobApplication
+	stmt := repo.filteredJobApplications(context.Background(), JobApplicationFilters{Tags: []string{"remote", "go"}}).Find(&applications).Statement
+	assert.Contains(t, stmt.SQL.String(), "tags @> $1::jsonb")
+	assert.Contains(t, stmt.Vars, `["remote","go"]`)
+
+	stmt = repo.filteredJobApplications(context.Background(), JobApplicationFilters{Tags: []string{"remote", "go"}, TagsMatchAny: true}).Find(&applications).Statement
+	assert.Contains(t, stmt.SQL.String(), "(tags @> $1::jsonb OR tags @> $2::jsonb)")
+	assert.Contains(t, stmt.Vars, `["remote"]`)
+	assert.Contains(t, stmt.Vars, `["go"]`)
+}
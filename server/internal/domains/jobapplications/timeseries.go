@@ -0,0 +1,82 @@
+package jobapplications
+
+import "time"
+
+// TimeSeriesMetric selects which timestamp the daily application counts are grouped by.
+type TimeSeriesMetric string
+
+const (
+	// TimeSeriesMetricApplied counts applications by the day they were submitted (applied_at)
+	TimeSeriesMetricApplied TimeSeriesMetric = "applied"
+	// TimeSeriesMetricCreated counts applications by the day they were added (created_at)
+	TimeSeriesMetricCreated TimeSeriesMetric = "created"
+	// TimeSeriesMetricResponded counts applications by the day a response arrived (response_received_at)
+	TimeSeriesMetricResponded TimeSeriesMetric = "responded"
+)
+
+const (
+	defaultTimeSeriesDays = 30
+	maxTimeSeriesDays     = 365
+	timeSeriesDateLayout  = "2006-01-02"
+)
+
+// timeSeriesColumns maps each metric to the column it groups by. Only these
+// columns are ever interpolated into the grouping query.
+var timeSeriesColumns = map[TimeSeriesMetric]string{
+	TimeSeriesMetricApplied:   "applied_at",
+	TimeSeriesMetricCreated:   "created_at",
+	TimeSeriesMetricResponded: "response_received_at",
+}
+
+// IsValid reports whether the metric is supported.
+func (m TimeSeriesMetric) IsValid() bool {
+	_, ok := timeSeriesColumns[m]
+	return ok
+}
+
+// DailyCount is one point of the time series.
+type DailyCount struct {
+	Date  string `json:"date"` // YYYY-MM-DD (UTC)
+	Count int64  `json:"count"`
+}
+
+// ApplicationTimeSeries is a continuous run of daily counts ending today (UTC).
+type ApplicationTimeSeries struct {
+	Metric TimeSeriesMetric `json:"metric"`
+	Days   int              `json:"days"`
+	From   string           `json:"from"`
+	To     string           `json:"to"`
+	Total  int64            `json:"total"`
+	Points []DailyCount     `json:"points"`
+}
+
+// NewApplicationTimeSeries builds a series of days points ending on today's UTC date,
+// taking counts from the grouped query and filling days without rows with zero.
+func NewApplicationTimeSeries(metric TimeSeriesMetric, days int, today time.Time, counts map[string]int64) *ApplicationTimeSeries {
+	start := timeSeriesStart(today, days)
+
+	series := &ApplicationTimeSeries{
+		Metric: metric,
+		Days:   days,
+		Points: make([]DailyCount, 0, days),
+	}
+	for i := 0; i < days; i++ {
+		date := start.AddDate(0, 0, i).Format(timeSeriesDateLayout)
+		count := counts[date]
+		series.Points = append(series.Points, DailyCount{Date: date, Count: count})
+		series.Total += count
+	}
+	if days > 0 {
+		series.From = series.Points[0].Date
+		series.To = series.Points[days-1].Date
+	}
+
+	return series
+}
+
+// timeSeriesStart returns midnight UTC of the first day of a days-long window ending today.
+func timeSeriesStart(today time.Time, days int) time.Time {
+	today = today.UTC()
+	midnight := time.Date(today.Year(), today.Month(), today.Day(), 0, 0, 0, 0, time.UTC)
+	return midnight.AddDate(0, 0, -(days - 1))
+}
@@ -0,0 +1,65 @@
+package jobapplications
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewApplicationTimeSeries_ZeroFillsMissingDays(t *testing.T) {
+	today := time.Date(2024, time.March, 2, 15, 30, 0, 0, time.UTC)
+	counts := map[string]int64{
+		"2024-02-28": 3,
+		"2024-03-02": 2,
+		"2024-01-01": 9, // outside the window, ignored
+	}
+
+	series := NewApplicationTimeSeries(TimeSeriesMetricApplied, 4, today, counts)
+
+	assert.Equal(t, TimeSeriesMetricApplied, series.Metric)
+	assert.Equal(t, 4, series.Days)
+	assert.Equal(t, "2024-02-28", series.From)
+	assert.Equal(t, "2024-03-02", series.To)
+	assert.Equal(t, int64(5), series.Total)
+	assert.Equal(t, []DailyCount{
+		{Date: "2024-02-28", Count: 3},
+		{Date: "2024-02-29", Count: 0},
+		{Date: "2024-03-01", Count: 0},
+		{Date: "2024-03-02", Count: 2},
+	}, series.Points)
+}
+
+func TestNewApplicationTimeSeries_UsesUTCDate(t *testing.T) {
+	// 23:00 on March 1st in UTC-5 is already March 2nd in UTC
+	loc := time.FixedZone("UTC-5", -5*60*60)
+	today := time.Date(2024, time.March, 1, 23, 0, 0, 0, loc)
+
+	series := NewApplicationTimeSeries(TimeSeriesMetricCreated, 1, today, nil)
+
+	require.Len(t, series.Points, 1)
+	assert.Equal(t, "2024-03-02", series.From)
+	assert.Equal(t, "2024-03-02", series.To)
+	assert.Equal(t, int64(0), series.Total)
+}
+
+func TestValidateTimeSeriesParams(t *testing.T) {
+	metric, days, err := ValidateTimeSeriesParams("", "")
+	require.NoError(t, err)
+	assert.Equal(t, TimeSeriesMetricApplied, metric)
+	assert.Equal(t, defaultTimeSeriesDays, days)
+
+	metric, days, err = ValidateTimeSeriesParams(" Responded ", "365")
+	require.NoError(t, err)
+	assert.Equal(t, TimeSeriesMetricResponded, metric)
+	assert.Equal(t, 365, days)
+
+	for _, rawDays := range []string{"0", "366", "-1", "abc"} {
+		_, _, err = ValidateTimeSeriesParams("", rawDays)
+		assert.Error(t, err, "days=%q", rawDays)
+	}
+
+	_, _, err = ValidateTimeSeriesParams("updated", "")
+	assert.Error(t, err)
+}
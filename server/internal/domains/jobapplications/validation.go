@@ -2,8 +2,14 @@ package jobapplications
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
+	"github.com/google/uuid"
+
+	"woragis-jobs-service/pkg/jsonpatch"
+	"woragis-jobs-service/pkg/pagination"
 	"woragis-jobs-service/pkg/validation"
 )
 
@@ -69,28 +75,21 @@ func ValidateCreateJobApplicationPayload(payload *createJobApplicationPayload) e
 
 	// Validate interest level (optional, but if provided, validate)
 	if payload.InterestLevel != "" {
-		validLevels := []string{"low", "medium", "high", "very_high"}
-		isValid := false
-		for _, level := range validLevels {
-			if strings.ToLower(payload.InterestLevel) == level {
-				isValid = true
-				break
-			}
-		}
-		if !isValid {
-			return fmt.Errorf("interestLevel: must be one of: low, medium, high, very_high")
+		level, err := ParseInterestLevel(string(payload.InterestLevel))
+		if err != nil {
+			return err
 		}
+		payload.InterestLevel = level
 	}
 
 	// Validate tags (optional, but if provided, validate each tag)
 	if len(payload.Tags) > 0 {
-		if len(payload.Tags) > 20 {
-			return fmt.Errorf("tags: too many tags (maximum 20)")
+		tags, err := validation.ValidateTags(payload.Tags)
+		if err != nil {
+			return err
 		}
+		payload.Tags = tags
 		for i, tag := range payload.Tags {
-			if err := validation.ValidateString(tag, 1, 50, fmt.Sprintf("tags[%d]", i)); err != nil {
-				return fmt.Errorf("tags[%d]: %w", i, err)
-			}
 			// Check for SQL injection and XSS
 			if err := validation.ValidateNoSQLInjection(tag); err != nil {
 				return fmt.Errorf("tags[%d]: %w", i, err)
@@ -115,16 +114,128 @@ func ValidateCreateJobApplicationPayload(payload *createJobApplicationPayload) e
 		}
 	}
 
+	// Validate job description (optional, but if provided, validate)
+	if payload.JobDescription != "" {
+		if err := validation.ValidateString(payload.JobDescription, 1, 10000, "jobDescription"); err != nil {
+			return fmt.Errorf("jobDescription: %w", err)
+		}
+		// Check for SQL injection and XSS
+		if err := validation.ValidateNoSQLInjection(payload.JobDescription); err != nil {
+			return fmt.Errorf("jobDescription: %w", err)
+		}
+		if err := validation.ValidateNoXSS(payload.JobDescription); err != nil {
+			return fmt.Errorf("jobDescription: %w", err)
+		}
+	}
+
+	// Validate application method (optional; the website's default applies when blank)
+	if payload.ApplicationMethod != "" {
+		switch strings.ToLower(strings.TrimSpace(payload.ApplicationMethod)) {
+		case ApplicationMethodAuto, ApplicationMethodManual, ApplicationMethodAssisted:
+		default:
+			return fmt.Errorf("applicationMethod: must be one of: auto, manual, assisted")
+		}
+	}
+
+	// Validate language (optional, but if provided, validate ISO 639-1 format)
+	if payload.Language != "" {
+		if len(payload.Language) != 2 || payload.Language != strings.ToLower(payload.Language) {
+			return fmt.Errorf("language: must be a lowercase 2-character ISO 639-1 code")
+		}
+	}
+
+	return nil
+}
+
+// ValidateFromURLPayload validates the quick-create from URL payload
+func ValidateFromURLPayload(payload *fromURLPayload) error {
+	payload.URL = normalizeURL(payload.URL)
+	if err := validation.ValidateURL(payload.URL); err != nil {
+		return fmt.Errorf("url: %w", err)
+	}
+	if len(payload.URL) > 2048 {
+		return fmt.Errorf("url: must be at most 2048 characters")
+	}
 	return nil
 }
 
-// ValidateUpdateStatusPayload validates update status payload
+// ValidateIngestPayload only checks the source URL: captured fields are cleaned
+// up by the service instead of being rejected.
+func ValidateIngestPayload(payload *ingestPayload) error {
+	payload.SourceURL = normalizeURL(payload.SourceURL)
+	if err := validation.ValidateURL(payload.SourceURL); err != nil {
+		return fmt.Errorf("sourceUrl: %w", err)
+	}
+	if len(payload.SourceURL) > 2048 {
+		return fmt.Errorf("sourceUrl: must be at most 2048 characters")
+	}
+	return nil
+}
+
+// ValidateGenerateCoverLetterPayload checks the requested agent against the
+// allowlist, defaulting it to DefaultCoverLetterAgent when omitted
+func ValidateGenerateCoverLetterPayload(payload *generateCoverLetterPayload) error {
+	payload.Agent = strings.TrimSpace(payload.Agent)
+	if payload.Agent == "" {
+		payload.Agent = DefaultCoverLetterAgent
+		return nil
+	}
+	if !IsValidCoverLetterAgent(payload.Agent) {
+		return fmt.Errorf("agent: unsupported cover letter agent %q", payload.Agent)
+	}
+	return nil
+}
+
+// ValidateRefineCoverLetterPayload requires feedback and checks the agent like
+// ValidateGenerateCoverLetterPayload. A blank previous letter means the
+// application's current one.
+func ValidateRefineCoverLetterPayload(payload *refineCoverLetterPayload) error {
+	payload.Feedback = strings.TrimSpace(payload.Feedback)
+	if payload.Feedback == "" {
+		return fmt.Errorf("feedback is required")
+	}
+	if len(payload.Feedback) > MaxCoverLetterFeedbackLength {
+		return fmt.Errorf("feedback: must be at most %d characters", MaxCoverLetterFeedbackLength)
+	}
+	payload.PreviousCoverLetter = strings.TrimSpace(payload.PreviousCoverLetter)
+	if len(payload.PreviousCoverLetter) > 20000 {
+		return fmt.Errorf("previousCoverLetter: must be at most 20000 characters")
+	}
+	generate := generateCoverLetterPayload{Agent: payload.Agent}
+	if err := ValidateGenerateCoverLetterPayload(&generate); err != nil {
+		return err
+	}
+	payload.Agent = generate.Agent
+	return nil
+}
+
+// ValidateDetectLanguagePayload validates the payload for DetectLanguage
+func ValidateDetectLanguagePayload(payload *detectLanguagePayload) error {
+	if err := validation.ValidateString(payload.Text, 1, 10000, "text"); err != nil {
+		return fmt.Errorf("text: %w", err)
+	}
+	return nil
+}
+
+// ValidateSuggestTagsPayload validates the payload for SuggestTags
+func ValidateSuggestTagsPayload(payload *suggestTagsPayload) error {
+	if err := validation.ValidateString(payload.JobDescription, 1, 20000, "jobDescription"); err != nil {
+		return fmt.Errorf("jobDescription: %w", err)
+	}
+	return nil
+}
+
+// ValidateUpdateStatusPayload normalizes the status, returning a domain error (422)
+// when it is not one of ApplicationStatuses.
 func ValidateUpdateStatusPayload(payload *updateStatusPayload) error {
-	// Status is validated by the ApplicationStatus type itself
-	// But we can add additional validation if needed
-	if payload.Status == "" {
+	if strings.TrimSpace(string(payload.Status)) == "" {
 		return fmt.Errorf("status is required")
 	}
+	status, err := ParseApplicationStatus(string(payload.Status))
+	if err != nil {
+		return err
+	}
+	payload.Status = status
 	return nil
 }
 
@@ -137,24 +248,15 @@ func ValidateUpdateJobApplicationPayload(payload *updateJobApplicationPayload) e
 		}
 	}
 
-	// Validate salary min (optional, but if provided, validate range)
-	if payload.SalaryMin != nil {
-		if *payload.SalaryMin < 0 {
-			return fmt.Errorf("salaryMin: must be non-negative")
-		}
-		if *payload.SalaryMin > 10000000 {
-			return fmt.Errorf("salaryMin: must be at most 10,000,000")
-		}
+	// Validate salary range (optional); negative or inverted ranges are a 422
+	if err := ValidateSalaryRange(payload.SalaryMin, payload.SalaryMax); err != nil {
+		return err
 	}
-
-	// Validate salary max (optional, but if provided, validate range)
-	if payload.SalaryMax != nil {
-		if *payload.SalaryMax < 0 {
-			return fmt.Errorf("salaryMax: must be non-negative")
-		}
-		if *payload.SalaryMax > 10000000 {
-			return fmt.Errorf("salaryMax: must be at most 10,000,000")
-		}
+	if payload.SalaryMin != nil && *payload.SalaryMin > 10000000 {
+		return fmt.Errorf("salaryMin: must be at most 10,000,000")
+	}
+	if payload.SalaryMax != nil && *payload.SalaryMax > 10000000 {
+		return fmt.Errorf("salaryMax: must be at most 10,000,000")
 	}
 
 	// Validate salary currency (optional, but if provided, validate format)
@@ -167,6 +269,9 @@ func ValidateUpdateJobApplicationPayload(payload *updateJobApplicationPayload) e
 		if currency != strings.ToUpper(currency) {
 			return fmt.Errorf("salaryCurrency: must be uppercase ISO 4217 code")
 		}
+		if !validation.IsCurrencyCode(currency) {
+			return NewDomainError(ErrCodeInvalidPayload, ErrUnknownSalaryCurrency)
+		}
 	}
 
 	// Validate job description (optional, but if provided, validate)
@@ -186,17 +291,11 @@ func ValidateUpdateJobApplicationPayload(payload *updateJobApplicationPayload) e
 
 	// Validate interest level (optional, but if provided, validate)
 	if payload.InterestLevel != nil && *payload.InterestLevel != "" {
-		validLevels := []string{"low", "medium", "high", "very_high"}
-		isValid := false
-		for _, level := range validLevels {
-			if strings.ToLower(*payload.InterestLevel) == level {
-				isValid = true
-				break
-			}
-		}
-		if !isValid {
-			return fmt.Errorf("interestLevel: must be one of: low, medium, high, very_high")
+		level, err := ParseInterestLevel(string(*payload.InterestLevel))
+		if err != nil {
+			return err
 		}
+		*payload.InterestLevel = level
 	}
 
 	// Validate notes (optional, but if provided, validate)
@@ -216,19 +315,17 @@ func ValidateUpdateJobApplicationPayload(payload *updateJobApplicationPayload) e
 
 	// Validate tags (optional, but if provided, validate each tag)
 	if len(payload.Tags) > 0 {
-		if len(payload.Tags) > 20 {
-			return fmt.Errorf("tags: too many tags (maximum 20)")
+		tags, err := validation.ValidateTags(payload.Tags)
+		if err != nil {
+			return err
 		}
+		payload.Tags = tags
 		for i, tag := range payload.Tags {
-			tagStr := fmt.Sprintf("%v", tag)
-			if err := validation.ValidateString(tagStr, 1, 50, fmt.Sprintf("tags[%d]", i)); err != nil {
-				return fmt.Errorf("tags[%d]: %w", i, err)
-			}
 			// Check for SQL injection and XSS
-			if err := validation.ValidateNoSQLInjection(tagStr); err != nil {
+			if err := validation.ValidateNoSQLInjection(tag); err != nil {
 				return fmt.Errorf("tags[%d]: %w", i, err)
 			}
-			if err := validation.ValidateNoXSS(tagStr); err != nil {
+			if err := validation.ValidateNoXSS(tag); err != nil {
 				return fmt.Errorf("tags[%d]: %w", i, err)
 			}
 		}
@@ -264,6 +361,17 @@ func ValidateUpdateJobApplicationPayload(payload *updateJobApplicationPayload) e
 		}
 	}
 
+	// Validate next action (optional, blank clears it)
+	if payload.NextAction != nil && strings.TrimSpace(*payload.NextAction) != "" {
+		action := strings.TrimSpace(*payload.NextAction)
+		if err := validation.ValidateString(action, 1, MaxNextActionLength, "nextAction"); err != nil {
+			return fmt.Errorf("nextAction: %w", err)
+		}
+		if err := validation.ValidateNoXSS(action); err != nil {
+			return fmt.Errorf("nextAction: %w", err)
+		}
+	}
+
 	// Validate application method (optional, but if provided, validate)
 	if payload.ApplicationMethod != nil && *payload.ApplicationMethod != "" {
 		method := *payload.ApplicationMethod
@@ -295,20 +403,8 @@ func ValidateUpdateJobApplicationPayload(payload *updateJobApplicationPayload) e
 }
 
 // ValidateListJobApplicationsQueryParams validates query parameters for ListJobApplications
-func ValidateListJobApplicationsQueryParams(limit, offset int, website, status, resumeIDStr, interestLevel, source, applicationMethod, language string) error {
-	// Validate limit
-	if limit < 1 {
-		return fmt.Errorf("limit: must be at least 1")
-	}
-	if limit > 200 {
-		return fmt.Errorf("limit: must be at most 200")
-	}
-
-	// Validate offset
-	if offset < 0 {
-		return fmt.Errorf("offset: must be at least 0")
-	}
-
+// (limit and offset are normalized by pagination.FromQuery)
+func ValidateListJobApplicationsQueryParams(website, status, resumeIDStr, interestLevel, source, applicationMethod, language string) error {
 	// Validate website (optional)
 	if website != "" {
 		if err := validation.ValidateString(website, 1, 255, "website"); err != nil {
@@ -318,7 +414,7 @@ func ValidateListJobApplicationsQueryParams(limit, offset int, website, status,
 
 	// Validate status (optional)
 	if status != "" {
-		validStatuses := []string{"draft", "applied", "interviewing", "offer", "rejected", "withdrawn", "accepted"}
+		validStatuses := []string{"draft", "applied", "interviewing", "offer", "rejected", "withdrawn", "accepted", "reopened"}
 		isValid := false
 		for _, validStatus := range validStatuses {
 			if strings.ToLower(status) == validStatus {
@@ -340,16 +436,8 @@ func ValidateListJobApplicationsQueryParams(limit, offset int, website, status,
 
 	// Validate interest level (optional)
 	if interestLevel != "" {
-		validLevels := []string{"low", "medium", "high", "very_high"}
-		isValid := false
-		for _, level := range validLevels {
-			if strings.ToLower(interestLevel) == level {
-				isValid = true
-				break
-			}
-		}
-		if !isValid {
-			return fmt.Errorf("interestLevel: must be one of: low, medium, high, very_high")
+		if _, err := ParseInterestLevel(interestLevel); err != nil {
+			return err
 		}
 	}
 
@@ -377,3 +465,593 @@ func ValidateListJobApplicationsQueryParams(limit, offset int, website, status,
 	return nil
 }
 
+
+// ValidateStaleFilterParams validates the stale and staleDays query parameters for ListJobApplications
+func ValidateStaleFilterParams(stale string, staleDays int) error {
+	if stale != "" && stale != "true" && stale != "false" {
+		return fmt.Errorf("stale: must be true or false")
+	}
+	if staleDays < 1 {
+		return fmt.Errorf("staleDays: must be at least 1")
+	}
+	if staleDays > 365 {
+		return fmt.Errorf("staleDays: must be at most 365")
+	}
+	return nil
+}
+
+// immutablePatchFields cannot be touched by a JSON Patch
+var immutablePatchFields = map[string]bool{
+	"id":        true,
+	"userId":    true,
+	"createdAt": true,
+	"updatedAt": true,
+}
+
+// patchableFields mirrors the fields accepted by the partial update payload
+var patchableFields = map[string]bool{
+	"resumeId":           true,
+	"salaryMin":          true,
+	"salaryMax":          true,
+	"salaryCurrency":     true,
+	"jobDescription":     true,
+	"deadline":           true,
+	"interestLevel":      true,
+	"notes":              true,
+	"tags":               true,
+	"followUpDate":       true,
+	"responseReceivedAt": true,
+	"rejectionReason":    true,
+	"nextInterviewDate":  true,
+	"source":             true,
+	"applicationMethod":  true,
+	"language":           true,
+	"nextAction":         true,
+	"nextActionDue":      true,
+}
+
+// ValidateJobApplicationPatch ensures a JSON Patch only modifies patchable fields.
+// "test" operations may read any field.
+func ValidateJobApplicationPatch(patch jsonpatch.Patch) error {
+	if len(patch) == 0 {
+		return fmt.Errorf("patch: at least one operation is required")
+	}
+	for i, op := range patch {
+		if op.Op == "test" {
+			continue
+		}
+		paths := []string{op.Path}
+		if op.Op == "move" {
+			paths = append(paths, op.From)
+		}
+		for _, path := range paths {
+			field := jsonpatch.TopLevelMember(path)
+			if immutablePatchFields[field] {
+				return fmt.Errorf("patch[%d]: %s is immutable", i, field)
+			}
+			if !patchableFields[field] {
+				return fmt.Errorf("patch[%d]: path %q cannot be modified", i, path)
+			}
+		}
+	}
+	return nil
+}
+
+// ValidateSalaryRange checks the salaries that are set: neither may be negative and,
+// when both are set, salaryMin may not exceed salaryMax.
+func ValidateSalaryRange(salaryMin, salaryMax *int) error {
+	if (salaryMin != nil && *salaryMin < 0) || (salaryMax != nil && *salaryMax < 0) {
+		return NewDomainError(ErrCodeInvalidPayload, ErrNegativeSalary)
+	}
+	if salaryMin != nil && salaryMax != nil && *salaryMin > *salaryMax {
+		return NewDomainError(ErrCodeInvalidPayload, ErrSalaryRangeInverted)
+	}
+	return nil
+}
+
+// ValidatePatchedJobApplication applies the partial update rules to a patched application
+func ValidatePatchedJobApplication(application *JobApplication) error {
+	payload := updateJobApplicationPayload{
+		SalaryMin:         application.SalaryMin,
+		SalaryMax:         application.SalaryMax,
+		SalaryCurrency:    &application.SalaryCurrency,
+		JobDescription:    &application.JobDescription,
+		InterestLevel:     &application.InterestLevel,
+		Notes:             &application.Notes,
+		Tags:              application.Tags,
+		RejectionReason:   &application.RejectionReason,
+		Source:            &application.Source,
+		ApplicationMethod: &application.ApplicationMethod,
+		Language:          &application.Language,
+	}
+	if err := ValidateUpdateJobApplicationPayload(&payload); err != nil {
+		return err
+	}
+	application.Tags = payload.Tags
+	return nil
+}
+
+// maxBulkTagApplications caps how many applications a single bulk tag request may touch
+const maxBulkTagApplications = 200
+
+// ValidateBulkTagPayload validates bulk tag add/remove payload
+func ValidateBulkTagPayload(payload *bulkTagPayload) error {
+	if len(payload.ApplicationIDs) == 0 {
+		return fmt.Errorf("applicationIds: at least one application id is required")
+	}
+	if len(payload.ApplicationIDs) > maxBulkTagApplications {
+		return fmt.Errorf("applicationIds: too many application ids (maximum %d)", maxBulkTagApplications)
+	}
+	for i, id := range payload.ApplicationIDs {
+		if err := validation.ValidateUUID(id); err != nil {
+			return fmt.Errorf("applicationIds[%d]: %w", i, err)
+		}
+	}
+
+	tag, err := validation.ValidateTag(payload.Tag)
+	if err != nil {
+		return err
+	}
+	payload.Tag = tag
+	// Check for SQL injection and XSS
+	if err := validation.ValidateNoSQLInjection(tag); err != nil {
+		return fmt.Errorf("tag: %w", err)
+	}
+	if err := validation.ValidateNoXSS(tag); err != nil {
+		return fmt.Errorf("tag: %w", err)
+	}
+
+	return nil
+}
+
+// maxBulkInterestApplications caps how many applications one bulk interest request may update
+const maxBulkInterestApplications = 200
+
+// ValidateBulkInterestPayload validates the bulk interest payload and returns the parsed
+// ids and the normalized level. An unknown level is a domain error, as on create and update.
+func ValidateBulkInterestPayload(payload *bulkInterestPayload) ([]uuid.UUID, InterestLevel, error) {
+	if len(payload.ApplicationIDs) == 0 {
+		return nil, "", fmt.Errorf("applicationIds: at least one application id is required")
+	}
+	if len(payload.ApplicationIDs) > maxBulkInterestApplications {
+		return nil, "", fmt.Errorf("applicationIds: too many application ids (maximum %d)", maxBulkInterestApplications)
+	}
+	ids := make([]uuid.UUID, 0, len(payload.ApplicationIDs))
+	for i, raw := range payload.ApplicationIDs {
+		if err := validation.ValidateUUID(raw); err != nil {
+			return nil, "", fmt.Errorf("applicationIds[%d]: %w", i, err)
+		}
+		id, _ := uuid.Parse(raw)
+		ids = append(ids, id)
+	}
+
+	level, err := ParseInterestLevel(payload.InterestLevel)
+	if err != nil {
+		return nil, "", err
+	}
+	return ids, level, nil
+}
+
+// maxBulkContactApplications caps how many applications one bulk contact request may move
+const maxBulkContactApplications = 200
+
+// ValidateBulkContactPayload validates the bulk contact payload and returns the parsed ids and
+// the shared reply. A missing responseDate means the reply arrived now.
+func ValidateBulkContactPayload(payload *bulkContactPayload) ([]uuid.UUID, BulkContact, error) {
+	var contact BulkContact
+	if len(payload.ApplicationIDs) == 0 {
+		return nil, contact, fmt.Errorf("applicationIds: at least one application id is required")
+	}
+	if len(payload.ApplicationIDs) > maxBulkContactApplications {
+		return nil, contact, fmt.Errorf("applicationIds: too many application ids (maximum %d)", maxBulkContactApplications)
+	}
+	ids := make([]uuid.UUID, 0, len(payload.ApplicationIDs))
+	for i, raw := range payload.ApplicationIDs {
+		if err := validation.ValidateUUID(raw); err != nil {
+			return nil, contact, fmt.Errorf("applicationIds[%d]: %w", i, err)
+		}
+		id, _ := uuid.Parse(raw)
+		ids = append(ids, id)
+	}
+
+	contact.Message = strings.TrimSpace(payload.Message)
+	if err := validation.ValidateString(contact.Message, 1, 5000, "message"); err != nil {
+		return nil, contact, fmt.Errorf("message: %w", err)
+	}
+	if err := validation.ValidateNoXSS(contact.Message); err != nil {
+		return nil, contact, fmt.Errorf("message: %w", err)
+	}
+
+	contact.ResponseChannel = strings.TrimSpace(payload.ResponseChannel)
+	if err := validation.ValidateString(contact.ResponseChannel, 0, 50, "responseChannel"); err != nil {
+		return nil, contact, fmt.Errorf("responseChannel: %w", err)
+	}
+
+	contact.ResponseDate = time.Now().UTC()
+	if payload.ResponseDate != "" {
+		responseDate, err := time.Parse(time.RFC3339, payload.ResponseDate)
+		if err != nil {
+			return nil, contact, fmt.Errorf("responseDate: invalid date format, use ISO 8601")
+		}
+		if responseDate.After(contact.ResponseDate) {
+			return nil, contact, fmt.Errorf("responseDate: cannot be in the future")
+		}
+		contact.ResponseDate = responseDate.UTC()
+	}
+
+	return dedupeIDs(ids), contact, nil
+}
+
+// maxBatchGetApplications caps how many applications one batch-get request can load
+const maxBatchGetApplications = 100
+
+// ValidateBatchGetPayload validates the batch-get payload and returns the parsed ids
+func ValidateBatchGetPayload(payload *batchGetPayload) ([]uuid.UUID, error) {
+	if len(payload.IDs) == 0 {
+		return nil, fmt.Errorf("ids: at least one application id is required")
+	}
+	if len(payload.IDs) > maxBatchGetApplications {
+		return nil, fmt.Errorf("ids: too many application ids (maximum %d)", maxBatchGetApplications)
+	}
+	ids := make([]uuid.UUID, 0, len(payload.IDs))
+	for i, raw := range payload.IDs {
+		if err := validation.ValidateUUID(raw); err != nil {
+			return nil, fmt.Errorf("ids[%d]: %w", i, err)
+		}
+		id, _ := uuid.Parse(raw)
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// maxCoverLetterBatchSize caps how many cover letters one batch request can generate
+const maxCoverLetterBatchSize = 20
+
+// ValidateCoverLetterBatchPayload validates the batch cover letter payload and returns the
+// parsed ids without duplicates. The agent is checked like ValidateGenerateCoverLetterPayload.
+func ValidateCoverLetterBatchPayload(payload *coverLetterBatchPayload) ([]uuid.UUID, error) {
+	if len(payload.ApplicationIDs) == 0 {
+		return nil, fmt.Errorf("applicationIds: at least one application id is required")
+	}
+	if len(payload.ApplicationIDs) > maxCoverLetterBatchSize {
+		return nil, fmt.Errorf("applicationIds: too many application ids (maximum %d)", maxCoverLetterBatchSize)
+	}
+	ids := make([]uuid.UUID, 0, len(payload.ApplicationIDs))
+	for i, raw := range payload.ApplicationIDs {
+		if err := validation.ValidateUUID(raw); err != nil {
+			return nil, fmt.Errorf("applicationIds[%d]: %w", i, err)
+		}
+		id, _ := uuid.Parse(raw)
+		ids = append(ids, id)
+	}
+
+	payload.Agent = strings.TrimSpace(payload.Agent)
+	if payload.Agent == "" {
+		payload.Agent = DefaultCoverLetterAgent
+	} else if !IsValidCoverLetterAgent(payload.Agent) {
+		return nil, fmt.Errorf("agent: unsupported cover letter agent %q", payload.Agent)
+	}
+	return dedupeIDs(ids), nil
+}
+
+const (
+	// minComparedApplications and maxComparedApplications bound the compare endpoint
+	minComparedApplications = 2
+	maxComparedApplications = 5
+)
+
+// ValidateOfferPayload validates an offer payload and converts it to OfferDetails
+func ValidateOfferPayload(payload *offerPayload) (OfferDetails, error) {
+	details := OfferDetails{
+		BaseSalary:    payload.BaseSalary,
+		Currency:      payload.Currency,
+		Bonus:         payload.Bonus,
+		EquityValue:   payload.EquityValue,
+		EquityDetails: payload.EquityDetails,
+		Notes:         payload.Notes,
+	}
+
+	if payload.BaseSalary <= 0 {
+		return details, fmt.Errorf("baseSalary: must be greater than zero")
+	}
+	if payload.Currency != "" && len(strings.TrimSpace(payload.Currency)) != 3 {
+		return details, fmt.Errorf("currency: must be a 3-letter code")
+	}
+	if payload.Bonus != nil && *payload.Bonus < 0 {
+		return details, fmt.Errorf("bonus: cannot be negative")
+	}
+	if payload.EquityValue != nil && *payload.EquityValue < 0 {
+		return details, fmt.Errorf("equityValue: cannot be negative")
+	}
+
+	for field, value := range map[string]string{"equityDetails": payload.EquityDetails, "notes": payload.Notes} {
+		if value == "" {
+			continue
+		}
+		if err := validation.ValidateString(value, 1, 5000, field); err != nil {
+			return details, fmt.Errorf("%s: %w", field, err)
+		}
+		if err := validation.ValidateNoXSS(value); err != nil {
+			return details, fmt.Errorf("%s: %w", field, err)
+		}
+	}
+
+	if payload.StartDate != "" {
+		startDate, err := time.Parse(time.RFC3339, payload.StartDate)
+		if err != nil {
+			return details, fmt.Errorf("startDate: invalid date format, use ISO 8601")
+		}
+		details.StartDate = &startDate
+	}
+	if payload.AcceptBy != "" {
+		acceptBy, err := time.Parse(time.RFC3339, payload.AcceptBy)
+		if err != nil {
+			return details, fmt.Errorf("acceptBy: invalid date format, use ISO 8601")
+		}
+		details.AcceptBy = &acceptBy
+	}
+
+	return details, nil
+}
+
+// ValidateExpensePayload validates an expense. The date may not be in the future.
+func ValidateExpensePayload(payload *expensePayload) (ExpenseDetails, error) {
+	details := ExpenseDetails{
+		Description: strings.TrimSpace(payload.Description),
+		AmountCents: payload.AmountCents,
+		Currency:    payload.Currency,
+	}
+
+	if err := validation.ValidateString(details.Description, 1, MaxExpenseDescriptionLength, "description"); err != nil {
+		return details, fmt.Errorf("description: %w", err)
+	}
+	if err := validation.ValidateNoXSS(details.Description); err != nil {
+		return details, fmt.Errorf("description: %w", err)
+	}
+	if payload.AmountCents <= 0 {
+		return details, fmt.Errorf("amountCents: must be greater than zero")
+	}
+	if payload.Currency != "" && len(strings.TrimSpace(payload.Currency)) != 3 {
+		return details, fmt.Errorf("currency: must be a 3-letter code")
+	}
+
+	if payload.IncurredAt != "" {
+		incurredAt, err := time.Parse(time.RFC3339, payload.IncurredAt)
+		if err != nil {
+			return details, fmt.Errorf("incurredAt: invalid date format, use ISO 8601")
+		}
+		if incurredAt.After(time.Now()) {
+			return details, fmt.Errorf("incurredAt: cannot be in the future")
+		}
+		details.IncurredAt = &incurredAt
+	}
+
+	return details, nil
+}
+
+// ValidateBulkDeleteParams parses the bulk delete query parameters. Dates
+// accept RFC3339 or YYYY-MM-DD (midnight UTC). At least one filter is required.
+func ValidateBulkDeleteParams(status, website, appliedBefore, createdBefore string) (BulkDeleteFilter, error) {
+	var filter BulkDeleteFilter
+
+	if status != "" {
+		appStatus, err := ParseApplicationStatus(status)
+		if err != nil {
+			return filter, fmt.Errorf("status: unsupported status %q", status)
+		}
+		filter.Status = &appStatus
+	}
+	if website != "" {
+		if err := validation.ValidateString(website, 1, 50, "website"); err != nil {
+			return filter, fmt.Errorf("website: %w", err)
+		}
+		normalizedWebsite := strings.ToLower(strings.TrimSpace(website))
+		filter.Website = &normalizedWebsite
+	}
+	if appliedBefore != "" {
+		parsed, err := parseFilterDate(appliedBefore)
+		if err != nil {
+			return filter, fmt.Errorf("appliedBefore: must be RFC3339 or YYYY-MM-DD")
+		}
+		filter.AppliedBefore = &parsed
+	}
+	if createdBefore != "" {
+		parsed, err := parseFilterDate(createdBefore)
+		if err != nil {
+			return filter, fmt.Errorf("createdBefore: must be RFC3339 or YYYY-MM-DD")
+		}
+		filter.CreatedBefore = &parsed
+	}
+
+	if filter.IsEmpty() {
+		return filter, fmt.Errorf("at least one filter is required: status, website, appliedBefore or createdBefore")
+	}
+	return filter, nil
+}
+
+func parseFilterDate(value string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if parsed, err := time.Parse(time.RFC3339, value); err == nil {
+		return parsed.UTC(), nil
+	}
+	return time.Parse(timeSeriesDateLayout, value)
+}
+
+// maxSnoozeDuration bounds how far ahead an application can be snoozed
+const maxSnoozeDuration = 365 * 24 * time.Hour
+
+// ValidateSnoozePayload parses the snooze date, which must be in the future and
+// at most a year away
+func ValidateSnoozePayload(payload *snoozePayload) (time.Time, error) {
+	if strings.TrimSpace(payload.Until) == "" {
+		return time.Time{}, fmt.Errorf("until: is required")
+	}
+	until, err := parseFilterDate(payload.Until)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("until: must be an RFC 3339 timestamp or YYYY-MM-DD date")
+	}
+	now := time.Now().UTC()
+	if !until.After(now) {
+		return time.Time{}, fmt.Errorf("until: must be in the future")
+	}
+	if until.Sub(now) > maxSnoozeDuration {
+		return time.Time{}, fmt.Errorf("until: must be at most one year ahead")
+	}
+	return until, nil
+}
+
+// MaxPinnedApplications caps how many applications a user can pin, which is also the
+// highest pin order
+const MaxPinnedApplications = 10
+
+// ValidatePinPayload checks the requested pin order is between 1 and MaxPinnedApplications
+func ValidatePinPayload(payload *pinPayload) (int, error) {
+	if payload.Order == nil {
+		return 0, fmt.Errorf("order: is required")
+	}
+	if *payload.Order < 1 || *payload.Order > MaxPinnedApplications {
+		return 0, fmt.Errorf("order: must be between 1 and %d", MaxPinnedApplications)
+	}
+	return *payload.Order, nil
+}
+
+// ValidateReopenPayload checks a reason is given for reopening and returns it trimmed
+func ValidateReopenPayload(payload *reopenPayload) (string, error) {
+	reason := strings.TrimSpace(payload.Reason)
+	if reason == "" {
+		return "", fmt.Errorf("reason: is required")
+	}
+	if err := validation.ValidateString(reason, 1, MaxStatusChangeReasonLength, "reason"); err != nil {
+		return "", fmt.Errorf("reason: %w", err)
+	}
+	if err := validation.ValidateNoXSS(reason); err != nil {
+		return "", fmt.Errorf("reason: %w", err)
+	}
+	return reason, nil
+}
+
+// ValidateTimeSeriesParams validates the query parameters of the time series endpoint,
+// returning the metric and number of days with defaults applied
+func ValidateTimeSeriesParams(rawMetric, rawDays string) (TimeSeriesMetric, int, error) {
+	metric := TimeSeriesMetricApplied
+	if rawMetric != "" {
+		metric = TimeSeriesMetric(strings.ToLower(strings.TrimSpace(rawMetric)))
+		if !metric.IsValid() {
+			return "", 0, fmt.Errorf("metric: must be one of applied, created, responded")
+		}
+	}
+
+	days := defaultTimeSeriesDays
+	if rawDays != "" {
+		parsed, err := strconv.Atoi(rawDays)
+		if err != nil || parsed < 1 || parsed > maxTimeSeriesDays {
+			return "", 0, fmt.Errorf("days: must be an integer between 1 and %d", maxTimeSeriesDays)
+		}
+		days = parsed
+	}
+
+	return metric, days, nil
+}
+
+// maxSearchLength caps the ?search= term of list endpoints
+const maxSearchLength = 100
+
+// ValidateSearchQuery validates the ?search= term matched against company, title and location
+func ValidateSearchQuery(search string) error {
+	if search == "" {
+		return nil
+	}
+	if err := validation.ValidateString(search, 1, maxSearchLength, "search"); err != nil {
+		return fmt.Errorf("search: %w", err)
+	}
+	return nil
+}
+
+// ParseTagFilter parses the comma-separated ?tags= filter and its ?tagMatch= mode, "all"
+// (the default) or "any". Blank entries are ignored.
+func ParseTagFilter(raw, match string) ([]string, bool, error) {
+	var matchAny bool
+	switch strings.ToLower(strings.TrimSpace(match)) {
+	case "", "all":
+	case "any":
+		matchAny = true
+	default:
+		return nil, false, fmt.Errorf("tagMatch: must be all or any")
+	}
+
+	var tags []string
+	for _, tag := range strings.Split(raw, ",") {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+	tags, err := validation.ValidateTags(tags)
+	if err != nil {
+		return nil, false, err
+	}
+	return tags, matchAny, nil
+}
+
+// ValidateCompanyNameQuery validates the required ?name= of the by-company listing
+func ValidateCompanyNameQuery(name string) error {
+	if err := validation.ValidateString(strings.TrimSpace(name), 1, 200, "name"); err != nil {
+		return fmt.Errorf("name: %w", err)
+	}
+	return nil
+}
+
+// ValidateBoardQueryParams validates the board's filters and returns the column size,
+// defaulting to defaultBoardLimitPerStatus and clamped to the pagination maximum
+func ValidateBoardQueryParams(website, search, rawLimitPerStatus, includeSnoozed string) (int, error) {
+	if website != "" {
+		if err := validation.ValidateString(website, 1, 255, "website"); err != nil {
+			return 0, fmt.Errorf("website: %w", err)
+		}
+	}
+	if err := ValidateSearchQuery(search); err != nil {
+		return 0, err
+	}
+	if includeSnoozed != "" && includeSnoozed != "true" && includeSnoozed != "false" {
+		return 0, fmt.Errorf("includeSnoozed: must be true or false")
+	}
+
+	limitPerStatus := defaultBoardLimitPerStatus
+	if rawLimitPerStatus != "" {
+		parsed, err := strconv.Atoi(rawLimitPerStatus)
+		if err != nil || parsed < 1 {
+			return 0, fmt.Errorf("limitPerStatus: must be a positive integer")
+		}
+		limitPerStatus = parsed
+	}
+	return min(limitPerStatus, pagination.CurrentLimits().Max), nil
+}
+
+// ValidateCompareIDs validates the comma-separated ids query parameter of the compare endpoint
+func ValidateCompareIDs(rawIDs string) ([]uuid.UUID, error) {
+	parts := strings.Split(rawIDs, ",")
+	ids := make([]uuid.UUID, 0, len(parts))
+	seen := make(map[uuid.UUID]struct{}, len(parts))
+	for i, part := range parts {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		if err := validation.ValidateUUID(part); err != nil {
+			return nil, fmt.Errorf("ids[%d]: %w", i, err)
+		}
+		id, _ := uuid.Parse(part)
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		ids = append(ids, id)
+	}
+
+	if len(ids) < minComparedApplications {
+		return nil, fmt.Errorf("ids: at least %d distinct application ids are required", minComparedApplications)
+	}
+	if len(ids) > maxComparedApplications {
+		return nil, fmt.Errorf("ids: too many application ids (maximum %d)", maxComparedApplications)
+	}
+	return ids, nil
+}
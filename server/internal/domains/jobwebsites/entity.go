@@ -1,11 +1,70 @@
 package jobwebsites
 
 import (
+	"database/sql/driver"
+	"encoding/json"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 )
 
+// Application methods a job website can support.
+const (
+	ApplicationMethodAuto     = "auto"
+	ApplicationMethodManual   = "manual"
+	ApplicationMethodAssisted = "assisted"
+)
+
+// IsValidApplicationMethod reports whether method is a known application method.
+func IsValidApplicationMethod(method string) bool {
+	switch method {
+	case ApplicationMethodAuto, ApplicationMethodManual, ApplicationMethodAssisted:
+		return true
+	}
+	return false
+}
+
+// JSONArray is a custom type for storing JSON arrays in PostgreSQL. It is never
+// null in JSON: nil and empty arrays both marshal to [], and NULL columns scan
+// to an empty array.
+type JSONArray []string
+
+// MarshalJSON implements the json.Marshaler interface.
+func (j JSONArray) MarshalJSON() ([]byte, error) {
+	if j == nil {
+		return []byte("[]"), nil
+	}
+	return json.Marshal([]string(j))
+}
+
+// Value implements the driver.Valuer interface.
+func (j JSONArray) Value() (driver.Value, error) {
+	if j == nil {
+		return nil, nil
+	}
+	return json.Marshal(j)
+}
+
+// Scan implements the sql.Scanner interface.
+func (j *JSONArray) Scan(value interface{}) error {
+	*j = JSONArray{}
+	if value == nil {
+		return nil
+	}
+	bytes, ok := value.([]byte)
+	if !ok {
+		bytes = []byte(value.(string))
+	}
+	if err := json.Unmarshal(bytes, j); err != nil {
+		return err
+	}
+	if *j == nil { // A JSON null stored in the column
+		*j = JSONArray{}
+	}
+	return nil
+}
+
 // JobWebsite represents a job website configuration.
 type JobWebsite struct {
 	ID           uuid.UUID `gorm:"column:id;type:uuid;primaryKey" json:"id"`
@@ -17,6 +76,12 @@ type JobWebsite struct {
 	Enabled      bool      `gorm:"column:enabled;not null;default:true" json:"enabled"`
 	BaseURL      string    `gorm:"column:base_url;size:512" json:"baseUrl"`
 	LoginURL     string    `gorm:"column:login_url;size:512" json:"loginUrl"`
+	// DefaultApplicationMethod is applied to new applications that do not choose one; empty means none
+	DefaultApplicationMethod string `gorm:"column:default_application_method;size:50" json:"defaultApplicationMethod,omitempty"`
+	// SupportedApplicationMethods restricts the methods applications may use; empty allows every method
+	SupportedApplicationMethods JSONArray `gorm:"column:supported_application_methods;type:jsonb" json:"supportedApplicationMethods"`
+	// ScrapingConfig holds parsing hints for posting pages on this website; nil means generic parsing
+	ScrapingConfig *ScrapingConfig `gorm:"column:scraping_config;type:jsonb" json:"scrapingConfig,omitempty"`
 	CreatedAt    time.Time `gorm:"column:created_at" json:"createdAt"`
 	UpdatedAt    time.Time `gorm:"column:updated_at" json:"updatedAt"`
 }
@@ -62,6 +127,22 @@ func (j *JobWebsite) Validate() error {
 	if j.CurrentCount < 0 {
 		return NewDomainError(ErrCodeInvalidPayload, ErrInvalidCurrentCount)
 	}
+	for _, method := range j.SupportedApplicationMethods {
+		if !IsValidApplicationMethod(method) {
+			return NewDomainError(ErrCodeInvalidPayload, ErrInvalidApplicationMethod)
+		}
+	}
+	if j.DefaultApplicationMethod != "" {
+		if !IsValidApplicationMethod(j.DefaultApplicationMethod) {
+			return NewDomainError(ErrCodeInvalidPayload, ErrInvalidApplicationMethod)
+		}
+		if !j.SupportsApplicationMethod(j.DefaultApplicationMethod) {
+			return NewDomainError(ErrCodeInvalidPayload, ErrDefaultMethodNotSupported)
+		}
+	}
+	if j.ScrapingConfig != nil {
+		return j.ScrapingConfig.Validate()
+	}
 	return nil
 }
 
@@ -109,3 +190,32 @@ func (j *JobWebsite) SetEnabled(enabled bool) {
 	j.UpdatedAt = time.Now().UTC()
 }
 
+// SetApplicationMethods replaces the default and supported application methods.
+// Methods are lowercased and duplicates dropped.
+func (j *JobWebsite) SetApplicationMethods(defaultMethod string, supported []string) error {
+	j.DefaultApplicationMethod = strings.ToLower(strings.TrimSpace(defaultMethod))
+	j.SupportedApplicationMethods = JSONArray{}
+	for _, method := range supported {
+		method = strings.ToLower(strings.TrimSpace(method))
+		if !j.supportsExactly(method) {
+			j.SupportedApplicationMethods = append(j.SupportedApplicationMethods, method)
+		}
+	}
+	j.UpdatedAt = time.Now().UTC()
+	return j.Validate()
+}
+
+// SupportsApplicationMethod reports whether applications on this website may use method.
+// A website without a supported list accepts every method.
+func (j *JobWebsite) SupportsApplicationMethod(method string) bool {
+	return len(j.SupportedApplicationMethods) == 0 || j.supportsExactly(method)
+}
+
+func (j *JobWebsite) supportsExactly(method string) bool {
+	for _, supported := range j.SupportedApplicationMethods {
+		if supported == method {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,69 @@
+package jobwebsites
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJobWebsite_SetApplicationMethods(t *testing.T) {
+	website, err := NewJobWebsite("glassdoor", "Glassdoor", "", "", 10)
+	require.NoError(t, err)
+	assert.True(t, website.SupportsApplicationMethod(ApplicationMethodAuto), "no supported list accepts every method")
+
+	require.NoError(t, website.SetApplicationMethods(" Manual ", []string{"manual", "MANUAL", "assisted"}))
+	assert.Equal(t, ApplicationMethodManual, website.DefaultApplicationMethod)
+	assert.Equal(t, JSONArray{"manual", "assisted"}, website.SupportedApplicationMethods)
+	assert.False(t, website.SupportsApplicationMethod(ApplicationMethodAuto))
+	assert.True(t, website.SupportsApplicationMethod(ApplicationMethodAssisted))
+}
+
+func TestJobWebsite_SetApplicationMethods_Invalid(t *testing.T) {
+	website, err := NewJobWebsite("glassdoor", "Glassdoor", "", "", 10)
+	require.NoError(t, err)
+
+	assert.EqualError(t, website.SetApplicationMethods("", []string{"manual", "email"}), ErrInvalidApplicationMethod)
+	assert.EqualError(t, website.SetApplicationMethods("robot", nil), ErrInvalidApplicationMethod)
+	assert.EqualError(t, website.SetApplicationMethods("auto", []string{"manual"}), ErrDefaultMethodNotSupported)
+}
+
+func TestJobWebsite_SetScrapingConfig(t *testing.T) {
+	website, err := NewJobWebsite("greenhouse", "Greenhouse", "", "", 10)
+	require.NoError(t, err)
+
+	require.NoError(t, website.SetScrapingConfig(&ScrapingConfig{
+		Strategy:  " Selectors ",
+		Selectors: map[string]string{"jobTitle": " h1.app-title ", "companyName": `meta[property="og:site_name"]`},
+	}))
+	require.NotNil(t, website.ScrapingConfig)
+	assert.Equal(t, ScrapingStrategySelectors, website.ScrapingConfig.Strategy)
+	assert.Equal(t, "h1.app-title", website.ScrapingConfig.Selectors["jobTitle"])
+
+	require.NoError(t, website.SetScrapingConfig(nil))
+	assert.Nil(t, website.ScrapingConfig)
+}
+
+func TestJobWebsite_SetScrapingConfig_Invalid(t *testing.T) {
+	website, err := NewJobWebsite("greenhouse", "Greenhouse", "", "", 10)
+	require.NoError(t, err)
+
+	assert.EqualError(t, website.SetScrapingConfig(&ScrapingConfig{Strategy: "headless"}), ErrInvalidScrapingStrategy)
+	assert.EqualError(t, website.SetScrapingConfig(&ScrapingConfig{Strategy: "selectors"}), ErrScrapingSelectorsRequired)
+	assert.EqualError(t, website.SetScrapingConfig(&ScrapingConfig{
+		Strategy:  "structured-data",
+		Selectors: map[string]string{"jobTitle": "h1"},
+	}), ErrScrapingSelectorsUnused)
+	assert.EqualError(t, website.SetScrapingConfig(&ScrapingConfig{
+		Strategy:  "selectors",
+		Selectors: map[string]string{"salary": ".salary"},
+	}), ErrInvalidScrapingField)
+
+	err = website.SetScrapingConfig(&ScrapingConfig{
+		Strategy:  "selectors",
+		Selectors: map[string]string{"jobTitle": "div > h1"},
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), ErrInvalidScrapingSelector)
+	assert.Nil(t, website.ScrapingConfig, "invalid configs are not stored")
+}
@@ -19,17 +19,37 @@ const (
 	ErrUnableToPersist     = "jobwebsites: unable to persist data"
 	ErrUnableToFetch       = "jobwebsites: unable to fetch data"
 	ErrUnableToUpdate      = "jobwebsites: unable to update data"
+
+	ErrReassignWebsitesRequired = "jobwebsites: from and to websites are required"
+	ErrReassignSameWebsite      = "jobwebsites: from and to must be different websites"
+	ErrReassignTargetNotFound   = "jobwebsites: to must be a registered website"
+
+	ErrInvalidApplicationMethod  = "jobwebsites: application method must be one of auto, manual, assisted"
+	ErrDefaultMethodNotSupported = "jobwebsites: default application method must be one of the supported methods"
+
+	ErrInvalidScrapingStrategy   = "jobwebsites: scraping strategy must be selectors or structured-data"
+	ErrScrapingSelectorsRequired = "jobwebsites: the selectors strategy needs at least one selector"
+	ErrScrapingSelectorsUnused   = "jobwebsites: selectors are only used by the selectors strategy"
+	ErrInvalidScrapingField      = "jobwebsites: selectors can only target companyName, jobTitle, location or jobDescription"
+	ErrInvalidScrapingSelector   = "jobwebsites: invalid selector"
 )
 
 type DomainError struct {
 	Code    int
 	Message string
+	// cause is the underlying database error, kept so retries can tell transient failures apart
+	cause error
 }
 
 func (e *DomainError) Error() string {
 	return e.Message
 }
 
+// Unwrap returns the underlying error, if any.
+func (e *DomainError) Unwrap() error {
+	return e.cause
+}
+
 func NewDomainError(code int, message string) *DomainError {
 	return &DomainError{
 		Code:    code,
@@ -37,6 +57,15 @@ func NewDomainError(code int, message string) *DomainError {
 	}
 }
 
+// newRepositoryError reports a failed database operation, keeping err as the cause.
+func newRepositoryError(message string, err error) *DomainError {
+	return &DomainError{
+		Code:    ErrCodeRepositoryFailure,
+		Message: message,
+		cause:   err,
+	}
+}
+
 func AsDomainError(err error) (*DomainError, bool) {
 	var domainErr *DomainError
 	if errors.As(err, &domainErr) {
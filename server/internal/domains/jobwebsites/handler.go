@@ -5,8 +5,8 @@ import (
 	"strconv"
 
 	"github.com/gofiber/fiber/v2"
-	"github.com/google/uuid"
 
+	"woragis-jobs-service/pkg/middleware"
 	"woragis-jobs-service/pkg/response"
 )
 
@@ -17,19 +17,30 @@ type Handler interface {
 	ListJobWebsites(c *fiber.Ctx) error
 	UpdateJobWebsite(c *fiber.Ctx) error
 	ResetCounter(c *fiber.Ctx) error
+	SetScrapingConfig(c *fiber.Ctx) error
+	DeleteScrapingConfig(c *fiber.Ctx) error
 	DeleteJobWebsite(c *fiber.Ctx) error
+	ReassignApplications(c *fiber.Ctx) error
 }
 
 type handler struct {
-	service Service
-	logger  *slog.Logger
+	service      Service
+	applications ApplicationReassigner
+	logger       *slog.Logger
 }
 
 // NewHandler constructs a job website handler.
 func NewHandler(service Service, logger *slog.Logger) Handler {
+	return NewHandlerWithApplications(service, nil, logger)
+}
+
+// NewHandlerWithApplications constructs a job website handler that can move the user's
+// applications between websites.
+func NewHandlerWithApplications(service Service, applications ApplicationReassigner, logger *slog.Logger) Handler {
 	return &handler{
-		service: service,
-		logger:  logger,
+		service:      service,
+		applications: applications,
+		logger:       logger,
 	}
 }
 
@@ -39,6 +50,8 @@ type createJobWebsitePayload struct {
 	BaseURL     string `json:"baseUrl"`
 	LoginURL    string `json:"loginUrl"`
 	DailyLimit  int    `json:"dailyLimit"`
+	DefaultApplicationMethod    string   `json:"defaultApplicationMethod,omitempty"`    // Applied to applications created without a method
+	SupportedApplicationMethods []string `json:"supportedApplicationMethods,omitempty"` // Empty accepts every method
 }
 
 type updateJobWebsitePayload struct {
@@ -47,6 +60,8 @@ type updateJobWebsitePayload struct {
 	BaseURL     *string `json:"baseUrl,omitempty"`
 	LoginURL    *string `json:"loginUrl,omitempty"`
 	DisplayName *string `json:"displayName,omitempty"`
+	DefaultApplicationMethod    *string   `json:"defaultApplicationMethod,omitempty"`
+	SupportedApplicationMethods *[]string `json:"supportedApplicationMethods,omitempty"`
 }
 
 func (h *handler) CreateJobWebsite(c *fiber.Ctx) error {
@@ -64,6 +79,7 @@ func (h *handler) CreateJobWebsite(c *fiber.Ctx) error {
 		payload.BaseURL,
 		payload.LoginURL,
 		payload.DailyLimit,
+		ApplicationMethods{Default: payload.DefaultApplicationMethod, Supported: payload.SupportedApplicationMethods},
 	)
 	if err != nil {
 		return h.handleError(c, err)
@@ -73,11 +89,9 @@ func (h *handler) CreateJobWebsite(c *fiber.Ctx) error {
 }
 
 func (h *handler) GetJobWebsite(c *fiber.Ctx) error {
-	websiteID, err := uuid.Parse(c.Params("id"))
+	websiteID, err := middleware.UUIDParam(c, "id")
 	if err != nil {
-		return response.Error(c, fiber.StatusBadRequest, ErrCodeInvalidPayload, fiber.Map{
-			"message": "invalid website id",
-		})
+		return middleware.InvalidUUIDParam(c, "id")
 	}
 
 	website, err := h.service.GetJobWebsite(c.Context(), websiteID)
@@ -108,11 +122,9 @@ func (h *handler) ListJobWebsites(c *fiber.Ctx) error {
 }
 
 func (h *handler) UpdateJobWebsite(c *fiber.Ctx) error {
-	websiteID, err := uuid.Parse(c.Params("id"))
+	websiteID, err := middleware.UUIDParam(c, "id")
 	if err != nil {
-		return response.Error(c, fiber.StatusBadRequest, ErrCodeInvalidPayload, fiber.Map{
-			"message": "invalid website id",
-		})
+		return middleware.InvalidUUIDParam(c, "id")
 	}
 
 	var payload updateJobWebsitePayload
@@ -133,11 +145,9 @@ func (h *handler) UpdateJobWebsite(c *fiber.Ctx) error {
 }
 
 func (h *handler) ResetCounter(c *fiber.Ctx) error {
-	websiteID, err := uuid.Parse(c.Params("id"))
+	websiteID, err := middleware.UUIDParam(c, "id")
 	if err != nil {
-		return response.Error(c, fiber.StatusBadRequest, ErrCodeInvalidPayload, fiber.Map{
-			"message": "invalid website id",
-		})
+		return middleware.InvalidUUIDParam(c, "id")
 	}
 
 	if err := h.service.ResetCount(c.Context(), websiteID); err != nil {
@@ -152,14 +162,47 @@ func (h *handler) ResetCounter(c *fiber.Ctx) error {
 	return response.Success(c, fiber.StatusOK, website)
 }
 
-func (h *handler) DeleteJobWebsite(c *fiber.Ctx) error {
-	websiteID, err := uuid.Parse(c.Params("id"))
+func (h *handler) SetScrapingConfig(c *fiber.Ctx) error {
+	websiteID, err := middleware.UUIDParam(c, "id")
 	if err != nil {
+		return middleware.InvalidUUIDParam(c, "id")
+	}
+
+	var payload ScrapingConfig
+	if err := c.BodyParser(&payload); err != nil {
 		return response.Error(c, fiber.StatusBadRequest, ErrCodeInvalidPayload, fiber.Map{
-			"message": "invalid website id",
+			"message": "invalid request payload",
 		})
 	}
 
+	website, err := h.service.SetScrapingConfig(c.Context(), websiteID, &payload)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return response.Success(c, fiber.StatusOK, website)
+}
+
+func (h *handler) DeleteScrapingConfig(c *fiber.Ctx) error {
+	websiteID, err := middleware.UUIDParam(c, "id")
+	if err != nil {
+		return middleware.InvalidUUIDParam(c, "id")
+	}
+
+	website, err := h.service.SetScrapingConfig(c.Context(), websiteID, nil)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return response.Success(c, fiber.StatusOK, website)
+}
+
+func (h *handler) DeleteJobWebsite(c *fiber.Ctx) error {
+	websiteID, err := middleware.UUIDParam(c, "id")
+	if err != nil {
+		return middleware.InvalidUUIDParam(c, "id")
+	}
+
 	if err := h.service.DeleteJobWebsite(c.Context(), websiteID); err != nil {
 		return h.handleError(c, err)
 	}
@@ -184,7 +227,7 @@ func (h *handler) handleError(c *fiber.Ctx, err error) error {
 		})
 	}
 
-	h.logger.Error("unhandled error", slog.Any("error", err))
+	h.logger.ErrorContext(c.UserContext(), "unhandled error", slog.Any("error", err))
 	return response.Error(c, fiber.StatusInternalServerError, 500, fiber.Map{
 		"message": "internal server error",
 	})
@@ -0,0 +1,75 @@
+package jobwebsites
+
+import (
+	"context"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"woragis-jobs-service/pkg/middleware"
+	"woragis-jobs-service/pkg/response"
+)
+
+// ApplicationReassigner moves a user's job applications from one website to another
+// and returns how many were moved. The job applications service implements it.
+type ApplicationReassigner interface {
+	ReassignWebsite(ctx context.Context, userID uuid.UUID, from, to string) (int, error)
+}
+
+type reassignApplicationsPayload struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// ReassignApplications moves all of the user's applications on one website to another,
+// e.g. after a job board rebrands. The target must be a registered website; the source
+// need not be, so applications on a website that was already removed can be moved too.
+func (h *handler) ReassignApplications(c *fiber.Ctx) error {
+	userID, err := middleware.GetUserIDFromFiberContext(c)
+	if err != nil {
+		return response.Error(c, fiber.StatusUnauthorized, 401, fiber.Map{
+			"message": "authentication required",
+		})
+	}
+	if h.applications == nil {
+		return response.Error(c, fiber.StatusServiceUnavailable, 503, fiber.Map{
+			"message": "reassigning applications is not available",
+		})
+	}
+
+	var payload reassignApplicationsPayload
+	if err := c.BodyParser(&payload); err != nil {
+		return response.Error(c, fiber.StatusBadRequest, ErrCodeInvalidPayload, fiber.Map{
+			"message": "invalid request payload",
+		})
+	}
+
+	// Applications store website names lowercased
+	from := strings.ToLower(strings.TrimSpace(payload.From))
+	to := strings.ToLower(strings.TrimSpace(payload.To))
+	if from == "" || to == "" {
+		return h.handleError(c, NewDomainError(ErrCodeInvalidPayload, ErrReassignWebsitesRequired))
+	}
+	if from == to {
+		return h.handleError(c, NewDomainError(ErrCodeInvalidPayload, ErrReassignSameWebsite))
+	}
+
+	if _, err := h.service.GetJobWebsiteByName(c.Context(), to); err != nil {
+		if domainErr, ok := AsDomainError(err); ok && domainErr.Code == ErrCodeNotFound {
+			return h.handleError(c, NewDomainError(ErrCodeInvalidPayload, ErrReassignTargetNotFound))
+		}
+		return h.handleError(c, err)
+	}
+
+	reassigned, err := h.applications.ReassignWebsite(c.Context(), userID, from, to)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return response.Success(c, fiber.StatusOK, fiber.Map{
+		"from":       from,
+		"to":         to,
+		"reassigned": reassigned,
+	})
+}
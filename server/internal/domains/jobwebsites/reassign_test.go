@@ -0,0 +1,79 @@
+package jobwebsites
+
+import (
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeWebsiteService knows a fixed set of website names.
+type fakeWebsiteService struct {
+	Service
+	names map[string]bool
+}
+
+func (s *fakeWebsiteService) GetJobWebsiteByName(_ context.Context, name string) (*JobWebsite, error) {
+	if !s.names[name] {
+		return nil, NewDomainError(ErrCodeNotFound, ErrWebsiteNotFound)
+	}
+	return &JobWebsite{Name: name}, nil
+}
+
+// fakeReassigner records the reassignment it was asked for.
+type fakeReassigner struct {
+	userID   uuid.UUID
+	from, to string
+	calls    int
+}
+
+func (r *fakeReassigner) ReassignWebsite(_ context.Context, userID uuid.UUID, from, to string) (int, error) {
+	r.userID, r.from, r.to = userID, from, to
+	r.calls++
+	return 3, nil
+}
+
+func TestReassignApplications(t *testing.T) {
+	userID := uuid.New()
+	reassigner := &fakeReassigner{}
+	app := fiber.New()
+	app.Use(func(c *fiber.Ctx) error {
+		c.Locals("userID", userID)
+		return c.Next()
+	})
+	h := NewHandlerWithApplications(&fakeWebsiteService{names: map[string]bool{"x-jobs": true}}, reassigner, nil)
+	app.Post("/job-websites/reassign", h.ReassignApplications)
+
+	post := func(body string) int {
+		req := httptest.NewRequest("POST", "/job-websites/reassign", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		if resp.StatusCode == fiber.StatusOK {
+			var decoded struct {
+				Data struct {
+					Reassigned int `json:"reassigned"`
+				} `json:"data"`
+			}
+			require.NoError(t, json.NewDecoder(resp.Body).Decode(&decoded))
+			assert.Equal(t, 3, decoded.Data.Reassigned)
+		}
+		return resp.StatusCode
+	}
+
+	assert.Equal(t, fiber.StatusOK, post(`{"from": " Twitter-Jobs ", "to": "X-Jobs"}`))
+	assert.Equal(t, userID, reassigner.userID)
+	assert.Equal(t, "twitter-jobs", reassigner.from)
+	assert.Equal(t, "x-jobs", reassigner.to)
+
+	assert.Equal(t, fiber.StatusBadRequest, post(`{"from": "twitter-jobs", "to": "unknown"}`), "target must be registered")
+	assert.Equal(t, fiber.StatusBadRequest, post(`{"from": "x-jobs", "to": "x-jobs"}`))
+	assert.Equal(t, fiber.StatusBadRequest, post(`{"to": "x-jobs"}`))
+	assert.Equal(t, 1, reassigner.calls)
+}
@@ -47,7 +47,7 @@ func (r *gormRepository) GetJobWebsite(ctx context.Context, websiteID uuid.UUID)
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, NewDomainError(ErrCodeNotFound, ErrWebsiteNotFound)
 		}
-		return nil, NewDomainError(ErrCodeRepositoryFailure, ErrUnableToFetch)
+		return nil, newRepositoryError(ErrUnableToFetch, err)
 	}
 	return &website, nil
 }
@@ -58,7 +58,7 @@ func (r *gormRepository) GetJobWebsiteByName(ctx context.Context, name string) (
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, NewDomainError(ErrCodeNotFound, ErrWebsiteNotFound)
 		}
-		return nil, NewDomainError(ErrCodeRepositoryFailure, ErrUnableToFetch)
+		return nil, newRepositoryError(ErrUnableToFetch, err)
 	}
 	return &website, nil
 }
@@ -74,7 +74,7 @@ func (r *gormRepository) ListJobWebsites(ctx context.Context, enabledOnly bool)
 	query = query.Order("name ASC")
 
 	if err := query.Find(&websites).Error; err != nil {
-		return nil, NewDomainError(ErrCodeRepositoryFailure, ErrUnableToFetch)
+		return nil, newRepositoryError(ErrUnableToFetch, err)
 	}
 
 	return websites, nil
@@ -83,7 +83,7 @@ func (r *gormRepository) ListJobWebsites(ctx context.Context, enabledOnly bool)
 func (r *gormRepository) DeleteJobWebsite(ctx context.Context, websiteID uuid.UUID) error {
 	result := r.db.WithContext(ctx).Delete(&JobWebsite{}, websiteID)
 	if result.Error != nil {
-		return NewDomainError(ErrCodeRepositoryFailure, ErrUnableToUpdate)
+		return newRepositoryError(ErrUnableToUpdate, result.Error)
 	}
 	if result.RowsAffected == 0 {
 		return NewDomainError(ErrCodeNotFound, ErrWebsiteNotFound)
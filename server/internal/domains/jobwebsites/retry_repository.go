@@ -0,0 +1,57 @@
+package jobwebsites
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"woragis-jobs-service/internal/database"
+)
+
+// retryingRepository retries calls to the wrapped repository after transient connection
+// errors, so a brief Postgres failover does not fail the request. Services are unaware of it.
+type retryingRepository struct {
+	next   Repository
+	policy database.RetryPolicy
+}
+
+// NewRetryingRepository wraps repo so every call is retried according to policy.
+func NewRetryingRepository(repo Repository, policy database.RetryPolicy) Repository {
+	return &retryingRepository{next: repo, policy: policy}
+}
+
+func (r *retryingRepository) CreateJobWebsite(ctx context.Context, website *JobWebsite) error {
+	return r.policy.Do(ctx, "CreateJobWebsite", func() error {
+		return r.next.CreateJobWebsite(ctx, website)
+	})
+}
+
+func (r *retryingRepository) UpdateJobWebsite(ctx context.Context, website *JobWebsite) error {
+	return r.policy.Do(ctx, "UpdateJobWebsite", func() error {
+		return r.next.UpdateJobWebsite(ctx, website)
+	})
+}
+
+func (r *retryingRepository) GetJobWebsite(ctx context.Context, websiteID uuid.UUID) (*JobWebsite, error) {
+	return database.Retry(ctx, r.policy, "GetJobWebsite", func() (*JobWebsite, error) {
+		return r.next.GetJobWebsite(ctx, websiteID)
+	})
+}
+
+func (r *retryingRepository) GetJobWebsiteByName(ctx context.Context, name string) (*JobWebsite, error) {
+	return database.Retry(ctx, r.policy, "GetJobWebsiteByName", func() (*JobWebsite, error) {
+		return r.next.GetJobWebsiteByName(ctx, name)
+	})
+}
+
+func (r *retryingRepository) ListJobWebsites(ctx context.Context, enabledOnly bool) ([]JobWebsite, error) {
+	return database.Retry(ctx, r.policy, "ListJobWebsites", func() ([]JobWebsite, error) {
+		return r.next.ListJobWebsites(ctx, enabledOnly)
+	})
+}
+
+func (r *retryingRepository) DeleteJobWebsite(ctx context.Context, websiteID uuid.UUID) error {
+	return r.policy.Do(ctx, "DeleteJobWebsite", func() error {
+		return r.next.DeleteJobWebsite(ctx, websiteID)
+	})
+}
@@ -1,14 +1,22 @@
 package jobwebsites
 
-import "github.com/gofiber/fiber/v2"
+import (
+	"github.com/gofiber/fiber/v2"
+
+	"woragis-jobs-service/pkg/middleware"
+)
 
 // SetupRoutes registers job website endpoints.
 func SetupRoutes(api fiber.Router, handler Handler) {
+	id := middleware.ValidateUUIDParam("id")
 	api.Post("/", handler.CreateJobWebsite)
 	api.Get("/", handler.ListJobWebsites)
-	api.Get("/:id", handler.GetJobWebsite)
-	api.Patch("/:id", handler.UpdateJobWebsite)
-	api.Post("/:id/reset-counter", handler.ResetCounter)
-	api.Delete("/:id", handler.DeleteJobWebsite)
+	api.Post("/reassign", handler.ReassignApplications) // {"from": "twitter-jobs", "to": "x-jobs"}: moves the user's applications
+	api.Get("/:id", id, handler.GetJobWebsite)
+	api.Patch("/:id", id, handler.UpdateJobWebsite)
+	api.Post("/:id/reset-counter", id, handler.ResetCounter)
+	api.Put("/:id/scraping-config", id, handler.SetScrapingConfig) // {"strategy": "selectors", "selectors": {"jobTitle": "h1.title"}}
+	api.Delete("/:id/scraping-config", id, handler.DeleteScrapingConfig) // Back to generic parsing with AI extraction
+	api.Delete("/:id", id, handler.DeleteJobWebsite)
 }
 
@@ -0,0 +1,106 @@
+package jobwebsites
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"woragis-jobs-service/pkg/htmlselect"
+)
+
+// Scraping strategies the from-url quick-create can use for a website's postings.
+const (
+	// ScrapingStrategySelectors reads fields from the elements matched by CSS selectors,
+	// falling back to structured data for fields without a selector or match
+	ScrapingStrategySelectors = "selectors"
+	// ScrapingStrategyStructuredData only reads schema.org JobPosting data and meta tags
+	ScrapingStrategyStructuredData = "structured-data"
+)
+
+// ScrapingFields are the posting fields a selector can be configured for.
+var ScrapingFields = []string{"companyName", "jobTitle", "location", "jobDescription"}
+
+// ScrapingConfig holds the parsing hints for a website's posting pages. Websites without
+// one are parsed generically, with AI extraction filling what the page does not reveal.
+type ScrapingConfig struct {
+	Strategy  string            `json:"strategy"`
+	Selectors map[string]string `json:"selectors,omitempty"` // Field name -> CSS selector
+}
+
+// Value implements the driver.Valuer interface.
+func (s *ScrapingConfig) Value() (driver.Value, error) {
+	if s == nil {
+		return nil, nil
+	}
+	return json.Marshal(s)
+}
+
+// Scan implements the sql.Scanner interface.
+func (s *ScrapingConfig) Scan(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+	bytes, ok := value.([]byte)
+	if !ok {
+		return json.Unmarshal([]byte(value.(string)), s)
+	}
+	return json.Unmarshal(bytes, s)
+}
+
+// Validate checks the strategy and that every selector targets a known field and compiles.
+// The selectors strategy needs at least one selector; the others take none.
+func (s *ScrapingConfig) Validate() error {
+	switch s.Strategy {
+	case ScrapingStrategySelectors:
+		if len(s.Selectors) == 0 {
+			return NewDomainError(ErrCodeInvalidPayload, ErrScrapingSelectorsRequired)
+		}
+	case ScrapingStrategyStructuredData:
+		if len(s.Selectors) > 0 {
+			return NewDomainError(ErrCodeInvalidPayload, ErrScrapingSelectorsUnused)
+		}
+	default:
+		return NewDomainError(ErrCodeInvalidPayload, ErrInvalidScrapingStrategy)
+	}
+
+	for field, selector := range s.Selectors {
+		if !isScrapingField(field) {
+			return NewDomainError(ErrCodeInvalidPayload, ErrInvalidScrapingField)
+		}
+		if _, err := htmlselect.Compile(selector); err != nil {
+			return NewDomainError(ErrCodeInvalidPayload, ErrInvalidScrapingSelector+": "+err.Error())
+		}
+	}
+	return nil
+}
+
+func isScrapingField(field string) bool {
+	for _, known := range ScrapingFields {
+		if known == field {
+			return true
+		}
+	}
+	return false
+}
+
+// SetScrapingConfig replaces the website's parsing hints; nil removes them. The strategy
+// is lowercased and selectors are trimmed before validation.
+func (j *JobWebsite) SetScrapingConfig(config *ScrapingConfig) error {
+	if config != nil {
+		normalized := &ScrapingConfig{
+			Strategy:  strings.ToLower(strings.TrimSpace(config.Strategy)),
+			Selectors: make(map[string]string, len(config.Selectors)),
+		}
+		for field, selector := range config.Selectors {
+			normalized.Selectors[strings.TrimSpace(field)] = strings.TrimSpace(selector)
+		}
+		if err := normalized.Validate(); err != nil {
+			return err
+		}
+		config = normalized
+	}
+	j.ScrapingConfig = config
+	j.UpdatedAt = time.Now().UTC()
+	return nil
+}
@@ -10,22 +10,31 @@ import (
 
 // Service orchestrates job website operations.
 type Service interface {
-	CreateJobWebsite(ctx context.Context, name, displayName, baseURL, loginURL string, dailyLimit int) (*JobWebsite, error)
+	CreateJobWebsite(ctx context.Context, name, displayName, baseURL, loginURL string, dailyLimit int, methods ApplicationMethods) (*JobWebsite, error)
 	GetJobWebsite(ctx context.Context, websiteID uuid.UUID) (*JobWebsite, error)
 	GetJobWebsiteByName(ctx context.Context, name string) (*JobWebsite, error)
 	ListJobWebsites(ctx context.Context, enabledOnly bool) ([]JobWebsite, error)
 	UpdateJobWebsite(ctx context.Context, websiteID uuid.UUID, updates JobWebsiteUpdates) (*JobWebsite, error)
 	IncrementCount(ctx context.Context, websiteName string) error
 	ResetCount(ctx context.Context, websiteID uuid.UUID) error
+	SetScrapingConfig(ctx context.Context, websiteID uuid.UUID, config *ScrapingConfig) (*JobWebsite, error)
 	DeleteJobWebsite(ctx context.Context, websiteID uuid.UUID) error
 }
 
 type JobWebsiteUpdates struct {
-	DailyLimit  *int
-	Enabled     *bool
-	BaseURL     *string
-	LoginURL    *string
-	DisplayName *string
+	DailyLimit                  *int
+	Enabled                     *bool
+	BaseURL                     *string
+	LoginURL                    *string
+	DisplayName                 *string
+	DefaultApplicationMethod    *string
+	SupportedApplicationMethods *[]string
+}
+
+// ApplicationMethods holds a website's default and supported application methods.
+type ApplicationMethods struct {
+	Default   string
+	Supported []string
 }
 
 type service struct {
@@ -41,11 +50,14 @@ func NewService(repo Repository, logger *slog.Logger) Service {
 	}
 }
 
-func (s *service) CreateJobWebsite(ctx context.Context, name, displayName, baseURL, loginURL string, dailyLimit int) (*JobWebsite, error) {
+func (s *service) CreateJobWebsite(ctx context.Context, name, displayName, baseURL, loginURL string, dailyLimit int, methods ApplicationMethods) (*JobWebsite, error) {
 	website, err := NewJobWebsite(name, displayName, baseURL, loginURL, dailyLimit)
 	if err != nil {
 		return nil, err
 	}
+	if err := website.SetApplicationMethods(methods.Default, methods.Supported); err != nil {
+		return nil, err
+	}
 
 	if err := s.repo.CreateJobWebsite(ctx, website); err != nil {
 		return nil, err
@@ -92,6 +104,18 @@ func (s *service) UpdateJobWebsite(ctx context.Context, websiteID uuid.UUID, upd
 		website.DisplayName = *updates.DisplayName
 		website.UpdatedAt = time.Now()
 	}
+	if updates.DefaultApplicationMethod != nil || updates.SupportedApplicationMethods != nil {
+		defaultMethod, supported := website.DefaultApplicationMethod, []string(website.SupportedApplicationMethods)
+		if updates.DefaultApplicationMethod != nil {
+			defaultMethod = *updates.DefaultApplicationMethod
+		}
+		if updates.SupportedApplicationMethods != nil {
+			supported = *updates.SupportedApplicationMethods
+		}
+		if err := website.SetApplicationMethods(defaultMethod, supported); err != nil {
+			return nil, err
+		}
+	}
 
 	if err := s.repo.UpdateJobWebsite(ctx, website); err != nil {
 		return nil, err
@@ -125,6 +149,24 @@ func (s *service) ResetCount(ctx context.Context, websiteID uuid.UUID) error {
 	return s.repo.UpdateJobWebsite(ctx, website)
 }
 
+// SetScrapingConfig replaces the parsing hints of a website; nil removes them.
+func (s *service) SetScrapingConfig(ctx context.Context, websiteID uuid.UUID, config *ScrapingConfig) (*JobWebsite, error) {
+	website, err := s.repo.GetJobWebsite(ctx, websiteID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := website.SetScrapingConfig(config); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.UpdateJobWebsite(ctx, website); err != nil {
+		return nil, err
+	}
+
+	return website, nil
+}
+
 func (s *service) DeleteJobWebsite(ctx context.Context, websiteID uuid.UUID) error {
 	return s.repo.DeleteJobWebsite(ctx, websiteID)
 }
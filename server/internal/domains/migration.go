@@ -4,6 +4,7 @@ import (
 	"gorm.io/gorm"
 
 	"woragis-jobs-service/internal/domains/jobapplications"
+	"woragis-jobs-service/internal/domains/jobapplications/contacts"
 	"woragis-jobs-service/internal/domains/jobapplications/responses"
 	"woragis-jobs-service/internal/domains/jobapplications/interviewstages"
 	"woragis-jobs-service/internal/domains/resumes"
@@ -25,6 +26,10 @@ func MigrateJobsTables(db *gorm.DB) error {
 	// Migrate job applications tables
 	if err := db.AutoMigrate(
 		&jobapplications.JobApplication{},
+		&jobapplications.Offer{},
+		&jobapplications.CoverLetterRevision{},
+		&jobapplications.StatusChange{},
+		&jobapplications.ApplicationExpense{},
 	); err != nil {
 		return err
 	}
@@ -47,10 +52,13 @@ func MigrateJobsTables(db *gorm.DB) error {
 	// Migrate subdomain tables
 	if err := db.AutoMigrate(
 		&responses.Response{},
+		&contacts.Contact{},
 		&interviewstages.InterviewStage{},
+		&interviewstages.InterviewTemplate{},
 	); err != nil {
 		return err
 	}
 
-	return nil
+	// Record the schema version last, so it is only written once every migration succeeded
+	return recordSchemaVersion(db)
 }
@@ -0,0 +1,32 @@
+package jobs
+
+import (
+	"context"
+	"log/slog"
+
+	"woragis-jobs-service/internal/config"
+	"woragis-jobs-service/internal/database"
+	"woragis-jobs-service/internal/domains/jobapplications"
+)
+
+// StartReminderScanner runs the follow-up/deadline reminder scanner in the background until ctx is cancelled.
+func StartReminderScanner(ctx context.Context, dbManager *database.Manager, cfg *config.ReminderConfig, logger *slog.Logger) {
+	if cfg == nil || !cfg.Enabled {
+		logger.Info("reminder scanner disabled")
+		return
+	}
+
+	var notifier jobapplications.ReminderNotifier
+	if cfg.WebhookURL != "" {
+		notifier = jobapplications.NewWebhookReminderNotifier(cfg.WebhookURL, cfg.WebhookSecret, nil)
+	} else {
+		logger.Warn("REMINDER_WEBHOOK_URL not set, reminders will only be logged")
+		notifier = jobapplications.NewLogReminderNotifier(logger)
+	}
+
+	repo := jobapplications.NewGormRepository(dbManager.GetPostgres())
+	scanner := jobapplications.NewReminderScanner(repo, notifier, cfg.DeadlineDaysBefore, logger)
+	go scanner.Run(ctx, cfg.ScanInterval)
+
+	logger.Info("reminder scanner started", "interval", cfg.ScanInterval, "deadline_days_before", cfg.DeadlineDaysBefore)
+}
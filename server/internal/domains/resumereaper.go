@@ -0,0 +1,24 @@
+package jobs
+
+import (
+	"context"
+	"log/slog"
+
+	"woragis-jobs-service/internal/config"
+	"woragis-jobs-service/internal/database"
+	"woragis-jobs-service/internal/domains/resumes"
+)
+
+// StartResumeJobReaper runs the resume job processing timeout reaper in the background until ctx is cancelled.
+func StartResumeJobReaper(ctx context.Context, dbManager *database.Manager, cfg *config.ResumeJobReaperConfig, logger *slog.Logger) {
+	if cfg == nil || !cfg.Enabled {
+		logger.Info("resume job reaper disabled")
+		return
+	}
+
+	repo := resumes.NewGormRepository(dbManager.GetPostgres())
+	reaper := resumes.NewProcessingJobReaper(repo, cfg.ProcessingTimeout, logger)
+	go reaper.Run(ctx, cfg.Interval)
+
+	logger.Info("resume job reaper started", "interval", cfg.Interval, "processing_timeout", cfg.ProcessingTimeout)
+}
@@ -0,0 +1,86 @@
+package resumes
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResume_AddAndRemoveTag(t *testing.T) {
+	resume := &Resume{Tags: JSONArray{"go"}}
+
+	assert.True(t, resume.AddTag("  Backend "))
+	assert.Equal(t, JSONArray{"go", "backend"}, resume.Tags)
+	assert.False(t, resume.AddTag("GO"), "tags are normalized before comparing")
+
+	assert.True(t, resume.RemoveTag("Backend"))
+	assert.False(t, resume.RemoveTag("backend"))
+	assert.Equal(t, JSONArray{"go"}, resume.Tags)
+
+	full := &Resume{}
+	for i := 0; i < maxResumeTags(); i++ {
+		require.True(t, full.AddTag(string(rune('a'+i))))
+	}
+	assert.False(t, full.HasTagCapacity())
+	assert.False(t, full.AddTag("overflow"))
+	assert.Len(t, full.Tags, maxResumeTags())
+}
+
+// fakeBulkResumeService records the bulk featured call.
+type fakeBulkResumeService struct {
+	Service
+	resumeIDs []uuid.UUID
+	featured  *bool
+}
+
+func (s *fakeBulkResumeService) SetResumesFeatured(_ context.Context, _ uuid.UUID, resumeIDs []uuid.UUID, featured bool) (*BulkResumeResult, error) {
+	s.resumeIDs = resumeIDs
+	s.featured = &featured
+	return &BulkResumeResult{Requested: len(resumeIDs), Updated: len(resumeIDs), Skipped: []uuid.UUID{}}, nil
+}
+
+func newBulkTestApp(svc Service) *fiber.App {
+	app := fiber.New()
+	app.Use(func(c *fiber.Ctx) error {
+		c.Locals("userID", uuid.New())
+		return c.Next()
+	})
+	h := NewHandler(svc, nil, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	app.Post("/resumes/featured", h.BulkSetFeatured)
+	return app
+}
+
+func TestBulkSetFeatured(t *testing.T) {
+	svc := &fakeBulkResumeService{}
+	app := newBulkTestApp(svc)
+	id := uuid.New()
+
+	req := httptest.NewRequest("POST", "/resumes/featured", strings.NewReader(`{"resumeIds":["`+id.String()+`"],"featured":false}`))
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := app.Test(req)
+	require.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	require.NotNil(t, svc.featured)
+	assert.False(t, *svc.featured)
+	assert.Equal(t, []uuid.UUID{id}, svc.resumeIDs)
+
+	for _, body := range []string{
+		`{"resumeIds":["` + id.String() + `"]}`,
+		`{"resumeIds":[],"featured":true}`,
+		`{"resumeIds":["not-a-uuid"],"featured":true}`,
+	} {
+		req := httptest.NewRequest("POST", "/resumes/featured", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		assert.Equal(t, fiber.StatusBadRequest, resp.StatusCode, body)
+	}
+}
@@ -7,11 +7,23 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+
+	"woragis-jobs-service/pkg/validation"
 )
 
-// JSONArray is a custom type for storing JSON arrays in PostgreSQL.
+// JSONArray is a custom type for storing JSON arrays in PostgreSQL. It is never
+// null in JSON: nil and empty arrays both marshal to [], and NULL columns scan
+// to an empty array.
 type JSONArray []string
 
+// MarshalJSON implements the json.Marshaler interface.
+func (j JSONArray) MarshalJSON() ([]byte, error) {
+	if j == nil {
+		return []byte("[]"), nil
+	}
+	return json.Marshal([]string(j))
+}
+
 // Value implements the driver.Valuer interface.
 func (j JSONArray) Value() (driver.Value, error) {
 	if j == nil {
@@ -22,15 +34,21 @@ func (j JSONArray) Value() (driver.Value, error) {
 
 // Scan implements the sql.Scanner interface.
 func (j *JSONArray) Scan(value interface{}) error {
+	*j = JSONArray{}
 	if value == nil {
-		*j = nil
 		return nil
 	}
 	bytes, ok := value.([]byte)
 	if !ok {
-		return json.Unmarshal([]byte(value.(string)), j)
+		bytes = []byte(value.(string))
+	}
+	if err := json.Unmarshal(bytes, j); err != nil {
+		return err
+	}
+	if *j == nil { // A JSON null stored in the column
+		*j = JSONArray{}
 	}
-	return json.Unmarshal(bytes, j)
+	return nil
 }
 
 // Resume represents a generated resume PDF file.
@@ -40,9 +58,11 @@ type Resume struct {
 	Title      string    `gorm:"column:title;size:255;not null" json:"title"`
 	IsMain     bool      `gorm:"column:is_main;not null;default:false;index" json:"isMain"`
 	IsFeatured bool      `gorm:"column:is_featured;not null;default:false;index" json:"isFeatured"`
+	// FilePath is the opaque key of the file in the configured storage backend
 	FilePath          string    `gorm:"column:file_path;size:512;not null" json:"filePath"`
 	FileName          string    `gorm:"column:file_name;size:255;not null" json:"fileName"`
 	FileSize          int64     `gorm:"column:file_size;default:0" json:"fileSize"`
+	Checksum          string    `gorm:"column:checksum;size:64;index" json:"checksum,omitempty"` // Hex SHA-256 of the file contents
 	Tags              JSONArray `gorm:"column:tags;type:jsonb;default:'[]'" json:"tags"`
 	ApplicationsUsed  int       `gorm:"column:applications_used;default:0" json:"applicationsUsed"`
 	InterviewRate     float64   `gorm:"column:interview_rate;default:0" json:"interviewRate"` // Percentage (0-100)
@@ -58,7 +78,7 @@ func (Resume) TableName() string {
 
 // NewResume creates a new resume entity.
 func NewResume(userID uuid.UUID, title, filePath, fileName string, fileSize int64, tags JSONArray) (*Resume, error) {
-	// Normalize tags: lowercase, trim, remove duplicates, limit to 10
+	// Normalize tags: lowercase, trim, remove duplicates, limit to the configured maximum
 	normalizedTags := normalizeTags(tags)
 	
 	resume := &Resume{
@@ -81,7 +101,12 @@ func NewResume(userID uuid.UUID, title, filePath, fileName string, fileSize int6
 	return resume, resume.Validate()
 }
 
-// normalizeTags normalizes tags: lowercase, trim, remove duplicates, limit to 10.
+// maxResumeTags is the most tags a resume keeps.
+func maxResumeTags() int {
+	return validation.CurrentTagLimits().MaxTags
+}
+
+// normalizeTags normalizes tags: lowercase, trim, remove duplicates, limit to maxResumeTags.
 func normalizeTags(tags JSONArray) JSONArray {
 	if tags == nil {
 		return JSONArray{}
@@ -89,10 +114,11 @@ func normalizeTags(tags JSONArray) JSONArray {
 	
 	seen := make(map[string]bool)
 	result := JSONArray{}
+	limit := maxResumeTags()
 	
 	for _, tag := range tags {
 		normalized := strings.ToLower(strings.TrimSpace(tag))
-		if normalized != "" && !seen[normalized] && len(result) < 10 {
+		if normalized != "" && !seen[normalized] && len(result) < limit {
 			seen[normalized] = true
 			result = append(result, normalized)
 		}
@@ -181,3 +207,50 @@ func (r *Resume) UpdateFilePath(filePath, fileName string, fileSize int64) error
 	return r.Validate()
 }
 
+// AddTag adds the normalized tag. It returns false when the resume already has the
+// tag or is at the tag limit; check HasTagCapacity to tell the two apart.
+func (r *Resume) AddTag(tag string) bool {
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	if tag == "" || !r.HasTagCapacity() {
+		return false
+	}
+	for _, existing := range r.Tags {
+		if existing == tag {
+			return false
+		}
+	}
+	r.Tags = append(r.Tags, tag)
+	r.UpdatedAt = time.Now().UTC()
+	return true
+}
+
+// RemoveTag drops the normalized tag. Returns true when the tags changed.
+func (r *Resume) RemoveTag(tag string) bool {
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	kept := make(JSONArray, 0, len(r.Tags))
+	for _, existing := range r.Tags {
+		if existing != tag {
+			kept = append(kept, existing)
+		}
+	}
+	if len(kept) == len(r.Tags) {
+		return false
+	}
+	r.Tags = kept
+	r.UpdatedAt = time.Now().UTC()
+	return true
+}
+
+// HasTagCapacity reports whether another tag fits under maxResumeTags.
+func (r *Resume) HasTagCapacity() bool {
+	return len(r.Tags) < maxResumeTags()
+}
+
+func containsTag(tags JSONArray, tag string) bool {
+	for _, existing := range tags {
+		if existing == tag {
+			return true
+		}
+	}
+	return false
+}
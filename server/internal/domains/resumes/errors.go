@@ -1,5 +1,11 @@
 package resumes
 
+import (
+	"errors"
+
+	"woragis-jobs-service/pkg/response"
+)
+
 // Error codes for resume domain.
 const (
 	ErrCodeInvalidPayload  = "INVALID_PAYLOAD"
@@ -8,6 +14,9 @@ const (
 	ErrCodeFileNotFound    = "FILE_NOT_FOUND"
 	ErrCodeFileReadError   = "FILE_READ_ERROR"
 	ErrCodeInvalidFileSize = "INVALID_FILE_SIZE"
+	ErrCodeAccessDenied    = "ACCESS_DENIED"
+	ErrCodeInvalidJobState = "INVALID_JOB_STATE"
+	ErrCodeStorageFailure  = "STORAGE_FAILURE"
 )
 
 // Error messages.
@@ -22,7 +31,17 @@ const (
 	ErrResumeNotFound  = "resumes: resume not found"
 	ErrFileNotFound    = "resumes: resume file not found"
 	ErrFileReadError   = "resumes: error reading resume file"
+	ErrFileWriteError  = "resumes: error storing resume file"
+	ErrStorageUnavailable = "resumes: file storage is not configured"
 	ErrNoMainResume    = "resumes: no main resume found"
+	ErrGenerationJobNotFound = "resumes: generation job not found"
+	ErrGenerationJobAccessDenied = "resumes: generation job belongs to another user"
+	ErrGenerationJobNotRetryable = "resumes: only failed generation jobs can be retried"
+	ErrGenerationJobFinished     = "resumes: generation job has already finished"
+	ErrGenerationJobInProgress   = "resumes: generation job is still in progress"
+	ErrGenerationJobFailed       = "resumes: generation job failed"
+	ErrGenerationJobCancelled    = "resumes: generation job was cancelled"
+	ErrInvalidJobPriority        = "resumes: priority must be high or normal"
 )
 
 // DomainError represents a domain-specific error.
@@ -43,3 +62,27 @@ func NewDomainError(code, message string) *DomainError {
 	}
 }
 
+// ErrorKind classifies the error for response.FromDomainError.
+func (e *DomainError) ErrorKind() response.ErrorKind {
+	switch e.Code {
+	case ErrCodeInvalidPayload, ErrCodeInvalidName, ErrCodeInvalidFileSize:
+		return response.KindValidation
+	case ErrCodeNotFound, ErrCodeFileNotFound:
+		return response.KindNotFound
+	case ErrCodeAccessDenied:
+		return response.KindForbidden
+	case ErrCodeInvalidJobState:
+		return response.KindConflict
+	default:
+		return response.KindInternal
+	}
+}
+
+// AsDomainError extracts a *DomainError from err.
+func AsDomainError(err error) (*DomainError, bool) {
+	var domainErr *DomainError
+	if errors.As(err, &domainErr) {
+		return domainErr, true
+	}
+	return nil, false
+}
@@ -0,0 +1,98 @@
+package resumes
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeGeneratedResumeRepository serves one generation job and the resumes it knows.
+type fakeGeneratedResumeRepository struct {
+	Repository
+	job     ResumeGenerationJob
+	resumes map[uuid.UUID]Resume
+}
+
+func (r *fakeGeneratedResumeRepository) GetResumeGenerationJob(_ context.Context, _ uuid.UUID) (*ResumeGenerationJob, error) {
+	job := r.job
+	return &job, nil
+}
+
+func (r *fakeGeneratedResumeRepository) GetResume(_ context.Context, resumeID uuid.UUID, _ uuid.UUID) (*Resume, error) {
+	resume, ok := r.resumes[resumeID]
+	if !ok {
+		return nil, NewDomainError(ErrCodeNotFound, ErrResumeNotFound)
+	}
+	return &resume, nil
+}
+
+func newGeneratedResumeTestApp(repo Repository, userID uuid.UUID) *fiber.App {
+	app := fiber.New()
+	app.Use(func(c *fiber.Ctx) error {
+		c.Locals("userID", userID)
+		return c.Next()
+	})
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	h := NewHandler(NewService(repo, nil, logger), nil, logger)
+	app.Get("/resumes/generation-jobs/:id/resume", h.GetGeneratedResume)
+	return app
+}
+
+func getGeneratedResume(t *testing.T, app *fiber.App, jobID uuid.UUID) (int, map[string]any) {
+	t.Helper()
+	resp, err := app.Test(httptest.NewRequest("GET", "/resumes/generation-jobs/"+jobID.String()+"/resume", nil))
+	require.NoError(t, err)
+	var body map[string]any
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	return resp.StatusCode, body
+}
+
+func TestGetGeneratedResume(t *testing.T) {
+	userID := uuid.New()
+	resumeID := uuid.New()
+
+	for name, tc := range map[string]struct {
+		job        ResumeGenerationJob
+		wantStatus int
+	}{
+		"completed":        {ResumeGenerationJob{Status: ResumeJobStatusCompleted, ResumeID: &resumeID}, fiber.StatusOK},
+		"processing":       {ResumeGenerationJob{Status: ResumeJobStatusProcessing}, fiber.StatusConflict},
+		"pending":          {ResumeGenerationJob{Status: ResumeJobStatusPending}, fiber.StatusConflict},
+		"cancelled":        {ResumeGenerationJob{Status: ResumeJobStatusCancelled}, fiber.StatusConflict},
+		"resume deleted":   {ResumeGenerationJob{Status: ResumeJobStatusCompleted, ResumeID: func() *uuid.UUID { id := uuid.New(); return &id }()}, fiber.StatusNotFound},
+		"other user's job": {ResumeGenerationJob{UserID: uuid.New(), Status: ResumeJobStatusCompleted, ResumeID: &resumeID}, fiber.StatusForbidden},
+	} {
+		t.Run(name, func(t *testing.T) {
+			tc.job.ID = uuid.New()
+			if tc.job.UserID == uuid.Nil {
+				tc.job.UserID = userID
+			}
+			repo := &fakeGeneratedResumeRepository{job: tc.job, resumes: map[uuid.UUID]Resume{resumeID: {ID: resumeID, Title: "Generated"}}}
+
+			status, _ := getGeneratedResume(t, newGeneratedResumeTestApp(repo, userID), tc.job.ID)
+			assert.Equal(t, tc.wantStatus, status)
+		})
+	}
+}
+
+func TestGetGeneratedResume_FailedJobReportsError(t *testing.T) {
+	userID := uuid.New()
+	job := ResumeGenerationJob{ID: uuid.New(), UserID: userID, Status: ResumeJobStatusFailed, ErrorMessage: "model timed out", ErrorCode: "AI_TIMEOUT"}
+	app := newGeneratedResumeTestApp(&fakeGeneratedResumeRepository{job: job}, userID)
+
+	status, body := getGeneratedResume(t, app, job.ID)
+	assert.Equal(t, fiber.StatusConflict, status)
+	raw, err := json.Marshal(body)
+	require.NoError(t, err)
+	assert.Contains(t, string(raw), "model timed out")
+	assert.Contains(t, string(raw), "AI_TIMEOUT")
+	assert.Contains(t, string(raw), `"status":"failed"`)
+}
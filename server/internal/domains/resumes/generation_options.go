@@ -0,0 +1,141 @@
+package resumes
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"slices"
+	"strings"
+
+	"woragis-jobs-service/pkg/validation"
+)
+
+// Allowed values for ResumeGenerationOptions. The resume worker reads these from the job metadata,
+// so adding a value here means teaching the worker about it too.
+var (
+	ResumeGenerationTones         = []string{"professional", "conversational", "enthusiastic"}
+	ResumeGenerationTargetLengths = []string{"short", "medium", "long"}
+	ResumeGenerationSections      = []string{"summary", "experience", "education", "skills", "projects", "certifications", "languages", "awards", "publications", "volunteering"}
+)
+
+// maxResumeGenerationTemplateSize bounds the template name
+const maxResumeGenerationTemplateSize = 100
+
+// ResumeGenerationOptions are the caller-controlled knobs of a resume generation job. They are
+// validated at the API boundary and stored flat in ResumeGenerationJob.Metadata under their JSON names.
+type ResumeGenerationOptions struct {
+	Template        string   `json:"template,omitempty"`
+	Tone            string   `json:"tone,omitempty"`
+	TargetLength    string   `json:"targetLength,omitempty"`
+	IncludeSections []string `json:"includeSections,omitempty"`
+}
+
+// ParseResumeGenerationOptions decodes the "options" object of a generate request, rejecting
+// unknown keys. An empty or null value yields zero options.
+func ParseResumeGenerationOptions(raw json.RawMessage) (ResumeGenerationOptions, error) {
+	var options ResumeGenerationOptions
+	if len(bytes.TrimSpace(raw)) == 0 || bytes.Equal(bytes.TrimSpace(raw), []byte("null")) {
+		return options, nil
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&options); err != nil {
+		return options, fmt.Errorf("options: %s", describeOptionsDecodeError(err))
+	}
+	if _, err := decoder.Token(); !errors.Is(err, io.EOF) {
+		return options, fmt.Errorf("options: must be a single JSON object")
+	}
+	return options, nil
+}
+
+// describeOptionsDecodeError turns decoder errors into messages that name the offending key.
+func describeOptionsDecodeError(err error) string {
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) {
+		if typeErr.Field == "" {
+			return "must be a JSON object"
+		}
+		if typeErr.Type.Kind() == reflect.Slice {
+			return fmt.Sprintf("%s: must be an array of strings", typeErr.Field)
+		}
+		return fmt.Sprintf("%s: must be a string", typeErr.Field)
+	}
+	// encoding/json reports unknown keys as `json: unknown field "name"`
+	if msg := err.Error(); strings.HasPrefix(msg, "json: unknown field ") {
+		return "unknown key " + strings.TrimPrefix(msg, "json: unknown field ")
+	}
+	return "must be a valid JSON object"
+}
+
+// Validate checks every option against its allowed values and normalizes case.
+func (o *ResumeGenerationOptions) Validate() error {
+	if o.Template != "" {
+		if err := validation.ValidateString(o.Template, 1, maxResumeGenerationTemplateSize, "template"); err != nil {
+			return fmt.Errorf("template: %w", err)
+		}
+		if err := validation.ValidateNoSQLInjection(o.Template); err != nil {
+			return fmt.Errorf("template: %w", err)
+		}
+		if err := validation.ValidateNoXSS(o.Template); err != nil {
+			return fmt.Errorf("template: %w", err)
+		}
+	}
+
+	if o.Tone != "" {
+		o.Tone = strings.ToLower(strings.TrimSpace(o.Tone))
+		if !slices.Contains(ResumeGenerationTones, o.Tone) {
+			return fmt.Errorf("tone: must be one of %s", strings.Join(ResumeGenerationTones, ", "))
+		}
+	}
+
+	if o.TargetLength != "" {
+		o.TargetLength = strings.ToLower(strings.TrimSpace(o.TargetLength))
+		if !slices.Contains(ResumeGenerationTargetLengths, o.TargetLength) {
+			return fmt.Errorf("targetLength: must be one of %s", strings.Join(ResumeGenerationTargetLengths, ", "))
+		}
+	}
+
+	if len(o.IncludeSections) > len(ResumeGenerationSections) {
+		return fmt.Errorf("includeSections: too many sections (maximum %d)", len(ResumeGenerationSections))
+	}
+	sections := make([]string, 0, len(o.IncludeSections))
+	for _, section := range o.IncludeSections {
+		section = strings.ToLower(strings.TrimSpace(section))
+		if !slices.Contains(ResumeGenerationSections, section) {
+			return fmt.Errorf("includeSections: %q is not one of %s", section, strings.Join(ResumeGenerationSections, ", "))
+		}
+		if slices.Contains(sections, section) {
+			return fmt.Errorf("includeSections: %q is listed more than once", section)
+		}
+		sections = append(sections, section)
+	}
+	if len(sections) > 0 {
+		o.IncludeSections = sections
+	}
+	return nil
+}
+
+// ApplyTo writes the options that are set into metadata.
+func (o ResumeGenerationOptions) ApplyTo(metadata map[string]interface{}) {
+	if o.Template != "" {
+		metadata["template"] = o.Template
+	}
+	if o.Tone != "" {
+		metadata["tone"] = o.Tone
+	}
+	if o.TargetLength != "" {
+		metadata["targetLength"] = o.TargetLength
+	}
+	if len(o.IncludeSections) > 0 {
+		metadata["includeSections"] = o.IncludeSections
+	}
+}
+
+// IsZero reports whether no option is set.
+func (o ResumeGenerationOptions) IsZero() bool {
+	return o.Template == "" && o.Tone == "" && o.TargetLength == "" && len(o.IncludeSections) == 0
+}
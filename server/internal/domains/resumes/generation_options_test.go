@@ -0,0 +1,79 @@
+package resumes
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateGenerateResumePayload_Options(t *testing.T) {
+	newPayload := func(options string) *generateResumePayload {
+		return &generateResumePayload{
+			JobApplicationID: "3f1c2a4e-8b7d-4c2e-9a1f-0d5e6b7c8a90",
+			Language:         "en",
+			Options:          json.RawMessage(options),
+		}
+	}
+
+	options, err := ValidateGenerateResumePayload(newPayload(`{"template":"modern","tone":"Professional","targetLength":"short","includeSections":["Summary","skills"]}`))
+	require.NoError(t, err)
+	assert.Equal(t, ResumeGenerationOptions{
+		Template:        "modern",
+		Tone:            "professional",
+		TargetLength:    "short",
+		IncludeSections: []string{"summary", "skills"},
+	}, options)
+
+	options, err = ValidateGenerateResumePayload(newPayload(""))
+	require.NoError(t, err)
+	assert.True(t, options.IsZero())
+
+	tests := []struct {
+		name    string
+		options string
+		wantErr string
+	}{
+		{name: "unknown key", options: `{"tone":"professional","font":"arial"}`, wantErr: `options: unknown key "font"`},
+		{name: "wrong type", options: `{"includeSections":"skills"}`, wantErr: "options: includeSections: must be an array of strings"},
+		{name: "not an object", options: `["skills"]`, wantErr: "options: must be a JSON object"},
+		{name: "unsupported tone", options: `{"tone":"sarcastic"}`, wantErr: "options.tone: must be one of professional, conversational, enthusiastic"},
+		{name: "unsupported length", options: `{"targetLength":"epic"}`, wantErr: "options.targetLength: must be one of short, medium, long"},
+		{name: "unsupported section", options: `{"includeSections":["hobbies"]}`, wantErr: `options.includeSections: "hobbies" is not one of`},
+		{name: "duplicate section", options: `{"includeSections":["skills","Skills"]}`, wantErr: `options.includeSections: "skills" is listed more than once`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ValidateGenerateResumePayload(newPayload(tt.options))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestValidateGenerateResumePayload_LegacyTemplate(t *testing.T) {
+	payload := &generateResumePayload{
+		JobApplicationID: "3f1c2a4e-8b7d-4c2e-9a1f-0d5e6b7c8a90",
+		Language:         "en",
+		Template:         "classic",
+	}
+	options, err := ValidateGenerateResumePayload(payload)
+	require.NoError(t, err)
+	assert.Equal(t, "classic", options.Template)
+
+	payload.Options = json.RawMessage(`{"template":"modern"}`)
+	_, err = ValidateGenerateResumePayload(payload)
+	assert.EqualError(t, err, "template: conflicts with options.template")
+}
+
+func TestResumeGenerationOptions_ApplyTo(t *testing.T) {
+	metadata := map[string]interface{}{"language": "en"}
+	ResumeGenerationOptions{Tone: "professional", IncludeSections: []string{"skills"}}.ApplyTo(metadata)
+
+	assert.Equal(t, map[string]interface{}{
+		"language":        "en",
+		"tone":            "professional",
+		"includeSections": []string{"skills"},
+	}, metadata)
+}
@@ -1,7 +1,10 @@
 package resumes
 
 import (
+	"bufio"
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"log/slog"
@@ -14,6 +17,7 @@ import (
 	"github.com/google/uuid"
 
 	"woragis-jobs-service/pkg/middleware"
+	"woragis-jobs-service/pkg/pagination"
 	"woragis-jobs-service/pkg/response"
 	"woragis-jobs-service/pkg/validation"
 )
@@ -27,6 +31,7 @@ type Handler interface {
 	GetResume(c *fiber.Ctx) error
 	ListResumes(c *fiber.Ctx) error
 	ListResumeTags(c *fiber.Ctx) error
+	LookupResumeByChecksum(c *fiber.Ctx) error
 	DownloadResume(c *fiber.Ctx) error
 	DownloadResumeByID(c *fiber.Ctx) error
 	PreviewResume(c *fiber.Ctx) error
@@ -35,11 +40,19 @@ type Handler interface {
 	MarkAsFeatured(c *fiber.Ctx) error
 	UnmarkAsMain(c *fiber.Ctx) error
 	UnmarkAsFeatured(c *fiber.Ctx) error
+	BulkAddTag(c *fiber.Ctx) error
+	BulkRemoveTag(c *fiber.Ctx) error
+	BulkSetFeatured(c *fiber.Ctx) error
 	RecalculateMetrics(c *fiber.Ctx) error
 	GetJobStatus(c *fiber.Ctx) error
 	RetryJob(c *fiber.Ctx) error
 	CancelJob(c *fiber.Ctx) error
+	ListGenerationJobs(c *fiber.Ctx) error
+	RetryGenerationJob(c *fiber.Ctx) error
+	StreamGenerationJobStatus(c *fiber.Ctx) error
+	GetGeneratedResume(c *fiber.Ctx) error
 	CompleteResumeGeneration(c *fiber.Ctx) error // Internal callback for resume worker
+	UpdateGenerationStatus(c *fiber.Ctx) error   // Internal callback for resume worker
 }
 
 // JobApplicationService is an interface to avoid circular dependencies
@@ -63,29 +76,26 @@ type handler struct {
 	jobApplicationService JobApplicationService // Optional: for generating resumes
 	queue                Queue                  // Redis queue for resume generation jobs
 	logger               *slog.Logger
-	baseFilePath         string                 // Base path where resume files are stored
 }
 
 var _ Handler = (*handler)(nil)
 
 // NewHandler constructs a resume handler.
-func NewHandler(service Service, queue Queue, baseFilePath string, logger *slog.Logger) Handler {
+func NewHandler(service Service, queue Queue, logger *slog.Logger) Handler {
 	return &handler{
-		service:      service,
-		queue:        queue,
-		logger:       logger,
-		baseFilePath: baseFilePath,
+		service: service,
+		queue:   queue,
+		logger:  logger,
 	}
 }
 
 // NewHandlerWithJobApplicationService constructs a resume handler with job application service.
-func NewHandlerWithJobApplicationService(service Service, jobApplicationService JobApplicationService, queue Queue, baseFilePath string, logger *slog.Logger) Handler {
+func NewHandlerWithJobApplicationService(service Service, jobApplicationService JobApplicationService, queue Queue, logger *slog.Logger) Handler {
 	return &handler{
 		service:              service,
 		jobApplicationService: jobApplicationService,
 		queue:                queue,
 		logger:               logger,
-		baseFilePath:         baseFilePath,
 	}
 }
 
@@ -104,16 +114,12 @@ func (h *handler) CreateResume(c *fiber.Ctx) error {
 
 	// Validate payload
 	if err := ValidateCreateResumePayload(&req); err != nil {
-		return response.Error(c, fiber.StatusBadRequest, 0, fiber.Map{"message": err.Error()})
+		return validationError(c, err)
 	}
 
 	resume, err := h.service.CreateResume(c.Context(), userID, req.Title, req.FilePath, req.FileName, req.FileSize, JSONArray(req.Tags))
 	if err != nil {
-		if domainErr, ok := err.(*DomainError); ok {
-			return response.Error(c, fiber.StatusBadRequest, 0, fiber.Map{"message": domainErr.Message})
-		}
-		h.logger.Error("failed to create resume", slog.Any("error", err))
-		return response.Error(c, fiber.StatusInternalServerError, 0, fiber.Map{"message": "failed to create resume"})
+		return h.handleError(c, err, "failed to create resume")
 	}
 
 	return response.Success(c, fiber.StatusCreated, resume)
@@ -163,44 +169,17 @@ func (h *handler) UploadResume(c *fiber.Ctx) error {
 		return response.Error(c, fiber.StatusBadRequest, 0, fiber.Map{"message": err.Error()})
 	}
 
-	// Create upload directory if it doesn't exist
-	uploadDir := filepath.Join(h.baseFilePath, "uploads")
-	if err := os.MkdirAll(uploadDir, 0755); err != nil {
-		h.logger.Error("failed to create upload directory", slog.Any("error", err))
-		return response.Error(c, fiber.StatusInternalServerError, 0, fiber.Map{"message": "failed to create upload directory"})
-	}
-
-	// Generate unique filename
-	timestamp := time.Now().Unix()
-	safeFilename := fmt.Sprintf("%d_%s", timestamp, fileHeader.Filename)
-	filePath := filepath.Join("uploads", safeFilename)
-	fullPath := filepath.Join(h.baseFilePath, filePath)
-
-	// Save file
-	if err := c.SaveFile(fileHeader, fullPath); err != nil {
-		h.logger.Error("failed to save file", slog.Any("error", err))
-		return response.Error(c, fiber.StatusInternalServerError, 0, fiber.Map{"message": "failed to save file"})
-	}
-
-	// Get file size
-	fileInfo, err := os.Stat(fullPath)
+	file, err := fileHeader.Open()
 	if err != nil {
-		h.logger.Error("failed to get file info", slog.Any("error", err))
-		// Clean up file
-		os.Remove(fullPath)
-		return response.Error(c, fiber.StatusInternalServerError, 0, fiber.Map{"message": "failed to get file info"})
+		h.logger.ErrorContext(c.UserContext(), "failed to open uploaded file", slog.Any("error", err))
+		return response.Error(c, fiber.StatusBadRequest, 0, fiber.Map{"message": "failed to read uploaded file"})
 	}
+	defer file.Close()
 
-	// Create resume entry (tags can be added later via update)
-	resume, err := h.service.CreateResume(c.Context(), userID, title, filePath, fileHeader.Filename, fileInfo.Size(), JSONArray{})
+	// Store file and create resume entry (tags can be added later via update)
+	resume, err := h.service.UploadResume(c.Context(), userID, title, fileHeader.Filename, contentType, fileHeader.Size, file, JSONArray{})
 	if err != nil {
-		// Clean up file if resume creation fails
-		os.Remove(fullPath)
-		if domainErr, ok := err.(*DomainError); ok {
-			return response.Error(c, fiber.StatusBadRequest, 0, fiber.Map{"message": domainErr.Message})
-		}
-		h.logger.Error("failed to create resume", slog.Any("error", err))
-		return response.Error(c, fiber.StatusInternalServerError, 0, fiber.Map{"message": "failed to create resume"})
+		return h.handleError(c, err, "failed to create resume")
 	}
 
 	return response.Success(c, fiber.StatusCreated, resume)
@@ -213,9 +192,9 @@ func (h *handler) UpdateResume(c *fiber.Ctx) error {
 		return response.Error(c, fiber.StatusUnauthorized, 0, fiber.Map{"message": "authentication required"})
 	}
 
-	resumeID, err := uuid.Parse(c.Params("id"))
+	resumeID, err := middleware.UUIDParam(c, "id")
 	if err != nil {
-		return response.Error(c, fiber.StatusBadRequest, 0, fiber.Map{"message": "invalid resume ID"})
+		return middleware.InvalidUUIDParam(c, "id")
 	}
 
 	var req updateResumePayload
@@ -226,7 +205,7 @@ func (h *handler) UpdateResume(c *fiber.Ctx) error {
 
 	// Validate payload
 	if err := ValidateUpdateResumePayload(&req); err != nil {
-		return response.Error(c, fiber.StatusBadRequest, 0, fiber.Map{"message": err.Error()})
+		return validationError(c, err)
 	}
 
 	var tags JSONArray
@@ -236,14 +215,7 @@ func (h *handler) UpdateResume(c *fiber.Ctx) error {
 
 	resume, err := h.service.UpdateResume(c.Context(), userID, resumeID, req.Title, tags)
 	if err != nil {
-		if domainErr, ok := err.(*DomainError); ok {
-			if domainErr.Code == ErrCodeNotFound {
-				return response.Error(c, fiber.StatusNotFound, 0, fiber.Map{"message": domainErr.Message})
-			}
-			return response.Error(c, fiber.StatusBadRequest, 0, fiber.Map{"message": domainErr.Message})
-		}
-		h.logger.Error("failed to update resume", slog.Any("error", err))
-		return response.Error(c, fiber.StatusInternalServerError, 0, fiber.Map{"message": "failed to update resume"})
+		return h.handleError(c, err, "failed to update resume")
 	}
 
 	return response.Success(c, fiber.StatusOK, resume)
@@ -256,48 +228,14 @@ func (h *handler) DeleteResume(c *fiber.Ctx) error {
 		return response.Error(c, fiber.StatusUnauthorized, 0, fiber.Map{"message": "authentication required"})
 	}
 
-	resumeID, err := uuid.Parse(c.Params("id"))
-	if err != nil {
-		return response.Error(c, fiber.StatusBadRequest, 0, fiber.Map{"message": "invalid resume ID"})
-	}
-
-	// Get resume first to get file path for deletion
-	resume, err := h.service.GetResume(c.Context(), userID, resumeID)
+	resumeID, err := middleware.UUIDParam(c, "id")
 	if err != nil {
-		if domainErr, ok := err.(*DomainError); ok {
-			if domainErr.Code == ErrCodeNotFound {
-				return response.Error(c, fiber.StatusNotFound, 0, fiber.Map{"message": domainErr.Message})
-			}
-			return response.Error(c, fiber.StatusBadRequest, 0, fiber.Map{"message": domainErr.Message})
-		}
-		h.logger.Error("failed to get resume for deletion", slog.Any("error", err))
-		return response.Error(c, fiber.StatusInternalServerError, 0, fiber.Map{"message": "failed to get resume"})
+		return middleware.InvalidUUIDParam(c, "id")
 	}
 
-	// Delete the resume from database
+	// Delete the resume record and its stored file
 	if err := h.service.DeleteResume(c.Context(), userID, resumeID); err != nil {
-		if domainErr, ok := err.(*DomainError); ok {
-			if domainErr.Code == ErrCodeNotFound {
-				return response.Error(c, fiber.StatusNotFound, 0, fiber.Map{"message": domainErr.Message})
-			}
-			return response.Error(c, fiber.StatusBadRequest, 0, fiber.Map{"message": domainErr.Message})
-		}
-		h.logger.Error("failed to delete resume", slog.Any("error", err))
-		return response.Error(c, fiber.StatusInternalServerError, 0, fiber.Map{"message": "failed to delete resume"})
-	}
-
-	// Delete the file
-	var fullPath string
-	if !filepath.IsAbs(resume.FilePath) {
-		fullPath = filepath.Join(h.baseFilePath, resume.FilePath)
-	} else {
-		fullPath = resume.FilePath
-	}
-
-	// Try to delete the file (ignore error if file doesn't exist)
-	if err := os.Remove(fullPath); err != nil && !os.IsNotExist(err) {
-		h.logger.Warn("failed to delete resume file", slog.String("path", fullPath), slog.Any("error", err))
-		// Don't fail the request if file deletion fails
+		return h.handleError(c, err, "failed to delete resume")
 	}
 
 	return response.Success(c, fiber.StatusNoContent, nil)
@@ -310,21 +248,14 @@ func (h *handler) GetResume(c *fiber.Ctx) error {
 		return response.Error(c, fiber.StatusUnauthorized, 0, fiber.Map{"message": "authentication required"})
 	}
 
-	resumeID, err := uuid.Parse(c.Params("id"))
+	resumeID, err := middleware.UUIDParam(c, "id")
 	if err != nil {
-		return response.Error(c, fiber.StatusBadRequest, 0, fiber.Map{"message": "invalid resume ID"})
+		return middleware.InvalidUUIDParam(c, "id")
 	}
 
 	resume, err := h.service.GetResume(c.Context(), userID, resumeID)
 	if err != nil {
-		if domainErr, ok := err.(*DomainError); ok {
-			if domainErr.Code == ErrCodeNotFound {
-				return response.Error(c, fiber.StatusNotFound, 0, fiber.Map{"message": domainErr.Message})
-			}
-			return response.Error(c, fiber.StatusBadRequest, 0, fiber.Map{"message": domainErr.Message})
-		}
-		h.logger.Error("failed to get resume", slog.Any("error", err))
-		return response.Error(c, fiber.StatusInternalServerError, 0, fiber.Map{"message": "failed to get resume"})
+		return h.handleError(c, err, "failed to get resume")
 	}
 
 	return response.Success(c, fiber.StatusOK, resume)
@@ -338,44 +269,73 @@ func (h *handler) ListResumes(c *fiber.Ctx) error {
 	}
 
 	// Get query parameters
-	limit := c.QueryInt("limit", 50)
-	offset := c.QueryInt("offset", 0)
 	search := c.Query("search", "")
 	tagFilter := c.Query("tags")
 
 	// Validate query parameters
-	if err := ValidateListResumesQueryParams(limit, offset, search); err != nil {
+	page, err := pagination.FromQuery(c)
+	if err != nil {
+		return response.Error(c, fiber.StatusBadRequest, 0, fiber.Map{"message": err.Error()})
+	}
+	if err := ValidateListResumesQueryParams(search); err != nil {
 		return response.Error(c, fiber.StatusBadRequest, 0, fiber.Map{"message": err.Error()})
 	}
 
-	var resumes []Resume
+	filters := ResumeFilters{
+		UserID: userID,
+		Limit:  page.Limit,
+		Offset: page.Offset,
+	}
 	if tagFilter != "" {
 		// Parse comma-separated tags
-		tags := strings.Split(tagFilter, ",")
-		normalizedTags := make([]string, 0, len(tags))
-		for _, tag := range tags {
+		for _, tag := range strings.Split(tagFilter, ",") {
 			normalized := strings.ToLower(strings.TrimSpace(tag))
 			if normalized != "" {
-				normalizedTags = append(normalizedTags, normalized)
+				filters.Tags = append(filters.Tags, normalized)
 			}
 		}
-		if len(normalizedTags) > 0 {
-			resumes, err = h.service.ListResumesByTags(c.Context(), userID, normalizedTags)
-		} else {
-			resumes, err = h.service.ListResumes(c.Context(), userID)
-		}
-	} else {
-		resumes, err = h.service.ListResumes(c.Context(), userID)
 	}
 
+	resumes, err := h.service.ListResumesWithFilters(c.Context(), filters)
 	if err != nil {
-		h.logger.Error("failed to list resumes", slog.Any("error", err))
+		h.logger.ErrorContext(c.UserContext(), "failed to list resumes", slog.Any("error", err))
 		return response.Error(c, fiber.StatusInternalServerError, 0, fiber.Map{"message": "failed to list resumes"})
 	}
 
+	// The body stays a bare array for compatibility; the effective page is in the headers
+	page.SetHeaders(c)
+	page.SetLinkHeader(c, len(resumes), pagination.UnknownTotal)
 	return response.Success(c, fiber.StatusOK, resumes)
 }
 
+// LookupResumeByChecksum reports whether the user already has a resume with the given
+// SHA-256 checksum, so clients can skip uploading duplicates.
+func (h *handler) LookupResumeByChecksum(c *fiber.Ctx) error {
+	userID, err := middleware.GetUserIDFromFiberContext(c)
+	if err != nil {
+		return response.Error(c, fiber.StatusUnauthorized, 0, fiber.Map{"message": "authentication required"})
+	}
+
+	checksum := c.Params("checksum")
+	if err := ValidateChecksum(checksum); err != nil {
+		return response.Error(c, fiber.StatusBadRequest, 0, fiber.Map{"message": err.Error()})
+	}
+
+	resume, err := h.service.FindResumeByChecksum(c.Context(), userID, checksum)
+	if err != nil {
+		if domainErr, ok := AsDomainError(err); ok && domainErr.Code == ErrCodeNotFound {
+			return response.Success(c, fiber.StatusOK, fiber.Map{"duplicate": false})
+		}
+		h.logger.ErrorContext(c.UserContext(), "failed to look up resume by checksum", slog.Any("error", err))
+		return response.Error(c, fiber.StatusInternalServerError, 0, fiber.Map{"message": "failed to look up resume"})
+	}
+
+	return response.Success(c, fiber.StatusOK, fiber.Map{
+		"duplicate": true,
+		"resume":    resume,
+	})
+}
+
 // ListResumeTags returns all unique tags from all resumes for the authenticated user (for autocomplete).
 func (h *handler) ListResumeTags(c *fiber.Ctx) error {
 	userID, err := middleware.GetUserIDFromFiberContext(c)
@@ -385,7 +345,7 @@ func (h *handler) ListResumeTags(c *fiber.Ctx) error {
 
 	resumes, err := h.service.ListResumes(c.Context(), userID)
 	if err != nil {
-		h.logger.Error("failed to list resumes", slog.Any("error", err))
+		h.logger.ErrorContext(c.UserContext(), "failed to list resumes", slog.Any("error", err))
 		return response.Error(c, fiber.StatusInternalServerError, 0, fiber.Map{"message": "failed to list resumes"})
 	}
 
@@ -415,21 +375,14 @@ func (h *handler) MarkAsMain(c *fiber.Ctx) error {
 		return response.Error(c, fiber.StatusUnauthorized, 0, fiber.Map{"message": "authentication required"})
 	}
 
-	resumeID, err := uuid.Parse(c.Params("id"))
+	resumeID, err := middleware.UUIDParam(c, "id")
 	if err != nil {
-		return response.Error(c, fiber.StatusBadRequest, 0, fiber.Map{"message": "invalid resume ID"})
+		return middleware.InvalidUUIDParam(c, "id")
 	}
 
 	resume, err := h.service.MarkAsMain(c.Context(), userID, resumeID)
 	if err != nil {
-		if domainErr, ok := err.(*DomainError); ok {
-			if domainErr.Code == ErrCodeNotFound {
-				return response.Error(c, fiber.StatusNotFound, 0, fiber.Map{"message": domainErr.Message})
-			}
-			return response.Error(c, fiber.StatusBadRequest, 0, fiber.Map{"message": domainErr.Message})
-		}
-		h.logger.Error("failed to mark resume as main", slog.Any("error", err))
-		return response.Error(c, fiber.StatusInternalServerError, 0, fiber.Map{"message": "failed to mark resume as main"})
+		return h.handleError(c, err, "failed to mark resume as main")
 	}
 
 	return response.Success(c, fiber.StatusOK, resume)
@@ -442,26 +395,84 @@ func (h *handler) MarkAsFeatured(c *fiber.Ctx) error {
 		return response.Error(c, fiber.StatusUnauthorized, 0, fiber.Map{"message": "authentication required"})
 	}
 
-	resumeID, err := uuid.Parse(c.Params("id"))
+	resumeID, err := middleware.UUIDParam(c, "id")
 	if err != nil {
-		return response.Error(c, fiber.StatusBadRequest, 0, fiber.Map{"message": "invalid resume ID"})
+		return middleware.InvalidUUIDParam(c, "id")
 	}
 
 	resume, err := h.service.MarkAsFeatured(c.Context(), userID, resumeID)
 	if err != nil {
-		if domainErr, ok := err.(*DomainError); ok {
-			if domainErr.Code == ErrCodeNotFound {
-				return response.Error(c, fiber.StatusNotFound, 0, fiber.Map{"message": domainErr.Message})
-			}
-			return response.Error(c, fiber.StatusBadRequest, 0, fiber.Map{"message": domainErr.Message})
-		}
-		h.logger.Error("failed to mark resume as featured", slog.Any("error", err))
-		return response.Error(c, fiber.StatusInternalServerError, 0, fiber.Map{"message": "failed to mark resume as featured"})
+		return h.handleError(c, err, "failed to mark resume as featured")
 	}
 
 	return response.Success(c, fiber.StatusOK, resume)
 }
 
+// BulkAddTag adds a tag to several resumes at once.
+func (h *handler) BulkAddTag(c *fiber.Ctx) error {
+	return h.bulkTag(c, h.service.AddTagToResumes, "failed to add tag to resumes")
+}
+
+// BulkRemoveTag removes a tag from several resumes at once.
+func (h *handler) BulkRemoveTag(c *fiber.Ctx) error {
+	return h.bulkTag(c, h.service.RemoveTagFromResumes, "failed to remove tag from resumes")
+}
+
+func (h *handler) bulkTag(c *fiber.Ctx, apply func(ctx context.Context, userID uuid.UUID, resumeIDs []uuid.UUID, tag string) (*BulkResumeResult, error), failure string) error {
+	userID, err := middleware.GetUserIDFromFiberContext(c)
+	if err != nil {
+		return response.Error(c, fiber.StatusUnauthorized, 0, fiber.Map{"message": "authentication required"})
+	}
+
+	var req bulkResumeTagPayload
+	if err := c.BodyParser(&req); err != nil {
+		return response.Error(c, fiber.StatusBadRequest, 0, fiber.Map{"message": "invalid request body"})
+	}
+	if err := ValidateBulkResumeTagPayload(&req); err != nil {
+		return validationError(c, err)
+	}
+
+	result, err := apply(c.Context(), userID, parseResumeIDs(req.ResumeIDs), req.Tag)
+	if err != nil {
+		return h.handleError(c, err, failure)
+	}
+
+	return response.Success(c, fiber.StatusOK, result)
+}
+
+// BulkSetFeatured sets or clears the featured flag on several resumes at once.
+func (h *handler) BulkSetFeatured(c *fiber.Ctx) error {
+	userID, err := middleware.GetUserIDFromFiberContext(c)
+	if err != nil {
+		return response.Error(c, fiber.StatusUnauthorized, 0, fiber.Map{"message": "authentication required"})
+	}
+
+	var req bulkResumeFeaturedPayload
+	if err := c.BodyParser(&req); err != nil {
+		return response.Error(c, fiber.StatusBadRequest, 0, fiber.Map{"message": "invalid request body"})
+	}
+	if err := ValidateBulkResumeFeaturedPayload(&req); err != nil {
+		return response.Error(c, fiber.StatusBadRequest, 0, fiber.Map{"message": err.Error()})
+	}
+
+	result, err := h.service.SetResumesFeatured(c.Context(), userID, parseResumeIDs(req.ResumeIDs), *req.Featured)
+	if err != nil {
+		return h.handleError(c, err, "failed to update featured resumes")
+	}
+
+	return response.Success(c, fiber.StatusOK, result)
+}
+
+// parseResumeIDs parses ids already checked by validateBulkResumeIDs.
+func parseResumeIDs(rawIDs []string) []uuid.UUID {
+	resumeIDs := make([]uuid.UUID, 0, len(rawIDs))
+	for _, rawID := range rawIDs {
+		id, _ := uuid.Parse(rawID)
+		resumeIDs = append(resumeIDs, id)
+	}
+	return resumeIDs
+}
+
 // UnmarkAsMain removes the main flag from a resume.
 func (h *handler) UnmarkAsMain(c *fiber.Ctx) error {
 	userID, err := middleware.GetUserIDFromFiberContext(c)
@@ -469,21 +480,14 @@ func (h *handler) UnmarkAsMain(c *fiber.Ctx) error {
 		return response.Error(c, fiber.StatusUnauthorized, 0, fiber.Map{"message": "authentication required"})
 	}
 
-	resumeID, err := uuid.Parse(c.Params("id"))
+	resumeID, err := middleware.UUIDParam(c, "id")
 	if err != nil {
-		return response.Error(c, fiber.StatusBadRequest, 0, fiber.Map{"message": "invalid resume ID"})
+		return middleware.InvalidUUIDParam(c, "id")
 	}
 
 	resume, err := h.service.UnmarkAsMain(c.Context(), userID, resumeID)
 	if err != nil {
-		if domainErr, ok := err.(*DomainError); ok {
-			if domainErr.Code == ErrCodeNotFound {
-				return response.Error(c, fiber.StatusNotFound, 0, fiber.Map{"message": domainErr.Message})
-			}
-			return response.Error(c, fiber.StatusBadRequest, 0, fiber.Map{"message": domainErr.Message})
-		}
-		h.logger.Error("failed to unmark resume as main", slog.Any("error", err))
-		return response.Error(c, fiber.StatusInternalServerError, 0, fiber.Map{"message": "failed to unmark resume as main"})
+		return h.handleError(c, err, "failed to unmark resume as main")
 	}
 
 	return response.Success(c, fiber.StatusOK, resume)
@@ -496,21 +500,14 @@ func (h *handler) UnmarkAsFeatured(c *fiber.Ctx) error {
 		return response.Error(c, fiber.StatusUnauthorized, 0, fiber.Map{"message": "authentication required"})
 	}
 
-	resumeID, err := uuid.Parse(c.Params("id"))
+	resumeID, err := middleware.UUIDParam(c, "id")
 	if err != nil {
-		return response.Error(c, fiber.StatusBadRequest, 0, fiber.Map{"message": "invalid resume ID"})
+		return middleware.InvalidUUIDParam(c, "id")
 	}
 
 	resume, err := h.service.UnmarkAsFeatured(c.Context(), userID, resumeID)
 	if err != nil {
-		if domainErr, ok := err.(*DomainError); ok {
-			if domainErr.Code == ErrCodeNotFound {
-				return response.Error(c, fiber.StatusNotFound, 0, fiber.Map{"message": domainErr.Message})
-			}
-			return response.Error(c, fiber.StatusBadRequest, 0, fiber.Map{"message": domainErr.Message})
-		}
-		h.logger.Error("failed to unmark resume as featured", slog.Any("error", err))
-		return response.Error(c, fiber.StatusInternalServerError, 0, fiber.Map{"message": "failed to unmark resume as featured"})
+		return h.handleError(c, err, "failed to unmark resume as featured")
 	}
 
 	return response.Success(c, fiber.StatusOK, resume)
@@ -534,49 +531,10 @@ func (h *handler) DownloadResume(c *fiber.Ctx) error {
 	// Get the best resume (main > featured > most recent)
 	resume, err := h.service.GetBestResume(c.Context(), userID)
 	if err != nil {
-		if domainErr, ok := err.(*DomainError); ok {
-			if domainErr.Code == ErrCodeNotFound {
-				return response.Error(c, fiber.StatusNotFound, 0, fiber.Map{"message": domainErr.Message})
-			}
-		}
-		h.logger.Error("failed to get resume for download", slog.Any("error", err))
-		return response.Error(c, fiber.StatusInternalServerError, 0, fiber.Map{"message": "failed to get resume"})
-	}
-
-	// Build full file path
-	var fullPath string
-	if !filepath.IsAbs(resume.FilePath) {
-		fullPath = filepath.Join(h.baseFilePath, resume.FilePath)
-	} else {
-		fullPath = resume.FilePath
-	}
-
-	// Check if file exists
-	if _, err := os.Stat(fullPath); os.IsNotExist(err) {
-		h.logger.Error("resume file not found", slog.String("path", fullPath))
-		return response.Error(c, fiber.StatusNotFound, 0, fiber.Map{"message": ErrFileNotFound})
-	}
-
-	// Open file
-	file, err := os.Open(fullPath)
-	if err != nil {
-		h.logger.Error("failed to open resume file", slog.Any("error", err))
-		return response.Error(c, fiber.StatusInternalServerError, 0, fiber.Map{"message": ErrFileReadError})
-	}
-	defer file.Close()
-
-	// Set headers for PDF download
-	c.Set("Content-Type", "application/pdf")
-	c.Set("Content-Disposition", `attachment; filename="`+resume.FileName+`"`)
-
-	// Stream file to response
-	_, err = io.Copy(c.Response().BodyWriter(), file)
-	if err != nil {
-		h.logger.Error("failed to stream resume file", slog.Any("error", err))
-		return response.Error(c, fiber.StatusInternalServerError, 0, fiber.Map{"message": "failed to stream file"})
+		return h.handleError(c, err, "failed to get resume")
 	}
 
-	return nil
+	return h.streamResumeFile(c, resume, "attachment")
 }
 
 // DownloadResumeByID downloads a resume by its ID (authenticated endpoint).
@@ -586,57 +544,18 @@ func (h *handler) DownloadResumeByID(c *fiber.Ctx) error {
 		return response.Error(c, fiber.StatusUnauthorized, 0, fiber.Map{"message": "authentication required"})
 	}
 
-	resumeID, err := uuid.Parse(c.Params("id"))
+	resumeID, err := middleware.UUIDParam(c, "id")
 	if err != nil {
-		return response.Error(c, fiber.StatusBadRequest, 0, fiber.Map{"message": "invalid resume ID"})
+		return middleware.InvalidUUIDParam(c, "id")
 	}
 
 	// Get resume
 	resume, err := h.service.GetResume(c.Context(), userID, resumeID)
 	if err != nil {
-		if domainErr, ok := err.(*DomainError); ok {
-			if domainErr.Code == ErrCodeNotFound {
-				return response.Error(c, fiber.StatusNotFound, 0, fiber.Map{"message": domainErr.Message})
-			}
-		}
-		h.logger.Error("failed to get resume for download", slog.Any("error", err))
-		return response.Error(c, fiber.StatusInternalServerError, 0, fiber.Map{"message": "failed to get resume"})
-	}
-
-	// Build full file path
-	var fullPath string
-	if !filepath.IsAbs(resume.FilePath) {
-		fullPath = filepath.Join(h.baseFilePath, resume.FilePath)
-	} else {
-		fullPath = resume.FilePath
-	}
-
-	// Check if file exists
-	if _, err := os.Stat(fullPath); os.IsNotExist(err) {
-		h.logger.Error("resume file not found", slog.String("path", fullPath))
-		return response.Error(c, fiber.StatusNotFound, 0, fiber.Map{"message": ErrFileNotFound})
-	}
-
-	// Open file
-	file, err := os.Open(fullPath)
-	if err != nil {
-		h.logger.Error("failed to open resume file", slog.Any("error", err))
-		return response.Error(c, fiber.StatusInternalServerError, 0, fiber.Map{"message": ErrFileReadError})
-	}
-	defer file.Close()
-
-	// Set headers for PDF download
-	c.Set("Content-Type", "application/pdf")
-	c.Set("Content-Disposition", `attachment; filename="`+resume.FileName+`"`)
-
-	// Stream file to response
-	_, err = io.Copy(c.Response().BodyWriter(), file)
-	if err != nil {
-		h.logger.Error("failed to stream resume file", slog.Any("error", err))
-		return response.Error(c, fiber.StatusInternalServerError, 0, fiber.Map{"message": "failed to stream file"})
+		return h.handleError(c, err, "failed to get resume")
 	}
 
-	return nil
+	return h.streamResumeFile(c, resume, "attachment")
 }
 
 // PreviewResume serves the resume for preview (public endpoint).
@@ -655,45 +574,34 @@ func (h *handler) PreviewResume(c *fiber.Ctx) error {
 	// Get the best resume
 	resume, err := h.service.GetBestResume(c.Context(), userID)
 	if err != nil {
-		if domainErr, ok := err.(*DomainError); ok {
-			if domainErr.Code == ErrCodeNotFound {
-				return response.Error(c, fiber.StatusNotFound, 0, fiber.Map{"message": domainErr.Message})
-			}
-		}
-		h.logger.Error("failed to get resume for preview", slog.Any("error", err))
-		return response.Error(c, fiber.StatusInternalServerError, 0, fiber.Map{"message": "failed to get resume"})
-	}
-
-	// Build full file path
-	var fullPath string
-	if !filepath.IsAbs(resume.FilePath) {
-		fullPath = filepath.Join(h.baseFilePath, resume.FilePath)
-	} else {
-		fullPath = resume.FilePath
+		return h.handleError(c, err, "failed to get resume")
 	}
 
-	// Check if file exists
-	if _, err := os.Stat(fullPath); os.IsNotExist(err) {
-		h.logger.Error("resume file not found", slog.String("path", fullPath))
-		return response.Error(c, fiber.StatusNotFound, 0, fiber.Map{"message": ErrFileNotFound})
-	}
+	return h.streamResumeFile(c, resume, "inline")
+}
 
-	// Open file
-	file, err := os.Open(fullPath)
+// streamResumeFile writes the resume PDF from storage to the response.
+// disposition is "attachment" for downloads or "inline" for previews.
+func (h *handler) streamResumeFile(c *fiber.Ctx, resume *Resume, disposition string) error {
+	file, err := h.service.OpenResumeFile(c.Context(), resume)
 	if err != nil {
-		h.logger.Error("failed to open resume file", slog.Any("error", err))
+		if domainErr, ok := AsDomainError(err); ok && domainErr.Code == ErrCodeFileNotFound {
+			h.logger.ErrorContext(c.UserContext(), "resume file not found", slog.String("key", resume.FilePath))
+			return response.Error(c, fiber.StatusNotFound, 0, fiber.Map{"message": ErrFileNotFound})
+		}
+		h.logger.ErrorContext(c.UserContext(), "failed to open resume file", slog.Any("error", err))
 		return response.Error(c, fiber.StatusInternalServerError, 0, fiber.Map{"message": ErrFileReadError})
 	}
 	defer file.Close()
 
-	// Set headers for PDF preview (inline display)
+	// Set headers for PDF download or preview
 	c.Set("Content-Type", "application/pdf")
-	c.Set("Content-Disposition", `inline; filename="`+resume.FileName+`"`)
+	c.Set("Content-Disposition", disposition+`; filename="`+resume.FileName+`"`)
 
 	// Stream file to response
 	_, err = io.Copy(c.Response().BodyWriter(), file)
 	if err != nil {
-		h.logger.Error("failed to stream resume file", slog.Any("error", err))
+		h.logger.ErrorContext(c.UserContext(), "failed to stream resume file", slog.Any("error", err))
 		return response.Error(c, fiber.StatusInternalServerError, 0, fiber.Map{"message": "failed to stream file"})
 	}
 
@@ -714,7 +622,8 @@ func (h *handler) GenerateResume(c *fiber.Ctx) error {
 	}
 
 	// Validate payload
-	if err := ValidateGenerateResumePayload(&req); err != nil {
+	options, err := ValidateGenerateResumePayload(&req)
+	if err != nil {
 		return response.Error(c, fiber.StatusBadRequest, 0, fiber.Map{"message": err.Error()})
 	}
 
@@ -752,6 +661,29 @@ func (h *handler) GenerateResume(c *fiber.Ctx) error {
 		language = "en"
 	}
 
+	priority := ResumeJobPriority(req.Priority)
+
+	// Without a Redis queue, publish to the RabbitMQ lane of the requested priority
+	if h.queue == nil {
+		metadata := map[string]interface{}{
+			"jobApplicationId": jobAppID.String(),
+			"jobTitle":         jobApp.JobTitle,
+			"companyName":      jobApp.CompanyName,
+			"language":         language,
+		}
+		options.ApplyTo(metadata)
+		jobID, err := h.service.GenerateResume(c.Context(), userID, jobDescription, metadata, priority)
+		if err != nil {
+			return h.handleError(c, err, "failed to queue resume generation job")
+		}
+		return response.Success(c, fiber.StatusAccepted, fiber.Map{
+			"jobId":    jobID,
+			"status":   "pending",
+			"priority": priority,
+			"message":  "Resume generation job enqueued",
+		})
+	}
+
 	// Create job
 	job := &ResumeJob{
 		UserID:          userID,
@@ -759,12 +691,16 @@ func (h *handler) GenerateResume(c *fiber.Ctx) error {
 		JobDescription:  jobDescription,
 		JobTitle:        jobApp.JobTitle,
 		Language:        language,
+		Priority:        priority,
 		MaxRetries:      3,
 	}
+	if !options.IsZero() {
+		job.Options = &options
+	}
 
 	// Enqueue job
 	if err := h.queue.EnqueueJob(c.Context(), job); err != nil {
-		h.logger.Error("failed to enqueue resume generation job",
+		h.logger.ErrorContext(c.UserContext(), "failed to enqueue resume generation job",
 			slog.String("user_id", userID.String()),
 			slog.String("job_application_id", jobAppID.String()),
 			slog.Any("error", err),
@@ -772,7 +708,7 @@ func (h *handler) GenerateResume(c *fiber.Ctx) error {
 		return response.Error(c, fiber.StatusInternalServerError, 0, fiber.Map{"message": "failed to enqueue job"})
 	}
 
-	h.logger.Info("Resume generation job enqueued",
+	h.logger.InfoContext(c.UserContext(), "Resume generation job enqueued",
 		slog.String("job_id", job.ID),
 		slog.String("user_id", userID.String()),
 		slog.String("job_application_id", jobAppID.String()),
@@ -792,25 +728,20 @@ func (h *handler) RecalculateMetrics(c *fiber.Ctx) error {
 		return response.Error(c, fiber.StatusUnauthorized, 0, fiber.Map{"message": "authentication required"})
 	}
 
-	resumeID, err := uuid.Parse(c.Params("id"))
+	resumeID, err := middleware.UUIDParam(c, "id")
 	if err != nil {
-		return response.Error(c, fiber.StatusBadRequest, 0, fiber.Map{"message": "invalid resume ID"})
+		return middleware.InvalidUUIDParam(c, "id")
 	}
 
 	// Verify the resume belongs to the user
 	_, err = h.service.GetResume(c.Context(), userID, resumeID)
 	if err != nil {
-		if domainErr, ok := err.(*DomainError); ok {
-			if domainErr.Code == ErrCodeNotFound {
-				return response.Error(c, fiber.StatusNotFound, 0, fiber.Map{"message": domainErr.Message})
-			}
-		}
-		return response.Error(c, fiber.StatusInternalServerError, 0, fiber.Map{"message": "failed to get resume"})
+		return h.handleError(c, err, "failed to get resume")
 	}
 
 	// Recalculate metrics
 	if err := h.service.RecalculateResumeMetrics(c.Context(), resumeID); err != nil {
-		h.logger.Error("failed to recalculate resume metrics", slog.Any("error", err))
+		h.logger.ErrorContext(c.UserContext(), "failed to recalculate resume metrics", slog.Any("error", err))
 		return response.Error(c, fiber.StatusInternalServerError, 0, fiber.Map{"message": "failed to recalculate metrics"})
 	}
 
@@ -902,14 +833,14 @@ func (h *handler) RetryJob(c *fiber.Ctx) error {
 	job.Result = nil
 
 	if err := h.queue.EnqueueJob(c.Context(), job); err != nil {
-		h.logger.Error("failed to retry job",
+		h.logger.ErrorContext(c.UserContext(), "failed to retry job",
 			slog.String("job_id", jobID),
 			slog.Any("error", err),
 		)
 		return response.Error(c, fiber.StatusInternalServerError, 0, fiber.Map{"message": "failed to retry job"})
 	}
 
-	h.logger.Info("Job retried",
+	h.logger.InfoContext(c.UserContext(), "Job retried",
 		slog.String("job_id", jobID),
 		slog.String("user_id", userID.String()),
 	)
@@ -952,14 +883,14 @@ func (h *handler) CancelJob(c *fiber.Ctx) error {
 	errorMsg := "Job cancelled by user"
 	errorType := "permanent"
 	if err := h.queue.UpdateJobStatus(c.Context(), jobID, "failed", &errorMsg, &errorType, nil, nil); err != nil {
-		h.logger.Error("failed to cancel job",
+		h.logger.ErrorContext(c.UserContext(), "failed to cancel job",
 			slog.String("job_id", jobID),
 			slog.Any("error", err),
 		)
 		return response.Error(c, fiber.StatusInternalServerError, 0, fiber.Map{"message": "failed to cancel job"})
 	}
 
-	h.logger.Info("Job cancelled",
+	h.logger.InfoContext(c.UserContext(), "Job cancelled",
 		slog.String("job_id", jobID),
 		slog.String("user_id", userID.String()),
 	)
@@ -971,30 +902,202 @@ func (h *handler) CancelJob(c *fiber.Ctx) error {
 	})
 }
 
+// ListGenerationJobs lists the authenticated user's resume generation jobs, newest first.
+// Supports ?status=, ?createdAfter= (RFC3339), ?limit= and ?offset= query parameters.
+func (h *handler) ListGenerationJobs(c *fiber.Ctx) error {
+	userID, err := middleware.GetUserIDFromFiberContext(c)
+	if err != nil {
+		return response.Error(c, fiber.StatusUnauthorized, 0, fiber.Map{"message": "authentication required"})
+	}
+
+	status := c.Query("status")
+	createdAfter := c.Query("createdAfter")
+
+	page, err := pagination.FromQuery(c)
+	if err != nil {
+		return response.Error(c, fiber.StatusBadRequest, 0, fiber.Map{"message": err.Error()})
+	}
+	if err := ValidateListGenerationJobsQueryParams(status, createdAfter); err != nil {
+		return response.Error(c, fiber.StatusBadRequest, 0, fiber.Map{"message": err.Error()})
+	}
+
+	filters := GenerationJobFilters{
+		UserID: userID,
+		Limit:  page.Limit,
+		Offset: page.Offset,
+	}
+	if status != "" {
+		jobStatus := ResumeJobStatus(status)
+		filters.Status = &jobStatus
+	}
+	if createdAfter != "" {
+		createdAfterTime, _ := time.Parse(time.RFC3339, createdAfter)
+		filters.CreatedAfter = &createdAfterTime
+	}
+
+	jobs, err := h.service.ListUserResumeGenerationJobs(c.Context(), filters)
+	if err != nil {
+		h.logger.ErrorContext(c.UserContext(), "failed to list resume generation jobs", slog.Any("error", err))
+		return response.Error(c, fiber.StatusInternalServerError, 0, fiber.Map{"message": "failed to list generation jobs"})
+	}
+
+	page.SetHeaders(c)
+	page.SetLinkHeader(c, len(jobs), pagination.UnknownTotal)
+	return response.Success(c, fiber.StatusOK, fiber.Map{
+		"jobs":   jobs,
+		"count":  len(jobs),
+		"limit":  page.Limit,
+		"offset": page.Offset,
+	})
+}
+
+// RetryGenerationJob resets a failed resume generation job and republishes it to the worker.
+func (h *handler) RetryGenerationJob(c *fiber.Ctx) error {
+	userID, err := middleware.GetUserIDFromFiberContext(c)
+	if err != nil {
+		return response.Error(c, fiber.StatusUnauthorized, 0, fiber.Map{"message": "authentication required"})
+	}
+
+	jobID, err := middleware.UUIDParam(c, "id")
+	if err != nil {
+		return middleware.InvalidUUIDParam(c, "id")
+	}
+
+	job, err := h.service.RetryResumeGeneration(c.Context(), userID, jobID)
+	if err != nil {
+		return h.handleError(c, err, "failed to retry generation job")
+	}
+
+	return response.Success(c, fiber.StatusOK, job)
+}
+
+// GetGeneratedResume returns the resume a completed generation job produced. Jobs that have
+// not completed answer 409 with their status, plus the error details when the job failed.
+func (h *handler) GetGeneratedResume(c *fiber.Ctx) error {
+	userID, err := middleware.GetUserIDFromFiberContext(c)
+	if err != nil {
+		return response.Error(c, fiber.StatusUnauthorized, 0, fiber.Map{"message": "authentication required"})
+	}
+
+	jobID, err := middleware.UUIDParam(c, "id")
+	if err != nil {
+		return middleware.InvalidUUIDParam(c, "id")
+	}
+
+	job, resume, err := h.service.GetGeneratedResume(c.Context(), userID, jobID)
+	if err != nil {
+		if domainErr, ok := AsDomainError(err); ok && domainErr.Code == ErrCodeInvalidJobState && job != nil {
+			body := fiber.Map{
+				"message": domainErr.Message,
+				"jobId":   job.ID,
+				"status":  job.Status,
+			}
+			if job.Status == ResumeJobStatusFailed {
+				body["errorMessage"] = job.ErrorMessage
+				body["errorCode"] = job.ErrorCode
+			}
+			return response.Error(c, fiber.StatusConflict, 0, body)
+		}
+		return h.handleError(c, err, "failed to get generated resume")
+	}
+
+	return response.Success(c, fiber.StatusOK, resume)
+}
+
+const (
+	// statusStreamKeepAlive is how often a comment is sent to keep idle proxies from closing the stream
+	statusStreamKeepAlive = 15 * time.Second
+	// statusStreamMaxDuration bounds how long a single stream stays open
+	statusStreamMaxDuration = 10 * time.Minute
+)
+
+// StreamGenerationJobStatus streams status changes of a resume generation job as
+// Server-Sent Events until the job reaches a terminal state or the client disconnects.
+func (h *handler) StreamGenerationJobStatus(c *fiber.Ctx) error {
+	userID, err := middleware.GetUserIDFromFiberContext(c)
+	if err != nil {
+		return response.Error(c, fiber.StatusUnauthorized, 0, fiber.Map{"message": "authentication required"})
+	}
+
+	jobID, err := middleware.UUIDParam(c, "id")
+	if err != nil {
+		return middleware.InvalidUUIDParam(c, "id")
+	}
+
+	job, updates, unsubscribe, err := h.service.WatchResumeGenerationJob(c.Context(), userID, jobID)
+	if err != nil {
+		return h.handleError(c, err, "failed to watch generation job")
+	}
+
+	c.Set(fiber.HeaderContentType, "text/event-stream")
+	c.Set(fiber.HeaderCacheControl, "no-cache")
+	c.Set(fiber.HeaderConnection, "keep-alive")
+	c.Set("X-Accel-Buffering", "no")
+
+	initial := newJobStatusUpdate(job)
+	logger := h.logger
+
+	c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		defer unsubscribe()
+
+		if err := writeStatusEvent(w, initial); err != nil || initial.IsTerminal() {
+			return
+		}
+
+		keepAlive := time.NewTicker(statusStreamKeepAlive)
+		defer keepAlive.Stop()
+		deadline := time.NewTimer(statusStreamMaxDuration)
+		defer deadline.Stop()
+
+		for {
+			select {
+			case update, ok := <-updates:
+				if !ok {
+					return
+				}
+				if err := writeStatusEvent(w, update); err != nil {
+					logger.Debug("resume job status stream closed", slog.String("job_id", jobID.String()), slog.Any("error", err))
+					return
+				}
+				if update.IsTerminal() {
+					return
+				}
+			case <-keepAlive.C:
+				if _, err := w.WriteString(": keep-alive\n\n"); err != nil {
+					return
+				}
+				if err := w.Flush(); err != nil {
+					// Client went away
+					return
+				}
+			case <-deadline.C:
+				return
+			}
+		}
+	})
+
+	return nil
+}
+
+// writeStatusEvent writes a single SSE "status" event and flushes it to the client.
+func writeStatusEvent(w *bufio.Writer, update JobStatusUpdate) error {
+	payload, err := json.Marshal(update)
+	if err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(w, "event: status\ndata: %s\n\n", payload); err != nil {
+		return err
+	}
+	return w.Flush()
+}
+
 // CompleteResumeGeneration is an internal callback endpoint for the resume worker.
 // It saves the generated resume file, creates a database record, and links it to the job application.
 func (h *handler) CompleteResumeGeneration(c *fiber.Ctx) error {
-	// Validate API key for internal service authentication
-	apiKey := c.Get("X-API-Key")
-	if apiKey == "" {
-		apiKey = c.Get("x-api-key")
-	}
-	
-	expectedAPIKey := os.Getenv("PUBLIC_API_KEY")
-	if expectedAPIKey == "" {
-		h.logger.Warn("PUBLIC_API_KEY not set, allowing request without API key validation")
-	} else if apiKey != expectedAPIKey {
-		h.logger.Warn("Invalid API key provided for internal resume completion",
-			slog.String("provided_key_prefix", func() string {
-				if len(apiKey) > 8 {
-					return apiKey[:8]
-				}
-				return apiKey
-			}()),
-		)
+	if !h.authorizeInternalCall(c) {
 		return response.Error(c, fiber.StatusUnauthorized, 0, fiber.Map{"message": "unauthorized: invalid API key"})
 	}
-	
+
 	// Parse multipart form
 	form, err := c.MultipartForm()
 	if err != nil {
@@ -1053,47 +1156,24 @@ func (h *handler) CompleteResumeGeneration(c *fiber.Ctx) error {
 		}
 	}
 
-	// Create upload directory if it doesn't exist
-	uploadDir := filepath.Join(h.baseFilePath, "uploads")
-	if err := os.MkdirAll(uploadDir, 0755); err != nil {
-		h.logger.Error("failed to create upload directory", slog.Any("error", err))
-		return response.Error(c, fiber.StatusInternalServerError, 0, fiber.Map{"message": "failed to create upload directory"})
-	}
-
-	// Generate unique filename
-	timestamp := time.Now().Unix()
-	safeFilename := fmt.Sprintf("%d_%s", timestamp, fileHeader.Filename)
-	filePath := filepath.Join("uploads", safeFilename)
-	fullPath := filepath.Join(h.baseFilePath, filePath)
-
-	// Save file
-	if err := c.SaveFile(fileHeader, fullPath); err != nil {
-		h.logger.Error("failed to save file", slog.Any("error", err))
-		return response.Error(c, fiber.StatusInternalServerError, 0, fiber.Map{"message": "failed to save file"})
-	}
-
-	// Get file size
-	fileInfo, err := os.Stat(fullPath)
+	file, err := fileHeader.Open()
 	if err != nil {
-		h.logger.Error("failed to get file info", slog.Any("error", err))
-		// Clean up file
-		os.Remove(fullPath)
-		return response.Error(c, fiber.StatusInternalServerError, 0, fiber.Map{"message": "failed to get file info"})
+		h.logger.ErrorContext(c.UserContext(), "failed to open uploaded file", slog.Any("error", err))
+		return response.Error(c, fiber.StatusBadRequest, 0, fiber.Map{"message": "failed to read uploaded file"})
 	}
+	defer file.Close()
 
-	// Create resume entry
-	resume, err := h.service.CreateResume(c.Context(), userID, title, filePath, fileHeader.Filename, fileInfo.Size(), JSONArray(tags))
+	// Store file and create resume entry
+	resume, err := h.service.UploadResume(c.Context(), userID, title, fileHeader.Filename, "application/pdf", fileHeader.Size, file, JSONArray(tags))
 	if err != nil {
-		h.logger.Error("failed to create resume", slog.Any("error", err))
-		// Clean up file
-		os.Remove(fullPath)
+		h.logger.ErrorContext(c.UserContext(), "failed to create resume", slog.Any("error", err))
 		return response.Error(c, fiber.StatusInternalServerError, 0, fiber.Map{"message": "failed to create resume"})
 	}
 
 	// Link resume to job application
 	if h.jobApplicationService != nil {
 		if err := h.jobApplicationService.UpdateJobApplicationResumeID(c.Context(), jobApplicationID, resume.ID); err != nil {
-			h.logger.Warn("failed to link resume to job application",
+			h.logger.WarnContext(c.UserContext(), "failed to link resume to job application",
 				slog.String("job_application_id", jobApplicationID.String()),
 				slog.String("resume_id", resume.ID.String()),
 				slog.Any("error", err),
@@ -1102,22 +1182,35 @@ func (h *handler) CompleteResumeGeneration(c *fiber.Ctx) error {
 		}
 	}
 
+	// Mark the generation job completed so status subscribers are notified
+	if generationJobID, err := uuid.Parse(jobID); err == nil {
+		if err := h.service.CompleteResumeGeneration(c.Context(), generationJobID, resume.ID); err != nil {
+			h.logger.WarnContext(c.UserContext(), "failed to mark resume generation job completed",
+				slog.String("job_id", jobID),
+				slog.Any("error", err),
+			)
+			// Don't fail the request if status update fails
+		}
+	}
+
 	// Update job status to completed
 	result := &ResumeJobResult{
-		OutputPath: filePath,
+		OutputPath: resume.FilePath,
 		FileName:   fileHeader.Filename,
-		FileSize:   fileInfo.Size(),
+		FileSize:   resume.FileSize,
 		Tags:       tags,
 	}
-	if err := h.queue.UpdateJobStatus(c.Context(), jobID, "completed", nil, nil, nil, result); err != nil {
-		h.logger.Warn("failed to update job status",
-			slog.String("job_id", jobID),
-			slog.Any("error", err),
-		)
-		// Don't fail the request if status update fails
+	if h.queue != nil {
+		if err := h.queue.UpdateJobStatus(c.Context(), jobID, "completed", nil, nil, nil, result); err != nil {
+			h.logger.WarnContext(c.UserContext(), "failed to update job status",
+				slog.String("job_id", jobID),
+				slog.Any("error", err),
+			)
+			// Don't fail the request if status update fails
+		}
 	}
 
-	h.logger.Info("Resume generation completed and saved",
+	h.logger.InfoContext(c.UserContext(), "Resume generation completed and saved",
 		slog.String("job_id", jobID),
 		slog.String("job_application_id", jobApplicationID.String()),
 		slog.String("resume_id", resume.ID.String()),
@@ -1130,3 +1223,84 @@ func (h *handler) CompleteResumeGeneration(c *fiber.Ctx) error {
 	})
 }
 
+// UpdateGenerationStatus is an internal callback endpoint for the resume worker.
+// It records the processing and failed transitions of a generation job so status
+// subscribers see them; completion is reported through CompleteResumeGeneration.
+func (h *handler) UpdateGenerationStatus(c *fiber.Ctx) error {
+	if !h.authorizeInternalCall(c) {
+		return response.Error(c, fiber.StatusUnauthorized, 0, fiber.Map{"message": "unauthorized: invalid API key"})
+	}
+
+	var payload generationStatusPayload
+	if err := c.BodyParser(&payload); err != nil {
+		return response.Error(c, fiber.StatusBadRequest, 0, fiber.Map{"message": "invalid request body"})
+	}
+	if err := ValidateGenerationStatusPayload(&payload); err != nil {
+		return response.Error(c, fiber.StatusBadRequest, 0, fiber.Map{"message": err.Error()})
+	}
+
+	jobID, _ := uuid.Parse(payload.JobID)
+
+	var err error
+	if ResumeJobStatus(payload.Status) == ResumeJobStatusProcessing {
+		err = h.service.StartResumeGeneration(c.Context(), jobID)
+	} else {
+		err = h.service.FailResumeGeneration(c.Context(), jobID, payload.ErrorMessage, payload.ErrorCode)
+	}
+	if err != nil {
+		return h.handleError(c, err, "failed to update generation job status")
+	}
+
+	return response.Success(c, fiber.StatusOK, fiber.Map{
+		"jobId":  jobID,
+		"status": payload.Status,
+	})
+}
+
+// authorizeInternalCall checks the shared API key sent by internal services.
+// When PUBLIC_API_KEY is unset every call is allowed, matching local development setups.
+func (h *handler) authorizeInternalCall(c *fiber.Ctx) bool {
+	apiKey := c.Get("X-API-Key")
+	if apiKey == "" {
+		apiKey = c.Get("x-api-key")
+	}
+
+	expectedAPIKey := os.Getenv("PUBLIC_API_KEY")
+	if expectedAPIKey == "" {
+		h.logger.WarnContext(c.UserContext(), "PUBLIC_API_KEY not set, allowing request without API key validation")
+		return true
+	}
+	if apiKey != expectedAPIKey {
+		h.logger.WarnContext(c.UserContext(), "Invalid API key provided for internal resume callback",
+			slog.String("provided_key_prefix", func() string {
+				if len(apiKey) > 8 {
+					return apiKey[:8]
+				}
+				return apiKey
+			}()),
+		)
+		return false
+	}
+	return true
+}
+
+// handleError writes the error envelope for err. Domain errors are mapped by
+// response.FromDomainError; anything else is logged and reported as a 500
+// with fallbackMessage.
+// validationError answers a payload that failed validation. Errors that carry a kind, such
+// as too many tags, keep their status (422); plain messages are a 400.
+func validationError(c *fiber.Ctx, err error) error {
+	var domainErr response.DomainError
+	if errors.As(err, &domainErr) {
+		return response.FromDomainError(c, err)
+	}
+	return response.Error(c, fiber.StatusBadRequest, 0, fiber.Map{"message": err.Error()})
+}
+
+func (h *handler) handleError(c *fiber.Ctx, err error, fallbackMessage string) error {
+	if _, ok := AsDomainError(err); ok {
+		return response.FromDomainError(c, err)
+	}
+	h.logger.ErrorContext(c.UserContext(), fallbackMessage, slog.Any("error", err))
+	return response.Error(c, fiber.StatusInternalServerError, 0, fiber.Map{"message": fallbackMessage})
+}
@@ -0,0 +1,79 @@
+package resumes
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeLifecycleRepository serves and stores a single generation job.
+type fakeLifecycleRepository struct {
+	Repository
+	job ResumeGenerationJob
+}
+
+func (r *fakeLifecycleRepository) GetResumeGenerationJob(_ context.Context, _ uuid.UUID) (*ResumeGenerationJob, error) {
+	job := r.job
+	return &job, nil
+}
+
+func (r *fakeLifecycleRepository) UpdateResumeGenerationJob(_ context.Context, job *ResumeGenerationJob) error {
+	r.job = *job
+	return nil
+}
+
+// finishedJobs reads the lifecycle counter and the duration sample count for one label set.
+func finishedJobs(t *testing.T, status, errorCode string) (float64, uint64) {
+	t.Helper()
+	families, err := prometheus.DefaultGatherer.Gather()
+	require.NoError(t, err)
+
+	var count float64
+	var observed uint64
+	for _, family := range families {
+		for _, metric := range family.GetMetric() {
+			labels := map[string]string{}
+			for _, label := range metric.GetLabel() {
+				labels[label.GetName()] = label.GetValue()
+			}
+			if labels["status"] != status || labels["error_code"] != errorCode {
+				continue
+			}
+			switch family.GetName() {
+			case "resume_jobs_finished_total":
+				count = metric.GetCounter().GetValue()
+			case "resume_job_duration_seconds":
+				observed = metric.GetHistogram().GetSampleCount()
+			}
+		}
+	}
+	return count, observed
+}
+
+func TestResumeGenerationLifecycleMetrics(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	createdAt := time.Now().UTC().Add(-2 * time.Minute)
+	repo := &fakeLifecycleRepository{job: ResumeGenerationJob{ID: uuid.New(), Status: ResumeJobStatusProcessing, CreatedAt: createdAt}}
+	svc := NewService(repo, nil, logger)
+
+	failedBefore, failedObservedBefore := finishedJobs(t, "failed", "LLM_TIMEOUT")
+	require.NoError(t, svc.FailResumeGeneration(context.Background(), repo.job.ID, "timed out", "LLM_TIMEOUT"))
+	require.NoError(t, svc.FailResumeGeneration(context.Background(), repo.job.ID, "timed out", "LLM_TIMEOUT"))
+	failed, failedObserved := finishedJobs(t, "failed", "LLM_TIMEOUT")
+	assert.Equal(t, failedBefore+1, failed, "a repeated failure callback is not counted again")
+	assert.Equal(t, failedObservedBefore+1, failedObserved)
+
+	repo.job.Status = ResumeJobStatusProcessing
+	completedBefore, _ := finishedJobs(t, "completed", "")
+	require.NoError(t, svc.CompleteResumeGeneration(context.Background(), repo.job.ID, uuid.New()))
+	require.NoError(t, svc.CompleteResumeGeneration(context.Background(), repo.job.ID, uuid.New()))
+	completed, _ := finishedJobs(t, "completed", "")
+	assert.Equal(t, completedBefore+1, completed)
+}
@@ -0,0 +1,32 @@
+package resumes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRabbitMQPublisherConfigRoutingKey(t *testing.T) {
+	cfg := DefaultRabbitMQPublisherConfig()
+
+	assert.Equal(t, cfg.HighPriorityRoutingKey, cfg.routingKey(ResumeJobPriorityHigh))
+	assert.Equal(t, cfg.NormalPriorityRoutingKey, cfg.routingKey(ResumeJobPriorityNormal))
+	assert.Equal(t, resumeRoutingKey, cfg.routingKey(""))
+}
+
+func TestValidateGenerateResumePayloadPriority(t *testing.T) {
+	payload := &generateResumePayload{
+		JobApplicationID: "3f1c2a4e-8b7d-4c2e-9a1f-0d5e6b7c8a90",
+		Language:         "en",
+	}
+
+	for _, priority := range []string{"", "high", "normal"} {
+		payload.Priority = priority
+		_, err := ValidateGenerateResumePayload(payload)
+		assert.NoError(t, err, priority)
+	}
+
+	payload.Priority = "urgent"
+	_, err := ValidateGenerateResumePayload(payload)
+	assert.Error(t, err)
+}
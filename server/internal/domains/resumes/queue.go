@@ -32,6 +32,8 @@ type ResumeJob struct {
 	JobDescription  string    `json:"job_description"`
 	JobTitle        string    `json:"job_title"`
 	Language        string    `json:"language"`
+	Priority        ResumeJobPriority `json:"priority,omitempty"`
+	Options         *ResumeGenerationOptions `json:"options,omitempty"`
 	Status          string    `json:"status"` // pending, processing, completed, failed, retrying, dead_letter
 	RetryCount      int       `json:"retry_count"`
 	MaxRetries      int       `json:"max_retries"`
@@ -0,0 +1,35 @@
+package resumes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRabbitMQPublisherConfigQueueArguments(t *testing.T) {
+	args := DefaultRabbitMQPublisherConfig().queueArguments()
+
+	assert.Equal(t, int64(86400000), args["x-message-ttl"])
+	assert.Equal(t, int64(10000), args["x-max-length"])
+	assert.Equal(t, ResumeQueueOverflowDropHead, args["x-overflow"])
+	assert.Equal(t, resumeDeadLetterExchange, args["x-dead-letter-exchange"])
+	assert.Equal(t, resumeDeadLetterQueue, args["x-dead-letter-routing-key"])
+
+	args = RabbitMQPublisherConfig{}.queueArguments()
+	assert.NotContains(t, args, "x-message-ttl")
+	assert.NotContains(t, args, "x-max-length")
+	assert.NotContains(t, args, "x-overflow")
+	assert.NotContains(t, args, "x-max-priority", "priority queues are off by default")
+}
+
+func TestRabbitMQPublisherConfigMessagePriority(t *testing.T) {
+	cfg := RabbitMQPublisherConfig{MaxPriority: 10}
+	assert.Equal(t, int64(10), cfg.queueArguments()["x-max-priority"])
+	assert.Equal(t, uint8(10), cfg.messagePriority(ResumeJobPriorityHigh))
+	assert.Equal(t, uint8(5), cfg.messagePriority(ResumeJobPriorityNormal))
+	assert.Equal(t, uint8(0), cfg.messagePriority(""))
+
+	for _, priority := range []ResumeJobPriority{ResumeJobPriorityHigh, ResumeJobPriorityNormal, ""} {
+		assert.Equal(t, uint8(0), DefaultRabbitMQPublisherConfig().messagePriority(priority), "disabled")
+	}
+}
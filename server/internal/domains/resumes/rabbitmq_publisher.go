@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"log/slog"
+	"time"
 
 	amqp "github.com/rabbitmq/amqp091-go"
 )
@@ -20,6 +21,7 @@ type ResumeWorkerJob struct {
 	JobID          string                 `json:"jobId"`
 	UserID         string                 `json:"userId"`
 	JobDescription string                 `json:"jobDescription"`
+	Priority       ResumeJobPriority      `json:"priority,omitempty"`
 	Metadata       map[string]interface{} `json:"metadata"`
 }
 
@@ -27,15 +29,135 @@ const (
 	resumeExchange   = "woragis.tasks"
 	resumeQueue      = "resumes.queue"
 	resumeRoutingKey = "resumes.generate"
+
+	resumeDeadLetterExchange = "woragis.dlx"
+	resumeDeadLetterQueue    = "resumes.queue.failed"
 )
 
+// Overflow behaviours for a full resume queue. Both dead-letter the message that
+// does not fit: drop-head evicts the oldest queued job, reject-publish-dlx refuses
+// the newest one.
+const (
+	ResumeQueueOverflowDropHead         = "drop-head"
+	ResumeQueueOverflowRejectPublishDLX = "reject-publish-dlx"
+)
+
+// RabbitMQPublisherConfig selects where jobs of each priority are routed. Jobs
+// without a priority keep using resumes.generate, which is bound to the
+// normal-priority queue resumes.queue.
+type RabbitMQPublisherConfig struct {
+	// HighPriorityQueue receives high-priority jobs so workers can drain it first
+	HighPriorityQueue string
+	// HighPriorityRoutingKey is bound to HighPriorityQueue
+	HighPriorityRoutingKey string
+	// NormalPriorityRoutingKey is bound to resumes.queue next to resumes.generate
+	NormalPriorityRoutingKey string
+	// MessageTTL dead-letters jobs that wait longer than this; zero disables it
+	MessageTTL time.Duration
+	// MaxLength caps the number of jobs in each queue; zero disables it
+	MaxLength int
+	// Overflow selects which job is dead-lettered once MaxLength is reached
+	Overflow string
+	// MaxPriority declares the queues as priority queues with this many levels and
+	// stamps each job with a priority so workers take urgent jobs first within a
+	// queue; zero disables it
+	MaxPriority uint8
+}
+
+// DefaultRabbitMQPublisherConfig returns the routing used when nothing is configured.
+func DefaultRabbitMQPublisherConfig() RabbitMQPublisherConfig {
+	return RabbitMQPublisherConfig{
+		HighPriorityQueue:        "resumes.queue.high",
+		HighPriorityRoutingKey:   "resumes.generate.high",
+		NormalPriorityRoutingKey: "resumes.generate.normal",
+		MessageTTL:               24 * time.Hour,
+		MaxLength:                10000,
+		Overflow:                 ResumeQueueOverflowDropHead,
+	}
+}
+
+// queueArguments returns the arguments for the resume queues. Expired and overflowing
+// jobs are dead-lettered to resumes.queue.failed rather than dropped.
+func (cfg RabbitMQPublisherConfig) queueArguments() amqp.Table {
+	args := amqp.Table{
+		"x-dead-letter-exchange":    resumeDeadLetterExchange,
+		"x-dead-letter-routing-key": resumeDeadLetterQueue,
+	}
+	if cfg.MessageTTL > 0 {
+		args["x-message-ttl"] = cfg.MessageTTL.Milliseconds()
+	}
+	if cfg.MaxLength > 0 {
+		args["x-max-length"] = int64(cfg.MaxLength)
+		args["x-overflow"] = cfg.Overflow
+	}
+	if cfg.MaxPriority > 0 {
+		args["x-max-priority"] = int64(cfg.MaxPriority)
+	}
+	return args
+}
+
+// messagePriority returns the AMQP priority for jobs of the given priority: the maximum
+// for high, half of it for normal and zero for jobs without one. It is always zero when
+// priority queues are disabled.
+func (cfg RabbitMQPublisherConfig) messagePriority(priority ResumeJobPriority) uint8 {
+	switch priority {
+	case ResumeJobPriorityHigh:
+		return cfg.MaxPriority
+	case ResumeJobPriorityNormal:
+		return cfg.MaxPriority / 2
+	default:
+		return 0
+	}
+}
+
+// routingKey returns the routing key for jobs of the given priority.
+func (cfg RabbitMQPublisherConfig) routingKey(priority ResumeJobPriority) string {
+	switch priority {
+	case ResumeJobPriorityHigh:
+		return cfg.HighPriorityRoutingKey
+	case ResumeJobPriorityNormal:
+		return cfg.NormalPriorityRoutingKey
+	default:
+		return resumeRoutingKey
+	}
+}
+
 type rabbitMQPublisher struct {
 	channel *amqp.Channel
+	config  RabbitMQPublisherConfig
 	logger  *slog.Logger
 }
 
-// NewRabbitMQPublisher creates a new RabbitMQ publisher for resume jobs.
+// NewRabbitMQPublisher creates a new RabbitMQ publisher for resume jobs with the default routing.
 func NewRabbitMQPublisher(channel *amqp.Channel, logger *slog.Logger) (RabbitMQPublisher, error) {
+	return NewRabbitMQPublisherWithConfig(channel, DefaultRabbitMQPublisherConfig(), logger)
+}
+
+// NewRabbitMQPublisherWithConfig creates a RabbitMQ publisher for resume jobs, declaring
+// the normal and high-priority queues and their bindings. Blank routing fields and
+// overflow fall back to the defaults; a zero MessageTTL, MaxLength or MaxPriority leaves
+// that feature off.
+//
+// RabbitMQ refuses to redeclare an existing queue with different arguments, so changing
+// the TTL, max length or max priority requires deleting the queues (or applying a policy) first.
+func NewRabbitMQPublisherWithConfig(channel *amqp.Channel, config RabbitMQPublisherConfig, logger *slog.Logger) (RabbitMQPublisher, error) {
+	defaults := DefaultRabbitMQPublisherConfig()
+	if config.HighPriorityQueue == "" {
+		config.HighPriorityQueue = defaults.HighPriorityQueue
+	}
+	if config.HighPriorityRoutingKey == "" {
+		config.HighPriorityRoutingKey = defaults.HighPriorityRoutingKey
+	}
+	if config.NormalPriorityRoutingKey == "" {
+		config.NormalPriorityRoutingKey = defaults.NormalPriorityRoutingKey
+	}
+	if config.Overflow == "" {
+		config.Overflow = defaults.Overflow
+	}
+	if config.Overflow != ResumeQueueOverflowDropHead && config.Overflow != ResumeQueueOverflowRejectPublishDLX {
+		return nil, fmt.Errorf("unsupported queue overflow %q", config.Overflow)
+	}
+
 	// Declare exchange
 	err := channel.ExchangeDeclare(
 		resumeExchange,  // name
@@ -50,33 +172,62 @@ func NewRabbitMQPublisher(channel *amqp.Channel, logger *slog.Logger) (RabbitMQP
 		return nil, fmt.Errorf("failed to declare exchange: %w", err)
 	}
 
-	// Declare queue
-	_, err = channel.QueueDeclare(
-		resumeQueue, // name
-		true,        // durable
-		false,       // delete when unused
-		false,       // exclusive
-		false,       // no-wait
-		nil,         // arguments
+	// Declare the dead letter exchange and queue that collect expired and overflowing jobs
+	err = channel.ExchangeDeclare(
+		resumeDeadLetterExchange, // name
+		"direct",                 // kind
+		true,                     // durable
+		false,                    // auto-deleted
+		false,                    // internal
+		false,                    // no-wait
+		nil,                      // arguments
 	)
 	if err != nil {
-		return nil, fmt.Errorf("failed to declare queue: %w", err)
+		return nil, fmt.Errorf("failed to declare dead letter exchange: %w", err)
+	}
+	if _, err = channel.QueueDeclare(resumeDeadLetterQueue, true, false, false, false, nil); err != nil {
+		return nil, fmt.Errorf("failed to declare queue %s: %w", resumeDeadLetterQueue, err)
+	}
+	if err = channel.QueueBind(resumeDeadLetterQueue, resumeDeadLetterQueue, resumeDeadLetterExchange, false, nil); err != nil {
+		return nil, fmt.Errorf("failed to bind queue %s: %w", resumeDeadLetterQueue, err)
 	}
 
-	// Bind queue to exchange
-	err = channel.QueueBind(
-		resumeQueue,      // queue name
-		resumeRoutingKey, // routing key
-		resumeExchange,   // exchange
-		false,            // no-wait
-		nil,              // arguments
-	)
-	if err != nil {
-		return nil, fmt.Errorf("failed to bind queue: %w", err)
+	// Declare queues and bind each routing key to its queue
+	bindings := []struct{ queue, routingKey string }{
+		{resumeQueue, resumeRoutingKey},
+		{resumeQueue, config.NormalPriorityRoutingKey},
+		{config.HighPriorityQueue, config.HighPriorityRoutingKey},
+	}
+	args := config.queueArguments()
+	for _, queue := range []string{resumeQueue, config.HighPriorityQueue} {
+		_, err = channel.QueueDeclare(
+			queue, // name
+			true,  // durable
+			false, // delete when unused
+			false, // exclusive
+			false, // no-wait
+			args,  // arguments
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to declare queue %s: %w", queue, err)
+		}
+	}
+	for _, binding := range bindings {
+		err = channel.QueueBind(
+			binding.queue,      // queue name
+			binding.routingKey, // routing key
+			resumeExchange,     // exchange
+			false,              // no-wait
+			nil,                // arguments
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to bind queue %s to %s: %w", binding.queue, binding.routingKey, err)
+		}
 	}
 
 	return &rabbitMQPublisher{
 		channel: channel,
+		config:  config,
 		logger:  logger,
 	}, nil
 }
@@ -92,16 +243,18 @@ func (p *rabbitMQPublisher) PublishResumeGenerationJob(ctx context.Context, job
 		return fmt.Errorf("failed to marshal job: %w", err)
 	}
 
+	routingKey := p.config.routingKey(job.Priority)
 	err = p.channel.PublishWithContext(
 		ctx,
-		resumeExchange,   // exchange
-		resumeRoutingKey, // routing key
-		false,            // mandatory
-		false,            // immediate
+		resumeExchange, // exchange
+		routingKey,     // routing key
+		false,          // mandatory
+		false,          // immediate
 		amqp.Publishing{
 			ContentType:  "application/json",
 			Body:         body,
 			DeliveryMode: amqp.Persistent,
+			Priority:     p.config.messagePriority(job.Priority),
 		},
 	)
 
@@ -116,6 +269,7 @@ func (p *rabbitMQPublisher) PublishResumeGenerationJob(ctx context.Context, job
 	p.logger.Info("resume generation job published",
 		slog.String("jobId", job.JobID),
 		slog.String("userId", job.UserID),
+		slog.String("routingKey", routingKey),
 	)
 
 	return nil
@@ -0,0 +1,84 @@
+package resumes
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"woragis-jobs-service/pkg/metrics"
+)
+
+// JobErrorCodeProcessingTimeout is the error code set on jobs failed by the reaper.
+const JobErrorCodeProcessingTimeout = "PROCESSING_TIMEOUT"
+
+const processingTimeoutMessage = "Resume generation did not finish in time; the worker may have stopped. Please retry."
+
+// ProcessingJobReaper fails resume generation jobs that have been processing for
+// longer than timeout, so a job whose worker died does not stay processing forever.
+// The age of a job is measured from its last update, which is when it entered processing.
+// The failure is written with one conditional update, so several replicas can run a
+// reaper at once without double-counting. A worker that finishes after the timeout can
+// still complete the job.
+type ProcessingJobReaper struct {
+	repo    Repository
+	timeout time.Duration
+	logger  *slog.Logger
+	now     func() time.Time
+}
+
+// NewProcessingJobReaper constructs a processing timeout reaper.
+func NewProcessingJobReaper(repo Repository, timeout time.Duration, logger *slog.Logger) *ProcessingJobReaper {
+	return &ProcessingJobReaper{
+		repo:    repo,
+		timeout: timeout,
+		logger:  logger,
+		now:     time.Now,
+	}
+}
+
+// Run reaps immediately and then every interval until ctx is cancelled.
+func (r *ProcessingJobReaper) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := r.Reap(ctx); err != nil && r.logger != nil {
+			r.logger.Error("resume job reaper failed", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Reap fails all jobs processing for longer than the timeout and returns how many it failed.
+func (r *ProcessingJobReaper) Reap(ctx context.Context) (int, error) {
+	cutoff := r.now().UTC().Add(-r.timeout)
+
+	jobs, err := r.repo.FailStaleProcessingJobs(ctx, cutoff, processingTimeoutMessage, JobErrorCodeProcessingTimeout)
+	if err != nil {
+		return 0, err
+	}
+	if len(jobs) == 0 {
+		return 0, nil
+	}
+
+	metrics.RecordResumeJobsReaped(len(jobs))
+	now := r.now().UTC()
+	for i := range jobs {
+		jobs[i].recordFinished(now)
+	}
+	if r.logger != nil {
+		for _, job := range jobs {
+			r.logger.Warn("resume generation job timed out in processing",
+				"job_id", job.ID.String(),
+				"user_id", job.UserID.String(),
+				"timeout", r.timeout)
+		}
+	}
+
+	return len(jobs), nil
+}
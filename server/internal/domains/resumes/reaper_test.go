@@ -0,0 +1,56 @@
+package resumes
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeReaperRepository struct {
+	Repository
+	updatedBefore time.Time
+	errorMessage  string
+	errorCode     string
+	jobs          []ResumeGenerationJob
+	err           error
+}
+
+func (f *fakeReaperRepository) FailStaleProcessingJobs(ctx context.Context, updatedBefore time.Time, errorMessage, errorCode string) ([]ResumeGenerationJob, error) {
+	f.updatedBefore = updatedBefore
+	f.errorMessage = errorMessage
+	f.errorCode = errorCode
+	return f.jobs, f.err
+}
+
+func TestProcessingJobReaper_Reap(t *testing.T) {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	repo := &fakeReaperRepository{
+		jobs: []ResumeGenerationJob{
+			{ID: uuid.New(), UserID: uuid.New(), Status: ResumeJobStatusFailed},
+			{ID: uuid.New(), UserID: uuid.New(), Status: ResumeJobStatusFailed},
+		},
+	}
+	reaper := NewProcessingJobReaper(repo, 30*time.Minute, nil)
+	reaper.now = func() time.Time { return now }
+
+	reaped, err := reaper.Reap(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, reaped)
+	assert.Equal(t, now.Add(-30*time.Minute), repo.updatedBefore)
+	assert.Equal(t, JobErrorCodeProcessingTimeout, repo.errorCode)
+	assert.NotEmpty(t, repo.errorMessage)
+}
+
+func TestProcessingJobReaper_ReapError(t *testing.T) {
+	repo := &fakeReaperRepository{err: errors.New("db down")}
+	reaper := NewProcessingJobReaper(repo, time.Hour, nil)
+
+	reaped, err := reaper.Reap(context.Background())
+	assert.EqualError(t, err, "db down")
+	assert.Zero(t, reaped)
+}
@@ -3,9 +3,11 @@ package resumes
 import (
 	"context"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // ResumeMetrics holds calculated metrics for a resume.
@@ -21,20 +23,54 @@ type ResumeMetrics struct {
 type Repository interface {
 	CreateResume(ctx context.Context, resume *Resume) error
 	UpdateResume(ctx context.Context, resume *Resume) error
-	DeleteResume(ctx context.Context, resumeID uuid.UUID, userID uuid.UUID) error
+	CreateResumeSharingFile(ctx context.Context, resume *Resume, sourceID uuid.UUID) error
+	DeleteResume(ctx context.Context, resumeID uuid.UUID, userID uuid.UUID) (string, error)
 	GetResume(ctx context.Context, resumeID uuid.UUID, userID uuid.UUID) (*Resume, error)
 	ListResumes(ctx context.Context, userID uuid.UUID) ([]Resume, error)
 	ListResumesByTags(ctx context.Context, userID uuid.UUID, tags []string) ([]Resume, error)
+	ListResumesWithFilters(ctx context.Context, filters ResumeFilters) ([]Resume, error)
 	GetMainResume(ctx context.Context, userID uuid.UUID) (*Resume, error)
 	GetFeaturedResume(ctx context.Context, userID uuid.UUID) (*Resume, error)
+	FindResumeByChecksum(ctx context.Context, userID uuid.UUID, checksum string) (*Resume, error)
 	UnmarkAllAsMain(ctx context.Context, userID uuid.UUID) error
+	AddTagToResumes(ctx context.Context, userID uuid.UUID, resumeIDs []uuid.UUID, tag string) (*BulkResumeResult, error)
+	RemoveTagFromResumes(ctx context.Context, userID uuid.UUID, resumeIDs []uuid.UUID, tag string) (*BulkResumeResult, error)
+	SetResumesFeatured(ctx context.Context, userID uuid.UUID, resumeIDs []uuid.UUID, featured bool) (*BulkResumeResult, error)
 	CalculateResumeMetrics(ctx context.Context, resumeID uuid.UUID) (*ResumeMetrics, error)
 	UpdateResumeMetrics(ctx context.Context, resumeID uuid.UUID, metrics *ResumeMetrics) error
 	// Resume generation job operations
 	CreateResumeGenerationJob(ctx context.Context, job *ResumeGenerationJob) error
 	GetResumeGenerationJob(ctx context.Context, jobID uuid.UUID) (*ResumeGenerationJob, error)
 	UpdateResumeGenerationJob(ctx context.Context, job *ResumeGenerationJob) error
-	ListUserResumeGenerationJobs(ctx context.Context, userID uuid.UUID) ([]ResumeGenerationJob, error)
+	ResetFailedResumeGenerationJob(ctx context.Context, jobID uuid.UUID, userID uuid.UUID) (bool, error)
+	FailStaleProcessingJobs(ctx context.Context, updatedBefore time.Time, errorMessage, errorCode string) ([]ResumeGenerationJob, error)
+	ListUserResumeGenerationJobs(ctx context.Context, filters GenerationJobFilters) ([]ResumeGenerationJob, error)
+}
+
+// BulkResumeResult reports the outcome of a bulk resume operation.
+type BulkResumeResult struct {
+	Requested  int         `json:"requested"`
+	Updated    int         `json:"updated"`
+	Unchanged  int         `json:"unchanged"`
+	Skipped    []uuid.UUID `json:"skipped"`              // Missing or owned by another user
+	AtTagLimit []uuid.UUID `json:"atTagLimit,omitempty"` // Already carry the maximum number of tags
+}
+
+// ResumeFilters represents filtering and paging options for listing resumes.
+type ResumeFilters struct {
+	UserID uuid.UUID
+	Tags   []string // Resume must carry every tag
+	Limit  int
+	Offset int
+}
+
+// GenerationJobFilters represents filtering options for listing resume generation jobs.
+type GenerationJobFilters struct {
+	UserID       uuid.UUID
+	Status       *ResumeJobStatus
+	CreatedAfter *time.Time
+	Limit        int
+	Offset       int
 }
 
 // gormRepository implements Repository using GORM.
@@ -57,21 +93,68 @@ func (r *gormRepository) UpdateResume(ctx context.Context, resume *Resume) error
 	return r.db.WithContext(ctx).Save(resume).Error
 }
 
-// DeleteResume deletes a resume.
-func (r *gormRepository) DeleteResume(ctx context.Context, resumeID uuid.UUID, userID uuid.UUID) error {
-	result := r.db.WithContext(ctx).
-		Where("id = ? AND user_id = ?", resumeID, userID).
-		Delete(&Resume{})
-	
-	if result.Error != nil {
-		return result.Error
-	}
-	
-	if result.RowsAffected == 0 {
-		return NewDomainError(ErrCodeNotFound, ErrResumeNotFound)
+// CreateResumeSharingFile creates a resume that reuses the stored file of sourceID.
+// The source row is locked while the new record is inserted so a concurrent delete
+// can't remove the file in between; if the source is already gone it returns
+// ErrResumeNotFound and the caller should store the file itself.
+func (r *gormRepository) CreateResumeSharingFile(ctx context.Context, resume *Resume, sourceID uuid.UUID) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var source Resume
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ? AND user_id = ? AND file_path = ?", sourceID, resume.UserID, resume.FilePath).
+			First(&source).Error
+		if err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return NewDomainError(ErrCodeNotFound, ErrResumeNotFound)
+			}
+			return err
+		}
+
+		return tx.Create(resume).Error
+	})
+}
+
+// DeleteResume deletes a resume. It returns the resume's file path when no other
+// resume of the user still references it, or "" while the file is shared.
+// Storage keys are per user, so only the user's own resumes can share a file.
+func (r *gormRepository) DeleteResume(ctx context.Context, resumeID uuid.UUID, userID uuid.UUID) (string, error) {
+	var releasedPath string
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var resume Resume
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ? AND user_id = ?", resumeID, userID).
+			First(&resume).Error
+		if err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return NewDomainError(ErrCodeNotFound, ErrResumeNotFound)
+			}
+			return err
+		}
+
+		// Lock every record sharing the file; CreateResumeSharingFile locks one of
+		// them too, so the count below can't race with a new deduplicated upload
+		var sharing []Resume
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("user_id = ? AND file_path = ?", userID, resume.FilePath).
+			Find(&sharing).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Delete(&resume).Error; err != nil {
+			return err
+		}
+
+		if len(sharing) <= 1 {
+			releasedPath = resume.FilePath
+		}
+		return nil
+	})
+	if err != nil {
+		return "", err
 	}
-	
-	return nil
+
+	return releasedPath, nil
 }
 
 // GetResume retrieves a resume by ID.
@@ -102,6 +185,32 @@ func (r *gormRepository) ListResumes(ctx context.Context, userID uuid.UUID) ([]R
 	return resumes, err
 }
 
+// ListResumesWithFilters lists one page of a user's resumes, optionally filtered by tags.
+func (r *gormRepository) ListResumesWithFilters(ctx context.Context, filters ResumeFilters) ([]Resume, error) {
+	var resumes []Resume
+	query := r.db.WithContext(ctx).
+		Where("user_id = ?", filters.UserID)
+
+	for _, tag := range filters.Tags {
+		normalizedTag := strings.ToLower(strings.TrimSpace(tag))
+		if normalizedTag != "" {
+			query = query.Where("tags @> ?", `["`+normalizedTag+`"]`)
+		}
+	}
+	if filters.Limit > 0 {
+		query = query.Limit(filters.Limit)
+	}
+	if filters.Offset > 0 {
+		query = query.Offset(filters.Offset)
+	}
+
+	err := query.
+		Order("is_main DESC, is_featured DESC, created_at DESC").
+		Find(&resumes).Error
+
+	return resumes, err
+}
+
 // ListResumesByTags lists resumes filtered by tags (resume must have at least one matching tag).
 func (r *gormRepository) ListResumesByTags(ctx context.Context, userID uuid.UUID, tags []string) ([]Resume, error) {
 	if len(tags) == 0 {
@@ -162,6 +271,24 @@ func (r *gormRepository) GetFeaturedResume(ctx context.Context, userID uuid.UUID
 	return &resume, nil
 }
 
+// FindResumeByChecksum retrieves the oldest resume of a user whose file has the given checksum.
+func (r *gormRepository) FindResumeByChecksum(ctx context.Context, userID uuid.UUID, checksum string) (*Resume, error) {
+	var resume Resume
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND checksum = ?", userID, checksum).
+		Order("created_at ASC").
+		First(&resume).Error
+	
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, NewDomainError(ErrCodeNotFound, ErrResumeNotFound)
+		}
+		return nil, err
+	}
+	
+	return &resume, nil
+}
+
 // UnmarkAllAsMain unmarks all resumes as main for a user.
 func (r *gormRepository) UnmarkAllAsMain(ctx context.Context, userID uuid.UUID) error {
 	return r.db.WithContext(ctx).
@@ -250,6 +377,9 @@ func (r *gormRepository) GetResumeGenerationJob(ctx context.Context, jobID uuid.
 	var job ResumeGenerationJob
 	err := r.db.WithContext(ctx).Where("id = ?", jobID).First(&job).Error
 	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, NewDomainError(ErrCodeNotFound, ErrGenerationJobNotFound)
+		}
 		return nil, err
 	}
 	return &job, nil
@@ -260,14 +390,152 @@ func (r *gormRepository) UpdateResumeGenerationJob(ctx context.Context, job *Res
 	return r.db.WithContext(ctx).Save(job).Error
 }
 
-// ListUserResumeGenerationJobs lists all resume generation jobs for a user, ordered by newest first
-func (r *gormRepository) ListUserResumeGenerationJobs(ctx context.Context, userID uuid.UUID) ([]ResumeGenerationJob, error) {
+// ResetFailedResumeGenerationJob moves a failed job back to pending in a single
+// conditional update. It reports false when the job was not in the failed state,
+// so only one of several concurrent retries wins.
+func (r *gormRepository) ResetFailedResumeGenerationJob(ctx context.Context, jobID uuid.UUID, userID uuid.UUID) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&ResumeGenerationJob{}).
+		Where("id = ? AND user_id = ? AND status = ?", jobID, userID, ResumeJobStatusFailed).
+		Updates(map[string]interface{}{
+			"status":        ResumeJobStatusPending,
+			"error_message": "",
+			"error_code":    "",
+			"resume_id":     nil,
+			"updated_at":    time.Now().UTC(),
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// FailStaleProcessingJobs marks every job still processing and not updated since
+// updatedBefore as failed in a single conditional update, returning the jobs it changed.
+// A job the worker completes concurrently no longer matches, so it is never overwritten.
+func (r *gormRepository) FailStaleProcessingJobs(ctx context.Context, updatedBefore time.Time, errorMessage, errorCode string) ([]ResumeGenerationJob, error) {
 	var jobs []ResumeGenerationJob
 	err := r.db.WithContext(ctx).
-		Where("user_id = ?", userID).
-		Order("created_at DESC").
-		Find(&jobs).Error
+		Model(&jobs).
+		Clauses(clause.Returning{}).
+		Where("status = ? AND updated_at < ?", ResumeJobStatusProcessing, updatedBefore).
+		Updates(map[string]interface{}{
+			"status":        ResumeJobStatusFailed,
+			"error_message": errorMessage,
+			"error_code":    errorCode,
+			"updated_at":    time.Now().UTC(),
+		}).Error
+	if err != nil {
+		return nil, err
+	}
+	return jobs, nil
+}
+
+// ListUserResumeGenerationJobs lists a user's resume generation jobs, ordered by newest first
+func (r *gormRepository) ListUserResumeGenerationJobs(ctx context.Context, filters GenerationJobFilters) ([]ResumeGenerationJob, error) {
+	var jobs []ResumeGenerationJob
+	query := r.db.WithContext(ctx).
+		Where("user_id = ?", filters.UserID)
+
+	if filters.Status != nil {
+		query = query.Where("status = ?", *filters.Status)
+	}
+	if filters.CreatedAfter != nil {
+		query = query.Where("created_at > ?", *filters.CreatedAfter)
+	}
+
+	if filters.Limit > 0 {
+		query = query.Limit(filters.Limit)
+	}
+	if filters.Offset > 0 {
+		query = query.Offset(filters.Offset)
+	}
+
+	err := query.Order("created_at DESC").Find(&jobs).Error
 	return jobs, err
 }
 
+func (r *gormRepository) AddTagToResumes(ctx context.Context, userID uuid.UUID, resumeIDs []uuid.UUID, tag string) (*BulkResumeResult, error) {
+	return r.bulkUpdateResumes(ctx, userID, resumeIDs, "tags", func(resume *Resume, result *BulkResumeResult) bool {
+		if resume.AddTag(tag) {
+			return true
+		}
+		if !resume.HasTagCapacity() && !containsTag(resume.Tags, tag) {
+			result.AtTagLimit = append(result.AtTagLimit, resume.ID)
+		}
+		return false
+	})
+}
+
+func (r *gormRepository) RemoveTagFromResumes(ctx context.Context, userID uuid.UUID, resumeIDs []uuid.UUID, tag string) (*BulkResumeResult, error) {
+	return r.bulkUpdateResumes(ctx, userID, resumeIDs, "tags", func(resume *Resume, _ *BulkResumeResult) bool {
+		return resume.RemoveTag(tag)
+	})
+}
+
+// SetResumesFeatured sets or clears the featured flag. Featured is not exclusive, so
+// any number of resumes may share it; is_main is never touched, which keeps the
+// single-main invariant that MarkAsMain maintains.
+func (r *gormRepository) SetResumesFeatured(ctx context.Context, userID uuid.UUID, resumeIDs []uuid.UUID, featured bool) (*BulkResumeResult, error) {
+	return r.bulkUpdateResumes(ctx, userID, resumeIDs, "is_featured", func(resume *Resume, _ *BulkResumeResult) bool {
+		if resume.IsFeatured == featured {
+			return false
+		}
+		if featured {
+			resume.MarkAsFeatured()
+		} else {
+			resume.UnmarkAsFeatured()
+		}
+		return true
+	})
+}
+
+// bulkUpdateResumes applies mutate to every resume owned by userID in a single
+// transaction and writes back column for the ones it changed. IDs that do not exist
+// or belong to another user are reported as skipped.
+func (r *gormRepository) bulkUpdateResumes(ctx context.Context, userID uuid.UUID, resumeIDs []uuid.UUID, column string, mutate func(*Resume, *BulkResumeResult) bool) (*BulkResumeResult, error) {
+	result := &BulkResumeResult{
+		Requested: len(resumeIDs),
+		Skipped:   []uuid.UUID{},
+	}
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var resumes []Resume
+		if err := tx.Where("id IN ? AND user_id = ?", resumeIDs, userID).Find(&resumes).Error; err != nil {
+			return err
+		}
+
+		found := make(map[uuid.UUID]struct{}, len(resumes))
+		for i := range resumes {
+			resume := &resumes[i]
+			found[resume.ID] = struct{}{}
+			if !mutate(resume, result) {
+				result.Unchanged++
+				continue
+			}
+			values := map[string]interface{}{"updated_at": resume.UpdatedAt}
+			switch column {
+			case "tags":
+				values["tags"] = resume.Tags
+			case "is_featured":
+				values["is_featured"] = resume.IsFeatured
+			}
+			if err := tx.Model(resume).Updates(values).Error; err != nil {
+				return err
+			}
+			result.Updated++
+		}
 
+		for _, id := range resumeIDs {
+			if _, ok := found[id]; !ok {
+				result.Skipped = append(result.Skipped, id)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
@@ -6,6 +6,8 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+
+	"woragis-jobs-service/pkg/metrics"
 )
 
 // ResumeJobStatus represents the status of a resume generation job
@@ -19,12 +21,26 @@ const (
 	ResumeJobStatusCancelled  ResumeJobStatus = "cancelled"
 )
 
+// ResumeJobPriority selects the queue lane a generation job is published to
+type ResumeJobPriority string
+
+const (
+	ResumeJobPriorityHigh   ResumeJobPriority = "high"
+	ResumeJobPriorityNormal ResumeJobPriority = "normal"
+)
+
+// IsValid reports whether the priority is supported; blank means unspecified
+func (p ResumeJobPriority) IsValid() bool {
+	return p == "" || p == ResumeJobPriorityHigh || p == ResumeJobPriorityNormal
+}
+
 // ResumeGenerationJob tracks a resume generation request
 type ResumeGenerationJob struct {
 	ID             uuid.UUID       `gorm:"column:id;type:uuid;primaryKey" json:"id"`
 	UserID         uuid.UUID       `gorm:"column:user_id;type:uuid;index;not null" json:"userId"`
 	JobDescription string          `gorm:"column:job_description;type:text;not null" json:"jobDescription"`
 	Status         ResumeJobStatus `gorm:"column:status;type:varchar(50);default:'pending'" json:"status"`
+	Priority       ResumeJobPriority `gorm:"column:priority;type:varchar(20)" json:"priority,omitempty"` // Kept on retry
 	Metadata       JSONMetadata    `gorm:"column:metadata;type:jsonb;default:'{}'" json:"metadata"`
 	ErrorMessage   string          `gorm:"column:error_message;type:text" json:"errorMessage,omitempty"`
 	ErrorCode      string          `gorm:"column:error_code;type:varchar(50)" json:"errorCode,omitempty"`
@@ -109,3 +125,18 @@ func (j *ResumeGenerationJob) MarkCancelled() {
 	j.Status = ResumeJobStatusCancelled
 	j.UpdatedAt = time.Now().UTC()
 }
+
+// ResetForRetry puts a failed job back into the pending state so it can be republished
+func (j *ResumeGenerationJob) ResetForRetry() {
+	j.Status = ResumeJobStatusPending
+	j.ErrorMessage = ""
+	j.ErrorCode = ""
+	j.ResumeID = nil
+	j.UpdatedAt = time.Now().UTC()
+}
+
+// recordFinished reports a job that just reached a terminal status to the lifecycle
+// metrics, timing it from creation so retries count towards the total
+func (j *ResumeGenerationJob) recordFinished(now time.Time) {
+	metrics.RecordResumeJobFinished(string(j.Status), j.ErrorCode, now.Sub(j.CreatedAt).Seconds())
+}
@@ -0,0 +1,160 @@
+package resumes
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"woragis-jobs-service/internal/database"
+)
+
+// retryingRepository retries calls to the wrapped repository after transient connection
+// errors, so a brief Postgres failover does not fail the request. Services are unaware of it.
+type retryingRepository struct {
+	next   Repository
+	policy database.RetryPolicy
+}
+
+// NewRetryingRepository wraps repo so every call is retried according to policy.
+func NewRetryingRepository(repo Repository, policy database.RetryPolicy) Repository {
+	return &retryingRepository{next: repo, policy: policy}
+}
+
+func (r *retryingRepository) CreateResume(ctx context.Context, resume *Resume) error {
+	return r.policy.Do(ctx, "CreateResume", func() error {
+		return r.next.CreateResume(ctx, resume)
+	})
+}
+
+func (r *retryingRepository) UpdateResume(ctx context.Context, resume *Resume) error {
+	return r.policy.Do(ctx, "UpdateResume", func() error {
+		return r.next.UpdateResume(ctx, resume)
+	})
+}
+
+func (r *retryingRepository) CreateResumeSharingFile(ctx context.Context, resume *Resume, sourceID uuid.UUID) error {
+	return r.policy.Do(ctx, "CreateResumeSharingFile", func() error {
+		return r.next.CreateResumeSharingFile(ctx, resume, sourceID)
+	})
+}
+
+func (r *retryingRepository) DeleteResume(ctx context.Context, resumeID uuid.UUID, userID uuid.UUID) (string, error) {
+	return database.Retry(ctx, r.policy, "DeleteResume", func() (string, error) {
+		return r.next.DeleteResume(ctx, resumeID, userID)
+	})
+}
+
+func (r *retryingRepository) GetResume(ctx context.Context, resumeID uuid.UUID, userID uuid.UUID) (*Resume, error) {
+	return database.Retry(ctx, r.policy, "GetResume", func() (*Resume, error) {
+		return r.next.GetResume(ctx, resumeID, userID)
+	})
+}
+
+func (r *retryingRepository) ListResumes(ctx context.Context, userID uuid.UUID) ([]Resume, error) {
+	return database.Retry(ctx, r.policy, "ListResumes", func() ([]Resume, error) {
+		return r.next.ListResumes(ctx, userID)
+	})
+}
+
+func (r *retryingRepository) ListResumesByTags(ctx context.Context, userID uuid.UUID, tags []string) ([]Resume, error) {
+	return database.Retry(ctx, r.policy, "ListResumesByTags", func() ([]Resume, error) {
+		return r.next.ListResumesByTags(ctx, userID, tags)
+	})
+}
+
+func (r *retryingRepository) ListResumesWithFilters(ctx context.Context, filters ResumeFilters) ([]Resume, error) {
+	return database.Retry(ctx, r.policy, "ListResumesWithFilters", func() ([]Resume, error) {
+		return r.next.ListResumesWithFilters(ctx, filters)
+	})
+}
+
+func (r *retryingRepository) GetMainResume(ctx context.Context, userID uuid.UUID) (*Resume, error) {
+	return database.Retry(ctx, r.policy, "GetMainResume", func() (*Resume, error) {
+		return r.next.GetMainResume(ctx, userID)
+	})
+}
+
+func (r *retryingRepository) GetFeaturedResume(ctx context.Context, userID uuid.UUID) (*Resume, error) {
+	return database.Retry(ctx, r.policy, "GetFeaturedResume", func() (*Resume, error) {
+		return r.next.GetFeaturedResume(ctx, userID)
+	})
+}
+
+func (r *retryingRepository) FindResumeByChecksum(ctx context.Context, userID uuid.UUID, checksum string) (*Resume, error) {
+	return database.Retry(ctx, r.policy, "FindResumeByChecksum", func() (*Resume, error) {
+		return r.next.FindResumeByChecksum(ctx, userID, checksum)
+	})
+}
+
+func (r *retryingRepository) UnmarkAllAsMain(ctx context.Context, userID uuid.UUID) error {
+	return r.policy.Do(ctx, "UnmarkAllAsMain", func() error {
+		return r.next.UnmarkAllAsMain(ctx, userID)
+	})
+}
+
+func (r *retryingRepository) AddTagToResumes(ctx context.Context, userID uuid.UUID, resumeIDs []uuid.UUID, tag string) (*BulkResumeResult, error) {
+	return database.Retry(ctx, r.policy, "AddTagToResumes", func() (*BulkResumeResult, error) {
+		return r.next.AddTagToResumes(ctx, userID, resumeIDs, tag)
+	})
+}
+
+func (r *retryingRepository) RemoveTagFromResumes(ctx context.Context, userID uuid.UUID, resumeIDs []uuid.UUID, tag string) (*BulkResumeResult, error) {
+	return database.Retry(ctx, r.policy, "RemoveTagFromResumes", func() (*BulkResumeResult, error) {
+		return r.next.RemoveTagFromResumes(ctx, userID, resumeIDs, tag)
+	})
+}
+
+func (r *retryingRepository) SetResumesFeatured(ctx context.Context, userID uuid.UUID, resumeIDs []uuid.UUID, featured bool) (*BulkResumeResult, error) {
+	return database.Retry(ctx, r.policy, "SetResumesFeatured", func() (*BulkResumeResult, error) {
+		return r.next.SetResumesFeatured(ctx, userID, resumeIDs, featured)
+	})
+}
+
+func (r *retryingRepository) CalculateResumeMetrics(ctx context.Context, resumeID uuid.UUID) (*ResumeMetrics, error) {
+	return database.Retry(ctx, r.policy, "CalculateResumeMetrics", func() (*ResumeMetrics, error) {
+		return r.next.CalculateResumeMetrics(ctx, resumeID)
+	})
+}
+
+func (r *retryingRepository) UpdateResumeMetrics(ctx context.Context, resumeID uuid.UUID, metrics *ResumeMetrics) error {
+	return r.policy.Do(ctx, "UpdateResumeMetrics", func() error {
+		return r.next.UpdateResumeMetrics(ctx, resumeID, metrics)
+	})
+}
+
+func (r *retryingRepository) CreateResumeGenerationJob(ctx context.Context, job *ResumeGenerationJob) error {
+	return r.policy.Do(ctx, "CreateResumeGenerationJob", func() error {
+		return r.next.CreateResumeGenerationJob(ctx, job)
+	})
+}
+
+func (r *retryingRepository) GetResumeGenerationJob(ctx context.Context, jobID uuid.UUID) (*ResumeGenerationJob, error) {
+	return database.Retry(ctx, r.policy, "GetResumeGenerationJob", func() (*ResumeGenerationJob, error) {
+		return r.next.GetResumeGenerationJob(ctx, jobID)
+	})
+}
+
+func (r *retryingRepository) UpdateResumeGenerationJob(ctx context.Context, job *ResumeGenerationJob) error {
+	return r.policy.Do(ctx, "UpdateResumeGenerationJob", func() error {
+		return r.next.UpdateResumeGenerationJob(ctx, job)
+	})
+}
+
+func (r *retryingRepository) ResetFailedResumeGenerationJob(ctx context.Context, jobID uuid.UUID, userID uuid.UUID) (bool, error) {
+	return database.Retry(ctx, r.policy, "ResetFailedResumeGenerationJob", func() (bool, error) {
+		return r.next.ResetFailedResumeGenerationJob(ctx, jobID, userID)
+	})
+}
+
+func (r *retryingRepository) FailStaleProcessingJobs(ctx context.Context, updatedBefore time.Time, errorMessage, errorCode string) ([]ResumeGenerationJob, error) {
+	return database.Retry(ctx, r.policy, "FailStaleProcessingJobs", func() ([]ResumeGenerationJob, error) {
+		return r.next.FailStaleProcessingJobs(ctx, updatedBefore, errorMessage, errorCode)
+	})
+}
+
+func (r *retryingRepository) ListUserResumeGenerationJobs(ctx context.Context, filters GenerationJobFilters) ([]ResumeGenerationJob, error) {
+	return database.Retry(ctx, r.policy, "ListUserResumeGenerationJobs", func() ([]ResumeGenerationJob, error) {
+		return r.next.ListUserResumeGenerationJobs(ctx, filters)
+	})
+}
@@ -1,26 +1,42 @@
 package resumes
 
-import "github.com/gofiber/fiber/v2"
+import (
+	"github.com/gofiber/fiber/v2"
+
+	"woragis-jobs-service/pkg/middleware"
+	"woragis-jobs-service/pkg/security"
+)
 
 // SetupRoutes registers resume endpoints.
 func SetupRoutes(api fiber.Router, handler Handler) {
-	api.Post("/upload", handler.UploadResume) // File upload endpoint (must be before /:id routes)
+	id := middleware.ValidateUUIDParam("id") // Not applied to /jobs/:id: queue job IDs are not UUIDs
+	api.Post("/upload", security.RequestSizeLimitFor(maxUploadRequestSize), handler.UploadResume) // File upload endpoint (must be before /:id routes)
+	// Every route registered below is JSON-only; the upload route above ends its chain before this runs
+	api.Use(security.RequestSizeLimitFor(security.DefaultJSONBodyLimit))
 	api.Post("/generate", handler.GenerateResume) // Generate resume endpoint (must be before /:id routes)
 	api.Post("/", handler.CreateResume)
 	api.Get("/tags", handler.ListResumeTags) // Get all tags for autocomplete (must be before /:id routes)
+	api.Post("/tags/add", handler.BulkAddTag)
+	api.Post("/tags/remove", handler.BulkRemoveTag)
+	api.Post("/featured", handler.BulkSetFeatured) // {"resumeIds": [...], "featured": true}; never changes the main resume
+	api.Get("/checksum/:checksum", handler.LookupResumeByChecksum) // Check for an existing upload with the same SHA-256 (must be before /:id routes)
+	api.Get("/generation-jobs", handler.ListGenerationJobs) // Supports ?status=, ?createdAfter=, ?limit=, ?offset= (must be before /:id routes)
 	api.Get("/", handler.ListResumes) // Supports ?tags=tag1,tag2 query parameter
-	api.Get("/:id/download", handler.DownloadResumeByID) // Download resume by ID (must be before /:id)
-	api.Get("/:id", handler.GetResume)
-	api.Patch("/:id", handler.UpdateResume)
-	api.Delete("/:id", handler.DeleteResume)
-	api.Patch("/:id/main", handler.MarkAsMain)
-	api.Patch("/:id/featured", handler.MarkAsFeatured)
-	api.Delete("/:id/main", handler.UnmarkAsMain)
-	api.Delete("/:id/featured", handler.UnmarkAsFeatured)
-	api.Post("/:id/recalculate-metrics", handler.RecalculateMetrics) // Manually recalculate metrics
+	api.Get("/:id/download", id, handler.DownloadResumeByID) // Download resume by ID (must be before /:id)
+	api.Get("/:id", id, handler.GetResume)
+	api.Patch("/:id", id, handler.UpdateResume)
+	api.Delete("/:id", id, handler.DeleteResume)
+	api.Patch("/:id/main", id, handler.MarkAsMain)
+	api.Patch("/:id/featured", id, handler.MarkAsFeatured)
+	api.Delete("/:id/main", id, handler.UnmarkAsMain)
+	api.Delete("/:id/featured", id, handler.UnmarkAsFeatured)
+	api.Post("/:id/recalculate-metrics", id, handler.RecalculateMetrics) // Manually recalculate metrics
 	api.Get("/jobs/:id", handler.GetJobStatus) // Get job status
 	api.Post("/jobs/:id/retry", handler.RetryJob) // Retry failed job
 	api.Post("/jobs/:id/cancel", handler.CancelJob) // Cancel pending/processing job
+	api.Post("/generation-jobs/:id/retry", id, handler.RetryGenerationJob) // Requeue a failed generation job
+	api.Get("/generation-jobs/:id/events", id, handler.StreamGenerationJobStatus) // Server-Sent Events stream of job status changes
+	api.Get("/generation-jobs/:id/resume", id, handler.GetGeneratedResume) // The generated resume once the job completed; 409 with the status otherwise
 }
 
 // SetupPublicRoutes registers public resume endpoints.
@@ -31,6 +47,7 @@ func SetupPublicRoutes(api fiber.Router, handler Handler) {
 
 // SetupInternalRoutes registers internal resume endpoints (no auth middleware).
 func SetupInternalRoutes(api fiber.Router, handler Handler) {
-	api.Post("/resumes/complete", handler.CompleteResumeGeneration) // Internal callback for resume worker
+	api.Post("/resumes/complete", security.RequestSizeLimitFor(maxUploadRequestSize), handler.CompleteResumeGeneration) // Internal callback for resume worker
+	api.Post("/resumes/status", security.RequestSizeLimitFor(security.DefaultJSONBodyLimit), handler.UpdateGenerationStatus) // Internal callback for processing/failed transitions
 }
 
@@ -1,10 +1,21 @@
 package resumes
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
 	"log/slog"
+	"path/filepath"
+	"strings"
 
 	"github.com/google/uuid"
+
+	applogger "woragis-jobs-service/pkg/logger"
+	"woragis-jobs-service/pkg/storage"
 )
 
 // Service orchestrates resume workflows.
@@ -13,28 +24,41 @@ type Service interface {
 	UpdateResume(ctx context.Context, userID uuid.UUID, resumeID uuid.UUID, title string, tags JSONArray) (*Resume, error)
 	DeleteResume(ctx context.Context, userID uuid.UUID, resumeID uuid.UUID) error
 	GetResume(ctx context.Context, userID uuid.UUID, resumeID uuid.UUID) (*Resume, error)
+	UploadResume(ctx context.Context, userID uuid.UUID, title, fileName, contentType string, fileSize int64, content io.Reader, tags JSONArray) (*Resume, error)
+	OpenResumeFile(ctx context.Context, resume *Resume) (io.ReadCloser, error)
+	FindResumeByChecksum(ctx context.Context, userID uuid.UUID, checksum string) (*Resume, error)
 	ListResumes(ctx context.Context, userID uuid.UUID) ([]Resume, error)
 	ListResumesByTags(ctx context.Context, userID uuid.UUID, tags []string) ([]Resume, error)
+	ListResumesWithFilters(ctx context.Context, filters ResumeFilters) ([]Resume, error)
 	MarkAsMain(ctx context.Context, userID uuid.UUID, resumeID uuid.UUID) (*Resume, error)
 	MarkAsFeatured(ctx context.Context, userID uuid.UUID, resumeID uuid.UUID) (*Resume, error)
 	UnmarkAsMain(ctx context.Context, userID uuid.UUID, resumeID uuid.UUID) (*Resume, error)
 	UnmarkAsFeatured(ctx context.Context, userID uuid.UUID, resumeID uuid.UUID) (*Resume, error)
+	AddTagToResumes(ctx context.Context, userID uuid.UUID, resumeIDs []uuid.UUID, tag string) (*BulkResumeResult, error)
+	RemoveTagFromResumes(ctx context.Context, userID uuid.UUID, resumeIDs []uuid.UUID, tag string) (*BulkResumeResult, error)
+	SetResumesFeatured(ctx context.Context, userID uuid.UUID, resumeIDs []uuid.UUID, featured bool) (*BulkResumeResult, error)
 	GetMainResume(ctx context.Context, userID uuid.UUID) (*Resume, error)
 	GetFeaturedResume(ctx context.Context, userID uuid.UUID) (*Resume, error)
 	GetBestResume(ctx context.Context, userID uuid.UUID) (*Resume, error) // Returns main > featured > most recent
 	RecalculateResumeMetrics(ctx context.Context, resumeID uuid.UUID) error
 	// Resume generation operations
-	GenerateResume(ctx context.Context, userID uuid.UUID, jobDescription string, metadata map[string]interface{}) (jobID uuid.UUID, err error)
+	GenerateResume(ctx context.Context, userID uuid.UUID, jobDescription string, metadata map[string]interface{}, priority ResumeJobPriority) (jobID uuid.UUID, err error)
 	GetResumeGenerationJobStatus(ctx context.Context, jobID uuid.UUID) (*ResumeGenerationJob, error)
-	ListUserResumeGenerationJobs(ctx context.Context, userID uuid.UUID) ([]ResumeGenerationJob, error)
+	ListUserResumeGenerationJobs(ctx context.Context, filters GenerationJobFilters) ([]ResumeGenerationJob, error)
 	CompleteResumeGeneration(ctx context.Context, jobID uuid.UUID, resumeID uuid.UUID) error
-	FailResumeGeneration(ctx context.Context, jobID uuid.UUID, errorMessage string) error
+	StartResumeGeneration(ctx context.Context, jobID uuid.UUID) error
+	FailResumeGeneration(ctx context.Context, jobID uuid.UUID, errorMessage, errorCode string) error
+	RetryResumeGeneration(ctx context.Context, userID uuid.UUID, jobID uuid.UUID) (*ResumeGenerationJob, error)
+	WatchResumeGenerationJob(ctx context.Context, userID uuid.UUID, jobID uuid.UUID) (*ResumeGenerationJob, <-chan JobStatusUpdate, func(), error)
+	GetGeneratedResume(ctx context.Context, userID uuid.UUID, jobID uuid.UUID) (*ResumeGenerationJob, *Resume, error)
 }
 
 // service implements Service.
 type service struct {
 	repo                 Repository
 	rabbitMQPublisher    RabbitMQPublisher
+	statusHub            *JobStatusHub
+	fileStorage          storage.Backend
 	logger               *slog.Logger
 }
 
@@ -43,6 +67,35 @@ func NewService(repo Repository, publisher RabbitMQPublisher, logger *slog.Logge
 	return &service{
 		repo:                 repo,
 		rabbitMQPublisher:    publisher,
+		statusHub:            NewJobStatusHub(),
+		logger:               logger,
+	}
+}
+
+// NewServiceWithStatusHub creates a resume service that broadcasts generation job
+// status changes through the given hub.
+func NewServiceWithStatusHub(repo Repository, publisher RabbitMQPublisher, statusHub *JobStatusHub, logger *slog.Logger) Service {
+	if statusHub == nil {
+		statusHub = NewJobStatusHub()
+	}
+	return &service{
+		repo:                 repo,
+		rabbitMQPublisher:    publisher,
+		statusHub:            statusHub,
+		logger:               logger,
+	}
+}
+
+// NewServiceWithDependencies creates a resume service with a status hub and a file storage backend.
+func NewServiceWithDependencies(repo Repository, publisher RabbitMQPublisher, statusHub *JobStatusHub, fileStorage storage.Backend, logger *slog.Logger) Service {
+	if statusHub == nil {
+		statusHub = NewJobStatusHub()
+	}
+	return &service{
+		repo:                 repo,
+		rabbitMQPublisher:    publisher,
+		statusHub:            statusHub,
+		fileStorage:          fileStorage,
 		logger:               logger,
 	}
 }
@@ -63,6 +116,8 @@ func (s *service) CreateResume(ctx context.Context, userID uuid.UUID, title, fil
 
 // UpdateResume updates an existing resume.
 func (s *service) UpdateResume(ctx context.Context, userID uuid.UUID, resumeID uuid.UUID, title string, tags JSONArray) (*Resume, error) {
+	tagResumeLogs(ctx, resumeID)
+
 	resume, err := s.repo.GetResume(ctx, resumeID, userID)
 	if err != nil {
 		return nil, err
@@ -87,13 +142,139 @@ func (s *service) UpdateResume(ctx context.Context, userID uuid.UUID, resumeID u
 	return resume, nil
 }
 
-// DeleteResume deletes a resume.
+// UploadResume stores the file in the storage backend and creates the resume record.
+// If the user already has a file with identical contents, the new record references
+// the existing blob instead of storing a duplicate. A newly stored file is removed
+// again if the record cannot be created.
+func (s *service) UploadResume(ctx context.Context, userID uuid.UUID, title, fileName, contentType string, fileSize int64, content io.Reader, tags JSONArray) (*Resume, error) {
+	if s.fileStorage == nil {
+		return nil, NewDomainError(ErrCodeStorageFailure, ErrStorageUnavailable)
+	}
+
+	// Uploads are size-limited, so buffering lets us hash before deciding whether to store
+	data, err := io.ReadAll(content)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to read uploaded resume file", "error", err, "userId", userID)
+		return nil, NewDomainError(ErrCodeFileReadError, ErrFileReadError)
+	}
+	checksum := ChecksumOf(data)
+
+	existing, err := s.repo.FindResumeByChecksum(ctx, userID, checksum)
+	if err != nil {
+		if domainErr, ok := err.(*DomainError); !ok || domainErr.Code != ErrCodeNotFound {
+			return nil, err
+		}
+		existing = nil
+	}
+
+	if existing != nil {
+		resume, err := NewResume(userID, title, existing.FilePath, fileName, int64(len(data)), tags)
+		if err != nil {
+			return nil, err
+		}
+		resume.Checksum = checksum
+
+		err = s.repo.CreateResumeSharingFile(ctx, resume, existing.ID)
+		if err == nil {
+			s.logger.InfoContext(ctx, "reused existing resume file for duplicate upload", "userId", userID, "resumeId", resume.ID, "duplicateOf", existing.ID)
+			return resume, nil
+		}
+		// The original was deleted since we looked it up; store the file as a new blob
+		if domainErr, ok := err.(*DomainError); !ok || domainErr.Code != ErrCodeNotFound {
+			return nil, err
+		}
+	}
+
+	key := newStorageKey(userID, fileName)
+	if err := s.fileStorage.Put(ctx, key, bytes.NewReader(data), int64(len(data)), contentType); err != nil {
+		s.logger.ErrorContext(ctx, "failed to store resume file", "error", err, "userId", userID)
+		return nil, NewDomainError(ErrCodeStorageFailure, ErrFileWriteError)
+	}
+
+	resume, err := s.createResumeWithChecksum(ctx, userID, title, key, fileName, int64(len(data)), checksum, tags)
+	if err != nil {
+		if deleteErr := s.fileStorage.Delete(ctx, key); deleteErr != nil {
+			s.logger.WarnContext(ctx, "failed to remove orphaned resume file", "error", deleteErr, "key", key)
+		}
+		return nil, err
+	}
+
+	return resume, nil
+}
+
+func (s *service) createResumeWithChecksum(ctx context.Context, userID uuid.UUID, title, filePath, fileName string, fileSize int64, checksum string, tags JSONArray) (*Resume, error) {
+	resume, err := NewResume(userID, title, filePath, fileName, fileSize, tags)
+	if err != nil {
+		return nil, err
+	}
+	resume.Checksum = checksum
+
+	if err := s.repo.CreateResume(ctx, resume); err != nil {
+		return nil, err
+	}
+
+	return resume, nil
+}
+
+// FindResumeByChecksum returns the user's resume whose file has the given SHA-256 checksum.
+func (s *service) FindResumeByChecksum(ctx context.Context, userID uuid.UUID, checksum string) (*Resume, error) {
+	return s.repo.FindResumeByChecksum(ctx, userID, strings.ToLower(checksum))
+}
+
+// ChecksumOf returns the hex-encoded SHA-256 of data.
+func ChecksumOf(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// OpenResumeFile opens the stored file of a resume. The caller must close the reader.
+func (s *service) OpenResumeFile(ctx context.Context, resume *Resume) (io.ReadCloser, error) {
+	if s.fileStorage == nil {
+		return nil, NewDomainError(ErrCodeStorageFailure, ErrStorageUnavailable)
+	}
+
+	file, err := s.fileStorage.Get(ctx, resume.FilePath)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) || errors.Is(err, storage.ErrInvalidKey) {
+			return nil, NewDomainError(ErrCodeFileNotFound, ErrFileNotFound)
+		}
+		s.logger.ErrorContext(ctx, "failed to open resume file", "error", err, "resumeId", resume.ID)
+		return nil, NewDomainError(ErrCodeFileReadError, ErrFileReadError)
+	}
+
+	return file, nil
+}
+
+// DeleteResume deletes a resume and its stored file.
 func (s *service) DeleteResume(ctx context.Context, userID uuid.UUID, resumeID uuid.UUID) error {
-	return s.repo.DeleteResume(ctx, resumeID, userID)
+	tagResumeLogs(ctx, resumeID)
+
+	// Deduplicated uploads share a blob; the repository only releases it once nothing references it
+	releasedPath, err := s.repo.DeleteResume(ctx, resumeID, userID)
+	if err != nil {
+		return err
+	}
+
+	if s.fileStorage != nil && releasedPath != "" {
+		// Don't fail the request if file deletion fails; the record is already gone
+		if err := s.fileStorage.Delete(ctx, releasedPath); err != nil {
+			s.logger.WarnContext(ctx, "failed to delete resume file", "error", err, "resumeId", resumeID, "key", releasedPath)
+		}
+	}
+
+	return nil
+}
+
+// newStorageKey builds an opaque, collision-free storage key that keeps the original extension.
+func newStorageKey(userID uuid.UUID, fileName string) string {
+	ext := strings.ToLower(filepath.Ext(fileName))
+	return fmt.Sprintf("uploads/%s/%s%s", userID, uuid.New(), ext)
 }
 
 // GetResume retrieves a resume by ID.
 func (s *service) GetResume(ctx context.Context, userID uuid.UUID, resumeID uuid.UUID) (*Resume, error) {
+	tagResumeLogs(ctx, resumeID)
+
 	return s.repo.GetResume(ctx, resumeID, userID)
 }
 
@@ -107,8 +288,15 @@ func (s *service) ListResumesByTags(ctx context.Context, userID uuid.UUID, tags
 	return s.repo.ListResumesByTags(ctx, userID, tags)
 }
 
+// ListResumesWithFilters lists one page of a user's resumes.
+func (s *service) ListResumesWithFilters(ctx context.Context, filters ResumeFilters) ([]Resume, error) {
+	return s.repo.ListResumesWithFilters(ctx, filters)
+}
+
 // MarkAsMain marks a resume as main and unmarks others.
 func (s *service) MarkAsMain(ctx context.Context, userID uuid.UUID, resumeID uuid.UUID) (*Resume, error) {
+	tagResumeLogs(ctx, resumeID)
+
 	// Unmark all other resumes as main
 	if err := s.repo.UnmarkAllAsMain(ctx, userID); err != nil {
 		return nil, err
@@ -130,6 +318,8 @@ func (s *service) MarkAsMain(ctx context.Context, userID uuid.UUID, resumeID uui
 
 // MarkAsFeatured marks a resume as featured.
 func (s *service) MarkAsFeatured(ctx context.Context, userID uuid.UUID, resumeID uuid.UUID) (*Resume, error) {
+	tagResumeLogs(ctx, resumeID)
+
 	resume, err := s.repo.GetResume(ctx, resumeID, userID)
 	if err != nil {
 		return nil, err
@@ -145,6 +335,8 @@ func (s *service) MarkAsFeatured(ctx context.Context, userID uuid.UUID, resumeID
 
 // UnmarkAsMain removes the main flag from a resume.
 func (s *service) UnmarkAsMain(ctx context.Context, userID uuid.UUID, resumeID uuid.UUID) (*Resume, error) {
+	tagResumeLogs(ctx, resumeID)
+
 	resume, err := s.repo.GetResume(ctx, resumeID, userID)
 	if err != nil {
 		return nil, err
@@ -160,6 +352,8 @@ func (s *service) UnmarkAsMain(ctx context.Context, userID uuid.UUID, resumeID u
 
 // UnmarkAsFeatured removes the featured flag from a resume.
 func (s *service) UnmarkAsFeatured(ctx context.Context, userID uuid.UUID, resumeID uuid.UUID) (*Resume, error) {
+	tagResumeLogs(ctx, resumeID)
+
 	resume, err := s.repo.GetResume(ctx, resumeID, userID)
 	if err != nil {
 		return nil, err
@@ -221,35 +415,28 @@ func (s *service) RecalculateResumeMetrics(ctx context.Context, resumeID uuid.UU
 	return s.repo.UpdateResumeMetrics(ctx, resumeID, metrics)
 }
 
-// GenerateResume creates a resume generation job and publishes it to the queue.
-func (s *service) GenerateResume(ctx context.Context, userID uuid.UUID, jobDescription string, metadata map[string]interface{}) (uuid.UUID, error) {
+// GenerateResume creates a resume generation job and publishes it to the queue lane of
+// its priority. A blank priority keeps the default routing.
+func (s *service) GenerateResume(ctx context.Context, userID uuid.UUID, jobDescription string, metadata map[string]interface{}, priority ResumeJobPriority) (uuid.UUID, error) {
+	if !priority.IsValid() {
+		return uuid.Nil, NewDomainError(ErrCodeInvalidPayload, ErrInvalidJobPriority)
+	}
+
 	// Create a new resume generation job
 	job := NewResumeGenerationJob(userID, jobDescription, metadata)
+	job.Priority = priority
 	
 	// Persist the job to the database
 	if err := s.repo.CreateResumeGenerationJob(ctx, job); err != nil {
-		s.logger.Error("failed to create resume generation job", "error", err, "userId", userID)
+		s.logger.ErrorContext(ctx, "failed to create resume generation job", "error", err, "userId", userID)
 		return uuid.Nil, err
 	}
 	
-	// Convert to ResumeWorkerJob for publishing
-	workerJob := &ResumeWorkerJob{
-		JobID:          job.ID.String(),
-		UserID:         job.UserID.String(),
-		JobDescription: job.JobDescription,
-		Metadata:       job.Metadata,
-	}
-	
-	// Publish the job to RabbitMQ for the worker to process
-	if err := s.rabbitMQPublisher.PublishResumeGenerationJob(ctx, workerJob); err != nil {
-		s.logger.Error("failed to publish resume generation job", "error", err, "jobId", job.ID)
-		// Mark the job as failed since we couldn't queue it
-		job.MarkFailed("Failed to queue job for processing", "QUEUE_ERROR")
-		_ = s.repo.UpdateResumeGenerationJob(ctx, job)
+	if err := s.publishGenerationJob(ctx, job); err != nil {
 		return uuid.Nil, err
 	}
 	
-	s.logger.Info("resume generation job created and queued", "jobId", job.ID, "userId", userID)
+	s.logger.InfoContext(ctx, "resume generation job created and queued", "jobId", job.ID, "userId", userID)
 	return job.ID, nil
 }
 
@@ -257,18 +444,22 @@ func (s *service) GenerateResume(ctx context.Context, userID uuid.UUID, jobDescr
 func (s *service) GetResumeGenerationJobStatus(ctx context.Context, jobID uuid.UUID) (*ResumeGenerationJob, error) {
 	job, err := s.repo.GetResumeGenerationJob(ctx, jobID)
 	if err != nil {
-		s.logger.Error("failed to get resume generation job status", "error", err, "jobId", jobID)
+		s.logger.ErrorContext(ctx, "failed to get resume generation job status", "error", err, "jobId", jobID)
 		return nil, err
 	}
 	
 	return job, nil
 }
 
-// ListUserResumeGenerationJobs retrieves all resume generation jobs for a user.
-func (s *service) ListUserResumeGenerationJobs(ctx context.Context, userID uuid.UUID) ([]ResumeGenerationJob, error) {
-	jobs, err := s.repo.ListUserResumeGenerationJobs(ctx, userID)
+// ListUserResumeGenerationJobs retrieves a user's resume generation jobs matching the filters.
+func (s *service) ListUserResumeGenerationJobs(ctx context.Context, filters GenerationJobFilters) ([]ResumeGenerationJob, error) {
+	if filters.UserID == uuid.Nil {
+		return nil, NewDomainError(ErrCodeInvalidPayload, ErrEmptyUserID)
+	}
+
+	jobs, err := s.repo.ListUserResumeGenerationJobs(ctx, filters)
 	if err != nil {
-		s.logger.Error("failed to list user resume generation jobs", "error", err, "userId", userID)
+		s.logger.ErrorContext(ctx, "failed to list user resume generation jobs", "error", err, "userId", filters.UserID)
 		return nil, err
 	}
 	
@@ -279,36 +470,251 @@ func (s *service) ListUserResumeGenerationJobs(ctx context.Context, userID uuid.
 func (s *service) CompleteResumeGeneration(ctx context.Context, jobID uuid.UUID, resumeID uuid.UUID) error {
 	job, err := s.repo.GetResumeGenerationJob(ctx, jobID)
 	if err != nil {
-		s.logger.Error("failed to get resume generation job", "error", err, "jobId", jobID)
+		s.logger.ErrorContext(ctx, "failed to get resume generation job", "error", err, "jobId", jobID)
 		return err
 	}
 	
+	alreadyCompleted := job.Status == ResumeJobStatusCompleted
 	job.MarkCompleted(resumeID)
 	if err := s.repo.UpdateResumeGenerationJob(ctx, job); err != nil {
-		s.logger.Error("failed to update resume generation job", "error", err, "jobId", jobID)
+		s.logger.ErrorContext(ctx, "failed to update resume generation job", "error", err, "jobId", jobID)
 		return err
 	}
+	if !alreadyCompleted {
+		job.recordFinished(job.UpdatedAt)
+	}
 	
-	s.logger.Info("resume generation job completed", "jobId", jobID, "resumeId", resumeID)
+	s.statusHub.Publish(job)
+
+	s.logger.InfoContext(ctx, "resume generation job completed", "jobId", jobID, "resumeId", resumeID)
+	return nil
+}
+
+// StartResumeGeneration marks a pending resume generation job as processing.
+// Repeated calls for a job that is already processing are a no-op, so a
+// redelivered worker message does not fail.
+func (s *service) StartResumeGeneration(ctx context.Context, jobID uuid.UUID) error {
+	job, err := s.repo.GetResumeGenerationJob(ctx, jobID)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get resume generation job", "error", err, "jobId", jobID)
+		return err
+	}
+
+	switch job.Status {
+	case ResumeJobStatusProcessing:
+		return nil
+	case ResumeJobStatusPending:
+	default:
+		return NewDomainError(ErrCodeInvalidJobState, ErrGenerationJobFinished)
+	}
+
+	job.MarkProcessing()
+	if err := s.repo.UpdateResumeGenerationJob(ctx, job); err != nil {
+		s.logger.ErrorContext(ctx, "failed to update resume generation job", "error", err, "jobId", jobID)
+		return err
+	}
+
+	s.statusHub.Publish(job)
+
+	s.logger.InfoContext(ctx, "resume generation job processing", "jobId", jobID)
 	return nil
 }
 
 // FailResumeGeneration marks a resume generation job as failed with an error message.
-func (s *service) FailResumeGeneration(ctx context.Context, jobID uuid.UUID, errorMessage string) error {
+func (s *service) FailResumeGeneration(ctx context.Context, jobID uuid.UUID, errorMessage, errorCode string) error {
 	job, err := s.repo.GetResumeGenerationJob(ctx, jobID)
 	if err != nil {
-		s.logger.Error("failed to get resume generation job", "error", err, "jobId", jobID)
+		s.logger.ErrorContext(ctx, "failed to get resume generation job", "error", err, "jobId", jobID)
 		return err
 	}
-	
+
+	switch job.Status {
+	case ResumeJobStatusFailed:
+		return nil
+	case ResumeJobStatusPending, ResumeJobStatusProcessing:
+	default:
+		return NewDomainError(ErrCodeInvalidJobState, ErrGenerationJobFinished)
+	}
+
 	// Use a generic error code if none provided
-	job.MarkFailed(errorMessage, "GENERATION_ERROR")
+	if errorCode == "" {
+		errorCode = "GENERATION_ERROR"
+	}
+	job.MarkFailed(errorMessage, errorCode)
 	if err := s.repo.UpdateResumeGenerationJob(ctx, job); err != nil {
-		s.logger.Error("failed to update resume generation job", "error", err, "jobId", jobID)
+		s.logger.ErrorContext(ctx, "failed to update resume generation job", "error", err, "jobId", jobID)
 		return err
 	}
-	
-	s.logger.Info("resume generation job failed", "jobId", jobID, "error", errorMessage)
+	job.recordFinished(job.UpdatedAt)
+
+	s.statusHub.Publish(job)
+
+	s.logger.InfoContext(ctx, "resume generation job failed", "jobId", jobID, "error", errorMessage)
+	return nil
+}
+
+// RetryResumeGeneration resets a failed resume generation job to pending and republishes it.
+func (s *service) RetryResumeGeneration(ctx context.Context, userID uuid.UUID, jobID uuid.UUID) (*ResumeGenerationJob, error) {
+	job, err := s.repo.GetResumeGenerationJob(ctx, jobID)
+	if err != nil {
+		return nil, err
+	}
+
+	if job.UserID != userID {
+		return nil, NewDomainError(ErrCodeAccessDenied, ErrGenerationJobAccessDenied)
+	}
+
+	if job.Status != ResumeJobStatusFailed {
+		return nil, NewDomainError(ErrCodeInvalidJobState, ErrGenerationJobNotRetryable)
+	}
+
+	// The status check above is only a fast path; the conditional reset decides
+	// which of several concurrent retries gets to republish the job.
+	reset, err := s.repo.ResetFailedResumeGenerationJob(ctx, jobID, userID)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to reset resume generation job", "error", err, "jobId", jobID)
+		return nil, err
+	}
+	if !reset {
+		return nil, NewDomainError(ErrCodeInvalidJobState, ErrGenerationJobNotRetryable)
+	}
+	job.ResetForRetry()
+
+	s.statusHub.Publish(job)
+
+	if err := s.publishGenerationJob(ctx, job); err != nil {
+		return nil, err
+	}
+
+	s.logger.InfoContext(ctx, "resume generation job requeued", "jobId", jobID, "userId", userID)
+	return job, nil
+}
+
+// WatchResumeGenerationJob subscribes to status changes of a job owned by userID.
+// It returns the job's current state along with the update channel and a function
+// that releases the subscription.
+func (s *service) WatchResumeGenerationJob(ctx context.Context, userID uuid.UUID, jobID uuid.UUID) (*ResumeGenerationJob, <-chan JobStatusUpdate, func(), error) {
+	// Subscribe before reading so an update landing between the read and the subscription is not lost
+	updates, unsubscribe := s.statusHub.Subscribe(jobID)
+
+	job, err := s.repo.GetResumeGenerationJob(ctx, jobID)
+	if err != nil {
+		unsubscribe()
+		return nil, nil, nil, err
+	}
+
+	if job.UserID != userID {
+		unsubscribe()
+		return nil, nil, nil, NewDomainError(ErrCodeAccessDenied, ErrGenerationJobAccessDenied)
+	}
+
+	return job, updates, unsubscribe, nil
+}
+
+// GetGeneratedResume returns the resume produced by a completed generation job owned by userID.
+// A job that has not completed yields an ErrCodeInvalidJobState error together with the job,
+// so callers can report its status and, for failed jobs, the failure details.
+func (s *service) GetGeneratedResume(ctx context.Context, userID uuid.UUID, jobID uuid.UUID) (*ResumeGenerationJob, *Resume, error) {
+	job, err := s.repo.GetResumeGenerationJob(ctx, jobID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if job.UserID != userID {
+		return nil, nil, NewDomainError(ErrCodeAccessDenied, ErrGenerationJobAccessDenied)
+	}
+
+	switch job.Status {
+	case ResumeJobStatusCompleted:
+	case ResumeJobStatusFailed:
+		return job, nil, NewDomainError(ErrCodeInvalidJobState, ErrGenerationJobFailed)
+	case ResumeJobStatusCancelled:
+		return job, nil, NewDomainError(ErrCodeInvalidJobState, ErrGenerationJobCancelled)
+	default:
+		return job, nil, NewDomainError(ErrCodeInvalidJobState, ErrGenerationJobInProgress)
+	}
+
+	// The resume may have been deleted since the job completed
+	if job.ResumeID == nil {
+		return job, nil, NewDomainError(ErrCodeNotFound, ErrResumeNotFound)
+	}
+	resume, err := s.repo.GetResume(ctx, *job.ResumeID, userID)
+	if err != nil {
+		return job, nil, err
+	}
+	return job, resume, nil
+}
+
+// publishGenerationJob hands a persisted job to the worker queue, marking it failed if publishing fails.
+func (s *service) publishGenerationJob(ctx context.Context, job *ResumeGenerationJob) error {
+	// Convert to ResumeWorkerJob for publishing
+	workerJob := &ResumeWorkerJob{
+		JobID:          job.ID.String(),
+		UserID:         job.UserID.String(),
+		JobDescription: job.JobDescription,
+		Priority:       job.Priority,
+		Metadata:       job.Metadata,
+	}
+
+	// Publish the job to RabbitMQ for the worker to process
+	if err := s.rabbitMQPublisher.PublishResumeGenerationJob(ctx, workerJob); err != nil {
+		s.logger.ErrorContext(ctx, "failed to publish resume generation job", "error", err, "jobId", job.ID)
+		// Mark the job as failed since we couldn't queue it
+		job.MarkFailed("Failed to queue job for processing", "QUEUE_ERROR")
+		_ = s.repo.UpdateResumeGenerationJob(ctx, job)
+		s.statusHub.Publish(job)
+		return err
+	}
+
 	return nil
 }
 
+// tagResumeLogs adds the resume ID to the request-scoped log fields.
+func tagResumeLogs(ctx context.Context, id uuid.UUID) {
+	applogger.AddLogFields(ctx, slog.String("resume_id", id.String()))
+}
+
+// AddTagToResumes adds tag to each of the user's resumes in resumeIDs.
+func (s *service) AddTagToResumes(ctx context.Context, userID uuid.UUID, resumeIDs []uuid.UUID, tag string) (*BulkResumeResult, error) {
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	result, err := s.repo.AddTagToResumes(ctx, userID, dedupeResumeIDs(resumeIDs), tag)
+	if err != nil {
+		return nil, err
+	}
+	s.logger.InfoContext(ctx, "bulk resume tag added", "userId", userID, "tag", tag, "updated", result.Updated, "skipped", len(result.Skipped), "atTagLimit", len(result.AtTagLimit))
+	return result, nil
+}
+
+// RemoveTagFromResumes removes tag from each of the user's resumes in resumeIDs.
+func (s *service) RemoveTagFromResumes(ctx context.Context, userID uuid.UUID, resumeIDs []uuid.UUID, tag string) (*BulkResumeResult, error) {
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	result, err := s.repo.RemoveTagFromResumes(ctx, userID, dedupeResumeIDs(resumeIDs), tag)
+	if err != nil {
+		return nil, err
+	}
+	s.logger.InfoContext(ctx, "bulk resume tag removed", "userId", userID, "tag", tag, "updated", result.Updated, "skipped", len(result.Skipped))
+	return result, nil
+}
+
+// SetResumesFeatured sets or clears the featured flag on the user's resumes in resumeIDs.
+func (s *service) SetResumesFeatured(ctx context.Context, userID uuid.UUID, resumeIDs []uuid.UUID, featured bool) (*BulkResumeResult, error) {
+	result, err := s.repo.SetResumesFeatured(ctx, userID, dedupeResumeIDs(resumeIDs), featured)
+	if err != nil {
+		return nil, err
+	}
+	s.logger.InfoContext(ctx, "bulk resume featured updated", "userId", userID, "featured", featured, "updated", result.Updated, "skipped", len(result.Skipped))
+	return result, nil
+}
+
+func dedupeResumeIDs(ids []uuid.UUID) []uuid.UUID {
+	seen := make(map[uuid.UUID]struct{}, len(ids))
+	unique := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		unique = append(unique, id)
+	}
+	return unique
+}
@@ -0,0 +1,109 @@
+package resumes
+
+import (
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// statusSubscriberBuffer is how many updates a slow subscriber may lag behind before updates are dropped
+const statusSubscriberBuffer = 8
+
+// JobStatusUpdate is pushed to subscribers whenever a resume generation job changes state.
+type JobStatusUpdate struct {
+	JobID        uuid.UUID       `json:"jobId"`
+	Status       ResumeJobStatus `json:"status"`
+	ResumeID     *uuid.UUID      `json:"resumeId,omitempty"`
+	ErrorMessage string          `json:"errorMessage,omitempty"`
+	ErrorCode    string          `json:"errorCode,omitempty"`
+	UpdatedAt    time.Time       `json:"updatedAt"`
+}
+
+// IsTerminal reports whether no further updates are expected for the job.
+func (u JobStatusUpdate) IsTerminal() bool {
+	switch u.Status {
+	case ResumeJobStatusCompleted, ResumeJobStatusFailed, ResumeJobStatusCancelled:
+		return true
+	}
+	return false
+}
+
+// newJobStatusUpdate builds an update from the current job state.
+func newJobStatusUpdate(job *ResumeGenerationJob) JobStatusUpdate {
+	return JobStatusUpdate{
+		JobID:        job.ID,
+		Status:       job.Status,
+		ResumeID:     job.ResumeID,
+		ErrorMessage: job.ErrorMessage,
+		ErrorCode:    job.ErrorCode,
+		UpdatedAt:    job.UpdatedAt,
+	}
+}
+
+// JobStatusHub fans out job status updates to in-process subscribers keyed by job ID.
+// It only reaches subscribers connected to this process: with several replicas,
+// a worker callback that lands on another pod is not seen here, so clients
+// should fall back to polling the job when a stream ends without a terminal status.
+type JobStatusHub struct {
+	mu          sync.Mutex
+	subscribers map[uuid.UUID]map[chan JobStatusUpdate]struct{}
+}
+
+// NewJobStatusHub creates an empty hub.
+func NewJobStatusHub() *JobStatusHub {
+	return &JobStatusHub{
+		subscribers: make(map[uuid.UUID]map[chan JobStatusUpdate]struct{}),
+	}
+}
+
+// Subscribe registers interest in a job. The returned function must be called to release the subscription.
+func (h *JobStatusHub) Subscribe(jobID uuid.UUID) (<-chan JobStatusUpdate, func()) {
+	ch := make(chan JobStatusUpdate, statusSubscriberBuffer)
+
+	h.mu.Lock()
+	if h.subscribers[jobID] == nil {
+		h.subscribers[jobID] = make(map[chan JobStatusUpdate]struct{})
+	}
+	h.subscribers[jobID][ch] = struct{}{}
+	h.mu.Unlock()
+
+	var once sync.Once
+	unsubscribe := func() {
+		once.Do(func() {
+			h.mu.Lock()
+			defer h.mu.Unlock()
+			delete(h.subscribers[jobID], ch)
+			if len(h.subscribers[jobID]) == 0 {
+				delete(h.subscribers, jobID)
+			}
+			close(ch)
+		})
+	}
+	return ch, unsubscribe
+}
+
+// Publish sends the job's current state to all subscribers. Subscribers that are
+// not keeping up miss the update rather than blocking the publisher.
+func (h *JobStatusHub) Publish(job *ResumeGenerationJob) {
+	if h == nil || job == nil {
+		return
+	}
+	update := newJobStatusUpdate(job)
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for ch := range h.subscribers[job.ID] {
+		select {
+		case ch <- update:
+		default:
+		}
+	}
+}
+
+// SubscriberCount returns the number of active subscriptions for a job.
+func (h *JobStatusHub) SubscriberCount(jobID uuid.UUID) int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return len(h.subscribers[jobID])
+}
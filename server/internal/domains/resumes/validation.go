@@ -1,12 +1,24 @@
 package resumes
 
 import (
+	"encoding/json"
+	"encoding/hex"
 	"fmt"
 	"strings"
+	"time"
 
 	"woragis-jobs-service/pkg/validation"
 )
 
+const (
+	// MaxResumeFileSize is the largest resume file accepted (10MB)
+	MaxResumeFileSize int64 = 10 * 1024 * 1024
+	// maxUploadRequestSize allows for the multipart envelope around the file
+	maxUploadRequestSize = MaxResumeFileSize + 64*1024
+	// maxBulkResumes caps how many resumes a single bulk request may touch
+	maxBulkResumes = 200
+)
+
 // createResumePayload represents the payload for CreateResume
 type createResumePayload struct {
 	Title    string   `json:"title"`
@@ -22,11 +34,33 @@ type updateResumePayload struct {
 	Tags  []string `json:"tags"`
 }
 
+// bulkResumeTagPayload represents the payload for BulkAddTag and BulkRemoveTag
+type bulkResumeTagPayload struct {
+	ResumeIDs []string `json:"resumeIds"`
+	Tag       string   `json:"tag"`
+}
+
+// bulkResumeFeaturedPayload represents the payload for BulkSetFeatured
+type bulkResumeFeaturedPayload struct {
+	ResumeIDs []string `json:"resumeIds"`
+	Featured  *bool    `json:"featured"`
+}
+
 // generateResumePayload represents the payload for GenerateResume
 type generateResumePayload struct {
-	JobApplicationID string `json:"jobApplicationId"`
-	Language         string `json:"language"`
-	Template         string `json:"template,omitempty"`
+	JobApplicationID string          `json:"jobApplicationId"`
+	Language         string          `json:"language"`
+	Template         string          `json:"template,omitempty"` // legacy; prefer options.template
+	Priority         string          `json:"priority,omitempty"` // "high" or "normal"; blank keeps the default lane
+	Options          json.RawMessage `json:"options,omitempty"`  // ResumeGenerationOptions; unknown keys are rejected
+}
+
+// generationStatusPayload represents the payload for UpdateGenerationStatus
+type generationStatusPayload struct {
+	JobID        string `json:"jobId"`
+	Status       string `json:"status"`
+	ErrorMessage string `json:"errorMessage,omitempty"`
+	ErrorCode    string `json:"errorCode,omitempty"`
 }
 
 // ValidateCreateResumePayload validates create resume payload
@@ -68,21 +102,19 @@ func ValidateCreateResumePayload(payload *createResumePayload) error {
 
 	// Validate file size (optional, but if provided, validate)
 	if payload.FileSize > 0 {
-		maxSize := int64(10 * 1024 * 1024) // 10MB
-		if err := validation.ValidateFileSize(payload.FileSize, maxSize); err != nil {
+		if err := validation.ValidateFileSize(payload.FileSize, MaxResumeFileSize); err != nil {
 			return fmt.Errorf("fileSize: %w", err)
 		}
 	}
 
 	// Validate tags (optional, but if provided, validate each tag)
 	if len(payload.Tags) > 0 {
-		if len(payload.Tags) > 20 {
-			return fmt.Errorf("tags: too many tags (maximum 20)")
+		tags, err := validation.ValidateTags(payload.Tags)
+		if err != nil {
+			return err
 		}
+		payload.Tags = tags
 		for i, tag := range payload.Tags {
-			if err := validation.ValidateString(tag, 1, 50, fmt.Sprintf("tags[%d]", i)); err != nil {
-				return fmt.Errorf("tags[%d]: %w", i, err)
-			}
 			// Check for SQL injection and XSS
 			if err := validation.ValidateNoSQLInjection(tag); err != nil {
 				return fmt.Errorf("tags[%d]: %w", i, err)
@@ -114,13 +146,12 @@ func ValidateUpdateResumePayload(payload *updateResumePayload) error {
 
 	// Validate tags (optional, but if provided, validate each tag)
 	if len(payload.Tags) > 0 {
-		if len(payload.Tags) > 20 {
-			return fmt.Errorf("tags: too many tags (maximum 20)")
+		tags, err := validation.ValidateTags(payload.Tags)
+		if err != nil {
+			return err
 		}
+		payload.Tags = tags
 		for i, tag := range payload.Tags {
-			if err := validation.ValidateString(tag, 1, 50, fmt.Sprintf("tags[%d]", i)); err != nil {
-				return fmt.Errorf("tags[%d]: %w", i, err)
-			}
 			// Check for SQL injection and XSS
 			if err := validation.ValidateNoSQLInjection(tag); err != nil {
 				return fmt.Errorf("tags[%d]: %w", i, err)
@@ -134,60 +165,129 @@ func ValidateUpdateResumePayload(payload *updateResumePayload) error {
 	return nil
 }
 
-// ValidateGenerateResumePayload validates generate resume payload
-func ValidateGenerateResumePayload(payload *generateResumePayload) error {
+// ValidateBulkResumeTagPayload validates bulk tag add/remove payload
+func ValidateBulkResumeTagPayload(payload *bulkResumeTagPayload) error {
+	if err := validateBulkResumeIDs(payload.ResumeIDs); err != nil {
+		return err
+	}
+
+	tag, err := validation.ValidateTag(payload.Tag)
+	if err != nil {
+		return err
+	}
+	payload.Tag = tag
+	// Check for SQL injection and XSS
+	if err := validation.ValidateNoSQLInjection(tag); err != nil {
+		return fmt.Errorf("tag: %w", err)
+	}
+	if err := validation.ValidateNoXSS(tag); err != nil {
+		return fmt.Errorf("tag: %w", err)
+	}
+
+	return nil
+}
+
+// ValidateBulkResumeFeaturedPayload validates bulk featured payload
+func ValidateBulkResumeFeaturedPayload(payload *bulkResumeFeaturedPayload) error {
+	if err := validateBulkResumeIDs(payload.ResumeIDs); err != nil {
+		return err
+	}
+	if payload.Featured == nil {
+		return fmt.Errorf("featured is required")
+	}
+	return nil
+}
+
+func validateBulkResumeIDs(resumeIDs []string) error {
+	if len(resumeIDs) == 0 {
+		return fmt.Errorf("resumeIds: at least one resume id is required")
+	}
+	if len(resumeIDs) > maxBulkResumes {
+		return fmt.Errorf("resumeIds: too many resume ids (maximum %d)", maxBulkResumes)
+	}
+	for i, id := range resumeIDs {
+		if err := validation.ValidateUUID(id); err != nil {
+			return fmt.Errorf("resumeIds[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// ValidateGenerateResumePayload validates generate resume payload and returns its
+// generation options, with the legacy top-level template folded in
+func ValidateGenerateResumePayload(payload *generateResumePayload) (ResumeGenerationOptions, error) {
 	// Validate job application ID (required)
 	if payload.JobApplicationID == "" {
-		return fmt.Errorf("jobApplicationId is required")
+		return ResumeGenerationOptions{}, fmt.Errorf("jobApplicationId is required")
 	}
 	if err := validation.ValidateUUID(payload.JobApplicationID); err != nil {
-		return fmt.Errorf("jobApplicationId: %w", err)
+		return ResumeGenerationOptions{}, fmt.Errorf("jobApplicationId: %w", err)
 	}
 
 	// Validate language (required, ISO 639-1 format)
 	if payload.Language == "" {
-		return fmt.Errorf("language is required")
+		return ResumeGenerationOptions{}, fmt.Errorf("language is required")
 	}
 	if len(payload.Language) != 2 {
-		return fmt.Errorf("language: must be exactly 2 characters (ISO 639-1 code)")
+		return ResumeGenerationOptions{}, fmt.Errorf("language: must be exactly 2 characters (ISO 639-1 code)")
 	}
 	// Should be lowercase
 	if payload.Language != strings.ToLower(payload.Language) {
-		return fmt.Errorf("language: must be lowercase ISO 639-1 code")
+		return ResumeGenerationOptions{}, fmt.Errorf("language: must be lowercase ISO 639-1 code")
+	}
+
+	// Validate priority (optional)
+	if !ResumeJobPriority(payload.Priority).IsValid() {
+		return ResumeGenerationOptions{}, fmt.Errorf("priority: must be high or normal")
 	}
 
-	// Validate template (optional, but if provided, validate)
+	// Validate generation options (optional)
+	options, err := ParseResumeGenerationOptions(payload.Options)
+	if err != nil {
+		return ResumeGenerationOptions{}, err
+	}
 	if payload.Template != "" {
-		if err := validation.ValidateString(payload.Template, 1, 100, "template"); err != nil {
-			return fmt.Errorf("template: %w", err)
-		}
-		// Check for SQL injection and XSS
-		if err := validation.ValidateNoSQLInjection(payload.Template); err != nil {
-			return fmt.Errorf("template: %w", err)
-		}
-		if err := validation.ValidateNoXSS(payload.Template); err != nil {
-			return fmt.Errorf("template: %w", err)
+		if options.Template != "" && options.Template != payload.Template {
+			return ResumeGenerationOptions{}, fmt.Errorf("template: conflicts with options.template")
 		}
+		options.Template = payload.Template
+	}
+	if err := options.Validate(); err != nil {
+		return ResumeGenerationOptions{}, fmt.Errorf("options.%w", err)
 	}
 
-	return nil
+	return options, nil
 }
 
-// ValidateListResumesQueryParams validates query parameters for ListResumes
-func ValidateListResumesQueryParams(limit, offset int, search string) error {
-	// Validate limit
-	if limit < 1 {
-		return fmt.Errorf("limit: must be at least 1")
+// ValidateGenerationStatusPayload validates a worker status callback
+func ValidateGenerationStatusPayload(payload *generationStatusPayload) error {
+	if payload.JobID == "" {
+		return fmt.Errorf("jobId is required")
+	}
+	if err := validation.ValidateUUID(payload.JobID); err != nil {
+		return fmt.Errorf("jobId: %w", err)
 	}
-	if limit > 200 {
-		return fmt.Errorf("limit: must be at most 200")
+
+	// Completion goes through /resumes/complete because it carries the generated file
+	switch ResumeJobStatus(payload.Status) {
+	case ResumeJobStatusProcessing, ResumeJobStatusFailed:
+	default:
+		return fmt.Errorf("status: must be one of: processing, failed")
 	}
 
-	// Validate offset
-	if offset < 0 {
-		return fmt.Errorf("offset: must be at least 0")
+	if len(payload.ErrorMessage) > 2000 {
+		return fmt.Errorf("errorMessage: must be at most 2000 characters")
+	}
+	if len(payload.ErrorCode) > 50 {
+		return fmt.Errorf("errorCode: must be at most 50 characters")
 	}
 
+	return nil
+}
+
+// ValidateListResumesQueryParams validates query parameters for ListResumes
+// (limit and offset are normalized by pagination.FromQuery)
+func ValidateListResumesQueryParams(search string) error {
 	// Validate search (optional, but if provided, validate length)
 	if search != "" {
 		if err := validation.ValidateString(search, 1, 200, "search"); err != nil {
@@ -214,8 +314,7 @@ func ValidateUploadResumeFile(filename string, size int64, contentType string) e
 	}
 
 	// Validate file size (max 10MB)
-	maxSize := int64(10 * 1024 * 1024)
-	if err := validation.ValidateFileSize(size, maxSize); err != nil {
+	if err := validation.ValidateFileSize(size, MaxResumeFileSize); err != nil {
 		return fmt.Errorf("file: %w", err)
 	}
 
@@ -227,3 +326,37 @@ func ValidateUploadResumeFile(filename string, size int64, contentType string) e
 	return nil
 }
 
+
+// ValidateChecksum validates a hex-encoded SHA-256 checksum
+func ValidateChecksum(checksum string) error {
+	if len(checksum) != 64 {
+		return fmt.Errorf("checksum: must be a 64-character hex SHA-256 digest")
+	}
+	if _, err := hex.DecodeString(checksum); err != nil {
+		return fmt.Errorf("checksum: must be a 64-character hex SHA-256 digest")
+	}
+	return nil
+}
+
+// ValidateListGenerationJobsQueryParams validates query parameters for ListGenerationJobs
+// (limit and offset are normalized by pagination.FromQuery)
+func ValidateListGenerationJobsQueryParams(status, createdAfter string) error {
+	// Validate status (optional)
+	if status != "" {
+		switch ResumeJobStatus(status) {
+		case ResumeJobStatusPending, ResumeJobStatusProcessing, ResumeJobStatusCompleted,
+			ResumeJobStatusFailed, ResumeJobStatusCancelled:
+		default:
+			return fmt.Errorf("status: must be one of: pending, processing, completed, failed, cancelled")
+		}
+	}
+
+	// Validate createdAfter (optional, RFC3339)
+	if createdAfter != "" {
+		if _, err := time.Parse(time.RFC3339, createdAfter); err != nil {
+			return fmt.Errorf("createdAfter: must be an RFC3339 timestamp")
+		}
+	}
+
+	return nil
+}
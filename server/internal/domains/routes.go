@@ -5,40 +5,65 @@ import (
 
 	"github.com/gofiber/fiber/v2"
 
+	"woragis-jobs-service/internal/config"
 	"woragis-jobs-service/internal/database"
+	"woragis-jobs-service/internal/domains/account"
+	"woragis-jobs-service/internal/domains/auth"
+	"woragis-jobs-service/internal/domains/batch"
 	"woragis-jobs-service/internal/domains/jobapplications"
+	"woragis-jobs-service/internal/domains/jobapplications/contacts"
 	"woragis-jobs-service/internal/domains/jobapplications/interviewstages"
 	"woragis-jobs-service/internal/domains/jobapplications/responses"
 	"woragis-jobs-service/internal/domains/jobwebsites"
 	"woragis-jobs-service/internal/domains/resumes"
 	"woragis-jobs-service/pkg/aiservice"
 	authPkg "woragis-jobs-service/pkg/auth"
+	"woragis-jobs-service/pkg/featureflags"
 	"woragis-jobs-service/pkg/middleware"
+	"woragis-jobs-service/pkg/sanitize"
+	"woragis-jobs-service/pkg/security"
+	"woragis-jobs-service/pkg/storage"
 )
 
 // SetupRoutes sets up all jobs service routes
-func SetupRoutes(api fiber.Router, dbManager *database.Manager, jwtManager *authPkg.JWTManager, aiServiceURL string, logger *slog.Logger) {
+func SetupRoutes(api fiber.Router, dbManager *database.Manager, jwtManager *authPkg.JWTManager, aiServiceCfg *config.AIServiceConfig, rateLimitCfg *config.RateLimitConfig, jobAppCfg *config.JobApplicationConfig, exportCfg *config.ExportConfig, resumeQueueCfg *config.ResumeQueueConfig, featureFlagCfg *config.FeatureFlagConfig, batchCfg *config.BatchConfig, fileStorage storage.Backend, logger *slog.Logger) {
 	db := dbManager.GetPostgres()
-	// Apply JWT validation middleware to all routes (local validation, no HTTP calls)
-	if jwtManager != nil {
-		api.Use(middleware.JWTMiddleware(middleware.JWTConfig{
-			JWTManager: jwtManager,
-		}))
-	}
 
-	// Initialize repositories
-	jobAppRepo := jobapplications.NewGormRepository(db)
-	resumeRepo := resumes.NewGormRepository(db)
-	jobWebsiteRepo := jobwebsites.NewGormRepository(db)
+	// Initialize repositories, retrying transient connection errors such as a Postgres failover
+	retryPolicy := dbManager.GetRetryPolicy()
+	jobAppRepo := jobapplications.NewRetryingRepository(jobapplications.NewGormRepository(db), retryPolicy)
+	// Account deletion bypasses the application repository, so it drops cached applications itself
+	var applicationCache account.ApplicationCache
+	if jobAppCfg != nil && jobAppCfg.CacheEnabled {
+		if dbManager.GetRedis() != nil {
+			jobAppRepo = jobapplications.NewCachingRepository(jobAppRepo, dbManager.GetRedis(), jobAppCfg.CacheTTL, logger)
+			applicationCache = jobapplications.NewApplicationCacheInvalidator(dbManager.GetRedis(), logger)
+		} else {
+			logger.Warn("Redis not available, application read cache disabled")
+		}
+	}
+	resumeRepo := resumes.NewRetryingRepository(resumes.NewGormRepository(db), retryPolicy)
+	jobWebsiteRepo := jobwebsites.NewRetryingRepository(jobwebsites.NewGormRepository(db), retryPolicy)
 
 	// Initialize services
-	jobAppService := jobapplications.NewService(jobAppRepo, nil, logger) // Queue will be nil for now
 	
 	// Initialize RabbitMQ publisher for resume jobs
 	var resumePublisher resumes.RabbitMQPublisher = resumes.NewNoOpPublisher(logger)
 	if dbManager.GetRabbitMQ() != nil {
 		var err error
-		resumePublisher, err = resumes.NewRabbitMQPublisher(dbManager.GetRabbitMQ().Channel, logger)
+		publisherCfg := resumes.DefaultRabbitMQPublisherConfig()
+		if resumeQueueCfg != nil {
+			publisherCfg = resumes.RabbitMQPublisherConfig{
+				HighPriorityQueue:        resumeQueueCfg.HighPriorityQueue,
+				HighPriorityRoutingKey:   resumeQueueCfg.HighPriorityRoutingKey,
+				NormalPriorityRoutingKey: resumeQueueCfg.NormalPriorityRoutingKey,
+				MessageTTL:               resumeQueueCfg.MessageTTL,
+				MaxLength:                resumeQueueCfg.MaxLength,
+				Overflow:                 resumeQueueCfg.Overflow,
+				MaxPriority:              uint8(resumeQueueCfg.MaxPriority),
+			}
+		}
+		resumePublisher, err = resumes.NewRabbitMQPublisherWithConfig(dbManager.GetRabbitMQ().Channel, publisherCfg, logger)
 		if err != nil {
 			logger.Warn("failed to initialize RabbitMQ publisher", "error", err)
 			resumePublisher = resumes.NewNoOpPublisher(logger)
@@ -49,40 +74,158 @@ func SetupRoutes(api fiber.Router, dbManager *database.Manager, jwtManager *auth
 		logger.Warn("RabbitMQ connection not available, using no-op publisher")
 	}
 	
-	resumeService := resumes.NewService(resumeRepo, resumePublisher, logger)
+	resumeStatusHub := resumes.NewJobStatusHub()
+	resumeService := resumes.NewServiceWithDependencies(resumeRepo, resumePublisher, resumeStatusHub, fileStorage, logger)
 	jobWebsiteService := jobwebsites.NewService(jobWebsiteRepo, logger)
 
 	// Initialize AI service client for cover letter generation
 	var coverLetterGenerator jobapplications.CoverLetterGenerator
-	if aiServiceURL != "" {
-		aiClient := aiservice.NewClient(aiServiceURL)
-		coverLetterGenerator = jobapplications.NewAIServiceCoverLetterGenerator(aiClient, logger)
-		logger.Info("AI service client initialized for cover letter generation", "url", aiServiceURL)
+	var languageDetector jobapplications.LanguageDetector
+	var tagSuggester jobapplications.TagSuggester
+	var postingExtractor jobapplications.PostingExtractor
+	if aiServiceCfg != nil && aiServiceCfg.URL != "" {
+		aiClient := aiservice.NewClientWithOptions(aiServiceCfg.URL, aiservice.ClientOptions{
+			APIKeyHeader: aiServiceCfg.APIKeyHeader,
+			APIKey:       aiServiceCfg.APIKey,
+			MaxResponseBytes: aiServiceCfg.MaxResponseBytes,
+			BreakerThreshold: aiServiceCfg.BreakerThreshold,
+			BreakerCooldown:  aiServiceCfg.BreakerCooldown,
+			Timeout:          aiServiceCfg.Timeout,
+			MaxConcurrentRequests: aiServiceCfg.MaxConcurrent,
+			ConcurrencyWait:       aiServiceCfg.ConcurrencyWait,
+			AgentTemperatures:     aiServiceCfg.AgentTemperatures,
+		})
+		var coverLetterSanitizer *jobapplications.CoverLetterSanitizer
+		if aiServiceCfg.SanitizeCoverLetters {
+			var err error
+			coverLetterSanitizer, err = jobapplications.NewCoverLetterSanitizer(aiServiceCfg.CoverLetterPreamblePatterns)
+			if err != nil {
+				logger.Warn("invalid cover letter preamble patterns, using defaults", "error", err)
+				coverLetterSanitizer, _ = jobapplications.NewCoverLetterSanitizer(nil)
+			}
+		}
+		coverLetterGenerator = jobapplications.NewAIServiceCoverLetterGeneratorWithOptions(aiClient, jobapplications.CoverLetterGeneratorOptions{
+			Sanitizer: coverLetterSanitizer,
+			PromptLogging: jobapplications.PromptLogging{
+				Enabled:  aiServiceCfg.LogCoverLetterPrompts,
+				MaxChars: aiServiceCfg.CoverLetterPromptLogMaxChars,
+				Full:     aiServiceCfg.LogFullCoverLetterPrompts,
+			},
+		}, logger)
+		languageDetector = jobapplications.NewAIServiceLanguageDetector(aiClient, logger)
+		tagSuggester = jobapplications.NewAIServiceTagSuggester(aiClient, logger)
+		postingExtractor = jobapplications.NewAIServicePostingExtractor(aiClient, logger)
+		logger.Info("AI service client initialized for cover letter generation", "url", aiServiceCfg.URL, "api_key_configured", aiServiceCfg.APIKey != "")
 	} else {
 		logger.Warn("AI service URL not provided, cover letter generation will be disabled")
 	}
 
+	jobAppService := jobapplications.NewServiceWithPostingExtraction(jobAppRepo, nil, languageDetector, newWebsiteMethodsProvider(jobWebsiteService), tagSuggester,
+		newPostingStrategyProvider(jobWebsiteService), postingExtractor, logger) // Queue will be nil for now
+
 	// Initialize handlers
+	if jobAppCfg != nil && jobAppCfg.DefaultInterestLevel != "" {
+		if err := jobapplications.SetDefaultInterestLevel(jobAppCfg.DefaultInterestLevel); err != nil {
+			logger.Warn("invalid default interest level, using default", "value", jobAppCfg.DefaultInterestLevel, "default", jobapplications.DefaultInterestLevel(), "error", err)
+		}
+	}
+
+	// Opt-in: attach the user's best resume to applications created without one
+	var defaultResume jobapplications.DefaultResumeProvider
+	if jobAppCfg != nil && jobAppCfg.AutoAttachResume {
+		defaultResume = newBestResumeProvider(resumeService)
+	}
 	var jobAppHandler jobapplications.Handler
-	if coverLetterGenerator != nil {
-		jobAppHandler = jobapplications.NewHandlerWithDependencies(jobAppService, nil, nil, coverLetterGenerator, logger)
+	if coverLetterGenerator != nil || defaultResume != nil {
+		jobAppHandler = jobapplications.NewHandlerWithDefaultResume(jobAppService, nil, nil, coverLetterGenerator, defaultResume, logger)
 	} else {
 		jobAppHandler = jobapplications.NewHandler(jobAppService, logger)
 	}
-	resumeHandler := resumes.NewHandler(resumeService, nil, "", logger) // Queue and baseFilePath will be nil/empty for now
-	jobWebsiteHandler := jobwebsites.NewHandler(jobWebsiteService, logger)
+	resumeHandler := resumes.NewHandler(resumeService, nil, logger) // Queue will be nil for now
+	jobWebsiteHandler := jobwebsites.NewHandlerWithApplications(jobWebsiteService, jobAppService, logger)
 
 	// Initialize subdomain handlers
-	responseRepo := responses.NewGormRepository(db)
-	responseService := responses.NewService(responseRepo, logger)
+	contactRepo := contacts.NewRetryingRepository(contacts.NewGormRepository(db), retryPolicy)
+	contactService := contacts.NewService(contactRepo, logger)
+	contactHandler := contacts.NewHandler(contactService, logger)
+
+	responseRepo := responses.NewRetryingRepository(responses.NewGormRepository(db), retryPolicy)
+	responseService := responses.NewServiceWithContacts(responseRepo, contactService, logger)
 	responseHandler := responses.NewHandler(responseService, logger)
 	
-	stageRepo := interviewstages.NewGormRepository(db)
+	stageRepo := interviewstages.NewRetryingRepository(interviewstages.NewGormRepository(db), retryPolicy)
 	stageService := interviewstages.NewService(stageRepo, logger)
 	stageHandler := interviewstages.NewHandler(stageService, logger)
 
-	// Setup routes
-	jobapplications.SetupRoutes(api.Group("/job-applications"), jobAppHandler, responseHandler, stageHandler)
+	// Account data export and deletion
+	accountRepo := account.NewGormRepository(db)
+	accountService := account.NewServiceWithApplicationCache(accountRepo, fileStorage, applicationCache, logger)
+	exportFilter := sanitize.NewDefaultFilter()
+	if exportCfg != nil {
+		filter, err := sanitize.NewFilter(sanitize.Config{Rules: exportCfg.SanitizeRules, ProfanityWords: exportCfg.ProfanityWords})
+		if err != nil {
+			logger.Warn("invalid export sanitize rules, using defaults", "error", err)
+		} else {
+			exportFilter = filter
+		}
+	}
+	accountHandler := account.NewHandlerWithExportFilter(accountService, exportFilter, logger)
+
+	// Token introspection
+	authHandler := auth.NewHandler(jwtManager, logger)
+
+	// Internal worker callbacks authenticate with the shared API key instead of a user token,
+	// so they are registered before the JWT middleware below
+	resumes.SetupInternalRoutes(api.Group("/internal"), resumeHandler)
+
+	// Apply JWT validation middleware to all routes (local validation, no HTTP calls)
+	if jwtManager != nil {
+		api.Use(middleware.JWTMiddleware(middleware.JWTConfig{
+			JWTManager: jwtManager,
+		}))
+	}
+
+	// Feature flags are evaluated per user, so they also run after JWT validation
+	if featureFlagCfg != nil {
+		var overrides featureflags.OverrideStore
+		if featureFlagCfg.RedisOverrides && dbManager.GetRedis() != nil {
+			overrides = featureflags.NewRedisOverrideStore(dbManager.GetRedis(), logger)
+		}
+		evaluator, err := featureflags.NewEvaluator(featureFlagCfg.Rollouts, overrides, logger)
+		if err != nil {
+			logger.Warn("invalid feature flag configuration, all flags are off", "error", err)
+		} else {
+			api.Use(featureflags.Middleware(evaluator))
+		}
+	}
+
+	// Weighted rate limiting runs after JWT validation so each user has their own budget
+	if rateLimitCfg != nil {
+		weights := make([]security.RouteWeight, 0, len(rateLimitCfg.Weights))
+		for _, w := range rateLimitCfg.Weights {
+			weights = append(weights, security.RouteWeight{Method: w.Method, Pattern: w.Pattern, Weight: w.Weight})
+		}
+		api.Use(security.WeightedRateLimitMiddleware(security.WeightedRateLimitConfig{
+			RedisClient:   dbManager.GetRedis(),
+			Budget:        rateLimitCfg.Budget,
+			Window:        rateLimitCfg.Window,
+			DefaultWeight: rateLimitCfg.DefaultWeight,
+			Weights:       weights,
+		}))
+	}
+
+	// Setup routes (JSON-only groups are capped at 64KB; resumes sets per-route limits for uploads)
+	jsonBodyLimit := security.RequestSizeLimitFor(security.DefaultJSONBodyLimit)
+	jobapplications.SetupRoutes(api.Group("/job-applications", jsonBodyLimit), jobAppHandler, responseHandler, stageHandler, contactHandler)
 	resumes.SetupRoutes(api.Group("/resumes"), resumeHandler)
-	jobwebsites.SetupRoutes(api.Group("/job-websites"), jobWebsiteHandler)
+	jobwebsites.SetupRoutes(api.Group("/job-websites", jsonBodyLimit), jobWebsiteHandler)
+	interviewstages.SetupTemplateRoutes(api.Group("/interview-templates", jsonBodyLimit), stageHandler)
+	interviewstages.SetupAnalyticsRoutes(api.Group("/analytics"), stageHandler)
+	account.SetupRoutes(api.Group("/account", jsonBodyLimit), accountHandler)
+	auth.SetupRoutes(api.Group("/auth", jsonBodyLimit), authHandler)
+
+	// Each batch operation is replayed through the app and authenticated again like a direct call
+	if batchCfg != nil {
+		batch.SetupRoutes(api, batch.NewHandler(batchCfg.MaxOperations, logger), batchCfg.MaxOperations)
+	}
 }
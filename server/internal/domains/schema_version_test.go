@@ -0,0 +1,78 @@
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchemaVersionHandler(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	appliedAt := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		read       SchemaVersionReader
+		wantStatus int
+		wantBody   SchemaStatus
+	}{
+		{
+			name: "in sync",
+			read: func(context.Context) (*SchemaMigration, error) {
+				return &SchemaMigration{Version: SchemaVersion, AppliedAt: appliedAt}, nil
+			},
+			wantStatus: fiber.StatusOK,
+			wantBody:   SchemaStatus{ExpectedVersion: SchemaVersion, AppliedVersion: SchemaVersion, AppliedAt: &appliedAt, InSync: true},
+		},
+		{
+			name: "database ahead of this build",
+			read: func(context.Context) (*SchemaMigration, error) {
+				return &SchemaMigration{Version: SchemaVersion + 1, AppliedAt: appliedAt}, nil
+			},
+			wantStatus: fiber.StatusOK,
+			wantBody:   SchemaStatus{ExpectedVersion: SchemaVersion, AppliedVersion: SchemaVersion + 1, AppliedAt: &appliedAt},
+		},
+		{
+			name:       "never migrated",
+			read:       func(context.Context) (*SchemaMigration, error) { return nil, nil },
+			wantStatus: fiber.StatusOK,
+			wantBody:   SchemaStatus{ExpectedVersion: SchemaVersion},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := fiber.New()
+			app.Get("/healthz/schema", SchemaVersionHandler(tt.read, logger))
+
+			resp, err := app.Test(httptest.NewRequest("GET", "/healthz/schema", nil))
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantStatus, resp.StatusCode)
+
+			var body SchemaStatus
+			require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+			assert.Equal(t, tt.wantBody, body)
+		})
+	}
+}
+
+func TestSchemaVersionHandler_ReadError(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	app := fiber.New()
+	app.Get("/healthz/schema", SchemaVersionHandler(func(context.Context) (*SchemaMigration, error) {
+		return nil, errors.New("connection refused")
+	}, logger))
+
+	resp, err := app.Test(httptest.NewRequest("GET", "/healthz/schema", nil))
+	require.NoError(t, err)
+	assert.Equal(t, fiber.StatusServiceUnavailable, resp.StatusCode)
+}
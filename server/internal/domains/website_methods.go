@@ -0,0 +1,33 @@
+package jobs
+
+import (
+	"context"
+
+	"woragis-jobs-service/internal/domains/jobapplications"
+	"woragis-jobs-service/internal/domains/jobwebsites"
+)
+
+// websiteMethodsProvider exposes the application methods configured on job websites
+// to the job applications domain, which cannot import jobwebsites directly.
+type websiteMethodsProvider struct {
+	service jobwebsites.Service
+}
+
+func newWebsiteMethodsProvider(service jobwebsites.Service) jobapplications.WebsiteMethodsProvider {
+	return &websiteMethodsProvider{service: service}
+}
+
+// GetWebsiteApplicationMethods returns nil without an error for websites that are not configured.
+func (p *websiteMethodsProvider) GetWebsiteApplicationMethods(ctx context.Context, website string) (*jobapplications.WebsiteApplicationMethods, error) {
+	jobWebsite, err := p.service.GetJobWebsiteByName(ctx, website)
+	if err != nil {
+		if domainErr, ok := jobwebsites.AsDomainError(err); ok && domainErr.Code == jobwebsites.ErrCodeNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &jobapplications.WebsiteApplicationMethods{
+		Default:   jobWebsite.DefaultApplicationMethod,
+		Supported: jobWebsite.SupportedApplicationMethods,
+	}, nil
+}
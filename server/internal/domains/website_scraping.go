@@ -0,0 +1,37 @@
+package jobs
+
+import (
+	"context"
+
+	"woragis-jobs-service/internal/domains/jobapplications"
+	"woragis-jobs-service/internal/domains/jobwebsites"
+)
+
+// postingStrategyProvider exposes the scraping config of job websites to the from-url
+// quick-create of the job applications domain.
+type postingStrategyProvider struct {
+	service jobwebsites.Service
+}
+
+func newPostingStrategyProvider(service jobwebsites.Service) jobapplications.PostingStrategyProvider {
+	return &postingStrategyProvider{service: service}
+}
+
+// GetPostingStrategy returns nil without an error for websites that are not configured
+// or have no scraping config.
+func (p *postingStrategyProvider) GetPostingStrategy(ctx context.Context, website string) (*jobapplications.PostingStrategy, error) {
+	jobWebsite, err := p.service.GetJobWebsiteByName(ctx, website)
+	if err != nil {
+		if domainErr, ok := jobwebsites.AsDomainError(err); ok && domainErr.Code == jobwebsites.ErrCodeNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if jobWebsite.ScrapingConfig == nil {
+		return nil, nil
+	}
+	return &jobapplications.PostingStrategy{
+		Strategy:  jobWebsite.ScrapingConfig.Strategy,
+		Selectors: jobWebsite.ScrapingConfig.Selectors,
+	}, nil
+}
@@ -0,0 +1,143 @@
+package aiservice
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	appmetrics "woragis-jobs-service/pkg/metrics"
+)
+
+// ErrCircuitOpen is returned without contacting the AI service while the circuit breaker is open
+var ErrCircuitOpen = errors.New("aiservice: circuit breaker open, AI service temporarily unavailable")
+
+const (
+	defaultBreakerThreshold = 5
+	defaultBreakerCooldown  = 30 * time.Second
+)
+
+// BreakerState is the current state of the circuit breaker
+type BreakerState int
+
+const (
+	// BreakerClosed lets every request through
+	BreakerClosed BreakerState = iota
+	// BreakerHalfOpen lets a single probe request through to test recovery
+	BreakerHalfOpen
+	// BreakerOpen rejects every request until the cooldown elapses
+	BreakerOpen
+)
+
+// String returns the lowercase name of the state
+func (s BreakerState) String() string {
+	switch s {
+	case BreakerHalfOpen:
+		return "half-open"
+	case BreakerOpen:
+		return "open"
+	default:
+		return "closed"
+	}
+}
+
+// circuitBreaker opens after a number of consecutive failures and
+// half-opens after a cooldown to probe whether the service has recovered
+type circuitBreaker struct {
+	mu            sync.Mutex
+	state         BreakerState
+	failures      int
+	threshold     int
+	cooldown      time.Duration
+	openedAt      time.Time
+	probeInFlight bool
+	now           func() time.Time
+}
+
+func newCircuitBreaker(threshold int, cooldown time.Duration) *circuitBreaker {
+	if threshold <= 0 {
+		threshold = defaultBreakerThreshold
+	}
+	if cooldown <= 0 {
+		cooldown = defaultBreakerCooldown
+	}
+	b := &circuitBreaker{
+		threshold: threshold,
+		cooldown:  cooldown,
+		now:       time.Now,
+	}
+	appmetrics.SetAIServiceBreakerState(int(BreakerClosed))
+	return b
+}
+
+// allow reports whether a request may proceed
+func (b *circuitBreaker) allow() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case BreakerOpen:
+		if b.now().Sub(b.openedAt) < b.cooldown {
+			return ErrCircuitOpen
+		}
+		b.setState(BreakerHalfOpen)
+		b.probeInFlight = true
+		return nil
+	case BreakerHalfOpen:
+		if b.probeInFlight {
+			return ErrCircuitOpen
+		}
+		b.probeInFlight = true
+		return nil
+	}
+	return nil
+}
+
+// recordSuccess closes the breaker and resets the failure count
+func (b *circuitBreaker) recordSuccess() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.failures = 0
+	b.probeInFlight = false
+	if b.state != BreakerClosed {
+		b.setState(BreakerClosed)
+	}
+}
+
+// recordFailure counts a failure and opens the breaker when the threshold is reached
+// or when the half-open probe fails
+func (b *circuitBreaker) recordFailure() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.failures++
+	b.probeInFlight = false
+	if b.state == BreakerHalfOpen || b.failures >= b.threshold {
+		b.openedAt = b.now()
+		b.setState(BreakerOpen)
+	}
+}
+
+// releaseProbe frees the half-open probe slot without recording an outcome,
+// for requests that ended without telling us anything about the service
+// (e.g. the caller cancelled). The next request becomes the new probe.
+func (b *circuitBreaker) releaseProbe() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state == BreakerHalfOpen {
+		b.probeInFlight = false
+	}
+}
+
+// State returns the current breaker state
+func (b *circuitBreaker) State() BreakerState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.state
+}
+
+func (b *circuitBreaker) setState(state BreakerState) {
+	b.state = state
+	appmetrics.SetAIServiceBreakerState(int(state))
+}
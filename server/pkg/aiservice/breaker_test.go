@@ -0,0 +1,149 @@
+package aiservice
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCircuitBreaker_OpensAfterThreshold(t *testing.T) {
+	b := newCircuitBreaker(3, time.Minute)
+
+	for i := 0; i < 3; i++ {
+		require.NoError(t, b.allow())
+		b.recordFailure()
+	}
+
+	assert.Equal(t, BreakerOpen, b.State())
+	assert.ErrorIs(t, b.allow(), ErrCircuitOpen)
+}
+
+func TestCircuitBreaker_SuccessResetsFailures(t *testing.T) {
+	b := newCircuitBreaker(2, time.Minute)
+
+	b.recordFailure()
+	b.recordSuccess()
+	b.recordFailure()
+
+	assert.Equal(t, BreakerClosed, b.State())
+}
+
+func TestCircuitBreaker_HalfOpensAfterCooldown(t *testing.T) {
+	now := time.Now()
+	b := newCircuitBreaker(1, 10*time.Second)
+	b.now = func() time.Time { return now }
+
+	b.recordFailure()
+	assert.ErrorIs(t, b.allow(), ErrCircuitOpen)
+
+	now = now.Add(11 * time.Second)
+	require.NoError(t, b.allow(), "first request after cooldown should probe")
+	assert.Equal(t, BreakerHalfOpen, b.State())
+	assert.ErrorIs(t, b.allow(), ErrCircuitOpen, "only one probe at a time")
+
+	b.recordSuccess()
+	assert.Equal(t, BreakerClosed, b.State())
+	assert.NoError(t, b.allow())
+}
+
+func TestCircuitBreaker_FailedProbeReopens(t *testing.T) {
+	now := time.Now()
+	b := newCircuitBreaker(1, 10*time.Second)
+	b.now = func() time.Time { return now }
+
+	b.recordFailure()
+	now = now.Add(11 * time.Second)
+	require.NoError(t, b.allow())
+
+	b.recordFailure()
+	assert.Equal(t, BreakerOpen, b.State())
+	assert.ErrorIs(t, b.allow(), ErrCircuitOpen)
+}
+
+func TestClient_ChatFastFailsWhenOpen(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions(server.URL, ClientOptions{BreakerThreshold: 2, BreakerCooldown: time.Minute})
+
+	for i := 0; i < 2; i++ {
+		_, err := client.Chat(context.Background(), ChatRequest{Agent: "test", Input: "hi"})
+		require.Error(t, err)
+	}
+
+	_, err := client.Chat(context.Background(), ChatRequest{Agent: "test", Input: "hi"})
+	assert.True(t, errors.Is(err, ErrCircuitOpen))
+	assert.Equal(t, 2, calls)
+}
+
+func TestCircuitBreaker_ReleasedProbeAllowsNextProbe(t *testing.T) {
+	now := time.Now()
+	b := newCircuitBreaker(1, 10*time.Second)
+	b.now = func() time.Time { return now }
+
+	b.recordFailure()
+	now = now.Add(11 * time.Second)
+	require.NoError(t, b.allow())
+
+	b.releaseProbe()
+	assert.Equal(t, BreakerHalfOpen, b.State())
+	assert.NoError(t, b.allow(), "a released probe should let the next request probe")
+}
+
+func TestClient_CancelledProbeDoesNotWedgeBreaker(t *testing.T) {
+	var healthy atomic.Bool
+	started := make(chan struct{}, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req ChatRequest
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		switch {
+		case !healthy.Load():
+			w.WriteHeader(http.StatusBadGateway)
+		case req.Input == "hang":
+			started <- struct{}{}
+			<-r.Context().Done()
+		default:
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"output":"ok"}`))
+		}
+	}))
+	defer server.Close()
+
+	now := time.Now()
+	client := NewClientWithOptions(server.URL, ClientOptions{BreakerThreshold: 1, BreakerCooldown: 10 * time.Second})
+	client.breaker.now = func() time.Time { return now }
+
+	_, err := client.Chat(context.Background(), ChatRequest{Agent: "test", Input: "hi"})
+	require.Error(t, err)
+	require.Equal(t, BreakerOpen, client.BreakerState())
+
+	// The probe after the cooldown is cancelled by its caller mid-flight
+	healthy.Store(true)
+	now = now.Add(11 * time.Second)
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-started
+		cancel()
+	}()
+	_, err = client.Chat(ctx, ChatRequest{Agent: "test", Input: "hang"})
+	require.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, BreakerHalfOpen, client.BreakerState())
+
+	// The next request must be allowed to probe and close the breaker
+	resp, err := client.Chat(context.Background(), ChatRequest{Agent: "test", Input: "hi"})
+	require.NoError(t, err)
+	assert.Equal(t, "ok", resp.Output)
+	assert.Equal(t, BreakerClosed, client.BreakerState())
+}
@@ -4,26 +4,108 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
 	"time"
+	"unicode"
+	"unicode/utf8"
 )
 
+// DefaultMaxResponseBytes caps AI service response bodies when ClientOptions.MaxResponseBytes is unset
+const DefaultMaxResponseBytes int64 = 1 << 20 // 1MB
+
+// DefaultTimeout bounds a single AI service call when ClientOptions.Timeout is unset
+const DefaultTimeout = 60 * time.Second
+
+// ErrResponseTooLarge is returned when the AI service sends a body larger than the configured maximum
+var ErrResponseTooLarge = errors.New("aiservice: response exceeds maximum size")
+
+// ErrMalformedResponse is returned when the AI service sends a body that is not a chat response,
+// or whose output has no usable text once invalid bytes are dropped
+var ErrMalformedResponse = errors.New("aiservice: malformed response")
+
 // Client is an HTTP client for the AI Service
 type Client struct {
-	baseURL    string
-	httpClient *http.Client
+	baseURL          string
+	httpClient       *http.Client
+	apiKeyHeader     string
+	apiKey           string
+	breaker          *circuitBreaker
+	limiter          *concurrencyLimiter
+	maxResponseBytes int64
+	// agentTemperatures holds the default temperature per agent
+	agentTemperatures map[string]float64
+}
+
+// ClientOptions configures optional behaviour of the AI Service client
+type ClientOptions struct {
+	// APIKeyHeader is the header name used to send APIKey (e.g. "X-API-Key" or "Authorization")
+	APIKeyHeader string
+	// APIKey is attached to every request when set (use "Bearer <token>" with the Authorization header)
+	APIKey string
+	// BreakerThreshold is the number of consecutive failures that opens the circuit breaker (default 5)
+	BreakerThreshold int
+	// BreakerCooldown is how long the breaker stays open before a probe request is allowed (default 30s)
+	BreakerCooldown time.Duration
+	// MaxResponseBytes is the largest response body the client will buffer (default DefaultMaxResponseBytes)
+	MaxResponseBytes int64
+	// Timeout bounds each AI service call, including reading the response (default DefaultTimeout)
+	Timeout time.Duration
+	// MaxConcurrentRequests caps the calls in flight at once; further calls wait for a slot
+	// (default 0, no limit)
+	MaxConcurrentRequests int
+	// ConcurrencyWait is how long a call waits for a slot before failing with
+	// ErrConcurrencyLimit; zero fails immediately when the limit is reached
+	ConcurrencyWait time.Duration
+	// AgentTemperatures is the default temperature per agent, used when a ChatRequest
+	// leaves Temperature unset
+	AgentTemperatures map[string]float64
 }
 
 // NewClient creates a new AI Service client
 func NewClient(baseURL string) *Client {
+	return NewClientWithOptions(baseURL, ClientOptions{})
+}
+
+// NewClientWithOptions creates a new AI Service client with the given options
+func NewClientWithOptions(baseURL string, opts ClientOptions) *Client {
+	maxResponseBytes := opts.MaxResponseBytes
+	if maxResponseBytes <= 0 {
+		maxResponseBytes = DefaultMaxResponseBytes
+	}
+	timeout := opts.Timeout
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+
 	return &Client{
-		baseURL: baseURL,
+		baseURL: strings.TrimSuffix(baseURL, "/"),
 		httpClient: &http.Client{
-			Timeout: 60 * time.Second, // AI requests can take longer
+			Timeout: timeout, // AI requests can take longer than regular requests
 		},
+		apiKeyHeader:      opts.APIKeyHeader,
+		apiKey:            opts.APIKey,
+		breaker:           newCircuitBreaker(opts.BreakerThreshold, opts.BreakerCooldown),
+		limiter:           newConcurrencyLimiter(opts.MaxConcurrentRequests, opts.ConcurrencyWait),
+		maxResponseBytes:  maxResponseBytes,
+		agentTemperatures: opts.AgentTemperatures,
+	}
+}
+
+// BreakerState returns the current state of the client's circuit breaker
+func (c *Client) BreakerState() BreakerState {
+	return c.breaker.State()
+}
+
+// setAuthHeader attaches the configured API key, if any, to the request
+func (c *Client) setAuthHeader(req *http.Request) {
+	if c.apiKey == "" || c.apiKeyHeader == "" {
+		return
 	}
+	req.Header.Set(c.apiKeyHeader, c.apiKey)
 }
 
 // ChatRequest represents a chat request to the AI service
@@ -51,6 +133,10 @@ type ChatResponse struct {
 func (c *Client) Chat(ctx context.Context, req ChatRequest) (*ChatResponse, error) {
 	url := fmt.Sprintf("%s/v1/chat", c.baseURL)
 
+	if err := c.applyTemperature(&req); err != nil {
+		return nil, err
+	}
+
 	jsonData, err := json.Marshal(req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal request: %w", err)
@@ -62,30 +148,102 @@ func (c *Client) Chat(ctx context.Context, req ChatRequest) (*ChatResponse, erro
 	}
 
 	httpReq.Header.Set("Content-Type", "application/json")
+	c.setAuthHeader(httpReq)
+
+	// Take a concurrency slot before consulting the breaker, so a call that cannot get
+	// one never holds the half-open probe
+	release, err := c.limiter.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	if err := c.breaker.allow(); err != nil {
+		return nil, err
+	}
 
 	resp, err := c.httpClient.Do(httpReq)
 	if err != nil {
+		// A caller giving up is not a sign the AI service is unhealthy, but a
+		// cancelled probe must still give up its slot or the breaker never closes
+		if errors.Is(err, context.Canceled) {
+			c.breaker.releaseProbe()
+		} else {
+			c.breaker.recordFailure()
+		}
 		return nil, fmt.Errorf("failed to send request: %w", err)
 	}
 	defer resp.Body.Close()
 
-	body, err := io.ReadAll(resp.Body)
+	body, err := c.readBody(resp)
 	if err != nil {
+		// An oversized body means the AI service is misbehaving, so it counts against the breaker
+		c.breaker.recordFailure()
+		if errors.Is(err, ErrResponseTooLarge) {
+			return nil, err
+		}
 		return nil, fmt.Errorf("failed to read response: %w", err)
 	}
 
+	if resp.StatusCode >= http.StatusInternalServerError {
+		c.breaker.recordFailure()
+	} else {
+		c.breaker.recordSuccess()
+	}
+
+	// Replace invalid UTF-8 up front so neither error messages nor the output carry it downstream
+	body = bytes.ToValidUTF8(body, []byte(string(utf8.RuneError)))
+
 	if resp.StatusCode != http.StatusOK {
 		return nil, fmt.Errorf("AI service returned status %d: %s", resp.StatusCode, string(body))
 	}
 
 	var response ChatResponse
 	if err := json.Unmarshal(body, &response); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+		return nil, fmt.Errorf("%w: %v", ErrMalformedResponse, err)
+	}
+
+	output, ok := sanitizeOutput(response.Output)
+	if !ok {
+		return nil, fmt.Errorf("%w: output contains no valid text", ErrMalformedResponse)
 	}
+	response.Output = output
 
 	return &response, nil
 }
 
+// sanitizeOutput drops NUL characters, which Postgres rejects in text columns, and replaces
+// any invalid UTF-8 left with U+FFFD. It reports false when non-empty output is left with nothing but replacement characters
+// and whitespace, since such a reply is garbage rather than text.
+func sanitizeOutput(output string) (string, bool) {
+	if output == "" {
+		return output, true
+	}
+	output = strings.ToValidUTF8(strings.ReplaceAll(output, "\x00", ""), string(utf8.RuneError))
+	usable := strings.IndexFunc(output, func(r rune) bool {
+		return r != utf8.RuneError && !unicode.IsSpace(r)
+	}) >= 0
+	return output, usable
+}
+
+// readBody reads the response body without buffering more than maxResponseBytes
+func (c *Client) readBody(resp *http.Response) ([]byte, error) {
+	if resp.ContentLength > c.maxResponseBytes {
+		return nil, fmt.Errorf("%w: %d bytes declared, limit is %d", ErrResponseTooLarge, resp.ContentLength, c.maxResponseBytes)
+	}
+
+	// Read one byte past the limit so an exactly-full body can be told apart from an oversized one
+	body, err := io.ReadAll(io.LimitReader(resp.Body, c.maxResponseBytes+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(body)) > c.maxResponseBytes {
+		return nil, fmt.Errorf("%w: limit is %d bytes", ErrResponseTooLarge, c.maxResponseBytes)
+	}
+
+	return body, nil
+}
+
 // HealthCheck checks if the AI service is healthy
 func (c *Client) HealthCheck(ctx context.Context) error {
 	url := fmt.Sprintf("%s/healthz", c.baseURL)
@@ -94,6 +252,7 @@ func (c *Client) HealthCheck(ctx context.Context) error {
 	if err != nil {
 		return fmt.Errorf("failed to create request: %w", err)
 	}
+	c.setAuthHeader(httpReq)
 
 	resp, err := c.httpClient.Do(httpReq)
 	if err != nil {
@@ -107,4 +266,3 @@ func (c *Client) HealthCheck(ctx context.Context) error {
 
 	return nil
 }
-
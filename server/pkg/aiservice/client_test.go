@@ -0,0 +1,91 @@
+package aiservice
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_ChatRejectsOversizedResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"output":"` + strings.Repeat("a", 4096) + `"}`))
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions(server.URL, ClientOptions{MaxResponseBytes: 1024})
+
+	_, err := client.Chat(context.Background(), ChatRequest{Agent: "test", Input: "hi"})
+	assert.ErrorIs(t, err, ErrResponseTooLarge)
+}
+
+func TestClient_ChatRejectsOversizedChunkedResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Flushing before writing everything forces chunked encoding, so no Content-Length is declared
+		_, _ = w.Write([]byte(`{"output":"`))
+		w.(http.Flusher).Flush()
+		_, _ = w.Write([]byte(strings.Repeat("a", 4096) + `"}`))
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions(server.URL, ClientOptions{MaxResponseBytes: 1024})
+
+	_, err := client.Chat(context.Background(), ChatRequest{Agent: "test", Input: "hi"})
+	assert.ErrorIs(t, err, ErrResponseTooLarge)
+}
+
+func TestClient_ChatAcceptsResponseAtLimit(t *testing.T) {
+	body := `{"output":"hello"}`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(body))
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions(server.URL, ClientOptions{MaxResponseBytes: int64(len(body))})
+
+	resp, err := client.Chat(context.Background(), ChatRequest{Agent: "test", Input: "hi"})
+	require.NoError(t, err)
+	assert.Equal(t, "hello", resp.Output)
+}
+
+func TestClient_ChatSanitizesInvalidUTF8(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("{\"output\":\"Dear Acme,\xff\xfe I\\u0000 am excited.\"}"))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL)
+
+	resp, err := client.Chat(context.Background(), ChatRequest{Agent: "test", Input: "hi"})
+	require.NoError(t, err)
+	assert.True(t, utf8.ValidString(resp.Output))
+	assert.Equal(t, "Dear Acme,� I am excited.", resp.Output, "a run of invalid bytes becomes one U+FFFD")
+
+	_, err = json.Marshal(resp)
+	assert.NoError(t, err)
+}
+
+func TestClient_ChatRejectsMalformedOutput(t *testing.T) {
+	for name, body := range map[string]string{
+		"not json":      "\xff\xfe\xfd",
+		"only garbage":  "{\"output\":\"\xff\xfe \xfd\"}",
+		"wrong payload": `["output"]`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(body))
+			}))
+			defer server.Close()
+
+			_, err := NewClient(server.URL).Chat(context.Background(), ChatRequest{Agent: "test", Input: "hi"})
+			assert.ErrorIs(t, err, ErrMalformedResponse)
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package aiservice
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"golang.org/x/sync/semaphore"
+
+	appmetrics "woragis-jobs-service/pkg/metrics"
+)
+
+// ErrConcurrencyLimit is returned without contacting the AI service when the client's
+// concurrent request limit is reached and no slot frees up in time
+var ErrConcurrencyLimit = errors.New("aiservice: too many concurrent AI requests")
+
+// IsUnavailable reports whether err means the AI service was not called because the
+// client is shedding load, either through its circuit breaker or its concurrency limit
+func IsUnavailable(err error) bool {
+	return errors.Is(err, ErrCircuitOpen) || errors.Is(err, ErrConcurrencyLimit)
+}
+
+// concurrencyLimiter caps the AI service calls a client has in flight. A nil limiter
+// only tracks the in-flight gauge.
+type concurrencyLimiter struct {
+	sem  *semaphore.Weighted
+	wait time.Duration
+}
+
+// newConcurrencyLimiter returns nil when max is not positive, which disables the limit.
+// Callers wait up to wait for a slot; a zero wait fails fast.
+func newConcurrencyLimiter(max int, wait time.Duration) *concurrencyLimiter {
+	if max <= 0 {
+		return nil
+	}
+	if wait < 0 {
+		wait = 0
+	}
+	return &concurrencyLimiter{sem: semaphore.NewWeighted(int64(max)), wait: wait}
+}
+
+// acquire takes a slot and returns the func that frees it. It returns ErrConcurrencyLimit
+// when no slot frees up within the wait, or ctx's error if ctx ends first.
+func (l *concurrencyLimiter) acquire(ctx context.Context) (func(), error) {
+	if l != nil {
+		if err := l.take(ctx); err != nil {
+			return nil, err
+		}
+	}
+	appmetrics.IncAIServiceRequestsInFlight()
+	return func() {
+		appmetrics.DecAIServiceRequestsInFlight()
+		if l != nil {
+			l.sem.Release(1)
+		}
+	}, nil
+}
+
+func (l *concurrencyLimiter) take(ctx context.Context) error {
+	if l.sem.TryAcquire(1) {
+		return nil
+	}
+	if l.wait == 0 {
+		return ErrConcurrencyLimit
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, l.wait)
+	defer cancel()
+	if err := l.sem.Acquire(waitCtx, 1); err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
+		return ErrConcurrencyLimit
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+package aiservice
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newHeldServer answers chat requests once release is closed, signalling each arrival.
+func newHeldServer(t *testing.T) (*httptest.Server, chan struct{}, chan struct{}) {
+	t.Helper()
+	arrived := make(chan struct{}, 10)
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		arrived <- struct{}{}
+		<-release
+		_, _ = w.Write([]byte(`{"output":"hello"}`))
+	}))
+	t.Cleanup(server.Close)
+	return server, arrived, release
+}
+
+func TestClient_ChatFailsFastAtConcurrencyLimit(t *testing.T) {
+	server, arrived, release := newHeldServer(t)
+	client := NewClientWithOptions(server.URL, ClientOptions{MaxConcurrentRequests: 1})
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := client.Chat(context.Background(), ChatRequest{Agent: "test", Input: "hi"})
+		done <- err
+	}()
+	<-arrived
+
+	_, err := client.Chat(context.Background(), ChatRequest{Agent: "test", Input: "hi"})
+	assert.ErrorIs(t, err, ErrConcurrencyLimit)
+	assert.True(t, IsUnavailable(err))
+
+	close(release)
+	require.NoError(t, <-done)
+
+	_, err = client.Chat(context.Background(), ChatRequest{Agent: "test", Input: "hi"})
+	assert.NoError(t, err, "the slot is freed once the first call returns")
+}
+
+func TestClient_ChatQueuesForASlot(t *testing.T) {
+	server, arrived, release := newHeldServer(t)
+	client := NewClientWithOptions(server.URL, ClientOptions{MaxConcurrentRequests: 1, ConcurrencyWait: 5 * time.Second})
+
+	done := make(chan error, 2)
+	for i := 0; i < 2; i++ {
+		go func() {
+			_, err := client.Chat(context.Background(), ChatRequest{Agent: "test", Input: "hi"})
+			done <- err
+		}()
+	}
+	<-arrived
+	select {
+	case <-arrived:
+		t.Fatal("second call reached the AI service while the first held the only slot")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(release)
+	require.NoError(t, <-done)
+	require.NoError(t, <-done)
+}
+
+func TestClient_ChatGivesUpWaitingForASlot(t *testing.T) {
+	server, arrived, release := newHeldServer(t)
+	defer close(release)
+	client := NewClientWithOptions(server.URL, ClientOptions{MaxConcurrentRequests: 1, ConcurrencyWait: 20 * time.Millisecond})
+
+	go func() { _, _ = client.Chat(context.Background(), ChatRequest{Agent: "test", Input: "hi"}) }()
+	<-arrived
+
+	_, err := client.Chat(context.Background(), ChatRequest{Agent: "test", Input: "hi"})
+	assert.ErrorIs(t, err, ErrConcurrencyLimit)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = client.Chat(ctx, ChatRequest{Agent: "test", Input: "hi"})
+	assert.ErrorIs(t, err, context.Canceled, "a caller giving up is not reported as the limit")
+}
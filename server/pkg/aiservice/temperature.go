@@ -0,0 +1,48 @@
+package aiservice
+
+import (
+	"errors"
+	"fmt"
+)
+
+// MinTemperature and MaxTemperature bound the sampling temperature the AI service accepts
+const (
+	MinTemperature = 0.0
+	MaxTemperature = 2.0
+)
+
+// ErrInvalidTemperature is returned without contacting the AI service when a request's
+// temperature is outside [MinTemperature, MaxTemperature]
+var ErrInvalidTemperature = errors.New("aiservice: temperature out of range")
+
+// ValidateTemperature reports whether t is within [MinTemperature, MaxTemperature]
+func ValidateTemperature(t float64) error {
+	if !(t >= MinTemperature && t <= MaxTemperature) { // Also rejects NaN
+		return fmt.Errorf("%w: must be between %g and %g, got %g", ErrInvalidTemperature, MinTemperature, MaxTemperature, t)
+	}
+	return nil
+}
+
+// AgentTemperature returns the configured default temperature for agent. It is safe to
+// call on a nil client.
+func (c *Client) AgentTemperature(agent string) (float64, bool) {
+	if c == nil {
+		return 0, false
+	}
+	t, ok := c.agentTemperatures[agent]
+	return t, ok
+}
+
+// applyTemperature fills in the agent's default temperature when the request leaves it
+// unset, so an explicit per-request temperature always wins, and validates the result
+func (c *Client) applyTemperature(req *ChatRequest) error {
+	if req.Temperature == nil {
+		if t, ok := c.AgentTemperature(req.Agent); ok {
+			req.Temperature = &t
+		}
+	}
+	if req.Temperature == nil {
+		return nil
+	}
+	return ValidateTemperature(*req.Temperature)
+}
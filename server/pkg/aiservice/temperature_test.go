@@ -0,0 +1,54 @@
+package aiservice
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateTemperature(t *testing.T) {
+	for _, valid := range []float64{0, 0.7, 2} {
+		assert.NoError(t, ValidateTemperature(valid), valid)
+	}
+	for _, invalid := range []float64{-0.1, 2.01, math.NaN()} {
+		assert.ErrorIs(t, ValidateTemperature(invalid), ErrInvalidTemperature, invalid)
+	}
+}
+
+func TestClient_ChatAppliesAgentTemperature(t *testing.T) {
+	var sent []*float64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req ChatRequest
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		sent = append(sent, req.Temperature)
+		_, _ = w.Write([]byte(`{"output":"hello"}`))
+	}))
+	defer server.Close()
+	client := NewClientWithOptions(server.URL, ClientOptions{AgentTemperatures: map[string]float64{"resume_bullets": 0.3}})
+
+	_, err := client.Chat(context.Background(), ChatRequest{Agent: "resume_bullets", Input: "hi"})
+	require.NoError(t, err)
+	override := 1.2
+	_, err = client.Chat(context.Background(), ChatRequest{Agent: "resume_bullets", Input: "hi", Temperature: &override})
+	require.NoError(t, err)
+	_, err = client.Chat(context.Background(), ChatRequest{Agent: "other", Input: "hi"})
+	require.NoError(t, err)
+
+	require.Len(t, sent, 3)
+	require.NotNil(t, sent[0])
+	assert.Equal(t, 0.3, *sent[0])
+	require.NotNil(t, sent[1])
+	assert.Equal(t, 1.2, *sent[1], "a per-request temperature wins over the agent default")
+	assert.Nil(t, sent[2], "agents without a default leave the AI service's own")
+
+	tooHot := 2.5
+	_, err = client.Chat(context.Background(), ChatRequest{Agent: "resume_bullets", Input: "hi", Temperature: &tooHot})
+	assert.ErrorIs(t, err, ErrInvalidTemperature)
+	assert.Len(t, sent, 3, "an invalid temperature is rejected before calling the AI service")
+}
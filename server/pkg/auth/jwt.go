@@ -14,6 +14,7 @@ import (
 var (
 	ErrTokenInvalid = errors.New("invalid or expired token")
 	ErrTokenExpired = errors.New("token has expired")
+	ErrTokenRevoked = errors.New("token has been revoked")
 )
 
 type Claims struct {
@@ -104,7 +105,7 @@ func (j *JWTManager) Validate(tokenString string) (*Claims, error) {
 		
 		blacklisted, err := j.redisClient.Exists(ctx, fmt.Sprintf("token:blacklist:%s", tokenString)).Result()
 		if err == nil && blacklisted > 0 {
-			return nil, ErrTokenInvalid
+			return nil, ErrTokenRevoked
 		}
 	}
 
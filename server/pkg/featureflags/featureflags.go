@@ -0,0 +1,159 @@
+// Package featureflags gates features per request. Flags roll out to a percentage of
+// users from configuration, and individual users can be switched on or off through
+// overrides stored in Redis. Middleware evaluates the flags once per request and
+// stores them in the request context, where handlers and services read them with
+// Enabled.
+package featureflags
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"log/slog"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+// Flags is the set of flags evaluated for one request, keyed by name. Flags that are
+// not listed are off.
+type Flags map[string]bool
+
+type flagsKeyType struct{}
+
+// flagsKey is the context key for the evaluated flags. The same key is used for Fiber
+// locals so the flags are reachable from both c.UserContext() and c.Context().
+var flagsKey = flagsKeyType{}
+
+// WithFlags returns a context carrying flags.
+func WithFlags(ctx context.Context, flags Flags) context.Context {
+	return context.WithValue(ctx, flagsKey, flags)
+}
+
+// FromContext returns the flags carried by ctx, or nil outside a request scope.
+func FromContext(ctx context.Context) Flags {
+	if ctx == nil {
+		return nil
+	}
+	flags, _ := ctx.Value(flagsKey).(Flags)
+	return flags
+}
+
+// Enabled reports whether the named flag is on for the request ctx belongs to. It is
+// false when no flags were loaded, so gated code stays off by default.
+func Enabled(ctx context.Context, name string) bool {
+	return FromContext(ctx)[name]
+}
+
+// OverrideStore returns per-user flag overrides; true forces a flag on and false
+// forces it off regardless of its rollout percentage.
+type OverrideStore interface {
+	Overrides(ctx context.Context, userID uuid.UUID) (map[string]bool, error)
+}
+
+// Evaluator decides which flags are on for a user.
+type Evaluator struct {
+	rollouts  map[string]int
+	overrides OverrideStore
+	logger    *slog.Logger
+}
+
+// NewEvaluator creates an evaluator for the given rollout percentages (0-100) keyed by
+// flag name. overrides may be nil.
+func NewEvaluator(rollouts map[string]int, overrides OverrideStore, logger *slog.Logger) (*Evaluator, error) {
+	for name, percentage := range rollouts {
+		if percentage < 0 || percentage > 100 {
+			return nil, fmt.Errorf("feature flag %q: percentage must be between 0 and 100, got %d", name, percentage)
+		}
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &Evaluator{rollouts: rollouts, overrides: overrides, logger: logger}, nil
+}
+
+// Evaluate returns the flags for userID. Anonymous requests (uuid.Nil) only get flags
+// rolled out to everyone. When the override store fails, the rollout percentages
+// still apply.
+func (e *Evaluator) Evaluate(ctx context.Context, userID uuid.UUID) Flags {
+	flags := make(Flags, len(e.rollouts))
+	for name, percentage := range e.rollouts {
+		if userID == uuid.Nil {
+			flags[name] = percentage == 100
+			continue
+		}
+		flags[name] = bucket(name, userID) < percentage
+	}
+
+	if e.overrides == nil || userID == uuid.Nil {
+		return flags
+	}
+	overrides, err := e.overrides.Overrides(ctx, userID)
+	if err != nil {
+		e.logger.WarnContext(ctx, "failed to load feature flag overrides", "user_id", userID.String(), "error", err)
+		return flags
+	}
+	for name, enabled := range overrides {
+		flags[name] = enabled
+	}
+	return flags
+}
+
+// bucket places a user in 0-99 for a flag. Hashing the flag name too means the same
+// users are not always first in line for every rollout.
+func bucket(name string, userID uuid.UUID) int {
+	h := fnv.New32a()
+	h.Write([]byte(name))
+	h.Write([]byte{':'})
+	h.Write(userID[:])
+	return int(h.Sum32() % 100)
+}
+
+// Middleware evaluates the flags of the authenticated user and stores them in the
+// request context. It must run after the JWT middleware so the user is known.
+func Middleware(evaluator *Evaluator) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		userID, _ := c.Locals("userID").(uuid.UUID)
+		flags := evaluator.Evaluate(c.UserContext(), userID)
+		c.Locals(flagsKey, flags)
+		c.SetUserContext(WithFlags(c.UserContext(), flags))
+		return c.Next()
+	}
+}
+
+// redisOverridesKeyPrefix prefixes the Redis hash holding a user's overrides, e.g.
+// HSET feature_flags:user:<id> structured_cover_letters true
+const redisOverridesKeyPrefix = "feature_flags:user:"
+
+type redisOverrides struct {
+	client *redis.Client
+	logger *slog.Logger
+}
+
+// NewRedisOverrideStore reads per-user overrides from the feature_flags:user:<id> hash,
+// whose fields are flag names and values "true" or "false".
+func NewRedisOverrideStore(client *redis.Client, logger *slog.Logger) OverrideStore {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &redisOverrides{client: client, logger: logger}
+}
+
+func (r *redisOverrides) Overrides(ctx context.Context, userID uuid.UUID) (map[string]bool, error) {
+	values, err := r.client.HGetAll(ctx, redisOverridesKeyPrefix+userID.String()).Result()
+	if err != nil {
+		return nil, err
+	}
+	overrides := make(map[string]bool, len(values))
+	for name, raw := range values {
+		enabled, err := strconv.ParseBool(raw)
+		if err != nil {
+			r.logger.WarnContext(ctx, "ignoring invalid feature flag override", "user_id", userID.String(), "flag", name, "value", raw)
+			continue
+		}
+		overrides[name] = enabled
+	}
+	return overrides, nil
+}
@@ -0,0 +1,86 @@
+package featureflags
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeOverrides struct {
+	overrides map[string]bool
+	err       error
+}
+
+func (f fakeOverrides) Overrides(context.Context, uuid.UUID) (map[string]bool, error) {
+	return f.overrides, f.err
+}
+
+func TestEvaluator_Rollouts(t *testing.T) {
+	evaluator, err := NewEvaluator(map[string]int{"everyone": 100, "nobody": 0, "half": 50}, nil, nil)
+	require.NoError(t, err)
+
+	enabled := 0
+	for i := 0; i < 1000; i++ {
+		userID := uuid.New()
+		flags := evaluator.Evaluate(context.Background(), userID)
+		assert.True(t, flags["everyone"])
+		assert.False(t, flags["nobody"])
+		assert.Equal(t, flags["half"], evaluator.Evaluate(context.Background(), userID)["half"], "stable per user")
+		if flags["half"] {
+			enabled++
+		}
+	}
+	assert.InDelta(t, 500, enabled, 80)
+
+	anonymous := evaluator.Evaluate(context.Background(), uuid.Nil)
+	assert.True(t, anonymous["everyone"])
+	assert.False(t, anonymous["half"], "percentage rollouts need a user")
+
+	_, err = NewEvaluator(map[string]int{"broken": 101}, nil, nil)
+	assert.Error(t, err)
+}
+
+func TestEvaluator_Overrides(t *testing.T) {
+	rollouts := map[string]int{"beta": 0, "stable": 100}
+	evaluator, err := NewEvaluator(rollouts, fakeOverrides{overrides: map[string]bool{"beta": true, "stable": false}}, nil)
+	require.NoError(t, err)
+	flags := evaluator.Evaluate(context.Background(), uuid.New())
+	assert.True(t, flags["beta"])
+	assert.False(t, flags["stable"])
+
+	evaluator, err = NewEvaluator(rollouts, fakeOverrides{err: errors.New("redis down")}, nil)
+	require.NoError(t, err)
+	flags = evaluator.Evaluate(context.Background(), uuid.New())
+	assert.False(t, flags["beta"])
+	assert.True(t, flags["stable"], "rollouts still apply when overrides fail")
+}
+
+func TestMiddleware(t *testing.T) {
+	evaluator, err := NewEvaluator(map[string]int{"beta": 0}, fakeOverrides{overrides: map[string]bool{"beta": true}}, nil)
+	require.NoError(t, err)
+
+	app := fiber.New()
+	app.Use(func(c *fiber.Ctx) error {
+		c.Locals("userID", uuid.New())
+		return c.Next()
+	})
+	app.Use(Middleware(evaluator))
+	app.Get("/", func(c *fiber.Ctx) error {
+		assert.True(t, Enabled(c.UserContext(), "beta"))
+		assert.True(t, Enabled(c.Context(), "beta"), "handlers may pass either context")
+		assert.False(t, Enabled(c.Context(), "unknown"))
+		return c.SendStatus(fiber.StatusNoContent)
+	})
+
+	resp, err := app.Test(httptest.NewRequest("GET", "/", nil))
+	require.NoError(t, err)
+	assert.Equal(t, fiber.StatusNoContent, resp.StatusCode)
+
+	assert.False(t, Enabled(context.Background(), "beta"), "off outside a request")
+}
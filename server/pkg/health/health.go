@@ -3,6 +3,7 @@ package health
 import (
 	"context"
 	"log/slog"
+	"runtime"
 	"sync"
 	"time"
 
@@ -20,6 +21,9 @@ const (
 	StatusDegraded = "degraded"
 	// StatusUnhealthy indicates critical checks failed
 	StatusUnhealthy = "unhealthy"
+
+	// DefaultCacheTTL is how long a dependency check result is reused
+	DefaultCacheTTL = 2 * time.Second
 )
 
 // CheckResult represents the result of a health check
@@ -27,12 +31,37 @@ type CheckResult struct {
 	Name    string `json:"name"`
 	Status  string `json:"status"` // "ok" or "error"
 	Message string `json:"message,omitempty"`
+	// Latency is how long the check took; only the verbose response reports it
+	Latency time.Duration `json:"-"`
 }
 
 // HealthResponse represents the health check response
 type HealthResponse struct {
-	Status string        `json:"status"` // "healthy", "degraded", or "unhealthy"
-	Checks []CheckResult `json:"checks"`
+	Status    string        `json:"status"` // "healthy", "degraded", or "unhealthy"
+	Checks    []CheckResult `json:"checks"`
+	CheckedAt time.Time     `json:"checkedAt"` // When the dependencies were last probed
+}
+
+// DetailedCheckResult is a check result with its latency, for the verbose response
+type DetailedCheckResult struct {
+	CheckResult
+	LatencyMs float64 `json:"latencyMs"`
+}
+
+// BuildInfo identifies the running build in the verbose response
+type BuildInfo struct {
+	Version   string `json:"version"`
+	Commit    string `json:"commit,omitempty"`
+	BuildTime string `json:"buildTime,omitempty"`
+	GoVersion string `json:"goVersion"`
+}
+
+// DetailedHealthResponse is the verbose health check response (GET /healthz?verbose=true)
+type DetailedHealthResponse struct {
+	Status    string                `json:"status"`
+	Checks    []DetailedCheckResult `json:"checks"`
+	CheckedAt time.Time             `json:"checkedAt"` // Latencies were measured at this time
+	Build     BuildInfo             `json:"build"`
 }
 
 // RabbitMQChecker is an interface for checking RabbitMQ connection
@@ -40,13 +69,25 @@ type RabbitMQChecker interface {
 	IsConnected() bool
 }
 
+// AIServiceChecker is an interface for checking the AI service
+type AIServiceChecker interface {
+	HealthCheck(ctx context.Context) error
+}
+
+// aiServiceCheckName is the one non-critical check: the AI service being down only
+// disables AI features, so it degrades the status rather than failing readiness
+const aiServiceCheckName = "ai_service"
+
 // HealthChecker manages health checks for dependencies
 type HealthChecker struct {
 	db            *gorm.DB
 	redisClient   *redis.Client
 	rabbitmqCheck RabbitMQChecker
+	aiCheck       AIServiceChecker
+	build         BuildInfo
 	logger        *slog.Logger
 	mu            sync.RWMutex
+	refreshMu     sync.Mutex // Serializes dependency probes so concurrent callers share one
 	cache         *HealthResponse
 	lastCheck     time.Time
 	cacheTTL      time.Duration
@@ -58,10 +99,44 @@ func NewHealthChecker(db *gorm.DB, redisClient *redis.Client, logger *slog.Logge
 		db:          db,
 		redisClient: redisClient,
 		logger:      logger,
-		cacheTTL:    5 * time.Second, // Cache results for 5 seconds
+		cacheTTL:    DefaultCacheTTL,
+		build:       BuildInfo{GoVersion: runtime.Version()},
 	}
 }
 
+// SetBuildInfo sets the build reported by the verbose response. GoVersion defaults to
+// the running Go version.
+func (h *HealthChecker) SetBuildInfo(build BuildInfo) {
+	if build.GoVersion == "" {
+		build.GoVersion = runtime.Version()
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.build = build
+}
+
+// SetCacheTTL sets how long dependency check results are reused. Keep it short
+// so outages are still reported promptly; zero disables caching.
+func (h *HealthChecker) SetCacheTTL(ttl time.Duration) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.cacheTTL = ttl
+	h.cache = nil
+}
+
+// cached returns the cached result if it is still fresh
+func (h *HealthChecker) cached() (HealthResponse, bool) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	if h.cache == nil || time.Since(h.lastCheck) >= h.cacheTTL {
+		return HealthResponse{}, false
+	}
+	cached := *h.cache
+	cached.Checks = append([]CheckResult(nil), h.cache.Checks...)
+	return cached, true
+}
+
 // SetRabbitMQChecker sets the RabbitMQ checker (optional)
 func (h *HealthChecker) SetRabbitMQChecker(checker RabbitMQChecker) {
 	h.mu.Lock()
@@ -71,29 +146,48 @@ func (h *HealthChecker) SetRabbitMQChecker(checker RabbitMQChecker) {
 	h.cache = nil
 }
 
+// SetAIServiceChecker sets the AI service checker (optional)
+func (h *HealthChecker) SetAIServiceChecker(checker AIServiceChecker) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.aiCheck = checker
+	h.cache = nil
+}
+
 // Check performs all health checks
 func (h *HealthChecker) Check(ctx context.Context) HealthResponse {
 	start := time.Now()
 	checkType := "readiness" // Default to readiness check
 
-	h.mu.RLock()
 	// Return cached result if still valid
-	if h.cache != nil && time.Since(h.lastCheck) < h.cacheTTL {
-		cached := *h.cache
-		h.mu.RUnlock()
+	if cached, ok := h.cached(); ok {
 		return cached
 	}
+
+	// Only one caller probes the dependencies at a time; callers that queued
+	// behind it reuse the result it just cached
+	h.refreshMu.Lock()
+	defer h.refreshMu.Unlock()
+	if cached, ok := h.cached(); ok {
+		return cached
+	}
+
+	h.mu.RLock()
+	rabbitmqCheck, aiCheck := h.rabbitmqCheck, h.aiCheck
 	h.mu.RUnlock()
 
 	// Perform checks
 	checks := []CheckResult{
-		h.checkDatabase(ctx),
-		h.checkRedis(ctx),
+		measure(ctx, h.checkDatabase),
+		measure(ctx, h.checkRedis),
 	}
 
-	// Add RabbitMQ check if checker is available
-	if h.rabbitmqCheck != nil {
-		checks = append(checks, h.checkRabbitMQ(ctx))
+	// Add RabbitMQ and AI service checks if checkers are available
+	if rabbitmqCheck != nil {
+		checks = append(checks, measure(ctx, h.checkRabbitMQ))
+	}
+	if aiCheck != nil {
+		checks = append(checks, measure(ctx, h.checkAIService))
 	}
 
 	// Determine overall status
@@ -107,7 +201,11 @@ func (h *HealthChecker) Check(ctx context.Context) HealthResponse {
 		appmetrics.SetHealthCheckStatus(check.Name, checkType, isHealthy)
 
 		if check.Status == "error" {
-			hasErrors = true
+			if check.Name == aiServiceCheckName {
+				hasWarnings = true
+			} else {
+				hasErrors = true
+			}
 		}
 	}
 
@@ -118,8 +216,9 @@ func (h *HealthChecker) Check(ctx context.Context) HealthResponse {
 	}
 
 	response := HealthResponse{
-		Status: status,
-		Checks: checks,
+		Status:    status,
+		Checks:    checks,
+		CheckedAt: time.Now().UTC(),
 	}
 
 	// Record health check metrics
@@ -132,9 +231,11 @@ func (h *HealthChecker) Check(ctx context.Context) HealthResponse {
 	}
 	appmetrics.RecordHealthCheck(checkType, statusLabel, duration)
 
-	// Cache the result
+	// Cache the result (unhealthy results too, so probes don't pile up on a failing dependency)
 	h.mu.Lock()
-	h.cache = &response
+	cached := response
+	cached.Checks = append([]CheckResult(nil), checks...)
+	h.cache = &cached
 	h.lastCheck = time.Now()
 	h.mu.Unlock()
 
@@ -148,8 +249,10 @@ func (h *HealthChecker) LivenessCheck(ctx context.Context) HealthResponse {
 
 	// Liveness check is simple - just verify the service is responding
 	// Don't check dependencies as they might be temporarily unavailable
+	// (and it never touches the cache, so it stays cheap)
 	response := HealthResponse{
-		Status: StatusHealthy,
+		Status:    StatusHealthy,
+		CheckedAt: time.Now().UTC(),
 		Checks: []CheckResult{
 			{
 				Name:   "service",
@@ -172,6 +275,14 @@ func (h *HealthChecker) ReadinessCheck(ctx context.Context) HealthResponse {
 	return h.Check(ctx)
 }
 
+// measure runs check and records how long it took
+func measure(ctx context.Context, check func(context.Context) CheckResult) CheckResult {
+	start := time.Now()
+	result := check(ctx)
+	result.Latency = time.Since(start)
+	return result
+}
+
 // checkDatabase checks database connectivity
 func (h *HealthChecker) checkDatabase(ctx context.Context) CheckResult {
 	if h.db == nil {
@@ -278,10 +389,64 @@ func (h *HealthChecker) checkRabbitMQ(ctx context.Context) CheckResult {
 	}
 }
 
-// Handler returns a Fiber handler for the health check endpoint
+// checkAIService checks that the AI service answers its health endpoint
+func (h *HealthChecker) checkAIService(ctx context.Context) CheckResult {
+	h.mu.RLock()
+	checker := h.aiCheck
+	h.mu.RUnlock()
+
+	checkCtx, cancel := context.WithTimeout(ctx, 2*time.Second)
+	defer cancel()
+
+	if err := checker.HealthCheck(checkCtx); err != nil {
+		return CheckResult{
+			Name:    aiServiceCheckName,
+			Status:  "error",
+			Message: err.Error(),
+		}
+	}
+
+	return CheckResult{
+		Name:   aiServiceCheckName,
+		Status: "ok",
+	}
+}
+
+// Detailed returns the health check result with per-check latencies and build info.
+// It shares the cached result with Check, so latencies are from the last probe.
+func (h *HealthChecker) Detailed(ctx context.Context) DetailedHealthResponse {
+	result := h.Check(ctx)
+
+	checks := make([]DetailedCheckResult, len(result.Checks))
+	for i, check := range result.Checks {
+		checks[i] = DetailedCheckResult{
+			CheckResult: check,
+			LatencyMs:   float64(check.Latency.Microseconds()) / 1000,
+		}
+	}
+
+	h.mu.RLock()
+	build := h.build
+	h.mu.RUnlock()
+
+	return DetailedHealthResponse{
+		Status:    result.Status,
+		Checks:    checks,
+		CheckedAt: result.CheckedAt,
+		Build:     build,
+	}
+}
+
+// Handler returns a Fiber handler for the health check endpoint. ?verbose=true adds
+// per-check latencies and build info for operators; probes get the terse response.
 func (h *HealthChecker) Handler() fiber.Handler {
 	return func(c *fiber.Ctx) error {
 		ctx := c.UserContext()
+		if c.QueryBool("verbose") {
+			detailed := h.Detailed(ctx)
+			return c.Status(healthStatusCode(detailed.Status)).JSON(detailed)
+		}
+
 		result := h.Check(ctx)
 
 		// Determine HTTP status code
@@ -324,3 +489,11 @@ func (h *HealthChecker) ReadinessHandler() fiber.Handler {
 		return c.Status(statusCode).JSON(result)
 	}
 }
+
+// healthStatusCode maps an overall status to the HTTP status: only unhealthy fails
+func healthStatusCode(status string) int {
+	if status == StatusUnhealthy {
+		return fiber.StatusServiceUnavailable
+	}
+	return fiber.StatusOK
+}
@@ -0,0 +1,137 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// countingRabbitMQ counts how often the dependencies are actually probed
+type countingRabbitMQ struct {
+	calls atomic.Int32
+}
+
+func (c *countingRabbitMQ) IsConnected() bool {
+	c.calls.Add(1)
+	return true
+}
+
+func newTestChecker(ttl time.Duration) (*HealthChecker, *countingRabbitMQ) {
+	checker := NewHealthChecker(nil, nil, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	probe := &countingRabbitMQ{}
+	checker.SetRabbitMQChecker(probe)
+	checker.SetCacheTTL(ttl)
+	return checker, probe
+}
+
+func TestCheck_ReusesResultWithinTTL(t *testing.T) {
+	checker, probe := newTestChecker(time.Minute)
+
+	first := checker.Check(context.Background())
+	second := checker.Check(context.Background())
+
+	assert.Equal(t, int32(1), probe.calls.Load())
+	assert.Equal(t, first.CheckedAt, second.CheckedAt)
+	// database and redis are not configured in this test
+	assert.Equal(t, StatusUnhealthy, second.Status)
+}
+
+func TestCheck_ConcurrentCallersShareOneProbe(t *testing.T) {
+	checker, probe := newTestChecker(time.Minute)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			checker.Check(context.Background())
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(1), probe.calls.Load())
+}
+
+func TestCheck_ProbesAgainAfterTTL(t *testing.T) {
+	checker, probe := newTestChecker(20 * time.Millisecond)
+
+	checker.Check(context.Background())
+	time.Sleep(30 * time.Millisecond)
+	checker.Check(context.Background())
+
+	assert.Equal(t, int32(2), probe.calls.Load())
+}
+
+func TestCheck_ZeroTTLDisablesCaching(t *testing.T) {
+	checker, probe := newTestChecker(0)
+
+	checker.Check(context.Background())
+	checker.Check(context.Background())
+
+	assert.Equal(t, int32(2), probe.calls.Load())
+}
+
+func TestLivenessCheck_DoesNotProbeDependencies(t *testing.T) {
+	checker, probe := newTestChecker(time.Minute)
+
+	result := checker.LivenessCheck(context.Background())
+
+	assert.Equal(t, StatusHealthy, result.Status)
+	assert.Equal(t, int32(0), probe.calls.Load())
+}
+
+// failingAIService reports the AI service as down
+type failingAIService struct{}
+
+func (failingAIService) HealthCheck(context.Context) error {
+	return errors.New("connection refused")
+}
+
+func TestCheck_AIServiceFailureIsNotCritical(t *testing.T) {
+	checker := NewHealthChecker(nil, nil, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	checker.SetAIServiceChecker(failingAIService{})
+
+	result := checker.Check(context.Background())
+
+	require.Len(t, result.Checks, 3)
+	assert.Equal(t, "ai_service", result.Checks[2].Name)
+	assert.Equal(t, "error", result.Checks[2].Status)
+	assert.Equal(t, "connection refused", result.Checks[2].Message)
+	// Only database and redis (not configured here) make the service unhealthy
+	assert.Equal(t, StatusUnhealthy, result.Status)
+}
+
+func TestHandler_Verbose(t *testing.T) {
+	checker, _ := newTestChecker(time.Minute)
+	checker.SetBuildInfo(BuildInfo{Version: "1.2.3"})
+	app := fiber.New()
+	app.Get("/healthz", checker.Handler())
+
+	resp, err := app.Test(httptest.NewRequest("GET", "/healthz?verbose=true", nil))
+	require.NoError(t, err)
+	assert.Equal(t, fiber.StatusServiceUnavailable, resp.StatusCode)
+	var detailed DetailedHealthResponse
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&detailed))
+	assert.Equal(t, "1.2.3", detailed.Build.Version)
+	assert.NotEmpty(t, detailed.Build.GoVersion)
+	require.Len(t, detailed.Checks, 3)
+	assert.Equal(t, "rabbitmq", detailed.Checks[2].Name)
+
+	resp, err = app.Test(httptest.NewRequest("GET", "/healthz", nil))
+	require.NoError(t, err)
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.NotContains(t, string(body), "latencyMs", "probes get the terse response")
+	assert.NotContains(t, string(body), "build")
+}
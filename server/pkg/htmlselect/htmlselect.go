@@ -0,0 +1,260 @@
+// Package htmlselect finds elements in HTML documents using a small subset of CSS
+// selectors: compounds of a type, #id, .class and [attr] or [attr=value] parts joined
+// by descendant combinators, such as "div.job-header h1" or `meta[property="og:title"]`.
+package htmlselect
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+// MaxSelectorLength bounds the selectors Compile accepts.
+const MaxSelectorLength = 200
+
+// Selector is a compiled selector.
+type Selector struct {
+	source string
+	steps  []compound // Outermost ancestor first
+}
+
+type compound struct {
+	tag     string
+	id      string
+	classes []string
+	attrs   []attrMatch
+}
+
+type attrMatch struct {
+	name     string
+	value    string
+	hasValue bool
+}
+
+// Compile parses selector. Child, sibling and pseudo-class selectors and selector
+// lists are not supported and are reported as errors.
+func Compile(selector string) (*Selector, error) {
+	selector = strings.TrimSpace(selector)
+	if selector == "" {
+		return nil, fmt.Errorf("htmlselect: selector is empty")
+	}
+	if len(selector) > MaxSelectorLength {
+		return nil, fmt.Errorf("htmlselect: selector is longer than %d characters", MaxSelectorLength)
+	}
+
+	parts, err := splitDescendants(selector)
+	if err != nil {
+		return nil, err
+	}
+	compiled := &Selector{source: selector, steps: make([]compound, 0, len(parts))}
+	for _, part := range parts {
+		step, err := parseCompound(part)
+		if err != nil {
+			return nil, err
+		}
+		compiled.steps = append(compiled.steps, step)
+	}
+	return compiled, nil
+}
+
+// String returns the selector as given to Compile.
+func (s *Selector) String() string {
+	return s.source
+}
+
+// First returns the first element under root, in document order, that s matches,
+// or nil when none does.
+func (s *Selector) First(root *html.Node) *html.Node {
+	if root == nil {
+		return nil
+	}
+	for n := root.FirstChild; n != nil; n = n.NextSibling {
+		if n.Type == html.ElementNode && s.matches(n) {
+			return n
+		}
+		if found := s.First(n); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+// matches checks the last compound against n and the others against its ancestors.
+// Only descendant combinators exist, so taking the nearest matching ancestor is enough.
+func (s *Selector) matches(n *html.Node) bool {
+	last := len(s.steps) - 1
+	if !s.steps[last].matches(n) {
+		return false
+	}
+	step := last - 1
+	for ancestor := n.Parent; ancestor != nil && step >= 0; ancestor = ancestor.Parent {
+		if ancestor.Type == html.ElementNode && s.steps[step].matches(ancestor) {
+			step--
+		}
+	}
+	return step < 0
+}
+
+func (c compound) matches(n *html.Node) bool {
+	if c.tag != "" && c.tag != "*" && n.Data != c.tag {
+		return false
+	}
+	if c.id != "" {
+		if id, ok := Attr(n, "id"); !ok || id != c.id {
+			return false
+		}
+	}
+	if len(c.classes) > 0 {
+		class, _ := Attr(n, "class")
+		classes := strings.Fields(class)
+		for _, want := range c.classes {
+			if !contains(classes, want) {
+				return false
+			}
+		}
+	}
+	for _, attr := range c.attrs {
+		value, ok := Attr(n, attr.name)
+		if !ok || (attr.hasValue && value != attr.value) {
+			return false
+		}
+	}
+	return true
+}
+
+// Attr returns the value of n's attribute name, matched case-insensitively.
+func Attr(n *html.Node, name string) (string, bool) {
+	for _, attr := range n.Attr {
+		if strings.EqualFold(attr.Key, name) {
+			return attr.Val, true
+		}
+	}
+	return "", false
+}
+
+// InnerHTML renders the children of n.
+func InnerHTML(n *html.Node) string {
+	var buf bytes.Buffer
+	for child := n.FirstChild; child != nil; child = child.NextSibling {
+		if err := html.Render(&buf, child); err != nil {
+			return buf.String()
+		}
+	}
+	return buf.String()
+}
+
+// splitDescendants splits selector on whitespace outside attribute brackets.
+func splitDescendants(selector string) ([]string, error) {
+	var parts []string
+	var current strings.Builder
+	var quote rune
+	inBrackets := false
+	for _, r := range selector {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			}
+		case inBrackets && (r == '"' || r == '\''):
+			quote = r
+		case r == '[':
+			inBrackets = true
+		case r == ']':
+			inBrackets = false
+		case !inBrackets && strings.ContainsRune(">+~,:", r):
+			return nil, fmt.Errorf("htmlselect: %q is not supported, only descendant selectors are", string(r))
+		case !inBrackets && (r == ' ' || r == '\t' || r == '\n'):
+			if current.Len() > 0 {
+				parts = append(parts, current.String())
+				current.Reset()
+			}
+			continue
+		}
+		current.WriteRune(r)
+	}
+	if inBrackets || quote != 0 {
+		return nil, fmt.Errorf("htmlselect: unterminated attribute selector")
+	}
+	if current.Len() > 0 {
+		parts = append(parts, current.String())
+	}
+	return parts, nil
+}
+
+// parseCompound parses one compound such as `a.job-link[data-id="1"]`.
+func parseCompound(part string) (compound, error) {
+	var c compound
+	rest := part
+	if name, tail := readIdent(rest, true); name != "" {
+		c.tag = strings.ToLower(name)
+		rest = tail
+	}
+	for rest != "" {
+		switch rest[0] {
+		case '#', '.':
+			name, tail := readIdent(rest[1:], false)
+			if name == "" {
+				return c, fmt.Errorf("htmlselect: missing name after %q in %q", rest[0], part)
+			}
+			if rest[0] == '#' {
+				c.id = name
+			} else {
+				c.classes = append(c.classes, name)
+			}
+			rest = tail
+		case '[':
+			end := strings.IndexByte(rest, ']')
+			attr, err := parseAttr(rest[1:end])
+			if err != nil {
+				return c, err
+			}
+			c.attrs = append(c.attrs, attr)
+			rest = rest[end+1:]
+		default:
+			return c, fmt.Errorf("htmlselect: unexpected %q in %q", rest[0], part)
+		}
+	}
+	return c, nil
+}
+
+func parseAttr(body string) (attrMatch, error) {
+	name, value, hasValue := strings.Cut(body, "=")
+	name = strings.TrimSpace(name)
+	if ident, tail := readIdent(name, false); ident == "" || tail != "" {
+		return attrMatch{}, fmt.Errorf("htmlselect: invalid attribute name %q", name)
+	}
+	value = strings.TrimSpace(value)
+	if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+		value = value[1 : len(value)-1]
+	}
+	return attrMatch{name: name, value: value, hasValue: hasValue}, nil
+}
+
+// readIdent reads a name made of ASCII letters, digits, '-' and '_'; allowStar also
+// accepts the universal selector.
+func readIdent(s string, allowStar bool) (string, string) {
+	if allowStar && strings.HasPrefix(s, "*") {
+		return "*", s[1:]
+	}
+	i := 0
+	for i < len(s) {
+		ch := s[i]
+		if (ch >= 'a' && ch <= 'z') || (ch >= 'A' && ch <= 'Z') || (ch >= '0' && ch <= '9') || ch == '-' || ch == '_' {
+			i++
+			continue
+		}
+		break
+	}
+	return s[:i], s[i:]
+}
+
+func contains(values []string, want string) bool {
+	for _, v := range values {
+		if v == want {
+			return true
+		}
+	}
+	return false
+}
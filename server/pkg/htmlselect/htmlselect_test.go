@@ -0,0 +1,58 @@
+package htmlselect
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/html"
+)
+
+const testPage = `<html><head>
+<meta property="og:title" content="Backend Engineer">
+</head><body>
+<div class="job header"><h1 id="title">Senior Go Engineer</h1><span class="company">Acme</span></div>
+<section data-section="description"><p>Build <b>APIs</b>.</p></section>
+<span class="company">Other</span>
+</body></html>`
+
+func first(t *testing.T, selector string) *html.Node {
+	t.Helper()
+	doc, err := html.Parse(strings.NewReader(testPage))
+	require.NoError(t, err)
+	compiled, err := Compile(selector)
+	require.NoError(t, err)
+	return compiled.First(doc)
+}
+
+func TestSelector_First(t *testing.T) {
+	for selector, want := range map[string]string{
+		"h1":                                  "Senior Go Engineer",
+		"#title":                              "Senior Go Engineer",
+		"div.job.header .company":             "Acme",
+		"body span.company":                   "Acme",
+		"* h1#title":                          "Senior Go Engineer",
+		`section[data-section=description] p`: "Build <b>APIs</b>.",
+	} {
+		node := first(t, selector)
+		require.NotNil(t, node, selector)
+		assert.Equal(t, want, InnerHTML(node), selector)
+	}
+
+	node := first(t, `meta[property="og:title"]`)
+	require.NotNil(t, node)
+	content, ok := Attr(node, "content")
+	assert.True(t, ok)
+	assert.Equal(t, "Backend Engineer", content)
+
+	assert.Nil(t, first(t, "div.missing"))
+	assert.Nil(t, first(t, "section h1"), "h1 is not inside the section")
+}
+
+func TestCompile_RejectsUnsupportedSelectors(t *testing.T) {
+	for _, selector := range []string{"", "div > h1", "h1, h2", "a:hover", "li + li", "[data-x", "div..job", strings.Repeat("a", MaxSelectorLength+1)} {
+		_, err := Compile(selector)
+		assert.Error(t, err, selector)
+	}
+}
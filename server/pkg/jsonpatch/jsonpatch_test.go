@@ -0,0 +1,88 @@
+package jsonpatch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func applyPatch(t *testing.T, doc, patch string) (string, error) {
+	t.Helper()
+	p, err := Decode([]byte(patch))
+	require.NoError(t, err)
+	out, err := p.Apply([]byte(doc))
+	return string(out), err
+}
+
+func TestApply_AddReplaceRemove(t *testing.T) {
+	out, err := applyPatch(t,
+		`{"notes":"old","tags":["a"]}`,
+		`[{"op":"replace","path":"/notes","value":"new"},
+		  {"op":"add","path":"/tags/-","value":"b"},
+		  {"op":"add","path":"/tags/0","value":"z"},
+		  {"op":"add","path":"/source","value":"referral"},
+		  {"op":"remove","path":"/tags/1"}]`)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"notes":"new","tags":["z","b"],"source":"referral"}`, out)
+}
+
+func TestApply_MoveAndCopy(t *testing.T) {
+	out, err := applyPatch(t,
+		`{"a":{"b":1},"c":[]}`,
+		`[{"op":"copy","from":"/a/b","path":"/c/-"},
+		  {"op":"move","from":"/a/b","path":"/d"}]`)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"a":{},"c":[1],"d":1}`, out)
+}
+
+func TestApply_NullValueIsAllowed(t *testing.T) {
+	out, err := applyPatch(t, `{"deadline":"2024-01-01T00:00:00Z"}`, `[{"op":"replace","path":"/deadline","value":null}]`)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"deadline":null}`, out)
+}
+
+func TestApply_TestOperation(t *testing.T) {
+	_, err := applyPatch(t, `{"n":1}`, `[{"op":"test","path":"/n","value":1}]`)
+	require.NoError(t, err)
+
+	_, err = applyPatch(t, `{"n":1}`, `[{"op":"test","path":"/n","value":2}]`)
+	assert.ErrorIs(t, err, ErrTestFailed)
+}
+
+func TestApply_Errors(t *testing.T) {
+	_, err := applyPatch(t, `{}`, `[{"op":"replace","path":"/missing","value":1}]`)
+	assert.Error(t, err, "replace requires an existing member")
+
+	_, err = applyPatch(t, `{"a":[1]}`, `[{"op":"remove","path":"/a/5"}]`)
+	assert.Error(t, err)
+
+	_, err = applyPatch(t, `{"a":{"b":1}}`, `[{"op":"move","from":"/a","path":"/a/b/c"}]`)
+	assert.Error(t, err)
+}
+
+func TestApply_EscapedPointer(t *testing.T) {
+	out, err := applyPatch(t, `{"a/b":1,"c~d":2}`, `[{"op":"remove","path":"/a~1b"},{"op":"remove","path":"/c~0d"}]`)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{}`, out)
+}
+
+func TestDecode_Invalid(t *testing.T) {
+	_, err := Decode([]byte(`{"op":"add"}`))
+	assert.Error(t, err, "patch must be an array")
+
+	_, err = Decode([]byte(`[{"op":"frobnicate","path":"/a"}]`))
+	assert.Error(t, err)
+
+	_, err = Decode([]byte(`[{"op":"add","path":"/a"}]`))
+	assert.Error(t, err, "add requires a value")
+
+	_, err = Decode([]byte(`[{"op":"remove","path":"a"}]`))
+	assert.Error(t, err, "pointer must start with /")
+}
+
+func TestTopLevelMember(t *testing.T) {
+	assert.Equal(t, "tags", TopLevelMember("/tags/0"))
+	assert.Equal(t, "userId", TopLevelMember("/userId"))
+	assert.Equal(t, "", TopLevelMember(""))
+}
@@ -0,0 +1,100 @@
+package logger
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type logFieldsKeyType struct{}
+
+// logFieldsKey is the context key for request-scoped log fields. The same key is
+// used for Fiber locals so the fields are reachable from both c.UserContext()
+// and c.Context(), whichever a handler passes down to its service.
+var logFieldsKey = logFieldsKeyType{}
+
+// Common request-scoped log field names.
+const (
+	RequestIDField = "request_id"
+	UserIDField    = "user_id"
+)
+
+// logFields holds attributes attached to every log line written in a request
+// scope. It is mutable because the user and resource IDs only become known
+// after RequestLoggerMiddleware has installed it (auth runs per route group,
+// resource IDs are parsed by handlers and services).
+type logFields struct {
+	mu    sync.RWMutex
+	attrs []slog.Attr
+}
+
+func (f *logFields) set(attrs ...slog.Attr) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, attr := range attrs {
+		replaced := false
+		for i := range f.attrs {
+			if f.attrs[i].Key == attr.Key {
+				f.attrs[i] = attr
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			f.attrs = append(f.attrs, attr)
+		}
+	}
+}
+
+func (f *logFields) snapshot() []slog.Attr {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	return append([]slog.Attr(nil), f.attrs...)
+}
+
+// WithLogFields returns a context carrying a fresh set of request-scoped log
+// fields initialised with attrs. Loggers created by New add these fields to
+// every record logged with the returned context (or one derived from it).
+func WithLogFields(ctx context.Context, attrs ...slog.Attr) context.Context {
+	fields := &logFields{}
+	fields.set(attrs...)
+	return context.WithValue(ctx, logFieldsKey, fields)
+}
+
+// AddLogFields adds attrs to the request-scoped log fields carried by ctx,
+// replacing any field with the same key. It is a no-op outside a request scope.
+func AddLogFields(ctx context.Context, attrs ...slog.Attr) {
+	if fields, ok := ctx.Value(logFieldsKey).(*logFields); ok {
+		fields.set(attrs...)
+	}
+}
+
+// LogFieldsFromContext returns the request-scoped log fields carried by ctx.
+func LogFieldsFromContext(ctx context.Context) []slog.Attr {
+	if ctx == nil {
+		return nil
+	}
+	if fields, ok := ctx.Value(logFieldsKey).(*logFields); ok {
+		return fields.snapshot()
+	}
+	return nil
+}
+
+// AddFiberLogFields adds attrs to the request-scoped log fields of a Fiber request.
+func AddFiberLogFields(c *fiber.Ctx, attrs ...slog.Attr) {
+	if fields, ok := c.Locals(logFieldsKey).(*logFields); ok {
+		fields.set(attrs...)
+	}
+}
+
+// attachFiberLogFields installs request-scoped log fields on both the user
+// context and the Fiber locals of a request.
+func attachFiberLogFields(c *fiber.Ctx, attrs ...slog.Attr) {
+	ctx := WithLogFields(c.UserContext(), attrs...)
+	c.Locals(logFieldsKey, ctx.Value(logFieldsKey))
+	c.SetUserContext(ctx)
+}
@@ -0,0 +1,102 @@
+package logger
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestLogger(buf *bytes.Buffer) *slog.Logger {
+	return slog.New(&serviceHandler{
+		Handler: slog.NewJSONHandler(buf, nil),
+		service: ServiceName,
+	})
+}
+
+func decodeLines(t *testing.T, buf *bytes.Buffer) []map[string]any {
+	t.Helper()
+	var lines []map[string]any
+	scanner := bufio.NewScanner(buf)
+	for scanner.Scan() {
+		var line map[string]any
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &line))
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+func TestRequestLoggerMiddleware_EnrichesRequestScopedLogs(t *testing.T) {
+	var buf bytes.Buffer
+	logger := newTestLogger(&buf)
+
+	app := fiber.New()
+	app.Use(RequestLoggerMiddleware(logger))
+	app.Use(func(c *fiber.Ctx) error {
+		// Stand-in for auth middleware, which runs after the logger is installed
+		AddFiberLogFields(c, slog.String(UserIDField, "user-1"))
+		return c.Next()
+	})
+	app.Get("/items/:id", func(c *fiber.Ctx) error {
+		// Services receive c.Context(); fields must be reachable from it too
+		var ctx context.Context = c.Context()
+		AddLogFields(ctx, slog.String("item_id", c.Params("id")))
+		logger.InfoContext(ctx, "loading item")
+		return c.SendStatus(fiber.StatusOK)
+	})
+
+	req := httptest.NewRequest("GET", "/items/42", nil)
+	req.Header.Set("X-Request-ID", "req-123")
+	resp, err := app.Test(req)
+	require.NoError(t, err)
+	assert.Equal(t, "req-123", resp.Header.Get("X-Request-ID"))
+
+	lines := decodeLines(t, &buf)
+	require.Len(t, lines, 2)
+	for _, line := range lines {
+		assert.Equal(t, "req-123", line[RequestIDField])
+		assert.Equal(t, "user-1", line[UserIDField])
+		assert.Equal(t, "42", line["item_id"])
+	}
+	assert.Equal(t, "loading item", lines[0]["msg"])
+	assert.Equal(t, "http request", lines[1]["msg"])
+}
+
+func TestRequestLoggerMiddleware_GeneratesRequestID(t *testing.T) {
+	var buf bytes.Buffer
+	app := fiber.New()
+	app.Use(RequestLoggerMiddleware(newTestLogger(&buf)))
+	app.Get("/", func(c *fiber.Ctx) error { return c.SendStatus(fiber.StatusOK) })
+
+	resp, err := app.Test(httptest.NewRequest("GET", "/", nil))
+	require.NoError(t, err)
+	assert.NotEmpty(t, resp.Header.Get("X-Request-ID"))
+}
+
+func TestServiceHandler_ExplicitAttrWinsOverContextField(t *testing.T) {
+	var buf bytes.Buffer
+	logger := newTestLogger(&buf)
+
+	ctx := WithLogFields(context.Background(), slog.String(UserIDField, "from-context"))
+	logger.InfoContext(ctx, "explicit", slog.String(UserIDField, "explicit"))
+	logger.With("component", "test").InfoContext(ctx, "derived")
+
+	lines := decodeLines(t, &buf)
+	require.Len(t, lines, 2)
+	assert.Equal(t, "explicit", lines[0][UserIDField])
+	assert.Equal(t, "from-context", lines[1][UserIDField])
+	assert.Equal(t, ServiceName, lines[1]["service"])
+}
+
+func TestAddLogFields_NoScopeIsNoop(t *testing.T) {
+	ctx := context.Background()
+	AddLogFields(ctx, slog.String(UserIDField, "ignored"))
+	assert.Empty(t, LogFieldsFromContext(ctx))
+}
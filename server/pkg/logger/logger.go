@@ -113,7 +113,8 @@ func NewWithConfig(cfg LogConfig) *slog.Logger {
 	return slog.New(handler)
 }
 
-// serviceHandler wraps a slog.Handler to automatically add service name and trace_id
+// serviceHandler wraps a slog.Handler to automatically add service name, trace_id
+// and the request-scoped log fields carried by the context
 type serviceHandler struct {
 	slog.Handler
 	service string
@@ -130,9 +131,34 @@ func (h *serviceHandler) Handle(ctx context.Context, r slog.Record) error {
 		}
 	}
 
+	// Add request-scoped fields (request_id, user_id, resource IDs) unless the
+	// caller already logged the same key explicitly
+	if fields := LogFieldsFromContext(ctx); len(fields) > 0 {
+		present := make(map[string]bool, r.NumAttrs())
+		r.Attrs(func(a slog.Attr) bool {
+			present[a.Key] = true
+			return true
+		})
+		for _, field := range fields {
+			if !present[field.Key] {
+				r.AddAttrs(field)
+			}
+		}
+	}
+
 	return h.Handler.Handle(ctx, r)
 }
 
+// WithAttrs keeps the wrapper in place for loggers derived with Logger.With
+func (h *serviceHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &serviceHandler{Handler: h.Handler.WithAttrs(attrs), service: h.service}
+}
+
+// WithGroup keeps the wrapper in place for loggers derived with Logger.WithGroup
+func (h *serviceHandler) WithGroup(name string) slog.Handler {
+	return &serviceHandler{Handler: h.Handler.WithGroup(name), service: h.service}
+}
+
 // WithTraceID adds a trace_id to the context for distributed tracing
 func WithTraceID(ctx context.Context, traceID string) context.Context {
 	return context.WithValue(ctx, TraceIDKey, traceID)
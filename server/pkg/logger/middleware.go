@@ -50,10 +50,22 @@ func RequestIDMiddleware(logger *slog.Logger) fiber.Handler {
 
 // RequestLoggerMiddleware logs HTTP requests with trace_id.
 // This should be used after RequestIDMiddleware to ensure trace_id is available.
+//
+// It also opens the request's log scope: a request_id (taken from X-Request-ID
+// or generated) is attached to the context, and auth middleware, handlers and
+// services add user_id and resource IDs to it. Every line logged with the
+// request context, including the access log line below, carries those fields.
 func RequestLoggerMiddleware(logger *slog.Logger) fiber.Handler {
 	return func(c *fiber.Ctx) error {
 		start := time.Now()
 
+		requestID := c.Get("X-Request-ID")
+		if requestID == "" || len(requestID) > 128 {
+			requestID = uuid.New().String()
+		}
+		c.Set("X-Request-ID", requestID)
+		attachFiberLogFields(c, slog.String(RequestIDField, requestID))
+
 		// Process request
 		err := c.Next()
 
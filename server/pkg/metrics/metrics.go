@@ -12,7 +12,7 @@ var (
 			Name: "http_requests_total",
 			Help: "Total number of HTTP requests",
 		},
-		[]string{"method", "endpoint", "status"},
+		[]string{"method", "route", "status"},
 	)
 
 	// HTTPRequestDuration tracks the duration of HTTP requests in seconds
@@ -22,7 +22,7 @@ var (
 			Help:    "HTTP request duration in seconds",
 			Buckets: prometheus.DefBuckets, // Default buckets: .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10
 		},
-		[]string{"method", "endpoint"},
+		[]string{"method", "route", "status"},
 	)
 
 	// HTTPRequestsInFlight tracks the number of HTTP requests currently being processed
@@ -51,6 +51,15 @@ var (
 		},
 	)
 
+	// DatabaseRetriesTotal counts repository calls retried after a transient connection error
+	DatabaseRetriesTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "database_retries_total",
+			Help: "Total number of database operations retried after a transient connection error",
+		},
+		[]string{"operation"},
+	)
+
 	// ExternalAPIRequestsTotal counts the total number of external API requests
 	ExternalAPIRequestsTotal = promauto.NewCounterVec(
 		prometheus.CounterOpts{
@@ -98,6 +107,49 @@ var (
 		[]string{"check_name", "check_type"},
 	)
 
+	// AIServiceBreakerState tracks the AI service circuit breaker (0 = closed, 1 = half-open, 2 = open)
+	AIServiceBreakerState = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "ai_service_circuit_breaker_state",
+			Help: "Current AI service circuit breaker state (0 = closed, 1 = half-open, 2 = open)",
+		},
+	)
+
+	// AIServiceRequestsInFlight tracks the number of AI service calls currently in progress
+	AIServiceRequestsInFlight = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "ai_service_requests_in_flight",
+			Help: "Number of AI service requests currently in progress",
+		},
+	)
+
+	// ResumeJobsReapedTotal counts resume generation jobs failed for staying in processing too long
+	ResumeJobsReapedTotal = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "resume_jobs_reaped_total",
+			Help: "Total number of resume generation jobs failed after exceeding the processing timeout",
+		},
+	)
+
+	// ResumeJobsFinishedTotal counts resume generation jobs reaching a terminal status
+	ResumeJobsFinishedTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "resume_jobs_finished_total",
+			Help: "Total number of resume generation jobs that completed or failed",
+		},
+		[]string{"status", "error_code"}, // error_code is empty for completed jobs
+	)
+
+	// ResumeJobDuration tracks the time from creating a resume generation job to its terminal status in seconds
+	ResumeJobDuration = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "resume_job_duration_seconds",
+			Help:    "Time from resume generation job creation to completion or failure in seconds",
+			Buckets: []float64{1, 5, 10, 30, 60, 120, 300, 600, 1800, 3600},
+		},
+		[]string{"status", "error_code"},
+	)
+
 	// Business Metrics for Auth Service
 
 	// UserRegistrationsTotal counts the total number of user registrations
@@ -160,12 +212,40 @@ var (
 		},
 		[]string{"endpoint"},
 	)
+
+	// AuthFailuresTotal counts requests the JWT middleware rejected, by reason
+	AuthFailuresTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "auth_failures_total",
+			Help: "Total number of requests rejected by JWT authentication",
+		},
+		[]string{"reason"},
+	)
+
+	// CSRFFailuresTotal counts state-changing requests the CSRF middleware rejected, by reason
+	CSRFFailuresTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "csrf_failures_total",
+			Help: "Total number of requests rejected by CSRF protection",
+		},
+		[]string{"reason"},
+	)
+)
+
+// Reasons a request fails authentication or CSRF validation
+const (
+	FailureReasonMissingToken    = "missing_token"
+	FailureReasonMalformedHeader = "malformed_header"
+	FailureReasonInvalidToken    = "invalid_token"
+	FailureReasonExpired         = "expired"
+	FailureReasonBlacklisted     = "blacklisted"
 )
 
 // RecordHTTPRequest records an HTTP request metric
-func RecordHTTPRequest(method, endpoint, status string, duration float64) {
-	HTTPRequestTotal.WithLabelValues(method, endpoint, status).Inc()
-	HTTPRequestDuration.WithLabelValues(method, endpoint).Observe(duration)
+// route should be the matched route template (e.g. /api/v1/resumes/:id), never the raw path
+func RecordHTTPRequest(method, route, status string, duration float64) {
+	HTTPRequestTotal.WithLabelValues(method, route, status).Inc()
+	HTTPRequestDuration.WithLabelValues(method, route, status).Observe(duration)
 }
 
 // IncHTTPRequestsInFlight increments the in-flight requests counter
@@ -188,12 +268,29 @@ func SetDatabaseConnectionsActive(count float64) {
 	DatabaseConnectionsActive.Set(count)
 }
 
+// RecordDatabaseRetry records a retry of a database operation
+func RecordDatabaseRetry(operation string) {
+	DatabaseRetriesTotal.WithLabelValues(operation).Inc()
+}
+
 // RecordExternalAPIRequest records an external API request metric
 func RecordExternalAPIRequest(service, endpoint, status string, duration float64) {
 	ExternalAPIRequestsTotal.WithLabelValues(service, endpoint, status).Inc()
 	ExternalAPIDuration.WithLabelValues(service, endpoint).Observe(duration)
 }
 
+// RecordResumeJobsReaped records resume generation jobs failed by the processing timeout reaper
+func RecordResumeJobsReaped(count int) {
+	ResumeJobsReapedTotal.Add(float64(count))
+}
+
+// RecordResumeJobFinished records a resume generation job reaching a terminal status
+// and how long it took since the job was created
+func RecordResumeJobFinished(status, errorCode string, duration float64) {
+	ResumeJobsFinishedTotal.WithLabelValues(status, errorCode).Inc()
+	ResumeJobDuration.WithLabelValues(status, errorCode).Observe(duration)
+}
+
 // RecordHealthCheck records a health check metric
 func RecordHealthCheck(checkType, status string, duration float64) {
 	HealthCheckTotal.WithLabelValues(checkType, status).Inc()
@@ -209,6 +306,21 @@ func SetHealthCheckStatus(checkName, checkType string, isHealthy bool) {
 	HealthCheckStatus.WithLabelValues(checkName, checkType).Set(status)
 }
 
+// SetAIServiceBreakerState sets the AI service circuit breaker state metric
+func SetAIServiceBreakerState(state int) {
+	AIServiceBreakerState.Set(float64(state))
+}
+
+// IncAIServiceRequestsInFlight increments the in-flight AI service requests gauge
+func IncAIServiceRequestsInFlight() {
+	AIServiceRequestsInFlight.Inc()
+}
+
+// DecAIServiceRequestsInFlight decrements the in-flight AI service requests gauge
+func DecAIServiceRequestsInFlight() {
+	AIServiceRequestsInFlight.Dec()
+}
+
 // Business Metrics Functions
 
 // RecordUserRegistration records a user registration
@@ -262,3 +374,12 @@ func RecordRequestTimeout(endpoint string) {
 	RequestTimeoutsTotal.WithLabelValues(endpoint).Inc()
 }
 
+// RecordAuthFailure records a request rejected by the JWT middleware
+func RecordAuthFailure(reason string) {
+	AuthFailuresTotal.WithLabelValues(reason).Inc()
+}
+
+// RecordCSRFFailure records a request rejected by the CSRF middleware
+func RecordCSRFFailure(reason string) {
+	CSRFFailuresTotal.WithLabelValues(reason).Inc()
+}
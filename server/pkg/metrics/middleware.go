@@ -1,6 +1,7 @@
 package metrics
 
 import (
+	"errors"
 	"strconv"
 	"strings"
 	"time"
@@ -8,6 +9,9 @@ import (
 	"github.com/gofiber/fiber/v2"
 )
 
+// unmatchedRoute labels requests that did not match any registered route
+const unmatchedRoute = "unmatched"
+
 // Middleware creates a Fiber middleware that records HTTP request metrics
 func Middleware() fiber.Handler {
 	return func(c *fiber.Ctx) error {
@@ -23,35 +27,45 @@ func Middleware() fiber.Handler {
 		// Calculate duration
 		duration := time.Since(start).Seconds()
 
-		// Normalize endpoint
-		endpoint := normalizeEndpoint(c.Path())
-
-		// Get status code as string
-		status := strconv.Itoa(c.Response().StatusCode())
+		// The error handler runs after this middleware returns, so resolve the
+		// status it will write for errors that have not been written yet
+		statusCode := c.Response().StatusCode()
+		if err != nil {
+			statusCode = fiber.StatusInternalServerError
+			var fiberErr *fiber.Error
+			if errors.As(err, &fiberErr) {
+				statusCode = fiberErr.Code
+			}
+		}
 
 		// Record metrics
-		RecordHTTPRequest(c.Method(), endpoint, status, duration)
+		RecordHTTPRequest(c.Method(), routeLabel(c, err), strconv.Itoa(statusCode), duration)
 
 		return err
 	}
 }
 
-// normalizeEndpoint normalizes the endpoint path for metrics
-// Replaces path parameters with placeholders (e.g., /users/123 -> /users/:id)
+// routeLabel returns the route template matched for the request (e.g.
+// /api/v1/resumes/:id) so that path parameters such as UUIDs do not end up
+// as label values and blow up metric cardinality. When a middleware ends the
+// request early (e.g. auth), the template is that middleware's mount prefix.
+func routeLabel(c *fiber.Ctx, err error) string {
+	// Fiber's router reports unmatched paths as a 404 "Cannot <METHOD> <path>" error
+	var fiberErr *fiber.Error
+	if errors.As(err, &fiberErr) && fiberErr.Code == fiber.StatusNotFound && strings.HasPrefix(fiberErr.Message, "Cannot "+c.Method()+" ") {
+		return unmatchedRoute
+	}
+
+	return normalizeEndpoint(c.Route().Path)
+}
+
+// normalizeEndpoint normalizes a route template for metrics
 func normalizeEndpoint(path string) string {
-	// For now, just return the path as-is
-	// In a more sophisticated implementation, you might want to:
-	// - Replace UUIDs with :id
-	// - Replace numeric IDs with :id
-	// - Normalize query parameters
-	// This helps reduce cardinality in metrics
-	
 	// Simple normalization: remove trailing slashes
 	path = strings.TrimSuffix(path, "/")
 	if path == "" {
 		path = "/"
 	}
-	
+
 	return path
 }
-
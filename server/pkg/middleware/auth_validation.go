@@ -2,10 +2,12 @@ package middleware
 
 import (
 	"fmt"
+	"log/slog"
 	"strings"
 
 	"github.com/gofiber/fiber/v2"
 	"woragis-jobs-service/pkg/authservice"
+	applogger "woragis-jobs-service/pkg/logger"
 	"woragis-jobs-service/pkg/utils"
 )
 
@@ -77,6 +79,7 @@ func AuthValidationMiddleware(config AuthValidationConfig) fiber.Handler {
 		c.Locals("userID", response.UserID)
 		c.Locals("userEmail", response.Email)
 		c.Locals("userRole", response.Role)
+		applogger.AddFiberLogFields(c, slog.String(applogger.UserIDField, response.UserID))
 
 		return c.Next()
 	}
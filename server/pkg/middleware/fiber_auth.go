@@ -2,8 +2,11 @@ package middleware
 
 import (
 	"errors"
+	"log/slog"
 
 	"woragis-jobs-service/pkg/auth"
+	applogger "woragis-jobs-service/pkg/logger"
+	"woragis-jobs-service/pkg/metrics"
 	"woragis-jobs-service/pkg/utils"
 
 	"github.com/gofiber/fiber/v2"
@@ -21,12 +24,14 @@ func JWTMiddleware(config JWTConfig) fiber.Handler {
 		// Get token from Authorization header
 		authHeader := c.Get("Authorization")
 		if authHeader == "" {
+			metrics.RecordAuthFailure(metrics.FailureReasonMissingToken)
 			return utils.UnauthorizedResponse(c, "Authorization header required")
 		}
 
 		// Extract token from "Bearer <token>"
 		token, err := auth.ExtractTokenFromHeader(authHeader)
 		if err != nil {
+			metrics.RecordAuthFailure(metrics.FailureReasonMalformedHeader)
 			return utils.UnauthorizedResponse(c, "Invalid authorization header format")
 		}
 
@@ -35,10 +40,17 @@ func JWTMiddleware(config JWTConfig) fiber.Handler {
 		if err != nil {
 			switch err {
 			case auth.ErrTokenExpired:
+				metrics.RecordAuthFailure(metrics.FailureReasonExpired)
 				return utils.UnauthorizedResponse(c, "Token has expired")
+			case auth.ErrTokenRevoked:
+				// Revoked tokens get the same response as invalid ones; only the metric tells them apart
+				metrics.RecordAuthFailure(metrics.FailureReasonBlacklisted)
+				return utils.UnauthorizedResponse(c, "Invalid token")
 			case auth.ErrTokenInvalid:
+				metrics.RecordAuthFailure(metrics.FailureReasonInvalidToken)
 				return utils.UnauthorizedResponse(c, "Invalid token")
 			default:
+				metrics.RecordAuthFailure(metrics.FailureReasonInvalidToken)
 				return utils.UnauthorizedResponse(c, "Token validation failed")
 			}
 		}
@@ -48,6 +60,7 @@ func JWTMiddleware(config JWTConfig) fiber.Handler {
 		c.Locals("userEmail", claims.Email)
 		c.Locals("userRole", claims.Role)
 		c.Locals("userName", claims.Name)
+		applogger.AddFiberLogFields(c, slog.String(applogger.UserIDField, claims.UserID.String()))
 
 		return c.Next()
 	}
@@ -108,6 +121,7 @@ func OptionalJWTMiddleware(config JWTConfig) fiber.Handler {
 					c.Locals("userEmail", claims.Email)
 					c.Locals("userRole", claims.Role)
 					c.Locals("userName", claims.Name)
+					applogger.AddFiberLogFields(c, slog.String(applogger.UserIDField, claims.UserID.String()))
 				}
 			}
 		}
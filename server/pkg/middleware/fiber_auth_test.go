@@ -0,0 +1,65 @@
+package middleware
+
+import (
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"woragis-jobs-service/pkg/auth"
+	"woragis-jobs-service/pkg/metrics"
+)
+
+// authFailures reads auth_failures_total for one reason.
+func authFailures(t *testing.T, reason string) float64 {
+	t.Helper()
+	families, err := prometheus.DefaultGatherer.Gather()
+	require.NoError(t, err)
+	for _, family := range families {
+		if family.GetName() != "auth_failures_total" {
+			continue
+		}
+		for _, metric := range family.GetMetric() {
+			for _, label := range metric.GetLabel() {
+				if label.GetName() == "reason" && label.GetValue() == reason {
+					return metric.GetCounter().GetValue()
+				}
+			}
+		}
+	}
+	return 0
+}
+
+func TestJWTMiddleware_RecordsFailureReasons(t *testing.T) {
+	expired := auth.NewJWTManager("test-secret-key", "test-issuer", -time.Hour, time.Hour)
+	expiredToken, _, err := expired.Generate(uuid.New(), "test@example.com", "user", "Test")
+	require.NoError(t, err)
+
+	app := fiber.New()
+	app.Use(JWTMiddleware(JWTConfig{JWTManager: auth.NewJWTManager("test-secret-key", "test-issuer", time.Hour, time.Hour)}))
+	app.Get("/", func(c *fiber.Ctx) error { return c.SendStatus(fiber.StatusOK) })
+
+	cases := map[string]string{
+		metrics.FailureReasonMissingToken:    "",
+		metrics.FailureReasonMalformedHeader: "Basic dXNlcjpwYXNz",
+		metrics.FailureReasonInvalidToken:    "Bearer not-a-jwt",
+		metrics.FailureReasonExpired:         "Bearer " + expiredToken,
+	}
+	for reason, header := range cases {
+		before := authFailures(t, reason)
+
+		req := httptest.NewRequest("GET", "/", nil)
+		if header != "" {
+			req.Header.Set("Authorization", header)
+		}
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		assert.Equal(t, fiber.StatusUnauthorized, resp.StatusCode, reason)
+		assert.Equal(t, before+1, authFailures(t, reason), reason)
+	}
+}
@@ -0,0 +1,47 @@
+package middleware
+
+import (
+	"fmt"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"woragis-jobs-service/pkg/response"
+)
+
+// InvalidIDCode identifies a malformed UUID path parameter in error responses
+const InvalidIDCode = "INVALID_ID"
+
+func uuidParamKey(name string) string {
+	return "uuidParam:" + name
+}
+
+// ValidateUUIDParam parses the named path parameter as a UUID and stores it for
+// UUIDParam. Malformed values are rejected with a uniform 400 before the handler runs.
+func ValidateUUIDParam(name string) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		id, err := uuid.Parse(c.Params(name))
+		if err != nil {
+			return InvalidUUIDParam(c, name)
+		}
+		c.Locals(uuidParamKey(name), id)
+		return c.Next()
+	}
+}
+
+// UUIDParam returns the path parameter validated by ValidateUUIDParam. On routes
+// registered without the middleware it parses the parameter itself.
+func UUIDParam(c *fiber.Ctx, name string) (uuid.UUID, error) {
+	if id, ok := c.Locals(uuidParamKey(name)).(uuid.UUID); ok {
+		return id, nil
+	}
+	return uuid.Parse(c.Params(name))
+}
+
+// InvalidUUIDParam writes the uniform response for a malformed UUID path parameter.
+func InvalidUUIDParam(c *fiber.Ctx, name string) error {
+	return response.Error(c, fiber.StatusBadRequest, fiber.StatusBadRequest, fiber.Map{
+		"code":    InvalidIDCode,
+		"message": fmt.Sprintf("%s: must be a valid UUID", name),
+	})
+}
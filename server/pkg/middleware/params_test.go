@@ -0,0 +1,60 @@
+package middleware
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateUUIDParam(t *testing.T) {
+	app := fiber.New()
+	app.Get("/items/:id", ValidateUUIDParam("id"), func(c *fiber.Ctx) error {
+		id, err := UUIDParam(c, "id")
+		require.NoError(t, err)
+		return c.SendString(id.String())
+	})
+
+	id := uuid.New()
+	resp, err := app.Test(httptest.NewRequest("GET", "/items/"+id.String(), nil))
+	require.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+	resp, err = app.Test(httptest.NewRequest("GET", "/items/not-a-uuid", nil))
+	require.NoError(t, err)
+	assert.Equal(t, fiber.StatusBadRequest, resp.StatusCode)
+
+	var body struct {
+		Success bool `json:"success"`
+		Data    struct {
+			Code    string `json:"code"`
+			Message string `json:"message"`
+		} `json:"data"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.False(t, body.Success)
+	assert.Equal(t, InvalidIDCode, body.Data.Code)
+	assert.Equal(t, "id: must be a valid UUID", body.Data.Message)
+}
+
+func TestUUIDParamWithoutMiddleware(t *testing.T) {
+	app := fiber.New()
+	app.Get("/items/:id", func(c *fiber.Ctx) error {
+		if _, err := UUIDParam(c, "id"); err != nil {
+			return InvalidUUIDParam(c, "id")
+		}
+		return c.SendStatus(fiber.StatusNoContent)
+	})
+
+	resp, err := app.Test(httptest.NewRequest("GET", "/items/"+uuid.NewString(), nil))
+	require.NoError(t, err)
+	assert.Equal(t, fiber.StatusNoContent, resp.StatusCode)
+
+	resp, err = app.Test(httptest.NewRequest("GET", "/items/42", nil))
+	require.NoError(t, err)
+	assert.Equal(t, fiber.StatusBadRequest, resp.StatusCode)
+}
@@ -0,0 +1,156 @@
+package pagination
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+const (
+	// DefaultLimit is the page size used when the client does not pass one
+	DefaultLimit = 50
+	// DefaultMaxLimit is the hard ceiling on page size unless configured otherwise
+	DefaultMaxLimit = 200
+
+	// LimitHeader and OffsetHeader echo the effective page so clients can
+	// detect clamping even on endpoints whose body is a bare array
+	LimitHeader  = "X-Pagination-Limit"
+	OffsetHeader = "X-Pagination-Offset"
+
+	// UnknownTotal is passed to SetLinkHeader by endpoints that do not count matches
+	UnknownTotal = -1
+)
+
+// Limits configures page sizes for every list endpoint.
+type Limits struct {
+	Default int
+	Max     int
+}
+
+var current atomic.Pointer[Limits]
+
+func init() {
+	current.Store(&Limits{Default: DefaultLimit, Max: DefaultMaxLimit})
+}
+
+// SetLimits replaces the service-wide page size limits. It is meant to be
+// called once at startup.
+func SetLimits(limits Limits) error {
+	if limits.Max < 1 {
+		return errors.New("pagination: max limit must be at least 1")
+	}
+	if limits.Default < 1 || limits.Default > limits.Max {
+		return fmt.Errorf("pagination: default limit must be between 1 and %d", limits.Max)
+	}
+	current.Store(&limits)
+	return nil
+}
+
+// CurrentLimits returns the limits in effect.
+func CurrentLimits() Limits {
+	return *current.Load()
+}
+
+// Params is a validated page request.
+type Params struct {
+	Limit  int `json:"limit"`
+	Offset int `json:"offset"`
+	// Clamped is true when the requested limit exceeded the ceiling
+	Clamped bool `json:"-"`
+}
+
+// Normalize validates limit and offset. Negative values are rejected, a zero
+// limit means the default, and limits above the ceiling are clamped to it.
+func Normalize(limit, offset int) (Params, error) {
+	if limit < 0 {
+		return Params{}, errors.New("limit: must be at least 1")
+	}
+	if offset < 0 {
+		return Params{}, errors.New("offset: must be at least 0")
+	}
+
+	limits := CurrentLimits()
+	params := Params{Limit: limit, Offset: offset}
+	switch {
+	case limit == 0:
+		params.Limit = limits.Default
+	case limit > limits.Max:
+		params.Limit = limits.Max
+		params.Clamped = true
+	}
+	return params, nil
+}
+
+// FromQuery reads ?limit= and ?offset= and normalizes them. Unlike
+// c.QueryInt, malformed numbers are reported instead of silently defaulted.
+func FromQuery(c *fiber.Ctx) (Params, error) {
+	limit, err := queryInt(c, "limit")
+	if err != nil {
+		return Params{}, err
+	}
+	offset, err := queryInt(c, "offset")
+	if err != nil {
+		return Params{}, err
+	}
+	return Normalize(limit, offset)
+}
+
+func queryInt(c *fiber.Ctx, key string) (int, error) {
+	raw := c.Query(key)
+	if raw == "" {
+		return 0, nil
+	}
+	value, err := strconv.Atoi(raw)
+	if err != nil {
+		return 0, fmt.Errorf("%s: must be an integer", key)
+	}
+	return value, nil
+}
+
+// SetHeaders writes the effective limit and offset to the response headers.
+func (p Params) SetHeaders(c *fiber.Ctx) {
+	c.Set(LimitHeader, strconv.Itoa(p.Limit))
+	c.Set(OffsetHeader, strconv.Itoa(p.Offset))
+}
+
+// SetLinkHeader writes an RFC 8288 (formerly RFC 5988) Link header with the first,
+// prev, next and last pages of the current request, keeping its other query parameters.
+// returned is the number of items on this page and total the number of matches, or
+// UnknownTotal; without a total, last is omitted and next is offered whenever the page
+// is full. Targets are relative references (path and query).
+func (p Params) SetLinkHeader(c *fiber.Ctx, returned int, total int64) {
+	query, err := url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return
+	}
+	link := func(offset int, rel string) string {
+		query.Set("limit", strconv.Itoa(p.Limit))
+		query.Set("offset", strconv.Itoa(offset))
+		return fmt.Sprintf(`<%s?%s>; rel="%s"`, c.Path(), query.Encode(), rel)
+	}
+
+	links := []string{link(0, "first")}
+	if p.Offset > 0 {
+		links = append(links, link(max(p.Offset-p.Limit, 0), "prev"))
+	}
+	if total == UnknownTotal {
+		if returned >= p.Limit {
+			links = append(links, link(p.Offset+p.Limit, "next"))
+		}
+	} else {
+		if int64(p.Offset+p.Limit) < total {
+			links = append(links, link(p.Offset+p.Limit, "next"))
+		}
+		last := 0
+		if total > 0 {
+			last = int((total - 1) / int64(p.Limit) * int64(p.Limit))
+		}
+		links = append(links, link(last, "last"))
+	}
+	c.Set(fiber.HeaderLink, strings.Join(links, ", "))
+}
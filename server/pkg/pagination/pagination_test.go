@@ -0,0 +1,110 @@
+package pagination
+
+import (
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalize(t *testing.T) {
+	params, err := Normalize(0, 0)
+	require.NoError(t, err)
+	assert.Equal(t, Params{Limit: DefaultLimit}, params)
+
+	params, err = Normalize(100000, 20)
+	require.NoError(t, err)
+	assert.Equal(t, Params{Limit: DefaultMaxLimit, Offset: 20, Clamped: true}, params)
+
+	params, err = Normalize(DefaultMaxLimit, 0)
+	require.NoError(t, err)
+	assert.False(t, params.Clamped)
+
+	_, err = Normalize(-1, 0)
+	assert.Error(t, err)
+	_, err = Normalize(10, -1)
+	assert.Error(t, err)
+}
+
+func TestSetLimits(t *testing.T) {
+	defer func() { require.NoError(t, SetLimits(Limits{Default: DefaultLimit, Max: DefaultMaxLimit})) }()
+
+	assert.Error(t, SetLimits(Limits{Default: 10, Max: 0}))
+	assert.Error(t, SetLimits(Limits{Default: 20, Max: 10}))
+
+	require.NoError(t, SetLimits(Limits{Default: 5, Max: 10}))
+	params, err := Normalize(0, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 5, params.Limit)
+	params, err = Normalize(50, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 10, params.Limit)
+}
+
+func TestFromQuery(t *testing.T) {
+	app := fiber.New()
+	app.Get("/", func(c *fiber.Ctx) error {
+		params, err := FromQuery(c)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).SendString(err.Error())
+		}
+		params.SetHeaders(c)
+		return c.SendStatus(fiber.StatusOK)
+	})
+
+	resp, err := app.Test(httptest.NewRequest("GET", "/?limit=5000&offset=40", nil))
+	require.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	assert.Equal(t, "200", resp.Header.Get(LimitHeader))
+	assert.Equal(t, "40", resp.Header.Get(OffsetHeader))
+
+	for _, query := range []string{"?limit=abc", "?limit=-5", "?offset=-1"} {
+		resp, err := app.Test(httptest.NewRequest("GET", "/"+query, nil))
+		require.NoError(t, err)
+		assert.Equal(t, fiber.StatusBadRequest, resp.StatusCode, query)
+	}
+}
+
+func TestSetLinkHeader(t *testing.T) {
+	app := fiber.New()
+	app.Get("/items", func(c *fiber.Ctx) error {
+		params, err := FromQuery(c)
+		if err != nil {
+			return err
+		}
+		returned, _ := strconv.Atoi(c.Get("X-Returned"))
+		total, _ := strconv.ParseInt(c.Get("X-Total"), 10, 64)
+		params.SetLinkHeader(c, returned, total)
+		return c.SendStatus(fiber.StatusOK)
+	})
+	link := func(query string, returned int, total int64) string {
+		req := httptest.NewRequest("GET", "/items?"+query, nil)
+		req.Header.Set("X-Returned", strconv.Itoa(returned))
+		req.Header.Set("X-Total", strconv.FormatInt(total, 10))
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		return resp.Header.Get(fiber.HeaderLink)
+	}
+
+	assert.Equal(t,
+		`</items?limit=10&offset=0&status=applied>; rel="first", `+
+			`</items?limit=10&offset=10&status=applied>; rel="prev", `+
+			`</items?limit=10&offset=30&status=applied>; rel="next", `+
+			`</items?limit=10&offset=40&status=applied>; rel="last"`,
+		link("status=applied&limit=10&offset=20", 10, 45))
+
+	assert.Equal(t,
+		`</items?limit=10&offset=0>; rel="first", </items?limit=10&offset=0>; rel="last"`,
+		link("limit=10", 0, 0))
+
+	assert.Equal(t,
+		`</items?limit=10&offset=0>; rel="first", </items?limit=10&offset=0>; rel="prev", </items?limit=10&offset=15>; rel="next"`,
+		link("limit=10&offset=5", 10, UnknownTotal), "without a total a full page offers next and no last")
+
+	assert.Equal(t,
+		`</items?limit=10&offset=0>; rel="first"`,
+		link("limit=10", 4, UnknownTotal))
+}
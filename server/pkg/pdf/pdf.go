@@ -0,0 +1,254 @@
+// Package pdf renders plain text documents, such as cover letters, to PDF. It only
+// supports what letters need: one built-in font (Helvetica, so nothing is embedded),
+// A4 pages, word wrapping and page breaks. Characters outside Windows-1252 are
+// replaced with "?".
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Page layout in points (1/72 inch)
+const (
+	pageWidth  = 595.28 // A4
+	pageHeight = 841.89
+	margin     = 72.0
+	fontSize   = 11.0
+	lineHeight = 15.0
+)
+
+// Document is a text document to render.
+type Document struct {
+	// Title and Author are written to the document information dictionary
+	Title  string
+	Author string
+	// Paragraphs are separated by a blank line. Newlines inside a paragraph are kept
+	// as line breaks; longer lines are wrapped at the page margins.
+	Paragraphs []string
+	// CreatedAt is recorded as the creation date when set
+	CreatedAt time.Time
+}
+
+// Render returns the document as a PDF file.
+func (d Document) Render() []byte {
+	pages := paginate(d.lines())
+
+	w := &writer{}
+	w.buf.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+
+	// Objects 1-4 are fixed; each page then takes a page object and a content stream
+	const catalogID, pagesID, fontID, infoID = 1, 2, 3, 4
+	pageIDs := make([]int, len(pages))
+	for i := range pages {
+		pageIDs[i] = 5 + 2*i
+	}
+
+	w.object(catalogID, fmt.Sprintf("<< /Type /Catalog /Pages %d 0 R >>", pagesID))
+
+	kids := make([]string, len(pageIDs))
+	for i, id := range pageIDs {
+		kids[i] = fmt.Sprintf("%d 0 R", id)
+	}
+	w.object(pagesID, fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(pageIDs)))
+	w.object(fontID, "<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>")
+
+	info := fmt.Sprintf("<< /Producer %s", literal("woragis-jobs-service"))
+	if d.Title != "" {
+		info += " /Title " + literal(d.Title)
+	}
+	if d.Author != "" {
+		info += " /Author " + literal(d.Author)
+	}
+	if !d.CreatedAt.IsZero() {
+		info += " /CreationDate " + literal(d.CreatedAt.UTC().Format("D:20060102150405Z"))
+	}
+	w.object(infoID, info+" >>")
+
+	for i, lines := range pages {
+		contentID := pageIDs[i] + 1
+		w.object(pageIDs[i], fmt.Sprintf("<< /Type /Page /Parent %d 0 R /MediaBox [0 0 %.2f %.2f] /Resources << /Font << /F1 %d 0 R >> >> /Contents %d 0 R >>",
+			pagesID, pageWidth, pageHeight, fontID, contentID))
+		content := pageContent(lines)
+		w.object(contentID, fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", len(content), content))
+	}
+
+	w.finish(catalogID, infoID)
+	return w.buf.Bytes()
+}
+
+// lines wraps the paragraphs to the text width, with an empty line between paragraphs.
+func (d Document) lines() []string {
+	var lines []string
+	for i, paragraph := range d.Paragraphs {
+		if i > 0 {
+			lines = append(lines, "")
+		}
+		for _, line := range strings.Split(strings.ReplaceAll(paragraph, "\r\n", "\n"), "\n") {
+			lines = append(lines, wrap(line, pageWidth-2*margin)...)
+		}
+	}
+	return lines
+}
+
+// paginate splits lines into pages. A document always has at least one page.
+func paginate(lines []string) [][]string {
+	usable := pageHeight - 2*margin
+	perPage := int(usable / lineHeight)
+	pages := [][]string{}
+	for len(lines) > perPage {
+		pages = append(pages, lines[:perPage])
+		lines = lines[perPage:]
+	}
+	return append(pages, lines)
+}
+
+func pageContent(lines []string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "BT\n/F1 %.0f Tf\n%.0f TL\n%.2f %.2f Td\n", fontSize, lineHeight, margin, pageHeight-margin-fontSize)
+	for i, line := range lines {
+		if i > 0 {
+			b.WriteString("T*\n")
+		}
+		if line != "" {
+			b.WriteString(literal(line) + " Tj\n")
+		}
+	}
+	b.WriteString("ET")
+	return b.String()
+}
+
+// wrap breaks line into pieces no wider than width, between words where possible.
+func wrap(line string, width float64) []string {
+	words := strings.Fields(line)
+	if len(words) == 0 {
+		return []string{""}
+	}
+
+	var lines []string
+	current := ""
+	for _, word := range words {
+		for textWidth(word) > width {
+			// A single word wider than the line is split wherever it overflows
+			if current != "" {
+				lines = append(lines, current)
+				current = ""
+			}
+			cut := fitRunes(word, width)
+			lines = append(lines, word[:cut])
+			word = word[cut:]
+		}
+		candidate := word
+		if current != "" {
+			candidate = current + " " + word
+		}
+		if textWidth(candidate) > width && current != "" {
+			lines = append(lines, current)
+			candidate = word
+		}
+		current = candidate
+	}
+	return append(lines, current)
+}
+
+// fitRunes returns the byte length of the longest prefix of word that fits in width,
+// and at least one rune.
+func fitRunes(word string, width float64) int {
+	total := 0.0
+	for i, r := range word {
+		total += runeWidth(r)
+		if total > width && i > 0 {
+			return i
+		}
+	}
+	return len(word)
+}
+
+func textWidth(s string) float64 {
+	total := 0.0
+	for _, r := range s {
+		total += runeWidth(r)
+	}
+	return total
+}
+
+func runeWidth(r rune) float64 {
+	width := 556 // Typical Helvetica glyph width, used outside printable ASCII
+	if r >= ' ' && r <= '~' {
+		width = helveticaWidths[r-' ']
+	}
+	return float64(width) * fontSize / 1000
+}
+
+// helveticaWidths are the Helvetica advance widths of ' ' through '~' in 1/1000 em.
+var helveticaWidths = [...]int{
+	278, 278, 355, 556, 556, 889, 667, 191, 333, 333, 389, 584, 278, 333, 278, 278, // space to /
+	556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 278, 278, 584, 584, 584, 556, // 0 to ?
+	1015, 667, 667, 722, 722, 667, 611, 778, 722, 278, 500, 667, 556, 833, 722, 778, // @ to O
+	667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 278, 278, 278, 469, 556, // P to _
+	333, 556, 556, 500, 556, 556, 278, 556, 556, 222, 222, 500, 222, 833, 556, 556, // ` to o
+	556, 556, 333, 500, 278, 556, 500, 722, 500, 500, 500, 334, 260, 334, 584, // p to ~
+}
+
+// winAnsiExtras maps the typographic characters Windows-1252 places in 0x80-0x9F.
+var winAnsiExtras = map[rune]byte{
+	'€': 0x80, '‚': 0x82, '„': 0x84, '…': 0x85, '•': 0x95, '–': 0x96, '—': 0x97,
+	'‘': 0x91, '’': 0x92, '“': 0x93, '”': 0x94, '™': 0x99,
+}
+
+// literal encodes s as a PDF literal string in WinAnsiEncoding.
+func literal(s string) string {
+	var b strings.Builder
+	b.WriteByte('(')
+	for _, r := range s {
+		var c byte
+		switch {
+		case r < 0x80:
+			c = byte(r)
+		case r >= 0xA0 && r <= 0xFF:
+			c = byte(r)
+		default:
+			extra, ok := winAnsiExtras[r]
+			if !ok {
+				extra = '?'
+			}
+			c = extra
+		}
+		switch {
+		case c == '(' || c == ')' || c == '\\':
+			b.WriteByte('\\')
+			b.WriteByte(c)
+		case c < 0x20 || c >= 0x7F:
+			fmt.Fprintf(&b, "\\%03o", c)
+		default:
+			b.WriteByte(c)
+		}
+	}
+	b.WriteByte(')')
+	return b.String()
+}
+
+// writer tracks object offsets for the cross-reference table.
+type writer struct {
+	buf     bytes.Buffer
+	offsets []int
+}
+
+func (w *writer) object(id int, body string) {
+	for len(w.offsets) < id {
+		w.offsets = append(w.offsets, 0)
+	}
+	w.offsets[id-1] = w.buf.Len()
+	fmt.Fprintf(&w.buf, "%d 0 obj\n%s\nendobj\n", id, body)
+}
+
+func (w *writer) finish(rootID, infoID int) {
+	xref := w.buf.Len()
+	fmt.Fprintf(&w.buf, "xref\n0 %d\n0000000000 65535 f \n", len(w.offsets)+1)
+	for _, offset := range w.offsets {
+		fmt.Fprintf(&w.buf, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&w.buf, "trailer\n<< /Size %d /Root %d 0 R /Info %d 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(w.offsets)+1, rootID, infoID, xref)
+}
@@ -0,0 +1,68 @@
+package pdf
+
+import (
+	"bytes"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDocument_Render(t *testing.T) {
+	out := Document{
+		Title:      "Cover letter (Acme)",
+		Author:     "Ada Lovelace",
+		Paragraphs: []string{"Ada Lovelace\nMarch 2, 2026", "Dear hiring team,", "I’d love to join Acme — really."},
+		CreatedAt:  time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC),
+	}.Render()
+
+	require.True(t, bytes.HasPrefix(out, []byte("%PDF-1.4\n")))
+	require.True(t, bytes.HasSuffix(out, []byte("%%EOF\n")))
+	assert.Contains(t, string(out), `/Title (Cover letter \(Acme\))`)
+	assert.Contains(t, string(out), `/CreationDate (D:20260302100000Z)`)
+	assert.Contains(t, string(out), `(Dear hiring team,) Tj`)
+	assert.Contains(t, string(out), `(I\222d love to join Acme \227 really.) Tj`, "typographic characters use WinAnsi codes")
+	assert.Equal(t, 1, strings.Count(string(out), "/Type /Page "))
+
+	// Every xref entry points at the start of its object
+	startxref := regexp.MustCompile(`startxref\n(\d+)`).FindSubmatch(out)
+	require.NotNil(t, startxref)
+	xref, err := strconv.Atoi(string(startxref[1]))
+	require.NoError(t, err)
+	entries := regexp.MustCompile(`(\d{10}) 00000 n`).FindAllSubmatch(out[xref:], -1)
+	require.NotEmpty(t, entries)
+	for i, entry := range entries {
+		offset, _ := strconv.Atoi(string(entry[1]))
+		assert.True(t, bytes.HasPrefix(out[offset:], []byte(strconv.Itoa(i+1)+" 0 obj")), "object %d", i+1)
+	}
+}
+
+func TestWrap(t *testing.T) {
+	width := 100.0
+	lines := wrap(strings.Repeat("word ", 30), width)
+	require.Greater(t, len(lines), 1)
+	for _, line := range lines {
+		assert.LessOrEqual(t, textWidth(line), width, line)
+	}
+	assert.Equal(t, strings.Repeat("word ", 30), strings.Join(lines, " ")+" ")
+
+	long := wrap(strings.Repeat("x", 100), width)
+	require.Greater(t, len(long), 1, "a word wider than the line is split")
+	assert.Equal(t, strings.Repeat("x", 100), strings.Join(long, ""))
+
+	assert.Equal(t, []string{""}, wrap("   ", width))
+}
+
+func TestDocument_RenderPaginates(t *testing.T) {
+	lines := make([]string, 120)
+	for i := range lines {
+		lines[i] = "line"
+	}
+	out := Document{Paragraphs: []string{strings.Join(lines, "\n")}}.Render()
+	assert.Equal(t, 3, strings.Count(string(out), "/Type /Page "))
+	assert.Contains(t, string(out), "/Count 3")
+}
@@ -0,0 +1,122 @@
+package response
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"unicode"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// Field casing negotiation lets legacy clients receive snake_case keys
+// (user_id, job_url) while the models keep their camelCase JSON tags.
+const (
+	// CasingHeader is the request header used to pick the response field casing
+	CasingHeader = "Accept-Casing"
+	// CasingQueryParam is the query flag alternative for clients that cannot set headers
+	CasingQueryParam = "casing"
+)
+
+// Casing is the naming convention used for JSON object keys in responses.
+type Casing int
+
+const (
+	CasingCamel Casing = iota // Default, as declared on the models
+	CasingSnake
+)
+
+// RequestedCasing returns the casing asked for by the request. The query flag
+// takes precedence over the header; unknown values fall back to camelCase.
+func RequestedCasing(c *fiber.Ctx) Casing {
+	value := c.Query(CasingQueryParam)
+	if value == "" {
+		value = c.Get(CasingHeader)
+	}
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "snake", "snake_case":
+		return CasingSnake
+	default:
+		return CasingCamel
+	}
+}
+
+// send writes body as JSON in the casing requested by the client.
+func send(c *fiber.Ctx, statusCode int, body fiber.Map) error {
+	c.Vary(CasingHeader)
+	if RequestedCasing(c) != CasingSnake {
+		return c.Status(statusCode).JSON(body)
+	}
+
+	converted, err := toSnakeKeys(body)
+	if err != nil {
+		return err
+	}
+	return c.Status(statusCode).JSON(converted)
+}
+
+// toSnakeKeys round-trips v through JSON so struct tags, omitempty and custom
+// marshalers apply, then rewrites every object key to snake_case. Keys of
+// free-form maps nested in the payload are rewritten as well.
+func toSnakeKeys(v interface{}) (interface{}, error) {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber() // Keep large integers and decimals exactly as marshaled
+	var generic interface{}
+	if err := decoder.Decode(&generic); err != nil {
+		return nil, err
+	}
+	return snakeKeys(generic), nil
+}
+
+func snakeKeys(v interface{}) interface{} {
+	switch value := v.(type) {
+	case map[string]interface{}:
+		converted := make(map[string]interface{}, len(value))
+		for key, item := range value {
+			converted[SnakeCase(key)] = snakeKeys(item)
+		}
+		return converted
+	case []interface{}:
+		for i, item := range value {
+			value[i] = snakeKeys(item)
+		}
+		return value
+	default:
+		return v
+	}
+}
+
+// SnakeCase converts a camelCase key to snake_case. Acronyms are kept
+// together, so "jobURL" becomes "job_url" and "resumeIDs" becomes "resume_ids".
+func SnakeCase(key string) string {
+	runes := []rune(key)
+	var b strings.Builder
+	b.Grow(len(key) + 4)
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			if i > 0 && runes[i-1] != '_' {
+				prevLower := unicode.IsLower(runes[i-1]) || unicode.IsDigit(runes[i-1])
+				// End of an acronym followed by a new word: "URLPath" -> "url_path"
+				nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1]) && unicode.IsUpper(runes[i-1])
+				if prevLower || (nextLower && !isPluralSuffix(runes, i)) {
+					b.WriteByte('_')
+				}
+			}
+			b.WriteRune(unicode.ToLower(r))
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// isPluralSuffix reports whether the lowercase rune after i is a trailing
+// plural "s" on an acronym, as in "IDs".
+func isPluralSuffix(runes []rune, i int) bool {
+	return i+2 == len(runes) && runes[i+1] == 's'
+}
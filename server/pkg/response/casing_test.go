@@ -0,0 +1,93 @@
+package response
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testApplication struct {
+	UserID    string   `json:"userId"`
+	JobURL    string   `json:"jobUrl"`
+	ResumeIDs []string `json:"resumeIDs"`
+	Salary    int64    `json:"salaryMax"`
+}
+
+func TestSnakeCase(t *testing.T) {
+	cases := map[string]string{
+		"userId":      "user_id",
+		"jobUrl":      "job_url",
+		"id":          "id",
+		"ID":          "id",
+		"jobURL":      "job_url",
+		"URLPath":     "url_path",
+		"resumeIDs":   "resume_ids",
+		"already_set": "already_set",
+		"top3Skills":  "top3_skills",
+	}
+	for in, want := range cases {
+		assert.Equal(t, want, SnakeCase(in), in)
+	}
+}
+
+func newCasingTestApp() *fiber.App {
+	app := fiber.New()
+	app.Get("/", func(c *fiber.Ctx) error {
+		return Success(c, fiber.StatusOK, []testApplication{{
+			UserID:    "u1",
+			JobURL:    "https://example.com",
+			ResumeIDs: []string{"r1"},
+			Salary:    9007199254740993,
+		}})
+	})
+	return app
+}
+
+func decodeBody(t *testing.T, body io.Reader) map[string]interface{} {
+	t.Helper()
+	decoder := json.NewDecoder(body)
+	decoder.UseNumber()
+	var decoded map[string]interface{}
+	require.NoError(t, decoder.Decode(&decoded))
+	return decoded
+}
+
+func TestSuccess_DefaultsToCamelCase(t *testing.T) {
+	resp, err := newCasingTestApp().Test(httptest.NewRequest("GET", "/", nil))
+	require.NoError(t, err)
+
+	body := decodeBody(t, resp.Body)
+	item := body["data"].([]interface{})[0].(map[string]interface{})
+	assert.Equal(t, "u1", item["userId"])
+	assert.Equal(t, "https://example.com", item["jobUrl"])
+}
+
+func TestSuccess_SnakeCaseViaHeaderOrQuery(t *testing.T) {
+	headerReq := httptest.NewRequest("GET", "/", nil)
+	headerReq.Header.Set(CasingHeader, "snake_case")
+
+	requests := map[string]*http.Request{
+		"header": headerReq,
+		"query":  httptest.NewRequest("GET", "/?casing=snake", nil),
+	}
+	for name, req := range requests {
+		resp, err := newCasingTestApp().Test(req)
+		require.NoError(t, err, name)
+		assert.Contains(t, resp.Header.Get("Vary"), CasingHeader, name)
+
+		body := decodeBody(t, resp.Body)
+		assert.Equal(t, true, body["success"], name)
+		item := body["data"].([]interface{})[0].(map[string]interface{})
+		assert.Equal(t, "u1", item["user_id"], name)
+		assert.Equal(t, "https://example.com", item["job_url"], name)
+		assert.Equal(t, []interface{}{"r1"}, item["resume_ids"], name)
+		assert.Equal(t, json.Number("9007199254740993"), item["salary_max"], name)
+		assert.NotContains(t, item, "userId", name)
+	}
+}
@@ -0,0 +1,68 @@
+package response
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// ErrorKind classifies a domain error so every domain maps it to the same HTTP status.
+type ErrorKind int
+
+const (
+	KindInternal    ErrorKind = iota // 500
+	KindValidation                   // 422: well-formed request that breaks a domain rule
+	KindNotFound                     // 404
+	KindConflict                     // 409: duplicate or invalid state transition
+	KindForbidden                    // 403
+	KindUnavailable                  // 503: a dependency (queue, AI service, database) is down
+)
+
+// StatusCode returns the HTTP status for the kind.
+func (k ErrorKind) StatusCode() int {
+	switch k {
+	case KindValidation:
+		return fiber.StatusUnprocessableEntity
+	case KindNotFound:
+		return fiber.StatusNotFound
+	case KindConflict:
+		return fiber.StatusConflict
+	case KindForbidden:
+		return fiber.StatusForbidden
+	case KindUnavailable:
+		return fiber.StatusServiceUnavailable
+	default:
+		return fiber.StatusInternalServerError
+	}
+}
+
+// DomainError is implemented by the error types of each domain package.
+type DomainError interface {
+	error
+	ErrorKind() ErrorKind
+}
+
+// codedError is implemented by domain errors that carry a numeric code for the envelope.
+type codedError interface {
+	ErrorCode() int
+}
+
+// FromDomainError writes the standard error envelope for err. Domain errors
+// keep their message and code; anything else becomes an opaque 500 so
+// internal details never leak to clients.
+func FromDomainError(c *fiber.Ctx, err error) error {
+	var domainErr DomainError
+	if !errors.As(err, &domainErr) {
+		return Error(c, fiber.StatusInternalServerError, fiber.StatusInternalServerError, fiber.Map{
+			"message": "internal server error",
+		})
+	}
+
+	code := 0
+	if coded, ok := domainErr.(codedError); ok {
+		code = coded.ErrorCode()
+	}
+	return Error(c, domainErr.ErrorKind().StatusCode(), code, fiber.Map{
+		"message": domainErr.Error(),
+	})
+}
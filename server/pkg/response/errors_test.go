@@ -0,0 +1,66 @@
+package response
+
+import (
+	"errors"
+	"fmt"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testDomainError struct {
+	kind ErrorKind
+	code int
+}
+
+func (e *testDomainError) Error() string        { return "domain failure" }
+func (e *testDomainError) ErrorKind() ErrorKind { return e.kind }
+func (e *testDomainError) ErrorCode() int       { return e.code }
+
+type uncodedDomainError struct{}
+
+func (uncodedDomainError) Error() string        { return "not found" }
+func (uncodedDomainError) ErrorKind() ErrorKind { return KindNotFound }
+
+func sendError(t *testing.T, err error) (int, map[string]interface{}) {
+	t.Helper()
+	app := fiber.New()
+	app.Get("/", func(c *fiber.Ctx) error { return FromDomainError(c, err) })
+
+	resp, testErr := app.Test(httptest.NewRequest("GET", "/", nil))
+	require.NoError(t, testErr)
+	return resp.StatusCode, decodeBody(t, resp.Body)
+}
+
+func TestFromDomainError_MapsKindToStatus(t *testing.T) {
+	cases := map[ErrorKind]int{
+		KindValidation:  fiber.StatusUnprocessableEntity,
+		KindNotFound:    fiber.StatusNotFound,
+		KindConflict:    fiber.StatusConflict,
+		KindForbidden:   fiber.StatusForbidden,
+		KindUnavailable: fiber.StatusServiceUnavailable,
+		KindInternal:    fiber.StatusInternalServerError,
+	}
+	for kind, want := range cases {
+		status, body := sendError(t, &testDomainError{kind: kind, code: 10003})
+		assert.Equal(t, want, status)
+		assert.Equal(t, false, body["success"])
+		assert.Equal(t, "10003", fmt.Sprint(body["code"]))
+		assert.Equal(t, "domain failure", body["data"].(map[string]interface{})["message"])
+	}
+}
+
+func TestFromDomainError_UnwrapsAndDefaultsCode(t *testing.T) {
+	status, body := sendError(t, fmt.Errorf("loading: %w", uncodedDomainError{}))
+	assert.Equal(t, fiber.StatusNotFound, status)
+	assert.Equal(t, "0", fmt.Sprint(body["code"]))
+}
+
+func TestFromDomainError_HidesUnknownErrors(t *testing.T) {
+	status, body := sendError(t, errors.New("pq: connection refused"))
+	assert.Equal(t, fiber.StatusInternalServerError, status)
+	assert.Equal(t, "internal server error", body["data"].(map[string]interface{})["message"])
+}
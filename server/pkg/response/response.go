@@ -6,7 +6,7 @@ import (
 
 // Success sends a successful JSON response
 func Success(c *fiber.Ctx, statusCode int, data interface{}) error {
-	return c.Status(statusCode).JSON(fiber.Map{
+	return send(c, statusCode, fiber.Map{
 		"success": true,
 		"data":    data,
 	})
@@ -14,7 +14,7 @@ func Success(c *fiber.Ctx, statusCode int, data interface{}) error {
 
 // Error sends an error JSON response
 func Error(c *fiber.Ctx, statusCode int, code int, data interface{}) error {
-	return c.Status(statusCode).JSON(fiber.Map{
+	return send(c, statusCode, fiber.Map{
 		"success": false,
 		"code":    code,
 		"data":    data,
@@ -0,0 +1,144 @@
+// Package sanitize scrubs personal data (emails, phone numbers) and profanity from
+// free text, e.g. before an export is shared with someone else. Filters only return
+// new strings; callers decide which copy of the data to scrub.
+package sanitize
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Rule names accepted in Config.Rules.
+const (
+	RuleEmail     = "email"
+	RulePhone     = "phone"
+	RuleProfanity = "profanity"
+)
+
+// DefaultRules are applied when Config.Rules is empty.
+var DefaultRules = []string{RuleEmail, RulePhone, RuleProfanity}
+
+// DefaultProfanityWords are masked by the profanity rule unless Config.ProfanityWords is set.
+var DefaultProfanityWords = []string{"fuck", "fucking", "shit", "bullshit", "bitch", "bastard", "asshole", "damn", "crap", "dick", "piss"}
+
+// Replacements written in place of scrubbed personal data.
+const (
+	EmailReplacement = "[email]"
+	PhoneReplacement = "[phone]"
+)
+
+var (
+	emailPattern = regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9\-]+(?:\.[A-Za-z0-9\-]+)*\.[A-Za-z]{2,}`)
+	// phoneCandidate finds digit runs that may be phone numbers; replacePhoneNumber decides
+	phoneCandidate = regexp.MustCompile(`\+?\(?\d[\d\s().\-]{5,}\d`)
+	datePattern    = regexp.MustCompile(`\b(?:\d{4}-\d{2}-\d{2}|\d{1,2}[./]\d{1,2}[./]\d{2,4})\b`)
+)
+
+// Config selects the rules a Filter applies.
+type Config struct {
+	// Rules are rule names applied in order; empty means DefaultRules
+	Rules []string
+	// ProfanityWords replaces DefaultProfanityWords when non-empty
+	ProfanityWords []string
+}
+
+// Filter applies a fixed set of scrubbing rules. A nil Filter returns text unchanged.
+type Filter struct {
+	rules []rule
+}
+
+type rule struct {
+	pattern *regexp.Regexp
+	replace func(match string) string
+}
+
+// NewFilter builds a Filter from cfg, rejecting unknown rule names.
+func NewFilter(cfg Config) (*Filter, error) {
+	names := cfg.Rules
+	if len(names) == 0 {
+		names = DefaultRules
+	}
+
+	filter := &Filter{}
+	for _, name := range names {
+		switch strings.ToLower(strings.TrimSpace(name)) {
+		case RuleEmail:
+			filter.rules = append(filter.rules, rule{pattern: emailPattern, replace: func(string) string { return EmailReplacement }})
+		case RulePhone:
+			filter.rules = append(filter.rules, rule{pattern: phoneCandidate, replace: replacePhoneNumber})
+		case RuleProfanity:
+			pattern, err := profanityPattern(cfg.ProfanityWords)
+			if err != nil {
+				return nil, err
+			}
+			filter.rules = append(filter.rules, rule{pattern: pattern, replace: mask})
+		default:
+			return nil, fmt.Errorf("sanitize: unknown rule %q (expected %s, %s or %s)", name, RuleEmail, RulePhone, RuleProfanity)
+		}
+	}
+	return filter, nil
+}
+
+// NewDefaultFilter returns a Filter applying DefaultRules with DefaultProfanityWords.
+func NewDefaultFilter() *Filter {
+	filter, _ := NewFilter(Config{})
+	return filter
+}
+
+// Apply returns text with every rule applied.
+func (f *Filter) Apply(text string) string {
+	if f == nil || text == "" {
+		return text
+	}
+	for _, r := range f.rules {
+		text = r.pattern.ReplaceAllStringFunc(text, r.replace)
+	}
+	return text
+}
+
+// profanityPattern matches any of words as a whole word, ignoring case.
+func profanityPattern(words []string) (*regexp.Regexp, error) {
+	if len(words) == 0 {
+		words = DefaultProfanityWords
+	}
+	quoted := make([]string, 0, len(words))
+	for _, word := range words {
+		if word = strings.TrimSpace(word); word != "" {
+			quoted = append(quoted, regexp.QuoteMeta(word))
+		}
+	}
+	if len(quoted) == 0 {
+		return nil, fmt.Errorf("sanitize: profanity rule needs at least one word")
+	}
+	return regexp.Compile(`(?i)\b(?:` + strings.Join(quoted, "|") + `)\b`)
+}
+
+// mask keeps the first letter of a word and stars out the rest.
+func mask(word string) string {
+	runes := []rune(word)
+	return string(runes[0]) + strings.Repeat("*", len(runes)-1)
+}
+
+// replacePhoneNumber scrubs a phone candidate that looks like a phone number. Numbers
+// need 7 to 15 digits (E.164) and, unless they have 10 or more digits, a "+",
+// parentheses or separators, so amounts such as 1500000 are kept. Candidates holding a
+// date such as 2024-01-15 or 15.01.2024 are kept too.
+func replacePhoneNumber(candidate string) string {
+	if datePattern.MatchString(candidate) {
+		return candidate
+	}
+	digits := 0
+	for _, r := range candidate {
+		if r >= '0' && r <= '9' {
+			digits++
+		}
+	}
+	if digits < 7 || digits > 15 {
+		return candidate
+	}
+	if digits < 10 && !strings.ContainsAny(candidate, "+() .-") {
+		return candidate
+	}
+	return PhoneReplacement
+}
@@ -0,0 +1,45 @@
+package sanitize
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilter_Apply(t *testing.T) {
+	filter := NewDefaultFilter()
+
+	tests := []struct {
+		name, text, want string
+	}{
+		{"email", "Reach Jane at jane.doe+jobs@mail.example.co.uk today", "Reach Jane at [email] today"},
+		{"international phone", "Call +55 (11) 98765-4321 after 5pm", "Call [phone] after 5pm"},
+		{"dotted phone", "Office: 555.123.4567", "Office: [phone]"},
+		{"plain ten digits", "cell 5551234567", "cell [phone]"},
+		{"amount kept", "Offered 1500000 in equity", "Offered 1500000 in equity"},
+		{"dates kept", "Interview on 2024-01-15, follow up 15.01.2024", "Interview on 2024-01-15, follow up 15.01.2024"},
+		{"profanity masked whole words only", "What a Damn mess, not a damnation", "What a D*** mess, not a damnation"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, filter.Apply(tt.text))
+		})
+	}
+}
+
+func TestNewFilter_Config(t *testing.T) {
+	filter, err := NewFilter(Config{Rules: []string{"Email"}})
+	require.NoError(t, err)
+	assert.Equal(t, "[email] / 555-123-4567 / damn", filter.Apply("a@b.io / 555-123-4567 / damn"), "only the configured rules run")
+
+	filter, err = NewFilter(Config{Rules: []string{RuleProfanity}, ProfanityWords: []string{"synergy"}})
+	require.NoError(t, err)
+	assert.Equal(t, "s****** and damn", filter.Apply("synergy and damn"))
+
+	_, err = NewFilter(Config{Rules: []string{"address"}})
+	assert.ErrorContains(t, err, `unknown rule "address"`)
+
+	var nilFilter *Filter
+	assert.Equal(t, "a@b.io", nilFilter.Apply("a@b.io"))
+}
@@ -6,10 +6,13 @@ import (
 	"encoding/base64"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/redis/go-redis/v9"
+
+	"woragis-jobs-service/pkg/metrics"
 )
 
 var (
@@ -18,6 +21,9 @@ var (
 	ErrCSRFTokenExpired = errors.New("CSRF token has expired")
 )
 
+// CSRFValidatePath is the endpoint that checks a CSRF token without consuming or rotating it
+const CSRFValidatePath = "/api/v1/csrf-token/validate"
+
 // CSRFConfig holds configuration for CSRF protection
 type CSRFConfig struct {
 	// RedisClient is the Redis client for storing CSRF tokens
@@ -36,6 +42,15 @@ type CSRFConfig struct {
 	ExemptRoutes []string
 	// ExemptMethods is a list of HTTP methods that don't require CSRF protection
 	ExemptMethods []string
+	// Exempt, when set, skips token validation for state-changing requests it returns true for.
+	//
+	// CSRF works because browsers attach ambient credentials (cookies) to cross-site requests.
+	// An Authorization header is never attached automatically: a forged form or image request
+	// cannot set it, and a cross-origin fetch that does must pass a CORS preflight first. A request
+	// that authenticates with a bearer token and carries no cookies therefore cannot be forged, so
+	// requiring a CSRF token only breaks programmatic clients. As soon as a request also carries
+	// cookies it may come from a browser session and must stay protected; see BearerTokenExemption.
+	Exempt func(c *fiber.Ctx) bool
 }
 
 // DefaultCSRFConfig returns default CSRF configuration
@@ -48,8 +63,28 @@ func DefaultCSRFConfig(redisClient *redis.Client, secureCookie bool) CSRFConfig
 		CookieName:   "csrf_token",
 		HeaderName:   "X-CSRF-Token",
 		SecureCookie: secureCookie,
-		ExemptRoutes: []string{"/healthz", "/metrics", "/api/v1/auth/login", "/api/v1/auth/register"},
+		ExemptRoutes: []string{"/healthz", "/metrics", "/api/v1/auth/login", "/api/v1/auth/register",
+			// Worker callbacks authenticate with an API key, not browser cookies
+			"/api/v1/internal/resumes/complete", "/api/v1/internal/resumes/status",
+			// Read-only check; validating it through the middleware would extend the token's TTL
+			CSRFValidatePath},
 		ExemptMethods: []string{"GET", "HEAD", "OPTIONS"},
+		Exempt:        BearerTokenExemption(),
+	}
+}
+
+// BearerTokenExemption returns a CSRFConfig.Exempt predicate that exempts API-token clients:
+// requests with an "Authorization: Bearer" header and no cookies at all. Any cookie, including
+// the CSRF cookie this middleware hands out on GET, marks a browser client, so its state-changing
+// requests still have to present the token.
+func BearerTokenExemption() func(c *fiber.Ctx) bool {
+	return func(c *fiber.Ctx) bool {
+		authHeader := c.Get(fiber.HeaderAuthorization)
+		if len(authHeader) <= len("Bearer ") || !strings.EqualFold(authHeader[:len("Bearer ")], "Bearer ") {
+			return false
+		}
+
+		return len(c.Request().Header.Peek(fiber.HeaderCookie)) == 0
 	}
 }
 
@@ -62,6 +97,11 @@ func generateToken(length int) (string, error) {
 	return base64.URLEncoding.EncodeToString(bytes), nil
 }
 
+// csrfTokenKey is the Redis key a CSRF token is stored under
+func csrfTokenKey(token string) string {
+	return fmt.Sprintf("csrf:token:%s", token)
+}
+
 // CSRFMiddleware creates a CSRF protection middleware
 func CSRFMiddleware(config CSRFConfig) fiber.Handler {
 	// Build exempt methods map for quick lookup
@@ -93,7 +133,7 @@ func CSRFMiddleware(config CSRFConfig) fiber.Handler {
 				defer cancel()
 
 				// Use token itself as key for more reliable validation
-				sessionKey := fmt.Sprintf("csrf:token:%s", token)
+				sessionKey := csrfTokenKey(token)
 				if err := config.RedisClient.Set(ctx, sessionKey, "valid", config.TokenTTL).Err(); err != nil {
 					// Log error but continue (graceful degradation)
 					c.Locals("csrf_error", "Failed to store CSRF token")
@@ -128,6 +168,11 @@ func CSRFMiddleware(config CSRFConfig) fiber.Handler {
 			return c.Next()
 		}
 
+		// Check if the request is exempt (e.g., bearer-token API clients)
+		if config.Exempt != nil && config.Exempt(c) {
+			return c.Next()
+		}
+
 		// Extract token from header or cookie
 		token := c.Get(config.HeaderName)
 		if token == "" {
@@ -136,6 +181,7 @@ func CSRFMiddleware(config CSRFConfig) fiber.Handler {
 		}
 
 		if token == "" {
+			metrics.RecordCSRFFailure(metrics.FailureReasonMissingToken)
 			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
 				"error": ErrCSRFTokenMissing.Error(),
 			})
@@ -147,9 +193,11 @@ func CSRFMiddleware(config CSRFConfig) fiber.Handler {
 			defer cancel()
 
 			// Validate token exists in Redis
-			sessionKey := fmt.Sprintf("csrf:token:%s", token)
+			sessionKey := csrfTokenKey(token)
 			exists, err := config.RedisClient.Exists(ctx, sessionKey).Result()
 			if err == redis.Nil || exists == 0 {
+				// An unknown token is either forged or expired; Redis can't tell them apart
+				metrics.RecordCSRFFailure(metrics.FailureReasonInvalidToken)
 				return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
 					"error": ErrCSRFTokenExpired.Error(),
 				})
@@ -168,3 +216,66 @@ func CSRFMiddleware(config CSRFConfig) fiber.Handler {
 	}
 }
 
+
+type csrfValidatePayload struct {
+	Token string `json:"token"`
+}
+
+// CSRFValidateHandler reports whether a CSRF token is still valid and its remaining TTL
+// in seconds. The token is read from the JSON body ("token"), then the CSRF header, then
+// the cookie. Unlike the middleware it only reads the token's TTL, so the token is
+// neither rotated nor extended.
+func CSRFValidateHandler(config CSRFConfig) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		var payload csrfValidatePayload
+		if len(c.Body()) > 0 {
+			if err := c.BodyParser(&payload); err != nil {
+				return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+					"error": "invalid request payload",
+				})
+			}
+		}
+
+		token := strings.TrimSpace(payload.Token)
+		if token == "" {
+			token = c.Get(config.HeaderName)
+		}
+		if token == "" {
+			token = c.Cookies(config.CookieName)
+		}
+		if token == "" {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": ErrCSRFTokenMissing.Error(),
+			})
+		}
+
+		if config.RedisClient == nil {
+			return c.Status(fiber.StatusServiceUnavailable).JSON(fiber.Map{
+				"error": "CSRF token store is not available",
+			})
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+		defer cancel()
+
+		ttl, err := config.RedisClient.TTL(ctx, csrfTokenKey(token)).Result()
+		if err != nil {
+			return c.Status(fiber.StatusServiceUnavailable).JSON(fiber.Map{
+				"error": "CSRF token store is not available",
+			})
+		}
+
+		// TTL is -2 for a missing key and -1 for a key without expiry
+		valid := ttl > 0 || ttl == -1
+		expiresIn := int64(0)
+		if ttl > 0 {
+			expiresIn = int64(ttl.Seconds())
+		}
+
+		return c.JSON(fiber.Map{
+			"success":          true,
+			"valid":            valid,
+			"expiresInSeconds": expiresIn,
+		})
+	}
+}
@@ -0,0 +1,116 @@
+package security
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newCSRFTestApp mounts the default CSRF middleware without Redis, so any
+// non-empty token passes and only the exemption decision is exercised.
+func newCSRFTestApp() *fiber.App {
+	app := fiber.New()
+	app.Use(CSRFMiddleware(DefaultCSRFConfig(nil, false)))
+	app.Post("/api/v1/job-applications", func(c *fiber.Ctx) error {
+		return c.SendStatus(fiber.StatusCreated)
+	})
+	return app
+}
+
+func TestCSRF_BearerWithoutCookiesIsExempt(t *testing.T) {
+	app := newCSRFTestApp()
+
+	req := httptest.NewRequest("POST", "/api/v1/job-applications", nil)
+	req.Header.Set("Authorization", "Bearer api-token")
+	resp, err := app.Test(req)
+	require.NoError(t, err)
+	assert.Equal(t, fiber.StatusCreated, resp.StatusCode)
+}
+
+func TestCSRF_BearerWithCSRFCookieIsChecked(t *testing.T) {
+	app := newCSRFTestApp()
+
+	req := httptest.NewRequest("POST", "/api/v1/job-applications", nil)
+	req.Header.Set("Authorization", "Bearer api-token")
+	req.Header.Set("Cookie", "csrf_token=")
+	resp, err := app.Test(req)
+	require.NoError(t, err)
+	assert.Equal(t, fiber.StatusForbidden, resp.StatusCode, "a cookie marks a browser client, so the token is required")
+
+	req = httptest.NewRequest("POST", "/api/v1/job-applications", nil)
+	req.Header.Set("Authorization", "Bearer api-token")
+	req.Header.Set("Cookie", "csrf_token=abc")
+	req.Header.Set("X-CSRF-Token", "abc")
+	resp, err = app.Test(req)
+	require.NoError(t, err)
+	assert.Equal(t, fiber.StatusCreated, resp.StatusCode)
+}
+
+func TestCSRF_WithoutBearerIsChecked(t *testing.T) {
+	app := newCSRFTestApp()
+
+	resp, err := app.Test(httptest.NewRequest("POST", "/api/v1/job-applications", nil))
+	require.NoError(t, err)
+	assert.Equal(t, fiber.StatusForbidden, resp.StatusCode)
+
+	req := httptest.NewRequest("POST", "/api/v1/job-applications", nil)
+	req.Header.Set("Authorization", "Basic dXNlcjpwYXNz")
+	resp, err = app.Test(req)
+	require.NoError(t, err)
+	assert.Equal(t, fiber.StatusForbidden, resp.StatusCode)
+
+	req = httptest.NewRequest("POST", "/api/v1/job-applications", nil)
+	req.Header.Set("X-CSRF-Token", "abc")
+	resp, err = app.Test(req)
+	require.NoError(t, err)
+	assert.Equal(t, fiber.StatusCreated, resp.StatusCode)
+}
+
+func TestCSRFValidate_IsExemptFromMiddleware(t *testing.T) {
+	cfg := DefaultCSRFConfig(nil, false)
+	app := fiber.New()
+	app.Use(CSRFMiddleware(cfg))
+	app.Post(CSRFValidatePath, CSRFValidateHandler(cfg))
+
+	// A stale token must reach the handler instead of being rejected by the middleware
+	req := httptest.NewRequest("POST", CSRFValidatePath, strings.NewReader(`{"token":"stale"}`))
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := app.Test(req)
+	require.NoError(t, err)
+	assert.Equal(t, fiber.StatusServiceUnavailable, resp.StatusCode, "without Redis there is no store to check against")
+
+	resp, err = app.Test(httptest.NewRequest("POST", CSRFValidatePath, nil))
+	require.NoError(t, err)
+	assert.Equal(t, fiber.StatusBadRequest, resp.StatusCode)
+}
+
+func TestCSRF_MissingTokenIsCounted(t *testing.T) {
+	missingTokens := func() float64 {
+		families, err := prometheus.DefaultGatherer.Gather()
+		require.NoError(t, err)
+		for _, family := range families {
+			if family.GetName() != "csrf_failures_total" {
+				continue
+			}
+			for _, metric := range family.GetMetric() {
+				for _, label := range metric.GetLabel() {
+					if label.GetName() == "reason" && label.GetValue() == "missing_token" {
+						return metric.GetCounter().GetValue()
+					}
+				}
+			}
+		}
+		return 0
+	}
+	before := missingTokens()
+
+	resp, err := newCSRFTestApp().Test(httptest.NewRequest("POST", "/api/v1/job-applications", nil))
+	require.NoError(t, err)
+	assert.Equal(t, fiber.StatusForbidden, resp.StatusCode)
+	assert.Equal(t, before+1, missingTokens())
+}
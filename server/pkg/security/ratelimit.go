@@ -1,11 +1,16 @@
 package security
 
 import (
+	"context"
 	"fmt"
+	"math"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/limiter"
+	"github.com/redis/go-redis/v9"
 )
 
 // RateLimitMiddleware creates a rate limiter middleware
@@ -37,3 +42,165 @@ func RateLimitMiddleware(maxRequests int, window time.Duration) fiber.Handler {
 	})
 }
 
+
+// RouteWeight assigns a rate limit cost to requests matching Method and Pattern.
+// Pattern segments starting with ':' match any single path segment, and a
+// trailing '*' matches the rest of the path.
+type RouteWeight struct {
+	Method  string
+	Pattern string
+	Weight  int
+}
+
+// WeightedRateLimitConfig holds configuration for the weighted rate limiter
+type WeightedRateLimitConfig struct {
+	// RedisClient stores the per-window counters; nil disables the limiter
+	RedisClient *redis.Client
+	// Budget is the number of weight units a client may spend per window
+	Budget int
+	// Window is the length of each fixed counting window
+	Window time.Duration
+	// DefaultWeight is charged for requests that match no route weight
+	DefaultWeight int
+	// Weights lists route-specific costs; the first match wins
+	Weights []RouteWeight
+}
+
+// weightedIncrScript charges the cost only if it fits in the remaining budget,
+// so a rejected expensive call does not eat into the budget for cheap reads.
+// It returns {allowed, used, ttl in ms}.
+var weightedIncrScript = redis.NewScript(`
+local used = tonumber(redis.call("GET", KEYS[1]) or "0")
+local cost = tonumber(ARGV[1])
+if used + cost > tonumber(ARGV[2]) then
+	return {0, used, redis.call("PTTL", KEYS[1])}
+end
+used = redis.call("INCRBY", KEYS[1], cost)
+if used == cost then
+	redis.call("PEXPIRE", KEYS[1], ARGV[3])
+end
+return {1, used, redis.call("PTTL", KEYS[1])}
+`)
+
+// WeightOf returns the cost of a request, falling back to the default weight.
+func (cfg WeightedRateLimitConfig) WeightOf(method, path string) int {
+	for _, rw := range cfg.Weights {
+		if strings.EqualFold(rw.Method, method) && MatchRoutePattern(rw.Pattern, path) {
+			return rw.Weight
+		}
+	}
+	return cfg.DefaultWeight
+}
+
+// MatchRoutePattern reports whether path matches a route pattern such as
+// "/api/v1/job-applications/:id/offer", where ":name" matches any one segment
+// and a trailing "*" matches the rest of the path.
+func MatchRoutePattern(pattern, path string) bool {
+	patternSegments := strings.Split(strings.Trim(pattern, "/"), "/")
+	pathSegments := strings.Split(strings.Trim(path, "/"), "/")
+	for i, segment := range patternSegments {
+		if segment == "*" && i == len(patternSegments)-1 {
+			return true
+		}
+		if i >= len(pathSegments) {
+			return false
+		}
+		if strings.HasPrefix(segment, ":") {
+			continue
+		}
+		if segment != pathSegments[i] {
+			return false
+		}
+	}
+	return len(patternSegments) == len(pathSegments)
+}
+
+// WeightedRateLimitMiddleware creates a rate limiter where each request spends
+// its route weight from a shared per-user budget, so a client can make many
+// cheap reads but only a few expensive AI generations per window.
+// It must run after authentication so the budget is tracked per user.
+// Redis errors let the request through (graceful degradation).
+func WeightedRateLimitMiddleware(config WeightedRateLimitConfig) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if config.RedisClient == nil || config.Budget <= 0 || config.Window <= 0 {
+			return c.Next()
+		}
+
+		cost := config.WeightOf(c.Method(), c.Path())
+		if cost <= 0 {
+			return c.Next()
+		}
+
+		identity := c.IP()
+		if userID := c.Locals("userID"); userID != nil {
+			identity = fmt.Sprintf("user:%v", userID)
+		}
+		window := time.Now().UnixMilli() / config.Window.Milliseconds()
+		key := fmt.Sprintf("ratelimit:weighted:%s:%d", identity, window)
+
+		allowed, used, ttl, err := config.spend(c.UserContext(), key, cost)
+		if err != nil {
+			c.Locals("ratelimit_warning", "Weighted rate limit skipped due to Redis error")
+			return c.Next()
+		}
+		remaining := int64(config.Budget) - used
+		if remaining < 0 {
+			remaining = 0
+		}
+		c.Set("X-RateLimit-Limit", strconv.Itoa(config.Budget))
+		c.Set("X-RateLimit-Remaining", strconv.FormatInt(remaining, 10))
+		c.Set("X-RateLimit-Cost", strconv.Itoa(cost))
+
+		if !allowed {
+			retryAfter := int64(math.Ceil(float64(ttl) / 1000))
+			if retryAfter < 1 {
+				retryAfter = 1
+			}
+			c.Set(fiber.HeaderRetryAfter, strconv.FormatInt(retryAfter, 10))
+			return c.Status(fiber.StatusTooManyRequests).JSON(fiber.Map{
+				"error":   "Rate limit exceeded",
+				"message": fmt.Sprintf("Request costs %d of %d units per %v; %d remaining", cost, config.Budget, config.Window, remaining),
+			})
+		}
+
+		c.Locals(rateLimitChargerKey, RateLimitCharger(func(ctx context.Context, method, path string) bool {
+			allowed, _, _, err := config.spend(ctx, key, config.WeightOf(method, path))
+			return allowed || err != nil
+		}))
+		return c.Next()
+	}
+}
+
+// spend charges cost units against the counter at key, returning whether they fit in the
+// budget along with the units used and the window's remaining time in milliseconds.
+func (cfg WeightedRateLimitConfig) spend(ctx context.Context, key string, cost int) (bool, int64, int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, 2*time.Second)
+	defer cancel()
+	result, err := weightedIncrScript.Run(ctx, cfg.RedisClient, []string{key},
+		cost, cfg.Budget, cfg.Window.Milliseconds()).Int64Slice()
+	if err != nil {
+		return false, 0, 0, err
+	}
+	if len(result) != 3 {
+		return false, 0, 0, fmt.Errorf("weighted rate limit script returned %d values", len(result))
+	}
+	return result[0] == 1, result[1], result[2], nil
+}
+
+// rateLimitChargerKey is the fiber.Ctx local holding the request's RateLimitCharger.
+const rateLimitChargerKey = "ratelimit_charger"
+
+// RateLimitCharger spends the weight of a request to method and path from the current
+// caller's budget and reports whether it fit. Redis errors allow the charge. It is safe
+// to call from other goroutines and after the handler returns.
+type RateLimitCharger func(ctx context.Context, method, path string) bool
+
+// RateLimitChargerFrom returns the charger the weighted rate limiter attached to c, so a
+// handler doing the work of several requests, such as a batch, can charge each of them.
+// Without an active weighted limiter every charge is allowed.
+func RateLimitChargerFrom(c *fiber.Ctx) RateLimitCharger {
+	if charger, ok := c.Locals(rateLimitChargerKey).(RateLimitCharger); ok {
+		return charger
+	}
+	return func(context.Context, string, string) bool { return true }
+}
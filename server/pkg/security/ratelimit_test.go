@@ -0,0 +1,79 @@
+package security
+
+import (
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatchRoutePattern(t *testing.T) {
+	tests := []struct {
+		pattern, path string
+		want          bool
+	}{
+		{"/api/v1/resumes/generate", "/api/v1/resumes/generate", true},
+		{"/api/v1/resumes/generate", "/api/v1/resumes/generate/", true},
+		{"/api/v1/resumes/generate", "/api/v1/resumes", false},
+		{"/api/v1/resumes/generate", "/api/v1/resumes/generate/extra", false},
+		{"/api/v1/job-applications/:id/cover-letter", "/api/v1/job-applications/42/cover-letter", true},
+		{"/api/v1/job-applications/:id/cover-letter", "/api/v1/job-applications/cover-letter", false},
+		{"/api/v1/job-applications/:id", "/api/v1/job-applications/42/offer", false},
+		{"/api/v1/ai/*", "/api/v1/ai/chat/stream", true},
+		{"/api/v1/ai/*", "/api/v1/ai", true},
+		{"/api/v1/ai/*", "/api/v1/other", false},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, MatchRoutePattern(tt.pattern, tt.path), "%s ~ %s", tt.pattern, tt.path)
+	}
+}
+
+func TestWeightedRateLimitConfig_WeightOf(t *testing.T) {
+	cfg := WeightedRateLimitConfig{
+		DefaultWeight: 1,
+		Weights: []RouteWeight{
+			{Method: "POST", Pattern: "/api/v1/resumes/generate", Weight: 20},
+			{Method: "POST", Pattern: "/api/v1/resumes/*", Weight: 5},
+		},
+	}
+
+	assert.Equal(t, 20, cfg.WeightOf("post", "/api/v1/resumes/generate"), "first match wins, method is case-insensitive")
+	assert.Equal(t, 5, cfg.WeightOf("POST", "/api/v1/resumes/upload"))
+	assert.Equal(t, 1, cfg.WeightOf("GET", "/api/v1/resumes/generate"), "other methods fall back to the default")
+}
+
+func TestWeightedRateLimitMiddleware_DisabledWithoutRedis(t *testing.T) {
+	app := fiber.New()
+	app.Use(WeightedRateLimitMiddleware(WeightedRateLimitConfig{
+		Budget:        1,
+		Window:        time.Minute,
+		DefaultWeight: 10,
+	}))
+	app.Get("/api/v1/resumes", func(c *fiber.Ctx) error {
+		return c.SendStatus(fiber.StatusOK)
+	})
+
+	resp, err := app.Test(httptest.NewRequest("GET", "/api/v1/resumes", nil))
+	require.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	assert.Empty(t, resp.Header.Get("X-RateLimit-Limit"))
+}
+
+func TestRateLimitChargerFrom_AllowsWithoutLimiter(t *testing.T) {
+	app := fiber.New()
+	app.Get("/", func(c *fiber.Ctx) error {
+		charge := RateLimitChargerFrom(c)
+		if !charge(c.Context(), fiber.MethodPost, "/api/v1/resumes/generate") {
+			return c.SendStatus(fiber.StatusTooManyRequests)
+		}
+		return c.SendStatus(fiber.StatusOK)
+	})
+
+	resp, err := app.Test(httptest.NewRequest("GET", "/", nil))
+	require.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+}
@@ -8,11 +8,24 @@ import (
 	"github.com/gofiber/fiber/v2"
 )
 
-// RequestSizeLimitMiddleware limits request body size
+// DefaultJSONBodyLimit is the body size limit for JSON CRUD endpoints (64KB)
+const DefaultJSONBodyLimit = 64 * 1024
+
+// RequestSizeLimitMiddleware limits request body size for every request.
+// It acts as the outer ceiling; use RequestSizeLimitFor to narrow it per route or group.
 func RequestSizeLimitMiddleware(maxSize int64) fiber.Handler {
+	return RequestSizeLimitFor(maxSize)
+}
+
+// RequestSizeLimitFor limits the request body size of the routes it is applied to,
+// e.g. api.Group("/items", RequestSizeLimitFor(DefaultJSONBodyLimit)) or
+// api.Post("/upload", RequestSizeLimitFor(5*1024*1024), handler).
+// Both the declared Content-Length and the received body are checked, so chunked
+// requests without a Content-Length are limited too.
+func RequestSizeLimitFor(maxSize int64) fiber.Handler {
 	return func(c *fiber.Ctx) error {
 		contentLength := int64(c.Request().Header.ContentLength())
-		if contentLength > maxSize {
+		if contentLength > maxSize || int64(len(c.Request().Body())) > maxSize {
 			return c.Status(fiber.StatusRequestEntityTooLarge).JSON(fiber.Map{
 				"error": "Request body too large",
 				"message": fmt.Sprintf("Maximum request size is %d bytes", maxSize),
@@ -22,12 +35,48 @@ func RequestSizeLimitMiddleware(maxSize int64) fiber.Handler {
 	}
 }
 
-// InputSanitizationMiddleware sanitizes input to prevent injection attacks
-func InputSanitizationMiddleware() fiber.Handler {
+// InputSanitizationConfig holds configuration for input sanitization
+type InputSanitizationConfig struct {
+	// ExemptFields lists query parameters whose values are passed through untouched.
+	// Use it for long-form text such as job descriptions or cover letters, where
+	// whitespace and formatting are part of the content. Exempt fields are not
+	// unchecked: domain validation still applies to them.
+	ExemptFields []string
+	// ExemptRoutes is a list of routes whose requests are not sanitized at all
+	ExemptRoutes []string
+}
+
+// DefaultInputSanitizationConfig returns default input sanitization configuration
+func DefaultInputSanitizationConfig() InputSanitizationConfig {
+	return InputSanitizationConfig{
+		ExemptFields: []string{"jobDescription", "coverLetter"},
+	}
+}
+
+// InputSanitizationMiddleware sanitizes query parameters to prevent injection attacks.
+// Request bodies are left to the handlers' payload validation.
+func InputSanitizationMiddleware(config InputSanitizationConfig) fiber.Handler {
+	exemptFields := make(map[string]bool)
+	for _, field := range config.ExemptFields {
+		exemptFields[field] = true
+	}
+
+	exemptRoutes := make(map[string]bool)
+	for _, route := range config.ExemptRoutes {
+		exemptRoutes[route] = true
+	}
+
 	return func(c *fiber.Ctx) error {
+		if exemptRoutes[c.Path()] {
+			return c.Next()
+		}
+
 		// Sanitize query parameters
 		queries := c.Queries()
 		for key, value := range queries {
+			if exemptFields[key] {
+				continue
+			}
 			sanitized := SanitizeString(value)
 			if sanitized != value {
 				// Update query parameter if sanitization changed it
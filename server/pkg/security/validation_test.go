@@ -0,0 +1,42 @@
+package security
+
+import (
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInputSanitizationMiddleware(t *testing.T) {
+	app := fiber.New()
+	app.Use(InputSanitizationMiddleware(InputSanitizationConfig{
+		ExemptFields: []string{"jobDescription"},
+		ExemptRoutes: []string{"/raw"},
+	}))
+	echo := func(c *fiber.Ctx) error {
+		return c.SendString(c.Query("q") + "|" + c.Query("jobDescription") + "|" + string(c.Body()))
+	}
+	app.Post("/items", echo)
+	app.Post("/raw", echo)
+
+	body := `{"notes":"  keep\u0007 me  "}`
+	query := "?q=%20%20hello%07%20&jobDescription=%20%20indented%0Atext%20"
+
+	resp, err := app.Test(httptest.NewRequest("POST", "/items"+query, strings.NewReader(body)))
+	require.NoError(t, err)
+	got, _ := io.ReadAll(resp.Body)
+	assert.Equal(t, "hello|  indented\ntext |"+body, string(got), "only non-exempt query params are sanitized; bodies are untouched")
+
+	resp, err = app.Test(httptest.NewRequest("POST", "/raw"+query, strings.NewReader(body)))
+	require.NoError(t, err)
+	got, _ = io.ReadAll(resp.Body)
+	assert.Equal(t, "  hello\a |  indented\ntext |"+body, string(got))
+}
+
+func TestSanitizeString(t *testing.T) {
+	assert.Equal(t, "line one\nline\ttwo", SanitizeString("  line\x00 one\nline\ttwo\x1b  "))
+}
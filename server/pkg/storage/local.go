@@ -0,0 +1,91 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// LocalBackend stores objects as files under a base directory
+type LocalBackend struct {
+	baseDir string
+}
+
+// NewLocalBackend creates a local-disk backend rooted at baseDir, creating it if needed
+func NewLocalBackend(baseDir string) (*LocalBackend, error) {
+	if baseDir == "" {
+		baseDir = "."
+	}
+	if err := os.MkdirAll(baseDir, 0755); err != nil {
+		return nil, fmt.Errorf("storage: create base directory: %w", err)
+	}
+	return &LocalBackend{baseDir: baseDir}, nil
+}
+
+func (b *LocalBackend) path(key string) (string, error) {
+	cleaned, err := CleanKey(key)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(b.baseDir, filepath.FromSlash(cleaned)), nil
+}
+
+// Put writes the object to disk via a temporary file so readers never see a partial write
+func (b *LocalBackend) Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error {
+	fullPath, err := b.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(fullPath), 0755); err != nil {
+		return fmt.Errorf("storage: create directory: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(fullPath), ".upload-*")
+	if err != nil {
+		return fmt.Errorf("storage: create temp file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := io.Copy(tmp, r); err != nil {
+		tmp.Close()
+		return fmt.Errorf("storage: write file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("storage: close file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), fullPath); err != nil {
+		return fmt.Errorf("storage: move file into place: %w", err)
+	}
+	return nil
+}
+
+// Get opens the file stored under key
+func (b *LocalBackend) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	fullPath, err := b.path(key)
+	if err != nil {
+		return nil, err
+	}
+	file, err := os.Open(fullPath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("storage: open file: %w", err)
+	}
+	return file, nil
+}
+
+// Delete removes the file stored under key
+func (b *LocalBackend) Delete(ctx context.Context, key string) error {
+	fullPath, err := b.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(fullPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("storage: delete file: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"path"
+	"strings"
+)
+
+// ErrNotFound is returned when no object exists for a key
+var ErrNotFound = errors.New("storage: object not found")
+
+// ErrInvalidKey is returned for empty keys or keys that try to escape the storage root
+var ErrInvalidKey = errors.New("storage: invalid key")
+
+// Backend stores and retrieves files by opaque key
+type Backend interface {
+	// Put writes size bytes from r under key, replacing any existing object
+	Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error
+	// Get opens the object stored under key. The caller must close the reader.
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	// Delete removes the object stored under key. Deleting a missing key is not an error.
+	Delete(ctx context.Context, key string) error
+}
+
+// CleanKey normalizes a key to a slash-separated relative path and rejects
+// keys that are empty or contain ".." segments
+func CleanKey(key string) (string, error) {
+	key = strings.Trim(strings.ReplaceAll(strings.TrimSpace(key), "\\", "/"), "/")
+	if key == "" {
+		return "", ErrInvalidKey
+	}
+	for _, segment := range strings.Split(key, "/") {
+		if segment == ".." {
+			return "", ErrInvalidKey
+		}
+	}
+	return path.Clean(key), nil
+}
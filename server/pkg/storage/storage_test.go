@@ -0,0 +1,139 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCleanKey(t *testing.T) {
+	key, err := CleanKey("uploads/123_resume.pdf")
+	require.NoError(t, err)
+	assert.Equal(t, "uploads/123_resume.pdf", key)
+
+	key, err = CleanKey("/uploads//a.pdf")
+	require.NoError(t, err)
+	assert.Equal(t, "uploads/a.pdf", key)
+
+	for _, bad := range []string{"", "   ", "/", "../etc/passwd", "uploads/../../secret", "uploads\\..\\x"} {
+		_, err := CleanKey(bad)
+		assert.ErrorIs(t, err, ErrInvalidKey, bad)
+	}
+}
+
+func TestLocalBackend_RoundTrip(t *testing.T) {
+	ctx := context.Background()
+	backend, err := NewLocalBackend(t.TempDir())
+	require.NoError(t, err)
+
+	content := []byte("%PDF-1.4 test")
+	require.NoError(t, backend.Put(ctx, "uploads/a.pdf", bytes.NewReader(content), int64(len(content)), "application/pdf"))
+
+	reader, err := backend.Get(ctx, "uploads/a.pdf")
+	require.NoError(t, err)
+	got, err := io.ReadAll(reader)
+	reader.Close()
+	require.NoError(t, err)
+	assert.Equal(t, content, got)
+
+	require.NoError(t, backend.Delete(ctx, "uploads/a.pdf"))
+	_, err = backend.Get(ctx, "uploads/a.pdf")
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	assert.NoError(t, backend.Delete(ctx, "uploads/a.pdf"), "deleting a missing key is not an error")
+}
+
+func TestLocalBackend_RejectsTraversal(t *testing.T) {
+	backend, err := NewLocalBackend(t.TempDir())
+	require.NoError(t, err)
+
+	_, err = backend.Get(context.Background(), "../outside.pdf")
+	assert.ErrorIs(t, err, ErrInvalidKey)
+}
+
+// fakeS3 is a minimal in-memory S3 object endpoint
+type fakeS3 struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+	auth    []string
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.auth = append(f.auth, r.Header.Get("Authorization"))
+
+	switch r.Method {
+	case http.MethodPut:
+		body, _ := io.ReadAll(r.Body)
+		f.objects[r.URL.Path] = body
+		w.WriteHeader(http.StatusOK)
+	case http.MethodGet:
+		body, ok := f.objects[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write(body)
+	case http.MethodDelete:
+		delete(f.objects, r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func TestS3Backend_RoundTrip(t *testing.T) {
+	fake := &fakeS3{objects: map[string][]byte{}}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	backend, err := NewS3Backend(S3Config{
+		Endpoint:        server.URL,
+		Region:          "eu-west-1",
+		Bucket:          "resumes",
+		AccessKeyID:     "AKIDEXAMPLE",
+		SecretAccessKey: "secret",
+		UsePathStyle:    true,
+	})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	content := []byte("resume bytes")
+	require.NoError(t, backend.Put(ctx, "uploads/my resume.pdf", bytes.NewReader(content), int64(len(content)), "application/pdf"))
+	assert.Contains(t, fake.objects, "/resumes/uploads/my resume.pdf")
+
+	reader, err := backend.Get(ctx, "uploads/my resume.pdf")
+	require.NoError(t, err)
+	got, _ := io.ReadAll(reader)
+	reader.Close()
+	assert.Equal(t, content, got)
+
+	require.NoError(t, backend.Delete(ctx, "uploads/my resume.pdf"))
+	_, err = backend.Get(ctx, "uploads/my resume.pdf")
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	for _, auth := range fake.auth {
+		assert.True(t, strings.HasPrefix(auth, "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/"), auth)
+		assert.Contains(t, auth, "/eu-west-1/s3/aws4_request")
+		assert.Contains(t, auth, "SignedHeaders=host;x-amz-content-sha256;x-amz-date")
+	}
+}
+
+func TestNewS3Backend_Validation(t *testing.T) {
+	_, err := NewS3Backend(S3Config{Endpoint: "http://minio:9000", AccessKeyID: "a", SecretAccessKey: "b"})
+	assert.Error(t, err, "bucket is required")
+
+	_, err = NewS3Backend(S3Config{Endpoint: "minio:9000", Bucket: "x", AccessKeyID: "a", SecretAccessKey: "b"})
+	assert.Error(t, err, "endpoint needs a scheme")
+}
+
+func TestURIEncodePath(t *testing.T) {
+	assert.Equal(t, "/bucket/uploads/my%20resume%2B1.pdf", uriEncodePath("/bucket/uploads/my resume+1.pdf"))
+}
@@ -2,17 +2,29 @@ package timeout
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"github.com/gofiber/fiber/v2"
 
 	appmetrics "woragis-jobs-service/pkg/metrics"
+	"woragis-jobs-service/pkg/security"
 )
 
+// RouteTimeout overrides the default timeout for one route
+type RouteTimeout struct {
+	Method string
+	// Pattern is a route pattern such as "/api/v1/job-applications/:id/generate-cover-letter"
+	Pattern string
+	Timeout time.Duration
+}
+
 // Config holds timeout configuration
 type Config struct {
 	// DefaultTimeout is the default timeout for requests
 	DefaultTimeout time.Duration
+	// Routes lists per-route timeouts; the first match wins
+	Routes []RouteTimeout
 	// HandlerTimeoutMsg is the message returned when timeout is exceeded
 	HandlerTimeoutMsg string
 	// OnTimeout is called when a timeout occurs
@@ -28,6 +40,16 @@ func DefaultConfig() Config {
 	}
 }
 
+// TimeoutFor returns the timeout for a request, falling back to DefaultTimeout
+func (cfg Config) TimeoutFor(method, path string) time.Duration {
+	for _, route := range cfg.Routes {
+		if strings.EqualFold(route.Method, method) && security.MatchRoutePattern(route.Pattern, path) {
+			return route.Timeout
+		}
+	}
+	return cfg.DefaultTimeout
+}
+
 // Middleware creates a timeout middleware that sets a context timeout for requests
 func Middleware(cfg Config) fiber.Handler {
 	if cfg.DefaultTimeout <= 0 {
@@ -39,7 +61,7 @@ func Middleware(cfg Config) fiber.Handler {
 
 	return func(c *fiber.Ctx) error {
 		// Create context with timeout
-		ctx, cancel := context.WithTimeout(c.UserContext(), cfg.DefaultTimeout)
+		ctx, cancel := context.WithTimeout(c.UserContext(), cfg.TimeoutFor(c.Method(), c.Path()))
 		defer cancel()
 
 		// Set the context in the request
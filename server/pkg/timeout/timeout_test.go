@@ -46,6 +46,31 @@ func TestMiddleware_WithinTimeout(t *testing.T) {
 	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
 }
 
+func TestMiddleware_RouteTimeoutOverridesDefault(t *testing.T) {
+	app := fiber.New()
+	cfg := DefaultConfig()
+	cfg.DefaultTimeout = 100 * time.Millisecond
+	cfg.Routes = []RouteTimeout{
+		{Method: "POST", Pattern: "/api/v1/job-applications/:id/generate-cover-letter", Timeout: time.Second},
+	}
+	app.Use(Middleware(cfg))
+
+	slow := func(c *fiber.Ctx) error {
+		time.Sleep(200 * time.Millisecond)
+		return c.JSON(fiber.Map{"message": "success"})
+	}
+	app.Post("/api/v1/job-applications/:id/generate-cover-letter", slow)
+	app.Post("/api/v1/job-applications/:id/snooze", slow)
+
+	resp, err := app.Test(httptest.NewRequest("POST", "/api/v1/job-applications/abc/generate-cover-letter", nil))
+	require.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+	resp, err = app.Test(httptest.NewRequest("POST", "/api/v1/job-applications/abc/snooze", nil))
+	require.NoError(t, err)
+	assert.Equal(t, fiber.StatusRequestTimeout, resp.StatusCode)
+}
+
 func TestWithTimeout_Success(t *testing.T) {
 	err := WithTimeout(context.Background(), 1*time.Second, func(ctx context.Context) error {
 		time.Sleep(100 * time.Millisecond)
@@ -35,6 +35,7 @@ var (
 type Config struct {
 	ServiceName    string
 	ServiceVersion string
+	ServiceCommit  string // Optional VCS revision of the build
 	Environment    string
 	JaegerEndpoint string
 	SamplingRate   float64 // 0.0 to 1.0 (1.0 = 100%)
@@ -112,13 +113,17 @@ func Init(cfg Config) (func(), error) {
 	resourceCtx, resourceCancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer resourceCancel()
 	
+	attributes := []attribute.KeyValue{
+		semconv.ServiceNameKey.String(cfg.ServiceName),
+		semconv.ServiceVersionKey.String(cfg.ServiceVersion),
+		attribute.String("environment", cfg.Environment),
+	}
+	if cfg.ServiceCommit != "" {
+		attributes = append(attributes, attribute.String("service.commit", cfg.ServiceCommit))
+	}
 	res, err := resource.New(
 		resourceCtx,
-		resource.WithAttributes(
-			semconv.ServiceNameKey.String(cfg.ServiceName),
-			semconv.ServiceVersionKey.String(cfg.ServiceVersion),
-			attribute.String("environment", cfg.Environment),
-		),
+		resource.WithAttributes(attributes...),
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create resource: %w", err)
@@ -0,0 +1,95 @@
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync/atomic"
+
+	"woragis-jobs-service/pkg/response"
+)
+
+const (
+	// DefaultMaxTags is how many tags one application or resume may carry unless configured otherwise
+	DefaultMaxTags = 25
+	// DefaultMaxTagLength is the longest tag accepted unless configured otherwise
+	DefaultMaxTagLength = 50
+)
+
+// TagLimits bounds the tags stored on one application or resume.
+type TagLimits struct {
+	MaxTags      int
+	MaxTagLength int
+}
+
+var tagLimits atomic.Pointer[TagLimits]
+
+func init() {
+	tagLimits.Store(&TagLimits{MaxTags: DefaultMaxTags, MaxTagLength: DefaultMaxTagLength})
+}
+
+// SetTagLimits replaces the service-wide tag limits. It is meant to be called once at startup.
+func SetTagLimits(limits TagLimits) error {
+	if limits.MaxTags < 1 {
+		return errors.New("validation: max tags must be at least 1")
+	}
+	if limits.MaxTagLength < 1 {
+		return errors.New("validation: max tag length must be at least 1")
+	}
+	tagLimits.Store(&limits)
+	return nil
+}
+
+// CurrentTagLimits returns the tag limits in effect.
+func CurrentTagLimits() TagLimits {
+	return *tagLimits.Load()
+}
+
+// TagError reports tags that break the tag rules. Handlers answer it with a 422.
+type TagError struct {
+	Message string
+}
+
+func (e *TagError) Error() string {
+	return e.Message
+}
+
+// ErrorKind classifies the error for response.FromDomainError.
+func (e *TagError) ErrorKind() response.ErrorKind {
+	return response.KindValidation
+}
+
+// ValidateTags trims every tag and checks the list against CurrentTagLimits. Empty tags,
+// tags longer than MaxTagLength and lists longer than MaxTags are reported as a TagError.
+// The trimmed tags are returned in their original order.
+func ValidateTags(tags []string) ([]string, error) {
+	limits := CurrentTagLimits()
+	if len(tags) > limits.MaxTags {
+		return nil, &TagError{Message: fmt.Sprintf("tags: too many tags (maximum %d)", limits.MaxTags)}
+	}
+
+	trimmed := make([]string, len(tags))
+	for i, tag := range tags {
+		tag = strings.TrimSpace(tag)
+		if tag == "" {
+			return nil, &TagError{Message: fmt.Sprintf("tags[%d]: must not be empty", i)}
+		}
+		if len(tag) > limits.MaxTagLength {
+			return nil, &TagError{Message: fmt.Sprintf("tags[%d]: must be at most %d characters", i, limits.MaxTagLength)}
+		}
+		trimmed[i] = tag
+	}
+	return trimmed, nil
+}
+
+// ValidateTag trims a single tag, as added by bulk tag endpoints, and checks its length.
+func ValidateTag(tag string) (string, error) {
+	tag = strings.TrimSpace(tag)
+	if tag == "" {
+		return "", &TagError{Message: "tag: must not be empty"}
+	}
+	if maxLength := CurrentTagLimits().MaxTagLength; len(tag) > maxLength {
+		return "", &TagError{Message: fmt.Sprintf("tag: must be at most %d characters", maxLength)}
+	}
+	return tag, nil
+}
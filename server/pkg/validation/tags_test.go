@@ -0,0 +1,70 @@
+package validation
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"woragis-jobs-service/pkg/response"
+)
+
+func withTagLimits(t *testing.T, limits TagLimits) {
+	t.Helper()
+	previous := CurrentTagLimits()
+	require.NoError(t, SetTagLimits(limits))
+	t.Cleanup(func() { _ = SetTagLimits(previous) })
+}
+
+func TestValidateTags_Boundaries(t *testing.T) {
+	withTagLimits(t, TagLimits{MaxTags: 3, MaxTagLength: 5})
+
+	tags, err := ValidateTags([]string{" go ", "rust", "abcde"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"go", "rust", "abcde"}, tags, "exactly at both limits, trimmed")
+
+	_, err = ValidateTags([]string{"a", "b", "c", "d"})
+	assert.EqualError(t, err, "tags: too many tags (maximum 3)")
+
+	_, err = ValidateTags([]string{"go", "abcdef"})
+	assert.EqualError(t, err, "tags[1]: must be at most 5 characters")
+
+	_, err = ValidateTags([]string{"go", "   "})
+	assert.EqualError(t, err, "tags[1]: must not be empty")
+
+	var tagErr *TagError
+	require.ErrorAs(t, err, &tagErr)
+	assert.Equal(t, response.KindValidation, tagErr.ErrorKind())
+}
+
+func TestValidateTags_Defaults(t *testing.T) {
+	tags := make([]string, DefaultMaxTags)
+	for i := range tags {
+		tags[i] = strings.Repeat("x", DefaultMaxTagLength)
+	}
+	_, err := ValidateTags(tags)
+	require.NoError(t, err)
+
+	_, err = ValidateTags(append(tags, "one-more"))
+	assert.Error(t, err)
+}
+
+func TestValidateTag(t *testing.T) {
+	withTagLimits(t, TagLimits{MaxTags: 3, MaxTagLength: 5})
+
+	tag, err := ValidateTag("  go ")
+	require.NoError(t, err)
+	assert.Equal(t, "go", tag)
+
+	_, err = ValidateTag("abcdef")
+	assert.EqualError(t, err, "tag: must be at most 5 characters")
+
+	_, err = ValidateTag(" ")
+	assert.EqualError(t, err, "tag: must not be empty")
+}
+
+func TestSetTagLimits_RejectsNonPositive(t *testing.T) {
+	assert.Error(t, SetTagLimits(TagLimits{MaxTags: 0, MaxTagLength: 10}))
+	assert.Error(t, SetTagLimits(TagLimits{MaxTags: 10, MaxTagLength: 0}))
+}